@@ -61,7 +61,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsAppCss = "\x62\x6f\x64\x79\x20\x7b\x0a\x09\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x64\x64\x64\x3b\x0a\x09\x66\x6f\x6e\x74\x2d\x66\x61\x6d\x69\x6c\x79\x3a\x20\x48\x65\x6c\x76\x65\x74\x69\x63\x61\x2c\x20\x41\x72\x69\x61\x6c\x3b\x0a\x7d\x0a\x0a\x68\x31\x20\x7b\x0a\x09\x66\x6c\x6f\x61\x74\x3a\x72\x69\x67\x68\x74\x3b\x0a\x09\x63\x6f\x6c\x6f\x72\x3a\x23\x61\x61\x61\x3b\x0a\x09\x66\x6f\x6e\x74\x2d\x73\x74\x79\x6c\x65\x3a\x20\x69\x74\x61\x6c\x69\x63\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x2d\x72\x69\x67\x68\x74\x3a\x31\x35\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x77\x65\x62\x73\x6f\x63\x6b\x42\x72\x6f\x6b\x65\x6e\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x72\x65\x64\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x77\x68\x69\x74\x65\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x62\x6f\x6c\x64\x3b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x63\x65\x6e\x74\x65\x72\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x32\x65\x6d\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x31\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x6e\x6f\x6a\x73\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x6f\x72\x61\x6e\x67\x65\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x77\x68\x69\x74\x65\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x62\x6f\x6c\x64\x3b\x0a\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x63\x65\x6e\x74\x65\x72\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x32\x65\x6d\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x31\x35\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x7b\x0a\x20\x20\x2f\x2a\x62\x6f\x72\x64\x65\x72\x3a\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x62\x6c\x75\x65\x3b\x2a\x2f\x0a\x7d\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x75\x6c\x20\x7b\x0a\x09\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x6c\x69\x2e\x67\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x20\x20\x63\x75\x72\x73\x6f\x72\x3a\x20\x70\x6f\x69\x6e\x74\x65\x72\x3b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x20\x20\x77\x69\x64\x74\x68\x3a\x38\x65\x6d\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x65\x65\x65\x3b\x0a\x7d\x0a\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x7b\x0a\x20\x20\x2f\x2a\x62\x6f\x72\x64\x65\x72\x3a\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x67\x72\x65\x65\x6e\x3b\x2a\x2f\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x64\x69\x76\x2e\x63\x47\x72\x6f\x75\x70\x20\x7b\x0a\x09\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x75\x6c\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x6c\x69\x2e\x63\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x09\x77\x69\x64\x74\x68\x3a\x38\x65\x6d\x3b\x0a\x09\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x6c\x69\x2e\x4e\x4f\x4e\x45\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x61\x61\x61\x3b\x0a\x7d\x20\x0a\x6c\x69\x2e\x57\x41\x49\x54\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x39\x39\x63\x3b\x0a\x7d\x20\x0a\x6c\x69\x2e\x42\x55\x53\x59\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x39\x63\x63\x3b\x0a\x7d\x20\x0a\x6c\x69\x2e\x45\x52\x52\x4f\x52\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x63\x39\x39\x3b\x0a\x7d\x0a\x6c\x69\x2e\x44\x4f\x4e\x45\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x39\x63\x39\x3b\x0a\x7d\x0a\x0a\x23\x6c\x65\x67\x65\x6e\x64\x20\x75\x6c\x20\x7b\x0a\x09\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x6c\x65\x67\x65\x6e\x64\x20\x6c\x69\x2e\x63\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x09\x77\x69\x64\x74\x68\x3a\x35\x65\x6d\x3b\x0a\x09\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a"
+var _assetsAppCss = "\x62\x6f\x64\x79\x20\x7b\x0a\x09\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x64\x64\x64\x3b\x0a\x09\x66\x6f\x6e\x74\x2d\x66\x61\x6d\x69\x6c\x79\x3a\x20\x48\x65\x6c\x76\x65\x74\x69\x63\x61\x2c\x20\x41\x72\x69\x61\x6c\x3b\x0a\x7d\x0a\x0a\x68\x31\x20\x7b\x0a\x09\x66\x6c\x6f\x61\x74\x3a\x72\x69\x67\x68\x74\x3b\x0a\x09\x63\x6f\x6c\x6f\x72\x3a\x23\x61\x61\x61\x3b\x0a\x09\x66\x6f\x6e\x74\x2d\x73\x74\x79\x6c\x65\x3a\x20\x69\x74\x61\x6c\x69\x63\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x2d\x72\x69\x67\x68\x74\x3a\x31\x35\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x77\x65\x62\x73\x6f\x63\x6b\x42\x72\x6f\x6b\x65\x6e\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x72\x65\x64\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x77\x68\x69\x74\x65\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x62\x6f\x6c\x64\x3b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x63\x65\x6e\x74\x65\x72\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x32\x65\x6d\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x31\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x6e\x6f\x6a\x73\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x6f\x72\x61\x6e\x67\x65\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x77\x68\x69\x74\x65\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x62\x6f\x6c\x64\x3b\x0a\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x63\x65\x6e\x74\x65\x72\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x32\x65\x6d\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x31\x35\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x7b\x0a\x20\x20\x2f\x2a\x62\x6f\x72\x64\x65\x72\x3a\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x62\x6c\x75\x65\x3b\x2a\x2f\x0a\x7d\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x75\x6c\x20\x7b\x0a\x09\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x6c\x69\x2e\x67\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x20\x20\x63\x75\x72\x73\x6f\x72\x3a\x20\x70\x6f\x69\x6e\x74\x65\x72\x3b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x20\x20\x77\x69\x64\x74\x68\x3a\x38\x65\x6d\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x65\x65\x65\x3b\x0a\x7d\x0a\x23\x67\x72\x6f\x75\x70\x73\x20\x6c\x69\x2e\x67\x42\x75\x74\x74\x6f\x6e\x20\x62\x75\x74\x74\x6f\x6e\x2e\x73\x74\x61\x72\x74\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x6c\x65\x66\x74\x3a\x20\x35\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x7b\x0a\x20\x20\x2f\x2a\x62\x6f\x72\x64\x65\x72\x3a\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x67\x72\x65\x65\x6e\x3b\x2a\x2f\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x64\x69\x76\x2e\x63\x47\x72\x6f\x75\x70\x20\x7b\x0a\x09\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x75\x6c\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x6c\x69\x2e\x63\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x20\x20\x6f\x76\x65\x72\x66\x6c\x6f\x77\x2d\x77\x72\x61\x70\x3a\x20\x61\x6e\x79\x77\x68\x65\x72\x65\x3b\x0a\x09\x77\x69\x64\x74\x68\x3a\x38\x65\x6d\x3b\x0a\x09\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x6c\x69\x2e\x63\x42\x75\x74\x74\x6f\x6e\x20\x62\x75\x74\x74\x6f\x6e\x2e\x63\x6f\x6e\x66\x69\x72\x6d\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x74\x6f\x70\x3a\x20\x34\x70\x78\x3b\x0a\x7d\x0a\x23\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x75\x6c\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x6c\x69\x2e\x63\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x64\x69\x76\x2e\x69\x64\x65\x6e\x74\x69\x74\x79\x20\x7b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x30\x2e\x38\x65\x6d\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x34\x70\x78\x3b\x0a\x7d\x0a\x6c\x69\x2e\x55\x4e\x41\x53\x53\x49\x47\x4e\x45\x44\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x64\x64\x62\x3b\x0a\x7d\x0a\x6c\x69\x2e\x4e\x4f\x4e\x45\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x61\x61\x61\x3b\x0a\x7d\x20\x0a\x6c\x69\x2e\x57\x41\x49\x54\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x39\x39\x63\x3b\x0a\x7d\x20\x0a\x6c\x69\x2e\x42\x55\x53\x59\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x39\x63\x63\x3b\x0a\x7d\x20\x0a\x6c\x69\x2e\x45\x52\x52\x4f\x52\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x63\x39\x39\x3b\x0a\x7d\x0a\x6c\x69\x2e\x44\x4f\x4e\x45\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x39\x63\x39\x3b\x0a\x7d\x0a\x6c\x69\x2e\x42\x41\x44\x53\x55\x4d\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x23\x63\x39\x63\x3b\x0a\x7d\x0a\x6c\x69\x2e\x42\x4f\x54\x54\x4c\x45\x4e\x45\x43\x4b\x20\x7b\x0a\x20\x20\x6f\x75\x74\x6c\x69\x6e\x65\x3a\x20\x33\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x23\x63\x33\x33\x3b\x0a\x7d\x0a\x0a\x64\x69\x76\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x20\x20\x68\x65\x69\x67\x68\x74\x3a\x20\x34\x70\x78\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x74\x6f\x70\x3a\x20\x34\x70\x78\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x65\x65\x65\x3b\x0a\x7d\x0a\x64\x69\x76\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x64\x69\x76\x2e\x62\x61\x72\x20\x7b\x0a\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x30\x3b\x0a\x20\x20\x68\x65\x69\x67\x68\x74\x3a\x20\x31\x30\x30\x25\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x33\x36\x39\x3b\x0a\x7d\x0a\x64\x69\x76\x2e\x61\x64\x64\x72\x65\x73\x73\x2c\x20\x64\x69\x76\x2e\x63\x68\x61\x69\x6e\x20\x7b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x30\x2e\x37\x65\x6d\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x34\x34\x34\x3b\x0a\x7d\x0a\x64\x69\x76\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x49\x6e\x66\x6f\x20\x7b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x30\x2e\x37\x65\x6d\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x34\x34\x34\x3b\x0a\x7d\x0a\x0a\x23\x6c\x65\x67\x65\x6e\x64\x20\x75\x6c\x20\x7b\x0a\x09\x6d\x61\x72\x67\x69\x6e\x3a\x30\x3b\x0a\x09\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a\x23\x6c\x65\x67\x65\x6e\x64\x20\x6c\x69\x2e\x63\x42\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x09\x77\x69\x64\x74\x68\x3a\x35\x65\x6d\x3b\x0a\x09\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x35\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x35\x70\x78\x3b\x0a\x7d\x0a"
 
 func assetsAppCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -76,12 +76,12 @@ func assetsAppCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/app.css", size: 1122, mode: os.FileMode(420), modTime: time.Unix(1482247073, 0)}
+	info := bindataFileInfo{name: "assets/app.css", size: 1874, mode: os.FileMode(420), modTime: time.Unix(1792264488, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsAppJs = "\x2f\x2a\x21\x20\x6a\x51\x75\x65\x72\x79\x20\x76\x33\x2e\x31\x2e\x31\x20\x7c\x20\x28\x63\x29\x20\x6a\x51\x75\x65\x72\x79\x20\x46\x6f\x75\x6e\x64\x61\x74\x69\x6f\x6e\x20\x7c\x20\x6a\x71\x75\x65\x72\x79\x2e\x6f\x72\x67\x2f\x6c\x69\x63\x65\x6e\x73\x65\x20\x2a\x2f\x0a\x21\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x22\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x22\x3b\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x6d\x6f\x64\x75\x6c\x65\x26\x26\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x6d\x6f\x64\x75\x6c\x65\x2e\x65\x78\x70\x6f\x72\x74\x73\x3f\x6d\x6f\x64\x75\x6c\x65\x2e\x65\x78\x70\x6f\x72\x74\x73\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x3f\x62\x28\x61\x2c\x21\x30\x29\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x21\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x29\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x45\x72\x72\x6f\x72\x28\x22\x6a\x51\x75\x65\x72\x79\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x61\x20\x77\x69\x6e\x64\x6f\x77\x20\x77\x69\x74\x68\x20\x61\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x61\x29\x7d\x3a\x62\x28\x61\x29\x7d\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x77\x69\x6e\x64\x6f\x77\x3f\x77\x69\x6e\x64\x6f\x77\x3a\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x22\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x22\x3b\x76\x61\x72\x20\x63\x3d\x5b\x5d\x2c\x64\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x65\x3d\x4f\x62\x6a\x65\x63\x74\x2e\x67\x65\x74\x50\x72\x6f\x74\x6f\x74\x79\x70\x65\x4f\x66\x2c\x66\x3d\x63\x2e\x73\x6c\x69\x63\x65\x2c\x67\x3d\x63\x2e\x63\x6f\x6e\x63\x61\x74\x2c\x68\x3d\x63\x2e\x70\x75\x73\x68\x2c\x69\x3d\x63\x2e\x69\x6e\x64\x65\x78\x4f\x66\x2c\x6a\x3d\x7b\x7d\x2c\x6b\x3d\x6a\x2e\x74\x6f\x53\x74\x72\x69\x6e\x67\x2c\x6c\x3d\x6a\x2e\x68\x61\x73\x4f\x77\x6e\x50\x72\x6f\x70\x65\x72\x74\x79\x2c\x6d\x3d\x6c\x2e\x74\x6f\x53\x74\x72\x69\x6e\x67\x2c\x6e\x3d\x6d\x2e\x63\x61\x6c\x6c\x28\x4f\x62\x6a\x65\x63\x74\x29\x2c\x6f\x3d\x7b\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x28\x61\x2c\x62\x29\x7b\x62\x3d\x62\x7c\x7c\x64\x3b\x76\x61\x72\x20\x63\x3d\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x73\x63\x72\x69\x70\x74\x22\x29\x3b\x63\x2e\x74\x65\x78\x74\x3d\x61\x2c\x62\x2e\x68\x65\x61\x64\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x63\x29\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x63\x29\x7d\x76\x61\x72\x20\x71\x3d\x22\x33\x2e\x31\x2e\x31\x22\x2c\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x72\x2e\x66\x6e\x2e\x69\x6e\x69\x74\x28\x61\x2c\x62\x29\x7d\x2c\x73\x3d\x2f\x5e\x5b\x5c\x73\x5c\x75\x46\x45\x46\x46\x5c\x78\x41\x30\x5d\x2b\x7c\x5b\x5c\x73\x5c\x75\x46\x45\x46\x46\x5c\x78\x41\x30\x5d\x2b\x24\x2f\x67\x2c\x74\x3d\x2f\x5e\x2d\x6d\x73\x2d\x2f\x2c\x75\x3d\x2f\x2d\x28\x5b\x61\x2d\x7a\x5d\x29\x2f\x67\x2c\x76\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x74\x6f\x55\x70\x70\x65\x72\x43\x61\x73\x65\x28\x29\x7d\x3b\x72\x2e\x66\x6e\x3d\x72\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x6a\x71\x75\x65\x72\x79\x3a\x71\x2c\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x3a\x72\x2c\x6c\x65\x6e\x67\x74\x68\x3a\x30\x2c\x74\x6f\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x7d\x2c\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x66\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x3a\x61\x3c\x30\x3f\x74\x68\x69\x73\x5b\x61\x2b\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x5d\x3a\x74\x68\x69\x73\x5b\x61\x5d\x7d\x2c\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x6d\x65\x72\x67\x65\x28\x74\x68\x69\x73\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x28\x29\x2c\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x3d\x74\x68\x69\x73\x2c\x62\x7d\x2c\x65\x61\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x2c\x6d\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x72\x2e\x6d\x61\x70\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x63\x2c\x62\x29\x7d\x29\x29\x7d\x2c\x73\x6c\x69\x63\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x66\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x29\x7d\x2c\x66\x69\x72\x73\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x71\x28\x30\x29\x7d\x2c\x6c\x61\x73\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x71\x28\x2d\x31\x29\x7d\x2c\x65\x71\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x2b\x61\x2b\x28\x61\x3c\x30\x3f\x62\x3a\x30\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x63\x3e\x3d\x30\x26\x26\x63\x3c\x62\x3f\x5b\x74\x68\x69\x73\x5b\x63\x5d\x5d\x3a\x5b\x5d\x29\x7d\x2c\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x7c\x7c\x74\x68\x69\x73\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x28\x29\x7d\x2c\x70\x75\x73\x68\x3a\x68\x2c\x73\x6f\x72\x74\x3a\x63\x2e\x73\x6f\x72\x74\x2c\x73\x70\x6c\x69\x63\x65\x3a\x63\x2e\x73\x70\x6c\x69\x63\x65\x7d\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x3d\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x30\x5d\x7c\x7c\x7b\x7d\x2c\x68\x3d\x31\x2c\x69\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6a\x3d\x21\x31\x3b\x66\x6f\x72\x28\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x67\x26\x26\x28\x6a\x3d\x67\x2c\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x68\x5d\x7c\x7c\x7b\x7d\x2c\x68\x2b\x2b\x29\x2c\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x67\x7c\x7c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x67\x29\x7c\x7c\x28\x67\x3d\x7b\x7d\x29\x2c\x68\x3d\x3d\x3d\x69\x26\x26\x28\x67\x3d\x74\x68\x69\x73\x2c\x68\x2d\x2d\x29\x3b\x68\x3c\x69\x3b\x68\x2b\x2b\x29\x69\x66\x28\x6e\x75\x6c\x6c\x21\x3d\x28\x61\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x68\x5d\x29\x29\x66\x6f\x72\x28\x62\x20\x69\x6e\x20\x61\x29\x63\x3d\x67\x5b\x62\x5d\x2c\x64\x3d\x61\x5b\x62\x5d\x2c\x67\x21\x3d\x3d\x64\x26\x26\x28\x6a\x26\x26\x64\x26\x26\x28\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x64\x29\x7c\x7c\x28\x65\x3d\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x64\x29\x29\x29\x3f\x28\x65\x3f\x28\x65\x3d\x21\x31\x2c\x66\x3d\x63\x26\x26\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x63\x29\x3f\x63\x3a\x5b\x5d\x29\x3a\x66\x3d\x63\x26\x26\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x63\x29\x3f\x63\x3a\x7b\x7d\x2c\x67\x5b\x62\x5d\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x6a\x2c\x66\x2c\x64\x29\x29\x3a\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x64\x26\x26\x28\x67\x5b\x62\x5d\x3d\x64\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x7d\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x65\x78\x70\x61\x6e\x64\x6f\x3a\x22\x6a\x51\x75\x65\x72\x79\x22\x2b\x28\x71\x2b\x4d\x61\x74\x68\x2e\x72\x61\x6e\x64\x6f\x6d\x28\x29\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x2f\x5c\x44\x2f\x67\x2c\x22\x22\x29\x2c\x69\x73\x52\x65\x61\x64\x79\x3a\x21\x30\x2c\x65\x72\x72\x6f\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x45\x72\x72\x6f\x72\x28\x61\x29\x7d\x2c\x6e\x6f\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x7d\x2c\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x61\x29\x7d\x2c\x69\x73\x41\x72\x72\x61\x79\x3a\x41\x72\x72\x61\x79\x2e\x69\x73\x41\x72\x72\x61\x79\x2c\x69\x73\x57\x69\x6e\x64\x6f\x77\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x61\x3d\x3d\x3d\x61\x2e\x77\x69\x6e\x64\x6f\x77\x7d\x2c\x69\x73\x4e\x75\x6d\x65\x72\x69\x63\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x74\x79\x70\x65\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x28\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x3d\x62\x7c\x7c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x62\x29\x26\x26\x21\x69\x73\x4e\x61\x4e\x28\x61\x2d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x61\x29\x29\x7d\x2c\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3b\x72\x65\x74\x75\x72\x6e\x21\x28\x21\x61\x7c\x7c\x22\x5b\x6f\x62\x6a\x65\x63\x74\x20\x4f\x62\x6a\x65\x63\x74\x5d\x22\x21\x3d\x3d\x6b\x2e\x63\x61\x6c\x6c\x28\x61\x29\x29\x26\x26\x28\x21\x28\x62\x3d\x65\x28\x61\x29\x29\x7c\x7c\x28\x63\x3d\x6c\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x22\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x22\x29\x26\x26\x62\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x2c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x26\x26\x6d\x2e\x63\x61\x6c\x6c\x28\x63\x29\x3d\x3d\x3d\x6e\x29\x29\x7d\x2c\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x66\x6f\x72\x28\x62\x20\x69\x6e\x20\x61\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x2c\x74\x79\x70\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x61\x2b\x22\x22\x3a\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x7c\x7c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x6a\x5b\x6b\x2e\x63\x61\x6c\x6c\x28\x61\x29\x5d\x7c\x7c\x22\x6f\x62\x6a\x65\x63\x74\x22\x3a\x74\x79\x70\x65\x6f\x66\x20\x61\x7d\x2c\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x70\x28\x61\x29\x7d\x2c\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x74\x2c\x22\x6d\x73\x2d\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x75\x2c\x76\x29\x7d\x2c\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x26\x26\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x7d\x2c\x65\x61\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x3b\x69\x66\x28\x77\x28\x61\x29\x29\x7b\x66\x6f\x72\x28\x63\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x63\x3b\x64\x2b\x2b\x29\x69\x66\x28\x62\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x64\x5d\x2c\x64\x2c\x61\x5b\x64\x5d\x29\x3d\x3d\x3d\x21\x31\x29\x62\x72\x65\x61\x6b\x7d\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x61\x29\x69\x66\x28\x62\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x64\x5d\x2c\x64\x2c\x61\x5b\x64\x5d\x29\x3d\x3d\x3d\x21\x31\x29\x62\x72\x65\x61\x6b\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x2c\x74\x72\x69\x6d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x22\x22\x3a\x28\x61\x2b\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x73\x2c\x22\x22\x29\x7d\x2c\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x7c\x7c\x5b\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x28\x77\x28\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x29\x3f\x72\x2e\x6d\x65\x72\x67\x65\x28\x63\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x5b\x61\x5d\x3a\x61\x29\x3a\x68\x2e\x63\x61\x6c\x6c\x28\x63\x2c\x61\x29\x29\x2c\x63\x7d\x2c\x69\x6e\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x62\x3f\x2d\x31\x3a\x69\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x61\x2c\x63\x29\x7d\x2c\x6d\x65\x72\x67\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x2b\x62\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x64\x3d\x30\x2c\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x63\x3b\x64\x2b\x2b\x29\x61\x5b\x65\x2b\x2b\x5d\x3d\x62\x5b\x64\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x65\x2c\x61\x7d\x2c\x67\x72\x65\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x2c\x65\x3d\x5b\x5d\x2c\x66\x3d\x30\x2c\x67\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x68\x3d\x21\x63\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x64\x3d\x21\x62\x28\x61\x5b\x66\x5d\x2c\x66\x29\x2c\x64\x21\x3d\x3d\x68\x26\x26\x65\x2e\x70\x75\x73\x68\x28\x61\x5b\x66\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x2c\x6d\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x30\x2c\x68\x3d\x5b\x5d\x3b\x69\x66\x28\x77\x28\x61\x29\x29\x66\x6f\x72\x28\x64\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x3c\x64\x3b\x66\x2b\x2b\x29\x65\x3d\x62\x28\x61\x5b\x66\x5d\x2c\x66\x2c\x63\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x65\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x65\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x66\x20\x69\x6e\x20\x61\x29\x65\x3d\x62\x28\x61\x5b\x66\x5d\x2c\x66\x2c\x63\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x65\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x61\x70\x70\x6c\x79\x28\x5b\x5d\x2c\x68\x29\x7d\x2c\x67\x75\x69\x64\x3a\x31\x2c\x70\x72\x6f\x78\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x61\x5b\x62\x5d\x2c\x62\x3d\x61\x2c\x61\x3d\x63\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3d\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x32\x29\x2c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x61\x70\x70\x6c\x79\x28\x62\x7c\x7c\x74\x68\x69\x73\x2c\x64\x2e\x63\x6f\x6e\x63\x61\x74\x28\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x29\x29\x7d\x2c\x65\x2e\x67\x75\x69\x64\x3d\x61\x2e\x67\x75\x69\x64\x3d\x61\x2e\x67\x75\x69\x64\x7c\x7c\x72\x2e\x67\x75\x69\x64\x2b\x2b\x2c\x65\x7d\x2c\x6e\x6f\x77\x3a\x44\x61\x74\x65\x2e\x6e\x6f\x77\x2c\x73\x75\x70\x70\x6f\x72\x74\x3a\x6f\x7d\x29\x2c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x53\x79\x6d\x62\x6f\x6c\x26\x26\x28\x72\x2e\x66\x6e\x5b\x53\x79\x6d\x62\x6f\x6c\x2e\x69\x74\x65\x72\x61\x74\x6f\x72\x5d\x3d\x63\x5b\x53\x79\x6d\x62\x6f\x6c\x2e\x69\x74\x65\x72\x61\x74\x6f\x72\x5d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x22\x42\x6f\x6f\x6c\x65\x61\x6e\x20\x4e\x75\x6d\x62\x65\x72\x20\x53\x74\x72\x69\x6e\x67\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x41\x72\x72\x61\x79\x20\x44\x61\x74\x65\x20\x52\x65\x67\x45\x78\x70\x20\x4f\x62\x6a\x65\x63\x74\x20\x45\x72\x72\x6f\x72\x20\x53\x79\x6d\x62\x6f\x6c\x22\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x6a\x5b\x22\x5b\x6f\x62\x6a\x65\x63\x74\x20\x22\x2b\x62\x2b\x22\x5d\x22\x5d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x21\x21\x61\x26\x26\x22\x6c\x65\x6e\x67\x74\x68\x22\x69\x6e\x20\x61\x26\x26\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x72\x2e\x74\x79\x70\x65\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x21\x3d\x3d\x63\x26\x26\x21\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x61\x29\x26\x26\x28\x22\x61\x72\x72\x61\x79\x22\x3d\x3d\x3d\x63\x7c\x7c\x30\x3d\x3d\x3d\x62\x7c\x7c\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x62\x3e\x30\x26\x26\x62\x2d\x31\x20\x69\x6e\x20\x61\x29\x7d\x76\x61\x72\x20\x78\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x2c\x71\x2c\x72\x2c\x73\x2c\x74\x2c\x75\x3d\x22\x73\x69\x7a\x7a\x6c\x65\x22\x2b\x31\x2a\x6e\x65\x77\x20\x44\x61\x74\x65\x2c\x76\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x77\x3d\x30\x2c\x78\x3d\x30\x2c\x79\x3d\x68\x61\x28\x29\x2c\x7a\x3d\x68\x61\x28\x29\x2c\x41\x3d\x68\x61\x28\x29\x2c\x42\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x26\x26\x28\x6c\x3d\x21\x30\x29\x2c\x30\x7d\x2c\x43\x3d\x7b\x7d\x2e\x68\x61\x73\x4f\x77\x6e\x50\x72\x6f\x70\x65\x72\x74\x79\x2c\x44\x3d\x5b\x5d\x2c\x45\x3d\x44\x2e\x70\x6f\x70\x2c\x46\x3d\x44\x2e\x70\x75\x73\x68\x2c\x47\x3d\x44\x2e\x70\x75\x73\x68\x2c\x48\x3d\x44\x2e\x73\x6c\x69\x63\x65\x2c\x49\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x30\x2c\x64\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x69\x66\x28\x61\x5b\x63\x5d\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3b\x72\x65\x74\x75\x72\x6e\x2d\x31\x7d\x2c\x4a\x3d\x22\x63\x68\x65\x63\x6b\x65\x64\x7c\x73\x65\x6c\x65\x63\x74\x65\x64\x7c\x61\x73\x79\x6e\x63\x7c\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x7c\x61\x75\x74\x6f\x70\x6c\x61\x79\x7c\x63\x6f\x6e\x74\x72\x6f\x6c\x73\x7c\x64\x65\x66\x65\x72\x7c\x64\x69\x73\x61\x62\x6c\x65\x64\x7c\x68\x69\x64\x64\x65\x6e\x7c\x69\x73\x6d\x61\x70\x7c\x6c\x6f\x6f\x70\x7c\x6d\x75\x6c\x74\x69\x70\x6c\x65\x7c\x6f\x70\x65\x6e\x7c\x72\x65\x61\x64\x6f\x6e\x6c\x79\x7c\x72\x65\x71\x75\x69\x72\x65\x64\x7c\x73\x63\x6f\x70\x65\x64\x22\x2c\x4b\x3d\x22\x5b\x5c\x5c\x78\x32\x30\x5c\x5c\x74\x5c\x5c\x72\x5c\x5c\x6e\x5c\x5c\x66\x5d\x22\x2c\x4c\x3d\x22\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5c\x5c\x77\x2d\x5d\x7c\x5b\x5e\x5c\x30\x2d\x5c\x5c\x78\x61\x30\x5d\x29\x2b\x22\x2c\x4d\x3d\x22\x5c\x5c\x5b\x22\x2b\x4b\x2b\x22\x2a\x28\x22\x2b\x4c\x2b\x22\x29\x28\x3f\x3a\x22\x2b\x4b\x2b\x22\x2a\x28\x5b\x2a\x5e\x24\x7c\x21\x7e\x5d\x3f\x3d\x29\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x27\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x27\x5d\x29\x2a\x29\x27\x7c\x5c\x22\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x5c\x22\x5d\x29\x2a\x29\x5c\x22\x7c\x28\x22\x2b\x4c\x2b\x22\x29\x29\x7c\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x5d\x22\x2c\x4e\x3d\x22\x3a\x28\x22\x2b\x4c\x2b\x22\x29\x28\x3f\x3a\x5c\x5c\x28\x28\x28\x27\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x27\x5d\x29\x2a\x29\x27\x7c\x5c\x22\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x5c\x22\x5d\x29\x2a\x29\x5c\x22\x29\x7c\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x28\x29\x5b\x5c\x5c\x5d\x5d\x7c\x22\x2b\x4d\x2b\x22\x29\x2a\x29\x7c\x2e\x2a\x29\x5c\x5c\x29\x7c\x29\x22\x2c\x4f\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x4b\x2b\x22\x2b\x22\x2c\x22\x67\x22\x29\x2c\x50\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2b\x7c\x28\x28\x3f\x3a\x5e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x5d\x29\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x29\x2a\x29\x22\x2b\x4b\x2b\x22\x2b\x24\x22\x2c\x22\x67\x22\x29\x2c\x51\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2a\x2c\x22\x2b\x4b\x2b\x22\x2a\x22\x29\x2c\x52\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2a\x28\x5b\x3e\x2b\x7e\x5d\x7c\x22\x2b\x4b\x2b\x22\x29\x22\x2b\x4b\x2b\x22\x2a\x22\x29\x2c\x53\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x3d\x22\x2b\x4b\x2b\x22\x2a\x28\x5b\x5e\x5c\x5c\x5d\x27\x5c\x22\x5d\x2a\x3f\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x5d\x22\x2c\x22\x67\x22\x29\x2c\x54\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x4e\x29\x2c\x55\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4c\x2b\x22\x24\x22\x29\x2c\x56\x3d\x7b\x49\x44\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x23\x28\x22\x2b\x4c\x2b\x22\x29\x22\x29\x2c\x43\x4c\x41\x53\x53\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x5c\x5c\x2e\x28\x22\x2b\x4c\x2b\x22\x29\x22\x29\x2c\x54\x41\x47\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x22\x2b\x4c\x2b\x22\x7c\x5b\x2a\x5d\x29\x22\x29\x2c\x41\x54\x54\x52\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4d\x29\x2c\x50\x53\x45\x55\x44\x4f\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4e\x29\x2c\x43\x48\x49\x4c\x44\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x3a\x28\x6f\x6e\x6c\x79\x7c\x66\x69\x72\x73\x74\x7c\x6c\x61\x73\x74\x7c\x6e\x74\x68\x7c\x6e\x74\x68\x2d\x6c\x61\x73\x74\x29\x2d\x28\x63\x68\x69\x6c\x64\x7c\x6f\x66\x2d\x74\x79\x70\x65\x29\x28\x3f\x3a\x5c\x5c\x28\x22\x2b\x4b\x2b\x22\x2a\x28\x65\x76\x65\x6e\x7c\x6f\x64\x64\x7c\x28\x28\x5b\x2b\x2d\x5d\x7c\x29\x28\x5c\x5c\x64\x2a\x29\x6e\x7c\x29\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x28\x5b\x2b\x2d\x5d\x7c\x29\x22\x2b\x4b\x2b\x22\x2a\x28\x5c\x5c\x64\x2b\x29\x7c\x29\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x29\x7c\x29\x22\x2c\x22\x69\x22\x29\x2c\x62\x6f\x6f\x6c\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x3f\x3a\x22\x2b\x4a\x2b\x22\x29\x24\x22\x2c\x22\x69\x22\x29\x2c\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2a\x5b\x3e\x2b\x7e\x5d\x7c\x3a\x28\x65\x76\x65\x6e\x7c\x6f\x64\x64\x7c\x65\x71\x7c\x67\x74\x7c\x6c\x74\x7c\x6e\x74\x68\x7c\x66\x69\x72\x73\x74\x7c\x6c\x61\x73\x74\x29\x28\x3f\x3a\x5c\x5c\x28\x22\x2b\x4b\x2b\x22\x2a\x28\x28\x3f\x3a\x2d\x5c\x5c\x64\x29\x3f\x5c\x5c\x64\x2a\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x29\x7c\x29\x28\x3f\x3d\x5b\x5e\x2d\x5d\x7c\x24\x29\x22\x2c\x22\x69\x22\x29\x7d\x2c\x57\x3d\x2f\x5e\x28\x3f\x3a\x69\x6e\x70\x75\x74\x7c\x73\x65\x6c\x65\x63\x74\x7c\x74\x65\x78\x74\x61\x72\x65\x61\x7c\x62\x75\x74\x74\x6f\x6e\x29\x24\x2f\x69\x2c\x58\x3d\x2f\x5e\x68\x5c\x64\x24\x2f\x69\x2c\x59\x3d\x2f\x5e\x5b\x5e\x7b\x5d\x2b\x5c\x7b\x5c\x73\x2a\x5c\x5b\x6e\x61\x74\x69\x76\x65\x20\x5c\x77\x2f\x2c\x5a\x3d\x2f\x5e\x28\x3f\x3a\x23\x28\x5b\x5c\x77\x2d\x5d\x2b\x29\x7c\x28\x5c\x77\x2b\x29\x7c\x5c\x2e\x28\x5b\x5c\x77\x2d\x5d\x2b\x29\x29\x24\x2f\x2c\x24\x3d\x2f\x5b\x2b\x7e\x5d\x2f\x2c\x5f\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5c\x5c\x5c\x5c\x28\x5b\x5c\x5c\x64\x61\x2d\x66\x5d\x7b\x31\x2c\x36\x7d\x22\x2b\x4b\x2b\x22\x3f\x7c\x28\x22\x2b\x4b\x2b\x22\x29\x7c\x2e\x29\x22\x2c\x22\x69\x67\x22\x29\x2c\x61\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x22\x30\x78\x22\x2b\x62\x2d\x36\x35\x35\x33\x36\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x21\x3d\x3d\x64\x7c\x7c\x63\x3f\x62\x3a\x64\x3c\x30\x3f\x53\x74\x72\x69\x6e\x67\x2e\x66\x72\x6f\x6d\x43\x68\x61\x72\x43\x6f\x64\x65\x28\x64\x2b\x36\x35\x35\x33\x36\x29\x3a\x53\x74\x72\x69\x6e\x67\x2e\x66\x72\x6f\x6d\x43\x68\x61\x72\x43\x6f\x64\x65\x28\x64\x3e\x3e\x31\x30\x7c\x35\x35\x32\x39\x36\x2c\x31\x30\x32\x33\x26\x64\x7c\x35\x36\x33\x32\x30\x29\x7d\x2c\x62\x61\x3d\x2f\x28\x5b\x5c\x30\x2d\x5c\x78\x31\x66\x5c\x78\x37\x66\x5d\x7c\x5e\x2d\x3f\x5c\x64\x29\x7c\x5e\x2d\x24\x7c\x5b\x5e\x5c\x30\x2d\x5c\x78\x31\x66\x5c\x78\x37\x66\x2d\x5c\x75\x46\x46\x46\x46\x5c\x77\x2d\x5d\x2f\x67\x2c\x63\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x22\x5c\x30\x22\x3d\x3d\x3d\x61\x3f\x22\x5c\x75\x66\x66\x66\x64\x22\x3a\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x2d\x31\x29\x2b\x22\x5c\x5c\x22\x2b\x61\x2e\x63\x68\x61\x72\x43\x6f\x64\x65\x41\x74\x28\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x29\x2e\x74\x6f\x53\x74\x72\x69\x6e\x67\x28\x31\x36\x29\x2b\x22\x20\x22\x3a\x22\x5c\x5c\x22\x2b\x61\x7d\x2c\x64\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6d\x28\x29\x7d\x2c\x65\x61\x3d\x74\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x21\x30\x26\x26\x28\x22\x66\x6f\x72\x6d\x22\x69\x6e\x20\x61\x7c\x7c\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x61\x29\x7d\x2c\x7b\x64\x69\x72\x3a\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x2c\x6e\x65\x78\x74\x3a\x22\x6c\x65\x67\x65\x6e\x64\x22\x7d\x29\x3b\x74\x72\x79\x7b\x47\x2e\x61\x70\x70\x6c\x79\x28\x44\x3d\x48\x2e\x63\x61\x6c\x6c\x28\x76\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x2c\x76\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x2c\x44\x5b\x76\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x2e\x6c\x65\x6e\x67\x74\x68\x5d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x63\x61\x74\x63\x68\x28\x66\x61\x29\x7b\x47\x3d\x7b\x61\x70\x70\x6c\x79\x3a\x44\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x46\x2e\x61\x70\x70\x6c\x79\x28\x61\x2c\x48\x2e\x63\x61\x6c\x6c\x28\x62\x29\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x64\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x61\x5b\x63\x2b\x2b\x5d\x3d\x62\x5b\x64\x2b\x2b\x5d\x29\x3b\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x63\x2d\x31\x7d\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x61\x28\x61\x2c\x62\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x68\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6f\x2c\x72\x2c\x73\x3d\x62\x26\x26\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x77\x3d\x62\x3f\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3a\x39\x3b\x69\x66\x28\x64\x3d\x64\x7c\x7c\x5b\x5d\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x7c\x7c\x21\x61\x7c\x7c\x31\x21\x3d\x3d\x77\x26\x26\x39\x21\x3d\x3d\x77\x26\x26\x31\x31\x21\x3d\x3d\x77\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x69\x66\x28\x21\x65\x26\x26\x28\x28\x62\x3f\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x62\x3a\x76\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x62\x29\x2c\x62\x3d\x62\x7c\x7c\x6e\x2c\x70\x29\x29\x7b\x69\x66\x28\x31\x31\x21\x3d\x3d\x77\x26\x26\x28\x6c\x3d\x5a\x2e\x65\x78\x65\x63\x28\x61\x29\x29\x29\x69\x66\x28\x66\x3d\x6c\x5b\x31\x5d\x29\x7b\x69\x66\x28\x39\x3d\x3d\x3d\x77\x29\x7b\x69\x66\x28\x21\x28\x6a\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x66\x29\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x69\x66\x28\x6a\x2e\x69\x64\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x70\x75\x73\x68\x28\x6a\x29\x2c\x64\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x73\x26\x26\x28\x6a\x3d\x73\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x66\x29\x29\x26\x26\x74\x28\x62\x2c\x6a\x29\x26\x26\x6a\x2e\x69\x64\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x70\x75\x73\x68\x28\x6a\x29\x2c\x64\x7d\x65\x6c\x73\x65\x7b\x69\x66\x28\x6c\x5b\x32\x5d\x29\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x61\x29\x29\x2c\x64\x3b\x69\x66\x28\x28\x66\x3d\x6c\x5b\x33\x5d\x29\x26\x26\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x28\x66\x29\x29\x2c\x64\x7d\x69\x66\x28\x63\x2e\x71\x73\x61\x26\x26\x21\x41\x5b\x61\x2b\x22\x20\x22\x5d\x26\x26\x28\x21\x71\x7c\x7c\x21\x71\x2e\x74\x65\x73\x74\x28\x61\x29\x29\x29\x7b\x69\x66\x28\x31\x21\x3d\x3d\x77\x29\x73\x3d\x62\x2c\x72\x3d\x61\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x7b\x28\x6b\x3d\x62\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x29\x29\x3f\x6b\x3d\x6b\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x62\x61\x2c\x63\x61\x29\x3a\x62\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x2c\x6b\x3d\x75\x29\x2c\x6f\x3d\x67\x28\x61\x29\x2c\x68\x3d\x6f\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x68\x2d\x2d\x29\x6f\x5b\x68\x5d\x3d\x22\x23\x22\x2b\x6b\x2b\x22\x20\x22\x2b\x73\x61\x28\x6f\x5b\x68\x5d\x29\x3b\x72\x3d\x6f\x2e\x6a\x6f\x69\x6e\x28\x22\x2c\x22\x29\x2c\x73\x3d\x24\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x71\x61\x28\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x7c\x7c\x62\x7d\x69\x66\x28\x72\x29\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x73\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x72\x29\x29\x2c\x64\x7d\x63\x61\x74\x63\x68\x28\x78\x29\x7b\x7d\x66\x69\x6e\x61\x6c\x6c\x79\x7b\x6b\x3d\x3d\x3d\x75\x26\x26\x62\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x29\x7d\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x69\x28\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x24\x31\x22\x29\x2c\x62\x2c\x64\x2c\x65\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x61\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x5b\x5d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x28\x63\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x70\x75\x73\x68\x28\x63\x2b\x22\x20\x22\x29\x3e\x64\x2e\x63\x61\x63\x68\x65\x4c\x65\x6e\x67\x74\x68\x26\x26\x64\x65\x6c\x65\x74\x65\x20\x62\x5b\x61\x2e\x73\x68\x69\x66\x74\x28\x29\x5d\x2c\x62\x5b\x63\x2b\x22\x20\x22\x5d\x3d\x65\x7d\x72\x65\x74\x75\x72\x6e\x20\x62\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x69\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x75\x5d\x3d\x21\x30\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6a\x61\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x6e\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x66\x69\x65\x6c\x64\x73\x65\x74\x22\x29\x3b\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x61\x28\x62\x29\x7d\x63\x61\x74\x63\x68\x28\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x66\x69\x6e\x61\x6c\x6c\x79\x7b\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x62\x29\x2c\x62\x3d\x6e\x75\x6c\x6c\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6b\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x73\x70\x6c\x69\x74\x28\x22\x7c\x22\x29\x2c\x65\x3d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x65\x2d\x2d\x29\x64\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x5b\x63\x5b\x65\x5d\x5d\x3d\x62\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x26\x26\x61\x2c\x64\x3d\x63\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x73\x6f\x75\x72\x63\x65\x49\x6e\x64\x65\x78\x2d\x62\x2e\x73\x6f\x75\x72\x63\x65\x49\x6e\x64\x65\x78\x3b\x69\x66\x28\x64\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x69\x66\x28\x63\x29\x77\x68\x69\x6c\x65\x28\x63\x3d\x63\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x69\x66\x28\x63\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x2d\x31\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x31\x3a\x2d\x31\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6d\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x63\x26\x26\x62\x2e\x74\x79\x70\x65\x3d\x3d\x3d\x61\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x28\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x63\x7c\x7c\x22\x62\x75\x74\x74\x6f\x6e\x22\x3d\x3d\x3d\x63\x29\x26\x26\x62\x2e\x74\x79\x70\x65\x3d\x3d\x3d\x61\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x66\x6f\x72\x6d\x22\x69\x6e\x20\x62\x3f\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x21\x31\x3f\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x62\x3f\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3f\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x3a\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x3a\x62\x2e\x69\x73\x44\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x7c\x7c\x62\x2e\x69\x73\x44\x69\x73\x61\x62\x6c\x65\x64\x21\x3d\x3d\x21\x61\x26\x26\x65\x61\x28\x62\x29\x3d\x3d\x3d\x61\x3a\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x3a\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x62\x26\x26\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x2b\x62\x2c\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3d\x61\x28\x5b\x5d\x2c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x62\x29\x2c\x67\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x63\x5b\x65\x3d\x66\x5b\x67\x5d\x5d\x26\x26\x28\x63\x5b\x65\x5d\x3d\x21\x28\x64\x5b\x65\x5d\x3d\x63\x5b\x65\x5d\x29\x29\x7d\x29\x7d\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x71\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x26\x26\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x26\x26\x61\x7d\x63\x3d\x67\x61\x2e\x73\x75\x70\x70\x6f\x72\x74\x3d\x7b\x7d\x2c\x66\x3d\x67\x61\x2e\x69\x73\x58\x4d\x4c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x26\x26\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x3b\x72\x65\x74\x75\x72\x6e\x21\x21\x62\x26\x26\x22\x48\x54\x4d\x4c\x22\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x7d\x2c\x6d\x3d\x67\x61\x2e\x73\x65\x74\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x65\x2c\x67\x3d\x61\x3f\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x3a\x76\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x21\x3d\x3d\x6e\x26\x26\x39\x3d\x3d\x3d\x67\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x67\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x3f\x28\x6e\x3d\x67\x2c\x6f\x3d\x6e\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x70\x3d\x21\x66\x28\x6e\x29\x2c\x76\x21\x3d\x3d\x6e\x26\x26\x28\x65\x3d\x6e\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x29\x26\x26\x65\x2e\x74\x6f\x70\x21\x3d\x3d\x65\x26\x26\x28\x65\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x3f\x65\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x75\x6e\x6c\x6f\x61\x64\x22\x2c\x64\x61\x2c\x21\x31\x29\x3a\x65\x2e\x61\x74\x74\x61\x63\x68\x45\x76\x65\x6e\x74\x26\x26\x65\x2e\x61\x74\x74\x61\x63\x68\x45\x76\x65\x6e\x74\x28\x22\x6f\x6e\x75\x6e\x6c\x6f\x61\x64\x22\x2c\x64\x61\x29\x29\x2c\x63\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3d\x22\x69\x22\x2c\x21\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x29\x7d\x29\x2c\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x6e\x2e\x63\x72\x65\x61\x74\x65\x43\x6f\x6d\x6d\x65\x6e\x74\x28\x22\x22\x29\x29\x2c\x21\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x22\x2a\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x29\x2c\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3d\x59\x2e\x74\x65\x73\x74\x28\x6e\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x2c\x63\x2e\x67\x65\x74\x42\x79\x49\x64\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6f\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x2e\x69\x64\x3d\x75\x2c\x21\x6e\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x4e\x61\x6d\x65\x7c\x7c\x21\x6e\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x4e\x61\x6d\x65\x28\x75\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x29\x2c\x63\x2e\x67\x65\x74\x42\x79\x49\x64\x3f\x28\x64\x2e\x66\x69\x6c\x74\x65\x72\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x29\x3d\x3d\x3d\x62\x7d\x7d\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x26\x26\x70\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3f\x5b\x63\x5d\x3a\x5b\x5d\x7d\x7d\x29\x3a\x28\x64\x2e\x66\x69\x6c\x74\x65\x72\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x63\x3d\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x26\x26\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x22\x69\x64\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x63\x2e\x76\x61\x6c\x75\x65\x3d\x3d\x3d\x62\x7d\x7d\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x26\x26\x70\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x61\x29\x3b\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x63\x3d\x66\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x22\x69\x64\x22\x29\x2c\x63\x26\x26\x63\x2e\x76\x61\x6c\x75\x65\x3d\x3d\x3d\x61\x29\x72\x65\x74\x75\x72\x6e\x5b\x66\x5d\x3b\x65\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x4e\x61\x6d\x65\x28\x61\x29\x2c\x64\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x65\x5b\x64\x2b\x2b\x5d\x29\x69\x66\x28\x63\x3d\x66\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x22\x69\x64\x22\x29\x2c\x63\x26\x26\x63\x2e\x76\x61\x6c\x75\x65\x3d\x3d\x3d\x61\x29\x72\x65\x74\x75\x72\x6e\x5b\x66\x5d\x7d\x72\x65\x74\x75\x72\x6e\x5b\x5d\x7d\x7d\x29\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x54\x41\x47\x3d\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3f\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x61\x29\x3a\x63\x2e\x71\x73\x61\x3f\x62\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x61\x29\x3a\x76\x6f\x69\x64\x20\x30\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x30\x2c\x66\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x61\x29\x3b\x69\x66\x28\x22\x2a\x22\x3d\x3d\x3d\x61\x29\x7b\x77\x68\x69\x6c\x65\x28\x63\x3d\x66\x5b\x65\x2b\x2b\x5d\x29\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x64\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x72\x65\x74\x75\x72\x6e\x20\x66\x7d\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x43\x4c\x41\x53\x53\x3d\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x70\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x28\x61\x29\x7d\x2c\x72\x3d\x5b\x5d\x2c\x71\x3d\x5b\x5d\x2c\x28\x63\x2e\x71\x73\x61\x3d\x59\x2e\x74\x65\x73\x74\x28\x6e\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x29\x29\x26\x26\x28\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x6f\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x61\x20\x69\x64\x3d\x27\x22\x2b\x75\x2b\x22\x27\x3e\x3c\x2f\x61\x3e\x3c\x73\x65\x6c\x65\x63\x74\x20\x69\x64\x3d\x27\x22\x2b\x75\x2b\x22\x2d\x5c\x72\x5c\x5c\x27\x20\x6d\x73\x61\x6c\x6c\x6f\x77\x63\x61\x70\x74\x75\x72\x65\x3d\x27\x27\x3e\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x27\x27\x3e\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x22\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x6d\x73\x61\x6c\x6c\x6f\x77\x63\x61\x70\x74\x75\x72\x65\x5e\x3d\x27\x27\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x5b\x2a\x5e\x24\x5d\x3d\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x27\x27\x7c\x5c\x22\x5c\x22\x29\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x73\x65\x6c\x65\x63\x74\x65\x64\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x5c\x5c\x5b\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x76\x61\x6c\x75\x65\x7c\x22\x2b\x4a\x2b\x22\x29\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x69\x64\x7e\x3d\x22\x2b\x75\x2b\x22\x2d\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x7e\x3d\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x3a\x63\x68\x65\x63\x6b\x65\x64\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x3a\x63\x68\x65\x63\x6b\x65\x64\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x61\x23\x22\x2b\x75\x2b\x22\x2b\x2a\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x2e\x23\x2e\x2b\x5b\x2b\x7e\x5d\x22\x29\x7d\x29\x2c\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x61\x20\x68\x72\x65\x66\x3d\x27\x27\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x27\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3e\x3c\x2f\x61\x3e\x3c\x73\x65\x6c\x65\x63\x74\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x27\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3e\x3c\x6f\x70\x74\x69\x6f\x6e\x2f\x3e\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x22\x3b\x76\x61\x72\x20\x62\x3d\x6e\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x3b\x62\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x2c\x22\x68\x69\x64\x64\x65\x6e\x22\x29\x2c\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x29\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x6e\x61\x6d\x65\x22\x2c\x22\x44\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x6e\x61\x6d\x65\x3d\x64\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x6e\x61\x6d\x65\x22\x2b\x4b\x2b\x22\x2a\x5b\x2a\x5e\x24\x7c\x21\x7e\x5d\x3f\x3d\x22\x29\x2c\x32\x21\x3d\x3d\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x3a\x65\x6e\x61\x62\x6c\x65\x64\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x3a\x65\x6e\x61\x62\x6c\x65\x64\x22\x2c\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x2c\x6f\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x21\x30\x2c\x32\x21\x3d\x3d\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x3a\x65\x6e\x61\x62\x6c\x65\x64\x22\x2c\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x2a\x2c\x3a\x78\x22\x29\x2c\x71\x2e\x70\x75\x73\x68\x28\x22\x2c\x2e\x2a\x3a\x22\x29\x7d\x29\x29\x2c\x28\x63\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x3d\x59\x2e\x74\x65\x73\x74\x28\x73\x3d\x6f\x2e\x6d\x61\x74\x63\x68\x65\x73\x7c\x7c\x6f\x2e\x77\x65\x62\x6b\x69\x74\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x6f\x2e\x6d\x6f\x7a\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x6f\x2e\x6f\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x6f\x2e\x6d\x73\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x29\x29\x26\x26\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x63\x2e\x64\x69\x73\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x4d\x61\x74\x63\x68\x3d\x73\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x22\x2a\x22\x29\x2c\x73\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x22\x5b\x73\x21\x3d\x27\x27\x5d\x3a\x78\x22\x29\x2c\x72\x2e\x70\x75\x73\x68\x28\x22\x21\x3d\x22\x2c\x4e\x29\x7d\x29\x2c\x71\x3d\x71\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x71\x2e\x6a\x6f\x69\x6e\x28\x22\x7c\x22\x29\x29\x2c\x72\x3d\x72\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x72\x2e\x6a\x6f\x69\x6e\x28\x22\x7c\x22\x29\x29\x2c\x62\x3d\x59\x2e\x74\x65\x73\x74\x28\x6f\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x29\x2c\x74\x3d\x62\x7c\x7c\x59\x2e\x74\x65\x73\x74\x28\x6f\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x29\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x39\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x3a\x61\x2c\x64\x3d\x62\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x64\x7c\x7c\x21\x28\x21\x64\x7c\x7c\x31\x21\x3d\x3d\x64\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x21\x28\x63\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3f\x63\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x64\x29\x3a\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x26\x26\x31\x36\x26\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x64\x29\x29\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x62\x29\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x69\x66\x28\x62\x3d\x3d\x3d\x61\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x2c\x42\x3d\x62\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x61\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x6c\x3d\x21\x30\x2c\x30\x3b\x76\x61\x72\x20\x64\x3d\x21\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x2d\x21\x62\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3f\x64\x3a\x28\x64\x3d\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x3d\x3d\x3d\x28\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x62\x29\x3f\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x62\x29\x3a\x31\x2c\x31\x26\x64\x7c\x7c\x21\x63\x2e\x73\x6f\x72\x74\x44\x65\x74\x61\x63\x68\x65\x64\x26\x26\x62\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x61\x29\x3d\x3d\x3d\x64\x3f\x61\x3d\x3d\x3d\x6e\x7c\x7c\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x3d\x3d\x76\x26\x26\x74\x28\x76\x2c\x61\x29\x3f\x2d\x31\x3a\x62\x3d\x3d\x3d\x6e\x7c\x7c\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x3d\x3d\x76\x26\x26\x74\x28\x76\x2c\x62\x29\x3f\x31\x3a\x6b\x3f\x49\x28\x6b\x2c\x61\x29\x2d\x49\x28\x6b\x2c\x62\x29\x3a\x30\x3a\x34\x26\x64\x3f\x2d\x31\x3a\x31\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x61\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x6c\x3d\x21\x30\x2c\x30\x3b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x66\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x67\x3d\x5b\x61\x5d\x2c\x68\x3d\x5b\x62\x5d\x3b\x69\x66\x28\x21\x65\x7c\x7c\x21\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x6e\x3f\x2d\x31\x3a\x62\x3d\x3d\x3d\x6e\x3f\x31\x3a\x65\x3f\x2d\x31\x3a\x66\x3f\x31\x3a\x6b\x3f\x49\x28\x6b\x2c\x61\x29\x2d\x49\x28\x6b\x2c\x62\x29\x3a\x30\x3b\x69\x66\x28\x65\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x6c\x61\x28\x61\x2c\x62\x29\x3b\x63\x3d\x61\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x67\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x63\x29\x3b\x63\x3d\x62\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x68\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x63\x29\x3b\x77\x68\x69\x6c\x65\x28\x67\x5b\x64\x5d\x3d\x3d\x3d\x68\x5b\x64\x5d\x29\x64\x2b\x2b\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3f\x6c\x61\x28\x67\x5b\x64\x5d\x2c\x68\x5b\x64\x5d\x29\x3a\x67\x5b\x64\x5d\x3d\x3d\x3d\x76\x3f\x2d\x31\x3a\x68\x5b\x64\x5d\x3d\x3d\x3d\x76\x3f\x31\x3a\x30\x7d\x2c\x6e\x29\x3a\x6e\x7d\x2c\x67\x61\x2e\x6d\x61\x74\x63\x68\x65\x73\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x67\x61\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x6e\x75\x6c\x6c\x2c\x62\x29\x7d\x2c\x67\x61\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x61\x29\x2c\x62\x3d\x62\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x53\x2c\x22\x3d\x27\x24\x31\x27\x5d\x22\x29\x2c\x63\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x26\x26\x70\x26\x26\x21\x41\x5b\x62\x2b\x22\x20\x22\x5d\x26\x26\x28\x21\x72\x7c\x7c\x21\x72\x2e\x74\x65\x73\x74\x28\x62\x29\x29\x26\x26\x28\x21\x71\x7c\x7c\x21\x71\x2e\x74\x65\x73\x74\x28\x62\x29\x29\x29\x74\x72\x79\x7b\x76\x61\x72\x20\x64\x3d\x73\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x62\x29\x3b\x69\x66\x28\x64\x7c\x7c\x63\x2e\x64\x69\x73\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x4d\x61\x74\x63\x68\x7c\x7c\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x26\x26\x31\x31\x21\x3d\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x63\x61\x74\x63\x68\x28\x65\x29\x7b\x7d\x72\x65\x74\x75\x72\x6e\x20\x67\x61\x28\x62\x2c\x6e\x2c\x6e\x75\x6c\x6c\x2c\x5b\x61\x5d\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x7d\x2c\x67\x61\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x61\x29\x2c\x74\x28\x61\x2c\x62\x29\x7d\x2c\x67\x61\x2e\x61\x74\x74\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x61\x29\x3b\x76\x61\x72\x20\x65\x3d\x64\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x5b\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x2c\x66\x3d\x65\x26\x26\x43\x2e\x63\x61\x6c\x6c\x28\x64\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x2c\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x3f\x65\x28\x61\x2c\x62\x2c\x21\x70\x29\x3a\x76\x6f\x69\x64\x20\x30\x3b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x66\x3f\x66\x3a\x63\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x7c\x7c\x21\x70\x3f\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x62\x29\x3a\x28\x66\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x62\x29\x29\x26\x26\x66\x2e\x73\x70\x65\x63\x69\x66\x69\x65\x64\x3f\x66\x2e\x76\x61\x6c\x75\x65\x3a\x6e\x75\x6c\x6c\x7d\x2c\x67\x61\x2e\x65\x73\x63\x61\x70\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x28\x61\x2b\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x62\x61\x2c\x63\x61\x29\x7d\x2c\x67\x61\x2e\x65\x72\x72\x6f\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x45\x72\x72\x6f\x72\x28\x22\x53\x79\x6e\x74\x61\x78\x20\x65\x72\x72\x6f\x72\x2c\x20\x75\x6e\x72\x65\x63\x6f\x67\x6e\x69\x7a\x65\x64\x20\x65\x78\x70\x72\x65\x73\x73\x69\x6f\x6e\x3a\x20\x22\x2b\x61\x29\x7d\x2c\x67\x61\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x30\x2c\x66\x3d\x30\x3b\x69\x66\x28\x6c\x3d\x21\x63\x2e\x64\x65\x74\x65\x63\x74\x44\x75\x70\x6c\x69\x63\x61\x74\x65\x73\x2c\x6b\x3d\x21\x63\x2e\x73\x6f\x72\x74\x53\x74\x61\x62\x6c\x65\x26\x26\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x2c\x61\x2e\x73\x6f\x72\x74\x28\x42\x29\x2c\x6c\x29\x7b\x77\x68\x69\x6c\x65\x28\x62\x3d\x61\x5b\x66\x2b\x2b\x5d\x29\x62\x3d\x3d\x3d\x61\x5b\x66\x5d\x26\x26\x28\x65\x3d\x64\x2e\x70\x75\x73\x68\x28\x66\x29\x29\x3b\x77\x68\x69\x6c\x65\x28\x65\x2d\x2d\x29\x61\x2e\x73\x70\x6c\x69\x63\x65\x28\x64\x5b\x65\x5d\x2c\x31\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6b\x3d\x6e\x75\x6c\x6c\x2c\x61\x7d\x2c\x65\x3d\x67\x61\x2e\x67\x65\x74\x54\x65\x78\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x22\x22\x2c\x64\x3d\x30\x2c\x66\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3b\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x31\x3d\x3d\x3d\x66\x7c\x7c\x39\x3d\x3d\x3d\x66\x7c\x7c\x31\x31\x3d\x3d\x3d\x66\x29\x7b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3b\x66\x6f\x72\x28\x61\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x3b\x61\x3b\x61\x3d\x61\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x63\x2b\x3d\x65\x28\x61\x29\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x33\x3d\x3d\x3d\x66\x7c\x7c\x34\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x56\x61\x6c\x75\x65\x7d\x65\x6c\x73\x65\x20\x77\x68\x69\x6c\x65\x28\x62\x3d\x61\x5b\x64\x2b\x2b\x5d\x29\x63\x2b\x3d\x65\x28\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x2c\x64\x3d\x67\x61\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x73\x3d\x7b\x63\x61\x63\x68\x65\x4c\x65\x6e\x67\x74\x68\x3a\x35\x30\x2c\x63\x72\x65\x61\x74\x65\x50\x73\x65\x75\x64\x6f\x3a\x69\x61\x2c\x6d\x61\x74\x63\x68\x3a\x56\x2c\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x3a\x7b\x7d\x2c\x66\x69\x6e\x64\x3a\x7b\x7d\x2c\x72\x65\x6c\x61\x74\x69\x76\x65\x3a\x7b\x22\x3e\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x2c\x66\x69\x72\x73\x74\x3a\x21\x30\x7d\x2c\x22\x20\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x7d\x2c\x22\x2b\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x66\x69\x72\x73\x74\x3a\x21\x30\x7d\x2c\x22\x7e\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x7d\x7d\x2c\x70\x72\x65\x46\x69\x6c\x74\x65\x72\x3a\x7b\x41\x54\x54\x52\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x31\x5d\x3d\x61\x5b\x31\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x61\x5b\x33\x5d\x3d\x28\x61\x5b\x33\x5d\x7c\x7c\x61\x5b\x34\x5d\x7c\x7c\x61\x5b\x35\x5d\x7c\x7c\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x22\x7e\x3d\x22\x3d\x3d\x3d\x61\x5b\x32\x5d\x26\x26\x28\x61\x5b\x33\x5d\x3d\x22\x20\x22\x2b\x61\x5b\x33\x5d\x2b\x22\x20\x22\x29\x2c\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x34\x29\x7d\x2c\x43\x48\x49\x4c\x44\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x31\x5d\x3d\x61\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x22\x6e\x74\x68\x22\x3d\x3d\x3d\x61\x5b\x31\x5d\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x33\x29\x3f\x28\x61\x5b\x33\x5d\x7c\x7c\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x61\x5b\x30\x5d\x29\x2c\x61\x5b\x34\x5d\x3d\x2b\x28\x61\x5b\x34\x5d\x3f\x61\x5b\x35\x5d\x2b\x28\x61\x5b\x36\x5d\x7c\x7c\x31\x29\x3a\x32\x2a\x28\x22\x65\x76\x65\x6e\x22\x3d\x3d\x3d\x61\x5b\x33\x5d\x7c\x7c\x22\x6f\x64\x64\x22\x3d\x3d\x3d\x61\x5b\x33\x5d\x29\x29\x2c\x61\x5b\x35\x5d\x3d\x2b\x28\x61\x5b\x37\x5d\x2b\x61\x5b\x38\x5d\x7c\x7c\x22\x6f\x64\x64\x22\x3d\x3d\x3d\x61\x5b\x33\x5d\x29\x29\x3a\x61\x5b\x33\x5d\x26\x26\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x61\x5b\x30\x5d\x29\x2c\x61\x7d\x2c\x50\x53\x45\x55\x44\x4f\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x21\x61\x5b\x36\x5d\x26\x26\x61\x5b\x32\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x56\x2e\x43\x48\x49\x4c\x44\x2e\x74\x65\x73\x74\x28\x61\x5b\x30\x5d\x29\x3f\x6e\x75\x6c\x6c\x3a\x28\x61\x5b\x33\x5d\x3f\x61\x5b\x32\x5d\x3d\x61\x5b\x34\x5d\x7c\x7c\x61\x5b\x35\x5d\x7c\x7c\x22\x22\x3a\x63\x26\x26\x54\x2e\x74\x65\x73\x74\x28\x63\x29\x26\x26\x28\x62\x3d\x67\x28\x63\x2c\x21\x30\x29\x29\x26\x26\x28\x62\x3d\x63\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x29\x22\x2c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x62\x29\x2d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x26\x26\x28\x61\x5b\x30\x5d\x3d\x61\x5b\x30\x5d\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x62\x29\x2c\x61\x5b\x32\x5d\x3d\x63\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x62\x29\x29\x2c\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x33\x29\x29\x7d\x7d\x2c\x66\x69\x6c\x74\x65\x72\x3a\x7b\x54\x41\x47\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x2a\x22\x3d\x3d\x3d\x61\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x26\x26\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x62\x7d\x7d\x2c\x43\x4c\x41\x53\x53\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x79\x5b\x61\x2b\x22\x20\x22\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x7c\x7c\x28\x62\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x28\x5e\x7c\x22\x2b\x4b\x2b\x22\x29\x22\x2b\x61\x2b\x22\x28\x22\x2b\x4b\x2b\x22\x7c\x24\x29\x22\x29\x29\x26\x26\x79\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x74\x65\x73\x74\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x61\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x7c\x7c\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x26\x26\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x29\x7c\x7c\x22\x22\x29\x7d\x29\x7d\x2c\x41\x54\x54\x52\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x67\x61\x2e\x61\x74\x74\x72\x28\x64\x2c\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x65\x3f\x22\x21\x3d\x22\x3d\x3d\x3d\x62\x3a\x21\x62\x7c\x7c\x28\x65\x2b\x3d\x22\x22\x2c\x22\x3d\x22\x3d\x3d\x3d\x62\x3f\x65\x3d\x3d\x3d\x63\x3a\x22\x21\x3d\x22\x3d\x3d\x3d\x62\x3f\x65\x21\x3d\x3d\x63\x3a\x22\x5e\x3d\x22\x3d\x3d\x3d\x62\x3f\x63\x26\x26\x30\x3d\x3d\x3d\x65\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x63\x29\x3a\x22\x2a\x3d\x22\x3d\x3d\x3d\x62\x3f\x63\x26\x26\x65\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x63\x29\x3e\x2d\x31\x3a\x22\x24\x3d\x22\x3d\x3d\x3d\x62\x3f\x63\x26\x26\x65\x2e\x73\x6c\x69\x63\x65\x28\x2d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x3d\x3d\x3d\x63\x3a\x22\x7e\x3d\x22\x3d\x3d\x3d\x62\x3f\x28\x22\x20\x22\x2b\x65\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x4f\x2c\x22\x20\x22\x29\x2b\x22\x20\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x63\x29\x3e\x2d\x31\x3a\x22\x7c\x3d\x22\x3d\x3d\x3d\x62\x26\x26\x28\x65\x3d\x3d\x3d\x63\x7c\x7c\x65\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2b\x31\x29\x3d\x3d\x3d\x63\x2b\x22\x2d\x22\x29\x29\x7d\x7d\x2c\x43\x48\x49\x4c\x44\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x22\x6e\x74\x68\x22\x21\x3d\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x33\x29\x2c\x67\x3d\x22\x6c\x61\x73\x74\x22\x21\x3d\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x2d\x34\x29\x2c\x68\x3d\x22\x6f\x66\x2d\x74\x79\x70\x65\x22\x3d\x3d\x3d\x62\x3b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x64\x26\x26\x30\x3d\x3d\x3d\x65\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x69\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x3d\x66\x21\x3d\x3d\x67\x3f\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x3a\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x71\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x72\x3d\x68\x26\x26\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x73\x3d\x21\x69\x26\x26\x21\x68\x2c\x74\x3d\x21\x31\x3b\x69\x66\x28\x71\x29\x7b\x69\x66\x28\x66\x29\x7b\x77\x68\x69\x6c\x65\x28\x70\x29\x7b\x6d\x3d\x62\x3b\x77\x68\x69\x6c\x65\x28\x6d\x3d\x6d\x5b\x70\x5d\x29\x69\x66\x28\x68\x3f\x6d\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x72\x3a\x31\x3d\x3d\x3d\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x6f\x3d\x70\x3d\x22\x6f\x6e\x6c\x79\x22\x3d\x3d\x3d\x61\x26\x26\x21\x6f\x26\x26\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x7d\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x69\x66\x28\x6f\x3d\x5b\x67\x3f\x71\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x3a\x71\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x5d\x2c\x67\x26\x26\x73\x29\x7b\x6d\x3d\x71\x2c\x6c\x3d\x6d\x5b\x75\x5d\x7c\x7c\x28\x6d\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x6a\x3d\x6b\x5b\x61\x5d\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x6a\x5b\x30\x5d\x3d\x3d\x3d\x77\x26\x26\x6a\x5b\x31\x5d\x2c\x74\x3d\x6e\x26\x26\x6a\x5b\x32\x5d\x2c\x6d\x3d\x6e\x26\x26\x71\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x5b\x6e\x5d\x3b\x77\x68\x69\x6c\x65\x28\x6d\x3d\x2b\x2b\x6e\x26\x26\x6d\x26\x26\x6d\x5b\x70\x5d\x7c\x7c\x28\x74\x3d\x6e\x3d\x30\x29\x7c\x7c\x6f\x2e\x70\x6f\x70\x28\x29\x29\x69\x66\x28\x31\x3d\x3d\x3d\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x2b\x2b\x74\x26\x26\x6d\x3d\x3d\x3d\x62\x29\x7b\x6b\x5b\x61\x5d\x3d\x5b\x77\x2c\x6e\x2c\x74\x5d\x3b\x62\x72\x65\x61\x6b\x7d\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x73\x26\x26\x28\x6d\x3d\x62\x2c\x6c\x3d\x6d\x5b\x75\x5d\x7c\x7c\x28\x6d\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x6a\x3d\x6b\x5b\x61\x5d\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x6a\x5b\x30\x5d\x3d\x3d\x3d\x77\x26\x26\x6a\x5b\x31\x5d\x2c\x74\x3d\x6e\x29\x2c\x74\x3d\x3d\x3d\x21\x31\x29\x77\x68\x69\x6c\x65\x28\x6d\x3d\x2b\x2b\x6e\x26\x26\x6d\x26\x26\x6d\x5b\x70\x5d\x7c\x7c\x28\x74\x3d\x6e\x3d\x30\x29\x7c\x7c\x6f\x2e\x70\x6f\x70\x28\x29\x29\x69\x66\x28\x28\x68\x3f\x6d\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x72\x3a\x31\x3d\x3d\x3d\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x26\x26\x2b\x2b\x74\x26\x26\x28\x73\x26\x26\x28\x6c\x3d\x6d\x5b\x75\x5d\x7c\x7c\x28\x6d\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x6b\x5b\x61\x5d\x3d\x5b\x77\x2c\x74\x5d\x29\x2c\x6d\x3d\x3d\x3d\x62\x29\x29\x62\x72\x65\x61\x6b\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x2d\x3d\x65\x2c\x74\x3d\x3d\x3d\x64\x7c\x7c\x74\x25\x64\x3d\x3d\x3d\x30\x26\x26\x74\x2f\x64\x3e\x3d\x30\x7d\x7d\x7d\x2c\x50\x53\x45\x55\x44\x4f\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x65\x3d\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x5b\x61\x5d\x7c\x7c\x64\x2e\x73\x65\x74\x46\x69\x6c\x74\x65\x72\x73\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7c\x7c\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x22\x75\x6e\x73\x75\x70\x70\x6f\x72\x74\x65\x64\x20\x70\x73\x65\x75\x64\x6f\x3a\x20\x22\x2b\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x5b\x75\x5d\x3f\x65\x28\x62\x29\x3a\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x28\x63\x3d\x5b\x61\x2c\x61\x2c\x22\x22\x2c\x62\x5d\x2c\x64\x2e\x73\x65\x74\x46\x69\x6c\x74\x65\x72\x73\x2e\x68\x61\x73\x4f\x77\x6e\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x3f\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x66\x3d\x65\x28\x61\x2c\x62\x29\x2c\x67\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x64\x3d\x49\x28\x61\x2c\x66\x5b\x67\x5d\x29\x2c\x61\x5b\x64\x5d\x3d\x21\x28\x63\x5b\x64\x5d\x3d\x66\x5b\x67\x5d\x29\x7d\x29\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x28\x61\x2c\x30\x2c\x63\x29\x7d\x29\x3a\x65\x7d\x7d\x2c\x70\x73\x65\x75\x64\x6f\x73\x3a\x7b\x6e\x6f\x74\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x5b\x5d\x2c\x63\x3d\x5b\x5d\x2c\x64\x3d\x68\x28\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x24\x31\x22\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x5b\x75\x5d\x3f\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x3d\x64\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x65\x2c\x5b\x5d\x29\x2c\x68\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x68\x2d\x2d\x29\x28\x66\x3d\x67\x5b\x68\x5d\x29\x26\x26\x28\x61\x5b\x68\x5d\x3d\x21\x28\x62\x5b\x68\x5d\x3d\x66\x29\x29\x7d\x29\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x65\x2c\x66\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x5b\x30\x5d\x3d\x61\x2c\x64\x28\x62\x2c\x6e\x75\x6c\x6c\x2c\x66\x2c\x63\x29\x2c\x62\x5b\x30\x5d\x3d\x6e\x75\x6c\x6c\x2c\x21\x63\x2e\x70\x6f\x70\x28\x29\x7d\x7d\x29\x2c\x68\x61\x73\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x67\x61\x28\x61\x2c\x62\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x7d\x7d\x29\x2c\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x28\x62\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x7c\x7c\x62\x2e\x69\x6e\x6e\x65\x72\x54\x65\x78\x74\x7c\x7c\x65\x28\x62\x29\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x61\x29\x3e\x2d\x31\x7d\x7d\x29\x2c\x6c\x61\x6e\x67\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x55\x2e\x74\x65\x73\x74\x28\x61\x7c\x7c\x22\x22\x29\x7c\x7c\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x22\x75\x6e\x73\x75\x70\x70\x6f\x72\x74\x65\x64\x20\x6c\x61\x6e\x67\x3a\x20\x22\x2b\x61\x29\x2c\x61\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x64\x6f\x20\x69\x66\x28\x63\x3d\x70\x3f\x62\x2e\x6c\x61\x6e\x67\x3a\x62\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x78\x6d\x6c\x3a\x6c\x61\x6e\x67\x22\x29\x7c\x7c\x62\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x6c\x61\x6e\x67\x22\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x63\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x63\x3d\x3d\x3d\x61\x7c\x7c\x30\x3d\x3d\x3d\x63\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x61\x2b\x22\x2d\x22\x29\x3b\x77\x68\x69\x6c\x65\x28\x28\x62\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x26\x26\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x7d\x29\x2c\x74\x61\x72\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x26\x26\x61\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x61\x73\x68\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x63\x2e\x73\x6c\x69\x63\x65\x28\x31\x29\x3d\x3d\x3d\x62\x2e\x69\x64\x7d\x2c\x72\x6f\x6f\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x6f\x7d\x2c\x66\x6f\x63\x75\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x6e\x2e\x61\x63\x74\x69\x76\x65\x45\x6c\x65\x6d\x65\x6e\x74\x26\x26\x28\x21\x6e\x2e\x68\x61\x73\x46\x6f\x63\x75\x73\x7c\x7c\x6e\x2e\x68\x61\x73\x46\x6f\x63\x75\x73\x28\x29\x29\x26\x26\x21\x21\x28\x61\x2e\x74\x79\x70\x65\x7c\x7c\x61\x2e\x68\x72\x65\x66\x7c\x7c\x7e\x61\x2e\x74\x61\x62\x49\x6e\x64\x65\x78\x29\x7d\x2c\x65\x6e\x61\x62\x6c\x65\x64\x3a\x6f\x61\x28\x21\x31\x29\x2c\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x6f\x61\x28\x21\x30\x29\x2c\x63\x68\x65\x63\x6b\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x62\x26\x26\x21\x21\x61\x2e\x63\x68\x65\x63\x6b\x65\x64\x7c\x7c\x22\x6f\x70\x74\x69\x6f\x6e\x22\x3d\x3d\x3d\x62\x26\x26\x21\x21\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x7d\x2c\x73\x65\x6c\x65\x63\x74\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x3d\x3d\x21\x30\x7d\x2c\x65\x6d\x70\x74\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x6f\x72\x28\x61\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x3b\x61\x3b\x61\x3d\x61\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x69\x66\x28\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3c\x36\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x2c\x70\x61\x72\x65\x6e\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x65\x6d\x70\x74\x79\x28\x61\x29\x7d\x2c\x68\x65\x61\x64\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x58\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x7d\x2c\x69\x6e\x70\x75\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x57\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x7d\x2c\x62\x75\x74\x74\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x62\x26\x26\x22\x62\x75\x74\x74\x6f\x6e\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x7c\x7c\x22\x62\x75\x74\x74\x6f\x6e\x22\x3d\x3d\x3d\x62\x7d\x2c\x74\x65\x78\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x26\x26\x22\x74\x65\x78\x74\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x26\x26\x28\x6e\x75\x6c\x6c\x3d\x3d\x28\x62\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x29\x29\x7c\x7c\x22\x74\x65\x78\x74\x22\x3d\x3d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x7d\x2c\x66\x69\x72\x73\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x5b\x30\x5d\x7d\x29\x2c\x6c\x61\x73\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x5b\x62\x2d\x31\x5d\x7d\x29\x2c\x65\x71\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x5b\x63\x3c\x30\x3f\x63\x2b\x62\x3a\x63\x5d\x7d\x29\x2c\x65\x76\x65\x6e\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x30\x3b\x63\x3c\x62\x3b\x63\x2b\x3d\x32\x29\x61\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2c\x6f\x64\x64\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x31\x3b\x63\x3c\x62\x3b\x63\x2b\x3d\x32\x29\x61\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2c\x6c\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x63\x3c\x30\x3f\x63\x2b\x62\x3a\x63\x3b\x2d\x2d\x64\x3e\x3d\x30\x3b\x29\x61\x2e\x70\x75\x73\x68\x28\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2c\x67\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x63\x3c\x30\x3f\x63\x2b\x62\x3a\x63\x3b\x2b\x2b\x64\x3c\x62\x3b\x29\x61\x2e\x70\x75\x73\x68\x28\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x7d\x7d\x2c\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x6e\x74\x68\x3d\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x65\x71\x3b\x66\x6f\x72\x28\x62\x20\x69\x6e\x7b\x72\x61\x64\x69\x6f\x3a\x21\x30\x2c\x63\x68\x65\x63\x6b\x62\x6f\x78\x3a\x21\x30\x2c\x66\x69\x6c\x65\x3a\x21\x30\x2c\x70\x61\x73\x73\x77\x6f\x72\x64\x3a\x21\x30\x2c\x69\x6d\x61\x67\x65\x3a\x21\x30\x7d\x29\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x5b\x62\x5d\x3d\x6d\x61\x28\x62\x29\x3b\x66\x6f\x72\x28\x62\x20\x69\x6e\x7b\x73\x75\x62\x6d\x69\x74\x3a\x21\x30\x2c\x72\x65\x73\x65\x74\x3a\x21\x30\x7d\x29\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x5b\x62\x5d\x3d\x6e\x61\x28\x62\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x61\x28\x29\x7b\x7d\x72\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x64\x2e\x66\x69\x6c\x74\x65\x72\x73\x3d\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2c\x64\x2e\x73\x65\x74\x46\x69\x6c\x74\x65\x72\x73\x3d\x6e\x65\x77\x20\x72\x61\x2c\x67\x3d\x67\x61\x2e\x74\x6f\x6b\x65\x6e\x69\x7a\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x3d\x7a\x5b\x61\x2b\x22\x20\x22\x5d\x3b\x69\x66\x28\x6b\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x30\x3a\x6b\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x3b\x68\x3d\x61\x2c\x69\x3d\x5b\x5d\x2c\x6a\x3d\x64\x2e\x70\x72\x65\x46\x69\x6c\x74\x65\x72\x3b\x77\x68\x69\x6c\x65\x28\x68\x29\x7b\x63\x26\x26\x21\x28\x65\x3d\x51\x2e\x65\x78\x65\x63\x28\x68\x29\x29\x7c\x7c\x28\x65\x26\x26\x28\x68\x3d\x68\x2e\x73\x6c\x69\x63\x65\x28\x65\x5b\x30\x5d\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7c\x7c\x68\x29\x2c\x69\x2e\x70\x75\x73\x68\x28\x66\x3d\x5b\x5d\x29\x29\x2c\x63\x3d\x21\x31\x2c\x28\x65\x3d\x52\x2e\x65\x78\x65\x63\x28\x68\x29\x29\x26\x26\x28\x63\x3d\x65\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x66\x2e\x70\x75\x73\x68\x28\x7b\x76\x61\x6c\x75\x65\x3a\x63\x2c\x74\x79\x70\x65\x3a\x65\x5b\x30\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x20\x22\x29\x7d\x29\x2c\x68\x3d\x68\x2e\x73\x6c\x69\x63\x65\x28\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x29\x3b\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x64\x2e\x66\x69\x6c\x74\x65\x72\x29\x21\x28\x65\x3d\x56\x5b\x67\x5d\x2e\x65\x78\x65\x63\x28\x68\x29\x29\x7c\x7c\x6a\x5b\x67\x5d\x26\x26\x21\x28\x65\x3d\x6a\x5b\x67\x5d\x28\x65\x29\x29\x7c\x7c\x28\x63\x3d\x65\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x66\x2e\x70\x75\x73\x68\x28\x7b\x76\x61\x6c\x75\x65\x3a\x63\x2c\x74\x79\x70\x65\x3a\x67\x2c\x6d\x61\x74\x63\x68\x65\x73\x3a\x65\x7d\x29\x2c\x68\x3d\x68\x2e\x73\x6c\x69\x63\x65\x28\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x29\x3b\x69\x66\x28\x21\x63\x29\x62\x72\x65\x61\x6b\x7d\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x68\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x68\x3f\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x61\x29\x3a\x7a\x28\x61\x2c\x69\x29\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x61\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x3d\x30\x2c\x63\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x64\x3d\x22\x22\x3b\x62\x3c\x63\x3b\x62\x2b\x2b\x29\x64\x2b\x3d\x61\x5b\x62\x5d\x2e\x76\x61\x6c\x75\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x74\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x62\x2e\x64\x69\x72\x2c\x65\x3d\x62\x2e\x6e\x65\x78\x74\x2c\x66\x3d\x65\x7c\x7c\x64\x2c\x67\x3d\x63\x26\x26\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x3d\x3d\x3d\x66\x2c\x68\x3d\x78\x2b\x2b\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x66\x69\x72\x73\x74\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x65\x29\x7b\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x5b\x64\x5d\x29\x69\x66\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x67\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x28\x62\x2c\x63\x2c\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x69\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x3d\x5b\x77\x2c\x68\x5d\x3b\x69\x66\x28\x69\x29\x7b\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x5b\x64\x5d\x29\x69\x66\x28\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x67\x29\x26\x26\x61\x28\x62\x2c\x63\x2c\x69\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x65\x6c\x73\x65\x20\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x5b\x64\x5d\x29\x69\x66\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x67\x29\x69\x66\x28\x6c\x3d\x62\x5b\x75\x5d\x7c\x7c\x28\x62\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x62\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x62\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x65\x26\x26\x65\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x62\x3d\x62\x5b\x64\x5d\x7c\x7c\x62\x3b\x65\x6c\x73\x65\x7b\x69\x66\x28\x28\x6a\x3d\x6b\x5b\x66\x5d\x29\x26\x26\x6a\x5b\x30\x5d\x3d\x3d\x3d\x77\x26\x26\x6a\x5b\x31\x5d\x3d\x3d\x3d\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x6d\x5b\x32\x5d\x3d\x6a\x5b\x32\x5d\x3b\x69\x66\x28\x6b\x5b\x66\x5d\x3d\x6d\x2c\x6d\x5b\x32\x5d\x3d\x61\x28\x62\x2c\x63\x2c\x69\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x75\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x65\x2d\x2d\x29\x69\x66\x28\x21\x61\x5b\x65\x5d\x28\x62\x2c\x63\x2c\x64\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x3a\x61\x5b\x30\x5d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x76\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x30\x2c\x65\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x67\x61\x28\x61\x2c\x62\x5b\x64\x5d\x2c\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x66\x2c\x67\x3d\x5b\x5d\x2c\x68\x3d\x30\x2c\x69\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6a\x3d\x6e\x75\x6c\x6c\x21\x3d\x62\x3b\x68\x3c\x69\x3b\x68\x2b\x2b\x29\x28\x66\x3d\x61\x5b\x68\x5d\x29\x26\x26\x28\x63\x26\x26\x21\x63\x28\x66\x2c\x64\x2c\x65\x29\x7c\x7c\x28\x67\x2e\x70\x75\x73\x68\x28\x66\x29\x2c\x6a\x26\x26\x62\x2e\x70\x75\x73\x68\x28\x68\x29\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x78\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x26\x26\x21\x64\x5b\x75\x5d\x26\x26\x28\x64\x3d\x78\x61\x28\x64\x29\x29\x2c\x65\x26\x26\x21\x65\x5b\x75\x5d\x26\x26\x28\x65\x3d\x78\x61\x28\x65\x2c\x66\x29\x29\x2c\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x2c\x67\x2c\x68\x2c\x69\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x3d\x5b\x5d\x2c\x6e\x3d\x5b\x5d\x2c\x6f\x3d\x67\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x70\x3d\x66\x7c\x7c\x76\x61\x28\x62\x7c\x7c\x22\x2a\x22\x2c\x68\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x5b\x68\x5d\x3a\x68\x2c\x5b\x5d\x29\x2c\x71\x3d\x21\x61\x7c\x7c\x21\x66\x26\x26\x62\x3f\x70\x3a\x77\x61\x28\x70\x2c\x6d\x2c\x61\x2c\x68\x2c\x69\x29\x2c\x72\x3d\x63\x3f\x65\x7c\x7c\x28\x66\x3f\x61\x3a\x6f\x7c\x7c\x64\x29\x3f\x5b\x5d\x3a\x67\x3a\x71\x3b\x69\x66\x28\x63\x26\x26\x63\x28\x71\x2c\x72\x2c\x68\x2c\x69\x29\x2c\x64\x29\x7b\x6a\x3d\x77\x61\x28\x72\x2c\x6e\x29\x2c\x64\x28\x6a\x2c\x5b\x5d\x2c\x68\x2c\x69\x29\x2c\x6b\x3d\x6a\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x28\x6c\x3d\x6a\x5b\x6b\x5d\x29\x26\x26\x28\x72\x5b\x6e\x5b\x6b\x5d\x5d\x3d\x21\x28\x71\x5b\x6e\x5b\x6b\x5d\x5d\x3d\x6c\x29\x29\x7d\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x65\x7c\x7c\x61\x29\x7b\x69\x66\x28\x65\x29\x7b\x6a\x3d\x5b\x5d\x2c\x6b\x3d\x72\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x28\x6c\x3d\x72\x5b\x6b\x5d\x29\x26\x26\x6a\x2e\x70\x75\x73\x68\x28\x71\x5b\x6b\x5d\x3d\x6c\x29\x3b\x65\x28\x6e\x75\x6c\x6c\x2c\x72\x3d\x5b\x5d\x2c\x6a\x2c\x69\x29\x7d\x6b\x3d\x72\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x28\x6c\x3d\x72\x5b\x6b\x5d\x29\x26\x26\x28\x6a\x3d\x65\x3f\x49\x28\x66\x2c\x6c\x29\x3a\x6d\x5b\x6b\x5d\x29\x3e\x2d\x31\x26\x26\x28\x66\x5b\x6a\x5d\x3d\x21\x28\x67\x5b\x6a\x5d\x3d\x6c\x29\x29\x7d\x7d\x65\x6c\x73\x65\x20\x72\x3d\x77\x61\x28\x72\x3d\x3d\x3d\x67\x3f\x72\x2e\x73\x70\x6c\x69\x63\x65\x28\x6f\x2c\x72\x2e\x6c\x65\x6e\x67\x74\x68\x29\x3a\x72\x29\x2c\x65\x3f\x65\x28\x6e\x75\x6c\x6c\x2c\x67\x2c\x72\x2c\x69\x29\x3a\x47\x2e\x61\x70\x70\x6c\x79\x28\x67\x2c\x72\x29\x7d\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x79\x61\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x2c\x63\x2c\x65\x2c\x66\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x67\x3d\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x61\x5b\x30\x5d\x2e\x74\x79\x70\x65\x5d\x2c\x68\x3d\x67\x7c\x7c\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x22\x20\x22\x5d\x2c\x69\x3d\x67\x3f\x31\x3a\x30\x2c\x6b\x3d\x74\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x7d\x2c\x68\x2c\x21\x30\x29\x2c\x6c\x3d\x74\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x28\x62\x2c\x61\x29\x3e\x2d\x31\x7d\x2c\x68\x2c\x21\x30\x29\x2c\x6d\x3d\x5b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x21\x67\x26\x26\x28\x64\x7c\x7c\x63\x21\x3d\x3d\x6a\x29\x7c\x7c\x28\x28\x62\x3d\x63\x29\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x6b\x28\x61\x2c\x63\x2c\x64\x29\x3a\x6c\x28\x61\x2c\x63\x2c\x64\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x6e\x75\x6c\x6c\x2c\x65\x7d\x5d\x3b\x69\x3c\x66\x3b\x69\x2b\x2b\x29\x69\x66\x28\x63\x3d\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x61\x5b\x69\x5d\x2e\x74\x79\x70\x65\x5d\x29\x6d\x3d\x5b\x74\x61\x28\x75\x61\x28\x6d\x29\x2c\x63\x29\x5d\x3b\x65\x6c\x73\x65\x7b\x69\x66\x28\x63\x3d\x64\x2e\x66\x69\x6c\x74\x65\x72\x5b\x61\x5b\x69\x5d\x2e\x74\x79\x70\x65\x5d\x2e\x61\x70\x70\x6c\x79\x28\x6e\x75\x6c\x6c\x2c\x61\x5b\x69\x5d\x2e\x6d\x61\x74\x63\x68\x65\x73\x29\x2c\x63\x5b\x75\x5d\x29\x7b\x66\x6f\x72\x28\x65\x3d\x2b\x2b\x69\x3b\x65\x3c\x66\x3b\x65\x2b\x2b\x29\x69\x66\x28\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x61\x5b\x65\x5d\x2e\x74\x79\x70\x65\x5d\x29\x62\x72\x65\x61\x6b\x3b\x72\x65\x74\x75\x72\x6e\x20\x78\x61\x28\x69\x3e\x31\x26\x26\x75\x61\x28\x6d\x29\x2c\x69\x3e\x31\x26\x26\x73\x61\x28\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x69\x2d\x31\x29\x2e\x63\x6f\x6e\x63\x61\x74\x28\x7b\x76\x61\x6c\x75\x65\x3a\x22\x20\x22\x3d\x3d\x3d\x61\x5b\x69\x2d\x32\x5d\x2e\x74\x79\x70\x65\x3f\x22\x2a\x22\x3a\x22\x22\x7d\x29\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x24\x31\x22\x29\x2c\x63\x2c\x69\x3c\x65\x26\x26\x79\x61\x28\x61\x2e\x73\x6c\x69\x63\x65\x28\x69\x2c\x65\x29\x29\x2c\x65\x3c\x66\x26\x26\x79\x61\x28\x61\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x65\x29\x29\x2c\x65\x3c\x66\x26\x26\x73\x61\x28\x61\x29\x29\x7d\x6d\x2e\x70\x75\x73\x68\x28\x63\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x75\x61\x28\x6d\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x7a\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x2c\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x2c\x66\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6b\x29\x7b\x76\x61\x72\x20\x6c\x2c\x6f\x2c\x71\x2c\x72\x3d\x30\x2c\x73\x3d\x22\x30\x22\x2c\x74\x3d\x66\x26\x26\x5b\x5d\x2c\x75\x3d\x5b\x5d\x2c\x76\x3d\x6a\x2c\x78\x3d\x66\x7c\x7c\x65\x26\x26\x64\x2e\x66\x69\x6e\x64\x2e\x54\x41\x47\x28\x22\x2a\x22\x2c\x6b\x29\x2c\x79\x3d\x77\x2b\x3d\x6e\x75\x6c\x6c\x3d\x3d\x76\x3f\x31\x3a\x4d\x61\x74\x68\x2e\x72\x61\x6e\x64\x6f\x6d\x28\x29\x7c\x7c\x2e\x31\x2c\x7a\x3d\x78\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x6f\x72\x28\x6b\x26\x26\x28\x6a\x3d\x67\x3d\x3d\x3d\x6e\x7c\x7c\x67\x7c\x7c\x6b\x29\x3b\x73\x21\x3d\x3d\x7a\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x28\x6c\x3d\x78\x5b\x73\x5d\x29\x3b\x73\x2b\x2b\x29\x7b\x69\x66\x28\x65\x26\x26\x6c\x29\x7b\x6f\x3d\x30\x2c\x67\x7c\x7c\x6c\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x3d\x3d\x6e\x7c\x7c\x28\x6d\x28\x6c\x29\x2c\x68\x3d\x21\x70\x29\x3b\x77\x68\x69\x6c\x65\x28\x71\x3d\x61\x5b\x6f\x2b\x2b\x5d\x29\x69\x66\x28\x71\x28\x6c\x2c\x67\x7c\x7c\x6e\x2c\x68\x29\x29\x7b\x69\x2e\x70\x75\x73\x68\x28\x6c\x29\x3b\x62\x72\x65\x61\x6b\x7d\x6b\x26\x26\x28\x77\x3d\x79\x29\x7d\x63\x26\x26\x28\x28\x6c\x3d\x21\x71\x26\x26\x6c\x29\x26\x26\x72\x2d\x2d\x2c\x66\x26\x26\x74\x2e\x70\x75\x73\x68\x28\x6c\x29\x29\x7d\x69\x66\x28\x72\x2b\x3d\x73\x2c\x63\x26\x26\x73\x21\x3d\x3d\x72\x29\x7b\x6f\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x71\x3d\x62\x5b\x6f\x2b\x2b\x5d\x29\x71\x28\x74\x2c\x75\x2c\x67\x2c\x68\x29\x3b\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x72\x3e\x30\x29\x77\x68\x69\x6c\x65\x28\x73\x2d\x2d\x29\x74\x5b\x73\x5d\x7c\x7c\x75\x5b\x73\x5d\x7c\x7c\x28\x75\x5b\x73\x5d\x3d\x45\x2e\x63\x61\x6c\x6c\x28\x69\x29\x29\x3b\x75\x3d\x77\x61\x28\x75\x29\x7d\x47\x2e\x61\x70\x70\x6c\x79\x28\x69\x2c\x75\x29\x2c\x6b\x26\x26\x21\x66\x26\x26\x75\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x26\x26\x72\x2b\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x26\x26\x67\x61\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x69\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6b\x26\x26\x28\x77\x3d\x79\x2c\x6a\x3d\x76\x29\x2c\x74\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3f\x69\x61\x28\x66\x29\x3a\x66\x7d\x72\x65\x74\x75\x72\x6e\x20\x68\x3d\x67\x61\x2e\x63\x6f\x6d\x70\x69\x6c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x5b\x5d\x2c\x66\x3d\x41\x5b\x61\x2b\x22\x20\x22\x5d\x3b\x69\x66\x28\x21\x66\x29\x7b\x62\x7c\x7c\x28\x62\x3d\x67\x28\x61\x29\x29\x2c\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x66\x3d\x79\x61\x28\x62\x5b\x63\x5d\x29\x2c\x66\x5b\x75\x5d\x3f\x64\x2e\x70\x75\x73\x68\x28\x66\x29\x3a\x65\x2e\x70\x75\x73\x68\x28\x66\x29\x3b\x66\x3d\x41\x28\x61\x2c\x7a\x61\x28\x65\x2c\x64\x29\x29\x2c\x66\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x3d\x61\x7d\x72\x65\x74\x75\x72\x6e\x20\x66\x7d\x2c\x69\x3d\x67\x61\x2e\x73\x65\x6c\x65\x63\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x3d\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x61\x2c\x6e\x3d\x21\x65\x26\x26\x67\x28\x61\x3d\x6d\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x61\x29\x3b\x69\x66\x28\x63\x3d\x63\x7c\x7c\x5b\x5d\x2c\x31\x3d\x3d\x3d\x6e\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7b\x69\x66\x28\x69\x3d\x6e\x5b\x30\x5d\x3d\x6e\x5b\x30\x5d\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x2c\x69\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x32\x26\x26\x22\x49\x44\x22\x3d\x3d\x3d\x28\x6a\x3d\x69\x5b\x30\x5d\x29\x2e\x74\x79\x70\x65\x26\x26\x39\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x70\x26\x26\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x69\x5b\x31\x5d\x2e\x74\x79\x70\x65\x5d\x29\x7b\x69\x66\x28\x62\x3d\x28\x64\x2e\x66\x69\x6e\x64\x2e\x49\x44\x28\x6a\x2e\x6d\x61\x74\x63\x68\x65\x73\x5b\x30\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x62\x29\x7c\x7c\x5b\x5d\x29\x5b\x30\x5d\x2c\x21\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3b\x6d\x26\x26\x28\x62\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x2c\x61\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x69\x2e\x73\x68\x69\x66\x74\x28\x29\x2e\x76\x61\x6c\x75\x65\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x66\x3d\x56\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x30\x3a\x69\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x66\x2d\x2d\x29\x7b\x69\x66\x28\x6a\x3d\x69\x5b\x66\x5d\x2c\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x6b\x3d\x6a\x2e\x74\x79\x70\x65\x5d\x29\x62\x72\x65\x61\x6b\x3b\x69\x66\x28\x28\x6c\x3d\x64\x2e\x66\x69\x6e\x64\x5b\x6b\x5d\x29\x26\x26\x28\x65\x3d\x6c\x28\x6a\x2e\x6d\x61\x74\x63\x68\x65\x73\x5b\x30\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x24\x2e\x74\x65\x73\x74\x28\x69\x5b\x30\x5d\x2e\x74\x79\x70\x65\x29\x26\x26\x71\x61\x28\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x7c\x7c\x62\x29\x29\x29\x7b\x69\x66\x28\x69\x2e\x73\x70\x6c\x69\x63\x65\x28\x66\x2c\x31\x29\x2c\x61\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x73\x61\x28\x69\x29\x2c\x21\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x63\x2c\x65\x29\x2c\x63\x3b\x62\x72\x65\x61\x6b\x7d\x7d\x7d\x72\x65\x74\x75\x72\x6e\x28\x6d\x7c\x7c\x68\x28\x61\x2c\x6e\x29\x29\x28\x65\x2c\x62\x2c\x21\x70\x2c\x63\x2c\x21\x62\x7c\x7c\x24\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x71\x61\x28\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x7c\x7c\x62\x29\x2c\x63\x7d\x2c\x63\x2e\x73\x6f\x72\x74\x53\x74\x61\x62\x6c\x65\x3d\x75\x2e\x73\x70\x6c\x69\x74\x28\x22\x22\x29\x2e\x73\x6f\x72\x74\x28\x42\x29\x2e\x6a\x6f\x69\x6e\x28\x22\x22\x29\x3d\x3d\x3d\x75\x2c\x63\x2e\x64\x65\x74\x65\x63\x74\x44\x75\x70\x6c\x69\x63\x61\x74\x65\x73\x3d\x21\x21\x6c\x2c\x6d\x28\x29\x2c\x63\x2e\x73\x6f\x72\x74\x44\x65\x74\x61\x63\x68\x65\x64\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x26\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x6e\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x66\x69\x65\x6c\x64\x73\x65\x74\x22\x29\x29\x7d\x29\x2c\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x61\x20\x68\x72\x65\x66\x3d\x27\x23\x27\x3e\x3c\x2f\x61\x3e\x22\x2c\x22\x23\x22\x3d\x3d\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x68\x72\x65\x66\x22\x29\x7d\x29\x7c\x7c\x6b\x61\x28\x22\x74\x79\x70\x65\x7c\x68\x72\x65\x66\x7c\x68\x65\x69\x67\x68\x74\x7c\x77\x69\x64\x74\x68\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x69\x66\x28\x21\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x62\x2c\x22\x74\x79\x70\x65\x22\x3d\x3d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3f\x31\x3a\x32\x29\x7d\x29\x2c\x63\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x26\x26\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x69\x6e\x70\x75\x74\x2f\x3e\x22\x2c\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x76\x61\x6c\x75\x65\x22\x2c\x22\x22\x29\x2c\x22\x22\x3d\x3d\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x76\x61\x6c\x75\x65\x22\x29\x7d\x29\x7c\x7c\x6b\x61\x28\x22\x76\x61\x6c\x75\x65\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x69\x66\x28\x21\x63\x26\x26\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x7d\x29\x2c\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x7d\x29\x7c\x7c\x6b\x61\x28\x4a\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x69\x66\x28\x21\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x62\x5d\x3d\x3d\x3d\x21\x30\x3f\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3a\x28\x64\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x62\x29\x29\x26\x26\x64\x2e\x73\x70\x65\x63\x69\x66\x69\x65\x64\x3f\x64\x2e\x76\x61\x6c\x75\x65\x3a\x6e\x75\x6c\x6c\x7d\x29\x2c\x67\x61\x7d\x28\x61\x29\x3b\x72\x2e\x66\x69\x6e\x64\x3d\x78\x2c\x72\x2e\x65\x78\x70\x72\x3d\x78\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x73\x2c\x72\x2e\x65\x78\x70\x72\x5b\x22\x3a\x22\x5d\x3d\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2c\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x3d\x72\x2e\x75\x6e\x69\x71\x75\x65\x3d\x78\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x2c\x72\x2e\x74\x65\x78\x74\x3d\x78\x2e\x67\x65\x74\x54\x65\x78\x74\x2c\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x3d\x78\x2e\x69\x73\x58\x4d\x4c\x2c\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3d\x78\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x2c\x72\x2e\x65\x73\x63\x61\x70\x65\x53\x65\x6c\x65\x63\x74\x6f\x72\x3d\x78\x2e\x65\x73\x63\x61\x70\x65\x3b\x76\x61\x72\x20\x79\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x5b\x5d\x2c\x65\x3d\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3b\x77\x68\x69\x6c\x65\x28\x28\x61\x3d\x61\x5b\x62\x5d\x29\x26\x26\x39\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x69\x66\x28\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x69\x66\x28\x65\x26\x26\x72\x28\x61\x29\x2e\x69\x73\x28\x63\x29\x29\x62\x72\x65\x61\x6b\x3b\x64\x2e\x70\x75\x73\x68\x28\x61\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x2c\x7a\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x5b\x5d\x3b\x61\x3b\x61\x3d\x61\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x21\x3d\x3d\x62\x26\x26\x63\x2e\x70\x75\x73\x68\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x2c\x41\x3d\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x2c\x42\x3d\x2f\x5e\x3c\x28\x5b\x61\x2d\x7a\x5d\x5b\x5e\x5c\x2f\x5c\x30\x3e\x3a\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2a\x29\x5b\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2a\x5c\x2f\x3f\x3e\x28\x3f\x3a\x3c\x5c\x2f\x5c\x31\x3e\x7c\x29\x24\x2f\x69\x2c\x43\x3d\x2f\x5e\x2e\x5b\x5e\x3a\x23\x5c\x5b\x5c\x2e\x2c\x5d\x2a\x24\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x44\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x62\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x64\x2c\x61\x29\x21\x3d\x3d\x63\x7d\x29\x3a\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x21\x3d\x3d\x63\x7d\x29\x3a\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x3f\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x69\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x61\x29\x3e\x2d\x31\x21\x3d\x3d\x63\x7d\x29\x3a\x43\x2e\x74\x65\x73\x74\x28\x62\x29\x3f\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x2c\x63\x29\x3a\x28\x62\x3d\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x29\x2c\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x69\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x61\x29\x3e\x2d\x31\x21\x3d\x3d\x63\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x29\x29\x7d\x72\x2e\x66\x69\x6c\x74\x65\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x62\x5b\x30\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x28\x61\x3d\x22\x3a\x6e\x6f\x74\x28\x22\x2b\x61\x2b\x22\x29\x22\x29\x2c\x31\x3d\x3d\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x31\x3d\x3d\x3d\x64\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x28\x64\x2c\x61\x29\x3f\x5b\x64\x5d\x3a\x5b\x5d\x3a\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x28\x61\x2c\x72\x2e\x67\x72\x65\x70\x28\x62\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x29\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x66\x69\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x65\x3d\x74\x68\x69\x73\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x72\x28\x61\x29\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x62\x3d\x30\x3b\x62\x3c\x64\x3b\x62\x2b\x2b\x29\x69\x66\x28\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x65\x5b\x62\x5d\x2c\x74\x68\x69\x73\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x29\x29\x3b\x66\x6f\x72\x28\x63\x3d\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x5b\x5d\x29\x2c\x62\x3d\x30\x3b\x62\x3c\x64\x3b\x62\x2b\x2b\x29\x72\x2e\x66\x69\x6e\x64\x28\x61\x2c\x65\x5b\x62\x5d\x2c\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3e\x31\x3f\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x63\x29\x3a\x63\x7d\x2c\x66\x69\x6c\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x44\x28\x74\x68\x69\x73\x2c\x61\x7c\x7c\x5b\x5d\x2c\x21\x31\x29\x29\x7d\x2c\x6e\x6f\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x44\x28\x74\x68\x69\x73\x2c\x61\x7c\x7c\x5b\x5d\x2c\x21\x30\x29\x29\x7d\x2c\x69\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x44\x28\x74\x68\x69\x73\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x41\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x72\x28\x61\x29\x3a\x61\x7c\x7c\x5b\x5d\x2c\x21\x31\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x7d\x29\x3b\x76\x61\x72\x20\x45\x2c\x46\x3d\x2f\x5e\x28\x3f\x3a\x5c\x73\x2a\x28\x3c\x5b\x5c\x77\x5c\x57\x5d\x2b\x3e\x29\x5b\x5e\x3e\x5d\x2a\x7c\x23\x28\x5b\x5c\x77\x2d\x5d\x2b\x29\x29\x24\x2f\x2c\x47\x3d\x72\x2e\x66\x6e\x2e\x69\x6e\x69\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3b\x69\x66\x28\x21\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x3b\x69\x66\x28\x63\x3d\x63\x7c\x7c\x45\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x7b\x69\x66\x28\x65\x3d\x22\x3c\x22\x3d\x3d\x3d\x61\x5b\x30\x5d\x26\x26\x22\x3e\x22\x3d\x3d\x3d\x61\x5b\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x5d\x26\x26\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x3d\x33\x3f\x5b\x6e\x75\x6c\x6c\x2c\x61\x2c\x6e\x75\x6c\x6c\x5d\x3a\x46\x2e\x65\x78\x65\x63\x28\x61\x29\x2c\x21\x65\x7c\x7c\x21\x65\x5b\x31\x5d\x26\x26\x62\x29\x72\x65\x74\x75\x72\x6e\x21\x62\x7c\x7c\x62\x2e\x6a\x71\x75\x65\x72\x79\x3f\x28\x62\x7c\x7c\x63\x29\x2e\x66\x69\x6e\x64\x28\x61\x29\x3a\x74\x68\x69\x73\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x28\x62\x29\x2e\x66\x69\x6e\x64\x28\x61\x29\x3b\x69\x66\x28\x65\x5b\x31\x5d\x29\x7b\x69\x66\x28\x62\x3d\x62\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x6f\x66\x20\x72\x3f\x62\x5b\x30\x5d\x3a\x62\x2c\x72\x2e\x6d\x65\x72\x67\x65\x28\x74\x68\x69\x73\x2c\x72\x2e\x70\x61\x72\x73\x65\x48\x54\x4d\x4c\x28\x65\x5b\x31\x5d\x2c\x62\x26\x26\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x62\x3a\x64\x2c\x21\x30\x29\x29\x2c\x42\x2e\x74\x65\x73\x74\x28\x65\x5b\x31\x5d\x29\x26\x26\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x62\x29\x29\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x62\x29\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x74\x68\x69\x73\x5b\x65\x5d\x29\x3f\x74\x68\x69\x73\x5b\x65\x5d\x28\x62\x5b\x65\x5d\x29\x3a\x74\x68\x69\x73\x2e\x61\x74\x74\x72\x28\x65\x2c\x62\x5b\x65\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x72\x65\x74\x75\x72\x6e\x20\x66\x3d\x64\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x65\x5b\x32\x5d\x29\x2c\x66\x26\x26\x28\x74\x68\x69\x73\x5b\x30\x5d\x3d\x66\x2c\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x31\x29\x2c\x74\x68\x69\x73\x7d\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x28\x74\x68\x69\x73\x5b\x30\x5d\x3d\x61\x2c\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x31\x2c\x74\x68\x69\x73\x29\x3a\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x2e\x72\x65\x61\x64\x79\x3f\x63\x2e\x72\x65\x61\x64\x79\x28\x61\x29\x3a\x61\x28\x72\x29\x3a\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x61\x2c\x74\x68\x69\x73\x29\x7d\x3b\x47\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x72\x2e\x66\x6e\x2c\x45\x3d\x72\x28\x64\x29\x3b\x76\x61\x72\x20\x48\x3d\x2f\x5e\x28\x3f\x3a\x70\x61\x72\x65\x6e\x74\x73\x7c\x70\x72\x65\x76\x28\x3f\x3a\x55\x6e\x74\x69\x6c\x7c\x41\x6c\x6c\x29\x29\x2f\x2c\x49\x3d\x7b\x63\x68\x69\x6c\x64\x72\x65\x6e\x3a\x21\x30\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x21\x30\x2c\x6e\x65\x78\x74\x3a\x21\x30\x2c\x70\x72\x65\x76\x3a\x21\x30\x7d\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x61\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x28\x61\x2c\x74\x68\x69\x73\x29\x2c\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x61\x3d\x30\x3b\x61\x3c\x63\x3b\x61\x2b\x2b\x29\x69\x66\x28\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x74\x68\x69\x73\x2c\x62\x5b\x61\x5d\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x29\x7d\x2c\x63\x6c\x6f\x73\x65\x73\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x66\x3d\x5b\x5d\x2c\x67\x3d\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x72\x28\x61\x29\x3b\x69\x66\x28\x21\x41\x2e\x74\x65\x73\x74\x28\x61\x29\x29\x66\x6f\x72\x28\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x66\x6f\x72\x28\x63\x3d\x74\x68\x69\x73\x5b\x64\x5d\x3b\x63\x26\x26\x63\x21\x3d\x3d\x62\x3b\x63\x3d\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x69\x66\x28\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3c\x31\x31\x26\x26\x28\x67\x3f\x67\x2e\x69\x6e\x64\x65\x78\x28\x63\x29\x3e\x2d\x31\x3a\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x28\x63\x2c\x61\x29\x29\x29\x7b\x66\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x62\x72\x65\x61\x6b\x7d\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x66\x29\x3a\x66\x29\x7d\x2c\x69\x6e\x64\x65\x78\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x69\x2e\x63\x61\x6c\x6c\x28\x72\x28\x61\x29\x2c\x74\x68\x69\x73\x5b\x30\x5d\x29\x3a\x69\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x61\x2e\x6a\x71\x75\x65\x72\x79\x3f\x61\x5b\x30\x5d\x3a\x61\x29\x3a\x74\x68\x69\x73\x5b\x30\x5d\x26\x26\x74\x68\x69\x73\x5b\x30\x5d\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3f\x74\x68\x69\x73\x2e\x66\x69\x72\x73\x74\x28\x29\x2e\x70\x72\x65\x76\x41\x6c\x6c\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x2d\x31\x7d\x2c\x61\x64\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x72\x2e\x6d\x65\x72\x67\x65\x28\x74\x68\x69\x73\x2e\x67\x65\x74\x28\x29\x2c\x72\x28\x61\x2c\x62\x29\x29\x29\x29\x7d\x2c\x61\x64\x64\x42\x61\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x61\x64\x64\x28\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x74\x68\x69\x73\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x3a\x74\x68\x69\x73\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x2e\x66\x69\x6c\x74\x65\x72\x28\x61\x29\x29\x7d\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4a\x28\x61\x2c\x62\x29\x7b\x77\x68\x69\x6c\x65\x28\x28\x61\x3d\x61\x5b\x62\x5d\x29\x26\x26\x31\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x72\x2e\x65\x61\x63\x68\x28\x7b\x70\x61\x72\x65\x6e\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x26\x26\x31\x31\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x62\x3a\x6e\x75\x6c\x6c\x7d\x2c\x70\x61\x72\x65\x6e\x74\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x29\x7d\x2c\x70\x61\x72\x65\x6e\x74\x73\x55\x6e\x74\x69\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x2c\x63\x29\x7d\x2c\x6e\x65\x78\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x28\x61\x2c\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x70\x72\x65\x76\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x28\x61\x2c\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x6e\x65\x78\x74\x41\x6c\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x70\x72\x65\x76\x41\x6c\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x6e\x65\x78\x74\x55\x6e\x74\x69\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x63\x29\x7d\x2c\x70\x72\x65\x76\x55\x6e\x74\x69\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x63\x29\x7d\x2c\x73\x69\x62\x6c\x69\x6e\x67\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x7a\x28\x28\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x7c\x7c\x7b\x7d\x29\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x61\x29\x7d\x2c\x63\x68\x69\x6c\x64\x72\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x7a\x28\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x7d\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x72\x2e\x6d\x65\x72\x67\x65\x28\x5b\x5d\x2c\x61\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x7d\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x72\x2e\x6d\x61\x70\x28\x74\x68\x69\x73\x2c\x62\x2c\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x55\x6e\x74\x69\x6c\x22\x21\x3d\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x2d\x35\x29\x26\x26\x28\x64\x3d\x63\x29\x2c\x64\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x64\x26\x26\x28\x65\x3d\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x64\x2c\x65\x29\x29\x2c\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x26\x26\x28\x49\x5b\x61\x5d\x7c\x7c\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x65\x29\x2c\x48\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x65\x2e\x72\x65\x76\x65\x72\x73\x65\x28\x29\x29\x2c\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x65\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x4b\x3d\x2f\x5b\x5e\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2b\x2f\x67\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4c\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x7b\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x62\x5b\x63\x5d\x3d\x21\x30\x7d\x29\x2c\x62\x7d\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x3d\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x4c\x28\x61\x29\x3a\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x61\x29\x3b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x5b\x5d\x2c\x67\x3d\x5b\x5d\x2c\x68\x3d\x2d\x31\x2c\x69\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x65\x3d\x61\x2e\x6f\x6e\x63\x65\x2c\x64\x3d\x62\x3d\x21\x30\x3b\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x68\x3d\x2d\x31\x29\x7b\x63\x3d\x67\x2e\x73\x68\x69\x66\x74\x28\x29\x3b\x77\x68\x69\x6c\x65\x28\x2b\x2b\x68\x3c\x66\x2e\x6c\x65\x6e\x67\x74\x68\x29\x66\x5b\x68\x5d\x2e\x61\x70\x70\x6c\x79\x28\x63\x5b\x30\x5d\x2c\x63\x5b\x31\x5d\x29\x3d\x3d\x3d\x21\x31\x26\x26\x61\x2e\x73\x74\x6f\x70\x4f\x6e\x46\x61\x6c\x73\x65\x26\x26\x28\x68\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x21\x31\x29\x7d\x61\x2e\x6d\x65\x6d\x6f\x72\x79\x7c\x7c\x28\x63\x3d\x21\x31\x29\x2c\x62\x3d\x21\x31\x2c\x65\x26\x26\x28\x66\x3d\x63\x3f\x5b\x5d\x3a\x22\x22\x29\x7d\x2c\x6a\x3d\x7b\x61\x64\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x26\x26\x28\x63\x26\x26\x21\x62\x26\x26\x28\x68\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x2c\x67\x2e\x70\x75\x73\x68\x28\x63\x29\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x64\x28\x62\x29\x7b\x72\x2e\x65\x61\x63\x68\x28\x62\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x3f\x61\x2e\x75\x6e\x69\x71\x75\x65\x26\x26\x6a\x2e\x68\x61\x73\x28\x63\x29\x7c\x7c\x66\x2e\x70\x75\x73\x68\x28\x63\x29\x3a\x63\x26\x26\x63\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x63\x29\x26\x26\x64\x28\x63\x29\x7d\x29\x7d\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x63\x26\x26\x21\x62\x26\x26\x69\x28\x29\x29\x2c\x74\x68\x69\x73\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x77\x68\x69\x6c\x65\x28\x28\x63\x3d\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x62\x2c\x66\x2c\x63\x29\x29\x3e\x2d\x31\x29\x66\x2e\x73\x70\x6c\x69\x63\x65\x28\x63\x2c\x31\x29\x2c\x63\x3c\x3d\x68\x26\x26\x68\x2d\x2d\x7d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x68\x61\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x61\x2c\x66\x29\x3e\x2d\x31\x3a\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x7d\x2c\x65\x6d\x70\x74\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x26\x26\x28\x66\x3d\x5b\x5d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x64\x69\x73\x61\x62\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x3d\x67\x3d\x5b\x5d\x2c\x66\x3d\x63\x3d\x22\x22\x2c\x74\x68\x69\x73\x7d\x2c\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x66\x7d\x2c\x6c\x6f\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x3d\x67\x3d\x5b\x5d\x2c\x63\x7c\x7c\x62\x7c\x7c\x28\x66\x3d\x63\x3d\x22\x22\x29\x2c\x74\x68\x69\x73\x7d\x2c\x6c\x6f\x63\x6b\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x65\x7d\x2c\x66\x69\x72\x65\x57\x69\x74\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x7c\x7c\x28\x63\x3d\x63\x7c\x7c\x5b\x5d\x2c\x63\x3d\x5b\x61\x2c\x63\x2e\x73\x6c\x69\x63\x65\x3f\x63\x2e\x73\x6c\x69\x63\x65\x28\x29\x3a\x63\x5d\x2c\x67\x2e\x70\x75\x73\x68\x28\x63\x29\x2c\x62\x7c\x7c\x69\x28\x29\x29\x2c\x74\x68\x69\x73\x7d\x2c\x66\x69\x72\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6a\x2e\x66\x69\x72\x65\x57\x69\x74\x68\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x74\x68\x69\x73\x7d\x2c\x66\x69\x72\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x64\x7d\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x6a\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4d\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4e\x28\x61\x29\x7b\x74\x68\x72\x6f\x77\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4f\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x74\x72\x79\x7b\x61\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x3d\x61\x2e\x70\x72\x6f\x6d\x69\x73\x65\x29\x3f\x64\x2e\x63\x61\x6c\x6c\x28\x61\x29\x2e\x64\x6f\x6e\x65\x28\x62\x29\x2e\x66\x61\x69\x6c\x28\x63\x29\x3a\x61\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x3d\x61\x2e\x74\x68\x65\x6e\x29\x3f\x64\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x62\x2c\x63\x29\x3a\x62\x2e\x63\x61\x6c\x6c\x28\x76\x6f\x69\x64\x20\x30\x2c\x61\x29\x7d\x63\x61\x74\x63\x68\x28\x61\x29\x7b\x63\x2e\x63\x61\x6c\x6c\x28\x76\x6f\x69\x64\x20\x30\x2c\x61\x29\x7d\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x44\x65\x66\x65\x72\x72\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x5b\x5b\x22\x6e\x6f\x74\x69\x66\x79\x22\x2c\x22\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x32\x5d\x2c\x5b\x22\x72\x65\x73\x6f\x6c\x76\x65\x22\x2c\x22\x64\x6f\x6e\x65\x22\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x30\x2c\x22\x72\x65\x73\x6f\x6c\x76\x65\x64\x22\x5d\x2c\x5b\x22\x72\x65\x6a\x65\x63\x74\x22\x2c\x22\x66\x61\x69\x6c\x22\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x31\x2c\x22\x72\x65\x6a\x65\x63\x74\x65\x64\x22\x5d\x5d\x2c\x64\x3d\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x2c\x65\x3d\x7b\x73\x74\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x2c\x61\x6c\x77\x61\x79\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x64\x6f\x6e\x65\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2e\x66\x61\x69\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x74\x68\x69\x73\x7d\x2c\x22\x63\x61\x74\x63\x68\x22\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x2e\x74\x68\x65\x6e\x28\x6e\x75\x6c\x6c\x2c\x61\x29\x7d\x2c\x70\x69\x70\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x2e\x65\x61\x63\x68\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x5b\x64\x5b\x34\x5d\x5d\x29\x26\x26\x61\x5b\x64\x5b\x34\x5d\x5d\x3b\x66\x5b\x64\x5b\x31\x5d\x5d\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x65\x26\x26\x65\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3b\x61\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2e\x70\x72\x6f\x6d\x69\x73\x65\x29\x3f\x61\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x28\x62\x2e\x6e\x6f\x74\x69\x66\x79\x29\x2e\x64\x6f\x6e\x65\x28\x62\x2e\x72\x65\x73\x6f\x6c\x76\x65\x29\x2e\x66\x61\x69\x6c\x28\x62\x2e\x72\x65\x6a\x65\x63\x74\x29\x3a\x62\x5b\x64\x5b\x30\x5d\x2b\x22\x57\x69\x74\x68\x22\x5d\x28\x74\x68\x69\x73\x2c\x65\x3f\x5b\x61\x5d\x3a\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x7d\x29\x7d\x29\x2c\x61\x3d\x6e\x75\x6c\x6c\x7d\x29\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x7d\x2c\x74\x68\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x30\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x28\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x68\x3d\x74\x68\x69\x73\x2c\x69\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x6a\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x2c\x6a\x3b\x69\x66\x28\x21\x28\x62\x3c\x66\x29\x29\x7b\x69\x66\x28\x61\x3d\x64\x2e\x61\x70\x70\x6c\x79\x28\x68\x2c\x69\x29\x2c\x61\x3d\x3d\x3d\x63\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x29\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x54\x79\x70\x65\x45\x72\x72\x6f\x72\x28\x22\x54\x68\x65\x6e\x61\x62\x6c\x65\x20\x73\x65\x6c\x66\x2d\x72\x65\x73\x6f\x6c\x75\x74\x69\x6f\x6e\x22\x29\x3b\x6a\x3d\x61\x26\x26\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x7c\x7c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x26\x26\x61\x2e\x74\x68\x65\x6e\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6a\x29\x3f\x65\x3f\x6a\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x67\x28\x66\x2c\x63\x2c\x4d\x2c\x65\x29\x2c\x67\x28\x66\x2c\x63\x2c\x4e\x2c\x65\x29\x29\x3a\x28\x66\x2b\x2b\x2c\x6a\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x67\x28\x66\x2c\x63\x2c\x4d\x2c\x65\x29\x2c\x67\x28\x66\x2c\x63\x2c\x4e\x2c\x65\x29\x2c\x67\x28\x66\x2c\x63\x2c\x4d\x2c\x63\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x29\x29\x29\x3a\x28\x64\x21\x3d\x3d\x4d\x26\x26\x28\x68\x3d\x76\x6f\x69\x64\x20\x30\x2c\x69\x3d\x5b\x61\x5d\x29\x2c\x28\x65\x7c\x7c\x63\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x29\x28\x68\x2c\x69\x29\x29\x7d\x7d\x2c\x6b\x3d\x65\x3f\x6a\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x74\x72\x79\x7b\x6a\x28\x29\x7d\x63\x61\x74\x63\x68\x28\x61\x29\x7b\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x48\x6f\x6f\x6b\x26\x26\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x48\x6f\x6f\x6b\x28\x61\x2c\x6b\x2e\x73\x74\x61\x63\x6b\x54\x72\x61\x63\x65\x29\x2c\x62\x2b\x31\x3e\x3d\x66\x26\x26\x28\x64\x21\x3d\x3d\x4e\x26\x26\x28\x68\x3d\x76\x6f\x69\x64\x20\x30\x2c\x69\x3d\x5b\x61\x5d\x29\x2c\x63\x2e\x72\x65\x6a\x65\x63\x74\x57\x69\x74\x68\x28\x68\x2c\x69\x29\x29\x7d\x7d\x3b\x62\x3f\x6b\x28\x29\x3a\x28\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x67\x65\x74\x53\x74\x61\x63\x6b\x48\x6f\x6f\x6b\x26\x26\x28\x6b\x2e\x73\x74\x61\x63\x6b\x54\x72\x61\x63\x65\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x67\x65\x74\x53\x74\x61\x63\x6b\x48\x6f\x6f\x6b\x28\x29\x29\x2c\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x6b\x29\x29\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x63\x5b\x30\x5d\x5b\x33\x5d\x2e\x61\x64\x64\x28\x67\x28\x30\x2c\x61\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x29\x3f\x65\x3a\x4d\x2c\x61\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x29\x29\x2c\x63\x5b\x31\x5d\x5b\x33\x5d\x2e\x61\x64\x64\x28\x67\x28\x30\x2c\x61\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x62\x3a\x4d\x29\x29\x2c\x63\x5b\x32\x5d\x5b\x33\x5d\x2e\x61\x64\x64\x28\x67\x28\x30\x2c\x61\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x3f\x64\x3a\x4e\x29\x29\x7d\x29\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x7d\x2c\x70\x72\x6f\x6d\x69\x73\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x61\x3f\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x61\x2c\x65\x29\x3a\x65\x7d\x7d\x2c\x66\x3d\x7b\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x67\x3d\x62\x5b\x32\x5d\x2c\x68\x3d\x62\x5b\x35\x5d\x3b\x65\x5b\x62\x5b\x31\x5d\x5d\x3d\x67\x2e\x61\x64\x64\x2c\x68\x26\x26\x67\x2e\x61\x64\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x64\x3d\x68\x7d\x2c\x63\x5b\x33\x2d\x61\x5d\x5b\x32\x5d\x2e\x64\x69\x73\x61\x62\x6c\x65\x2c\x63\x5b\x30\x5d\x5b\x32\x5d\x2e\x6c\x6f\x63\x6b\x29\x2c\x67\x2e\x61\x64\x64\x28\x62\x5b\x33\x5d\x2e\x66\x69\x72\x65\x29\x2c\x66\x5b\x62\x5b\x30\x5d\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x5b\x62\x5b\x30\x5d\x2b\x22\x57\x69\x74\x68\x22\x5d\x28\x74\x68\x69\x73\x3d\x3d\x3d\x66\x3f\x76\x6f\x69\x64\x20\x30\x3a\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x74\x68\x69\x73\x7d\x2c\x66\x5b\x62\x5b\x30\x5d\x2b\x22\x57\x69\x74\x68\x22\x5d\x3d\x67\x2e\x66\x69\x72\x65\x57\x69\x74\x68\x7d\x29\x2c\x65\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x66\x29\x2c\x62\x26\x26\x62\x2e\x63\x61\x6c\x6c\x28\x66\x2c\x66\x29\x2c\x66\x7d\x2c\x77\x68\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x62\x2c\x64\x3d\x41\x72\x72\x61\x79\x28\x63\x29\x2c\x65\x3d\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x67\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2c\x68\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x64\x5b\x61\x5d\x3d\x74\x68\x69\x73\x2c\x65\x5b\x61\x5d\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3a\x63\x2c\x2d\x2d\x62\x7c\x7c\x67\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x64\x2c\x65\x29\x7d\x7d\x3b\x69\x66\x28\x62\x3c\x3d\x31\x26\x26\x28\x4f\x28\x61\x2c\x67\x2e\x64\x6f\x6e\x65\x28\x68\x28\x63\x29\x29\x2e\x72\x65\x73\x6f\x6c\x76\x65\x2c\x67\x2e\x72\x65\x6a\x65\x63\x74\x29\x2c\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x3d\x3d\x3d\x67\x2e\x73\x74\x61\x74\x65\x28\x29\x7c\x7c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x5b\x63\x5d\x26\x26\x65\x5b\x63\x5d\x2e\x74\x68\x65\x6e\x29\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x74\x68\x65\x6e\x28\x29\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x4f\x28\x65\x5b\x63\x5d\x2c\x68\x28\x63\x29\x2c\x67\x2e\x72\x65\x6a\x65\x63\x74\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x50\x3d\x2f\x5e\x28\x45\x76\x61\x6c\x7c\x49\x6e\x74\x65\x72\x6e\x61\x6c\x7c\x52\x61\x6e\x67\x65\x7c\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x7c\x53\x79\x6e\x74\x61\x78\x7c\x54\x79\x70\x65\x7c\x55\x52\x49\x29\x45\x72\x72\x6f\x72\x24\x2f\x3b\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x48\x6f\x6f\x6b\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x61\x2e\x63\x6f\x6e\x73\x6f\x6c\x65\x26\x26\x61\x2e\x63\x6f\x6e\x73\x6f\x6c\x65\x2e\x77\x61\x72\x6e\x26\x26\x62\x26\x26\x50\x2e\x74\x65\x73\x74\x28\x62\x2e\x6e\x61\x6d\x65\x29\x26\x26\x61\x2e\x63\x6f\x6e\x73\x6f\x6c\x65\x2e\x77\x61\x72\x6e\x28\x22\x6a\x51\x75\x65\x72\x79\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x20\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x3a\x20\x22\x2b\x62\x2e\x6d\x65\x73\x73\x61\x67\x65\x2c\x62\x2e\x73\x74\x61\x63\x6b\x2c\x63\x29\x7d\x2c\x72\x2e\x72\x65\x61\x64\x79\x45\x78\x63\x65\x70\x74\x69\x6f\x6e\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x74\x68\x72\x6f\x77\x20\x62\x7d\x29\x7d\x3b\x76\x61\x72\x20\x51\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x3b\x72\x2e\x66\x6e\x2e\x72\x65\x61\x64\x79\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x51\x2e\x74\x68\x65\x6e\x28\x61\x29\x5b\x22\x63\x61\x74\x63\x68\x22\x5d\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x72\x65\x61\x64\x79\x45\x78\x63\x65\x70\x74\x69\x6f\x6e\x28\x61\x29\x7d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x69\x73\x52\x65\x61\x64\x79\x3a\x21\x31\x2c\x72\x65\x61\x64\x79\x57\x61\x69\x74\x3a\x31\x2c\x68\x6f\x6c\x64\x52\x65\x61\x64\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x3f\x72\x2e\x72\x65\x61\x64\x79\x57\x61\x69\x74\x2b\x2b\x3a\x72\x2e\x72\x65\x61\x64\x79\x28\x21\x30\x29\x7d\x2c\x72\x65\x61\x64\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x28\x61\x3d\x3d\x3d\x21\x30\x3f\x2d\x2d\x72\x2e\x72\x65\x61\x64\x79\x57\x61\x69\x74\x3a\x72\x2e\x69\x73\x52\x65\x61\x64\x79\x29\x7c\x7c\x28\x72\x2e\x69\x73\x52\x65\x61\x64\x79\x3d\x21\x30\x2c\x61\x21\x3d\x3d\x21\x30\x26\x26\x2d\x2d\x72\x2e\x72\x65\x61\x64\x79\x57\x61\x69\x74\x3e\x30\x7c\x7c\x51\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x64\x2c\x5b\x72\x5d\x29\x29\x7d\x7d\x29\x2c\x72\x2e\x72\x65\x61\x64\x79\x2e\x74\x68\x65\x6e\x3d\x51\x2e\x74\x68\x65\x6e\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x52\x28\x29\x7b\x64\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x44\x4f\x4d\x43\x6f\x6e\x74\x65\x6e\x74\x4c\x6f\x61\x64\x65\x64\x22\x2c\x52\x29\x2c\x0a\x61\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x6c\x6f\x61\x64\x22\x2c\x52\x29\x2c\x72\x2e\x72\x65\x61\x64\x79\x28\x29\x7d\x22\x63\x6f\x6d\x70\x6c\x65\x74\x65\x22\x3d\x3d\x3d\x64\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x7c\x7c\x22\x6c\x6f\x61\x64\x69\x6e\x67\x22\x21\x3d\x3d\x64\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x26\x26\x21\x64\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2e\x64\x6f\x53\x63\x72\x6f\x6c\x6c\x3f\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x72\x2e\x72\x65\x61\x64\x79\x29\x3a\x28\x64\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x44\x4f\x4d\x43\x6f\x6e\x74\x65\x6e\x74\x4c\x6f\x61\x64\x65\x64\x22\x2c\x52\x29\x2c\x61\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x6c\x6f\x61\x64\x22\x2c\x52\x29\x29\x3b\x76\x61\x72\x20\x53\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x29\x7b\x76\x61\x72\x20\x68\x3d\x30\x2c\x69\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6a\x3d\x6e\x75\x6c\x6c\x3d\x3d\x63\x3b\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x63\x29\x29\x7b\x65\x3d\x21\x30\x3b\x66\x6f\x72\x28\x68\x20\x69\x6e\x20\x63\x29\x53\x28\x61\x2c\x62\x2c\x68\x2c\x63\x5b\x68\x5d\x2c\x21\x30\x2c\x66\x2c\x67\x29\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x64\x26\x26\x28\x65\x3d\x21\x30\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x7c\x7c\x28\x67\x3d\x21\x30\x29\x2c\x6a\x26\x26\x28\x67\x3f\x28\x62\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x64\x29\x2c\x62\x3d\x6e\x75\x6c\x6c\x29\x3a\x28\x6a\x3d\x62\x2c\x62\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6a\x2e\x63\x61\x6c\x6c\x28\x72\x28\x61\x29\x2c\x63\x29\x7d\x29\x29\x2c\x62\x29\x29\x66\x6f\x72\x28\x3b\x68\x3c\x69\x3b\x68\x2b\x2b\x29\x62\x28\x61\x5b\x68\x5d\x2c\x63\x2c\x67\x3f\x64\x3a\x64\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x68\x5d\x2c\x68\x2c\x62\x28\x61\x5b\x68\x5d\x2c\x63\x29\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x3f\x61\x3a\x6a\x3f\x62\x2e\x63\x61\x6c\x6c\x28\x61\x29\x3a\x69\x3f\x62\x28\x61\x5b\x30\x5d\x2c\x63\x29\x3a\x66\x7d\x2c\x54\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x39\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x21\x2b\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x55\x28\x29\x7b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x3d\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x2b\x55\x2e\x75\x69\x64\x2b\x2b\x7d\x55\x2e\x75\x69\x64\x3d\x31\x2c\x55\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x63\x61\x63\x68\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x7c\x7c\x28\x62\x3d\x7b\x7d\x2c\x54\x28\x61\x29\x26\x26\x28\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x62\x3a\x4f\x62\x6a\x65\x63\x74\x2e\x64\x65\x66\x69\x6e\x65\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x61\x2c\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x2c\x7b\x76\x61\x6c\x75\x65\x3a\x62\x2c\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x3a\x21\x30\x7d\x29\x29\x29\x2c\x62\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x3d\x74\x68\x69\x73\x2e\x63\x61\x63\x68\x65\x28\x61\x29\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x29\x65\x5b\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x5d\x3d\x63\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x62\x29\x65\x5b\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x64\x29\x5d\x3d\x62\x5b\x64\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x2c\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x3f\x74\x68\x69\x73\x2e\x63\x61\x63\x68\x65\x28\x61\x29\x3a\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x26\x26\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x5b\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x5d\x7d\x2c\x61\x63\x63\x65\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x7c\x7c\x62\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x63\x3f\x74\x68\x69\x73\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x3a\x28\x74\x68\x69\x73\x2e\x73\x65\x74\x28\x61\x2c\x62\x2c\x63\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x63\x3a\x62\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x64\x29\x7b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x29\x7b\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x3f\x62\x3d\x62\x2e\x6d\x61\x70\x28\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x29\x3a\x28\x62\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x2c\x62\x3d\x62\x20\x69\x6e\x20\x64\x3f\x5b\x62\x5d\x3a\x62\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x29\x2c\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x64\x65\x6c\x65\x74\x65\x20\x64\x5b\x62\x5b\x63\x5d\x5d\x7d\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x7c\x7c\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x64\x29\x29\x26\x26\x28\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x76\x6f\x69\x64\x20\x30\x3a\x64\x65\x6c\x65\x74\x65\x20\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x29\x7d\x7d\x2c\x68\x61\x73\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x26\x26\x21\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x62\x29\x7d\x7d\x3b\x76\x61\x72\x20\x56\x3d\x6e\x65\x77\x20\x55\x2c\x57\x3d\x6e\x65\x77\x20\x55\x2c\x58\x3d\x2f\x5e\x28\x3f\x3a\x5c\x7b\x5b\x5c\x77\x5c\x57\x5d\x2a\x5c\x7d\x7c\x5c\x5b\x5b\x5c\x77\x5c\x57\x5d\x2a\x5c\x5d\x29\x24\x2f\x2c\x59\x3d\x2f\x5b\x41\x2d\x5a\x5d\x2f\x67\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x5a\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x74\x72\x75\x65\x22\x3d\x3d\x3d\x61\x7c\x7c\x22\x66\x61\x6c\x73\x65\x22\x21\x3d\x3d\x61\x26\x26\x28\x22\x6e\x75\x6c\x6c\x22\x3d\x3d\x3d\x61\x3f\x6e\x75\x6c\x6c\x3a\x61\x3d\x3d\x3d\x2b\x61\x2b\x22\x22\x3f\x2b\x61\x3a\x58\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x28\x61\x29\x3a\x61\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x24\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x63\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x69\x66\x28\x64\x3d\x22\x64\x61\x74\x61\x2d\x22\x2b\x62\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x59\x2c\x22\x2d\x24\x26\x22\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x63\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x64\x29\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x29\x7b\x74\x72\x79\x7b\x63\x3d\x5a\x28\x63\x29\x7d\x63\x61\x74\x63\x68\x28\x65\x29\x7b\x7d\x57\x2e\x73\x65\x74\x28\x61\x2c\x62\x2c\x63\x29\x7d\x65\x6c\x73\x65\x20\x63\x3d\x76\x6f\x69\x64\x20\x30\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x61\x73\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x57\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x7c\x7c\x56\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x7d\x2c\x64\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x57\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x62\x2c\x63\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x57\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x62\x29\x7d\x2c\x5f\x64\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x62\x2c\x63\x29\x7d\x2c\x5f\x72\x65\x6d\x6f\x76\x65\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x62\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x64\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x74\x68\x69\x73\x5b\x30\x5d\x2c\x67\x3d\x66\x26\x26\x66\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x65\x3d\x57\x2e\x67\x65\x74\x28\x66\x29\x2c\x31\x3d\x3d\x3d\x66\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x21\x56\x2e\x67\x65\x74\x28\x66\x2c\x22\x68\x61\x73\x44\x61\x74\x61\x41\x74\x74\x72\x73\x22\x29\x29\x29\x7b\x63\x3d\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x67\x5b\x63\x5d\x26\x26\x28\x64\x3d\x67\x5b\x63\x5d\x2e\x6e\x61\x6d\x65\x2c\x30\x3d\x3d\x3d\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x64\x61\x74\x61\x2d\x22\x29\x26\x26\x28\x64\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x64\x2e\x73\x6c\x69\x63\x65\x28\x35\x29\x29\x2c\x24\x28\x66\x2c\x64\x2c\x65\x5b\x64\x5d\x29\x29\x29\x3b\x56\x2e\x73\x65\x74\x28\x66\x2c\x22\x68\x61\x73\x44\x61\x74\x61\x41\x74\x74\x72\x73\x22\x2c\x21\x30\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x72\x65\x74\x75\x72\x6e\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x57\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x3a\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x69\x66\x28\x66\x26\x26\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x29\x7b\x69\x66\x28\x63\x3d\x57\x2e\x67\x65\x74\x28\x66\x2c\x61\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3b\x69\x66\x28\x63\x3d\x24\x28\x66\x2c\x61\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x65\x6c\x73\x65\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x57\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x7d\x29\x7d\x2c\x6e\x75\x6c\x6c\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x2c\x6e\x75\x6c\x6c\x2c\x21\x30\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x57\x2e\x72\x65\x6d\x6f\x76\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x69\x66\x28\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x28\x62\x7c\x7c\x22\x66\x78\x22\x29\x2b\x22\x71\x75\x65\x75\x65\x22\x2c\x64\x3d\x56\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x2c\x63\x26\x26\x28\x21\x64\x7c\x7c\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x63\x29\x3f\x64\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x62\x2c\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x63\x29\x29\x3a\x64\x2e\x70\x75\x73\x68\x28\x63\x29\x29\x2c\x64\x7c\x7c\x5b\x5d\x7d\x2c\x64\x65\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x62\x3d\x62\x7c\x7c\x22\x66\x78\x22\x3b\x76\x61\x72\x20\x63\x3d\x72\x2e\x71\x75\x65\x75\x65\x28\x61\x2c\x62\x29\x2c\x64\x3d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x65\x3d\x63\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x66\x3d\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x61\x2c\x62\x29\x2c\x67\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x61\x2c\x62\x29\x7d\x3b\x22\x69\x6e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x3d\x3d\x3d\x65\x26\x26\x28\x65\x3d\x63\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x64\x2d\x2d\x29\x2c\x65\x26\x26\x28\x22\x66\x78\x22\x3d\x3d\x3d\x62\x26\x26\x63\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x22\x69\x6e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x29\x2c\x64\x65\x6c\x65\x74\x65\x20\x66\x2e\x73\x74\x6f\x70\x2c\x65\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x67\x2c\x66\x29\x29\x2c\x21\x64\x26\x26\x66\x26\x26\x66\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x28\x29\x7d\x2c\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x3b\x72\x65\x74\x75\x72\x6e\x20\x56\x2e\x67\x65\x74\x28\x61\x2c\x63\x29\x7c\x7c\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x63\x2c\x7b\x65\x6d\x70\x74\x79\x3a\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2e\x61\x64\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x5b\x62\x2b\x22\x71\x75\x65\x75\x65\x22\x2c\x63\x5d\x29\x7d\x29\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x32\x3b\x72\x65\x74\x75\x72\x6e\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x28\x62\x3d\x61\x2c\x61\x3d\x22\x66\x78\x22\x2c\x63\x2d\x2d\x29\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3c\x63\x3f\x72\x2e\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x5b\x30\x5d\x2c\x61\x29\x3a\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x3f\x74\x68\x69\x73\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x2e\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x3b\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x74\x68\x69\x73\x2c\x61\x29\x2c\x22\x66\x78\x22\x3d\x3d\x3d\x61\x26\x26\x22\x69\x6e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x21\x3d\x3d\x63\x5b\x30\x5d\x26\x26\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x2c\x64\x65\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x2c\x63\x6c\x65\x61\x72\x51\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x61\x7c\x7c\x22\x66\x78\x22\x2c\x5b\x5d\x29\x7d\x2c\x70\x72\x6f\x6d\x69\x73\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x31\x2c\x65\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2c\x66\x3d\x74\x68\x69\x73\x2c\x67\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x68\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x2d\x2d\x64\x7c\x7c\x65\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x66\x2c\x5b\x66\x5d\x29\x7d\x3b\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x28\x62\x3d\x61\x2c\x61\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x61\x3d\x61\x7c\x7c\x22\x66\x78\x22\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x63\x3d\x56\x2e\x67\x65\x74\x28\x66\x5b\x67\x5d\x2c\x61\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x29\x2c\x63\x26\x26\x63\x2e\x65\x6d\x70\x74\x79\x26\x26\x28\x64\x2b\x2b\x2c\x63\x2e\x65\x6d\x70\x74\x79\x2e\x61\x64\x64\x28\x68\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x68\x28\x29\x2c\x65\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x62\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x5f\x3d\x2f\x5b\x2b\x2d\x5d\x3f\x28\x3f\x3a\x5c\x64\x2a\x5c\x2e\x7c\x29\x5c\x64\x2b\x28\x3f\x3a\x5b\x65\x45\x5d\x5b\x2b\x2d\x5d\x3f\x5c\x64\x2b\x7c\x29\x2f\x2e\x73\x6f\x75\x72\x63\x65\x2c\x61\x61\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x3f\x3a\x28\x5b\x2b\x2d\x5d\x29\x3d\x7c\x29\x28\x22\x2b\x5f\x2b\x22\x29\x28\x5b\x61\x2d\x7a\x25\x5d\x2a\x29\x24\x22\x2c\x22\x69\x22\x29\x2c\x62\x61\x3d\x5b\x22\x54\x6f\x70\x22\x2c\x22\x52\x69\x67\x68\x74\x22\x2c\x22\x42\x6f\x74\x74\x6f\x6d\x22\x2c\x22\x4c\x65\x66\x74\x22\x5d\x2c\x63\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x62\x7c\x7c\x61\x2c\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x7c\x7c\x22\x22\x3d\x3d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x26\x26\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x61\x29\x26\x26\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x7d\x2c\x64\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x3d\x7b\x7d\x3b\x66\x6f\x72\x28\x66\x20\x69\x6e\x20\x62\x29\x67\x5b\x66\x5d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x5b\x66\x5d\x2c\x61\x2e\x73\x74\x79\x6c\x65\x5b\x66\x5d\x3d\x62\x5b\x66\x5d\x3b\x65\x3d\x63\x2e\x61\x70\x70\x6c\x79\x28\x61\x2c\x64\x7c\x7c\x5b\x5d\x29\x3b\x66\x6f\x72\x28\x66\x20\x69\x6e\x20\x62\x29\x61\x2e\x73\x74\x79\x6c\x65\x5b\x66\x5d\x3d\x67\x5b\x66\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x65\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3d\x31\x2c\x67\x3d\x32\x30\x2c\x68\x3d\x64\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x63\x75\x72\x28\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x2c\x22\x22\x29\x7d\x2c\x69\x3d\x68\x28\x29\x2c\x6a\x3d\x63\x26\x26\x63\x5b\x33\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x62\x5d\x3f\x22\x22\x3a\x22\x70\x78\x22\x29\x2c\x6b\x3d\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x62\x5d\x7c\x7c\x22\x70\x78\x22\x21\x3d\x3d\x6a\x26\x26\x2b\x69\x29\x26\x26\x61\x61\x2e\x65\x78\x65\x63\x28\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x29\x29\x3b\x69\x66\x28\x6b\x26\x26\x6b\x5b\x33\x5d\x21\x3d\x3d\x6a\x29\x7b\x6a\x3d\x6a\x7c\x7c\x6b\x5b\x33\x5d\x2c\x63\x3d\x63\x7c\x7c\x5b\x5d\x2c\x6b\x3d\x2b\x69\x7c\x7c\x31\x3b\x64\x6f\x20\x66\x3d\x66\x7c\x7c\x22\x2e\x35\x22\x2c\x6b\x2f\x3d\x66\x2c\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x62\x2c\x6b\x2b\x6a\x29\x3b\x77\x68\x69\x6c\x65\x28\x66\x21\x3d\x3d\x28\x66\x3d\x68\x28\x29\x2f\x69\x29\x26\x26\x31\x21\x3d\x3d\x66\x26\x26\x2d\x2d\x67\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x28\x6b\x3d\x2b\x6b\x7c\x7c\x2b\x69\x7c\x7c\x30\x2c\x65\x3d\x63\x5b\x31\x5d\x3f\x6b\x2b\x28\x63\x5b\x31\x5d\x2b\x31\x29\x2a\x63\x5b\x32\x5d\x3a\x2b\x63\x5b\x32\x5d\x2c\x64\x26\x26\x28\x64\x2e\x75\x6e\x69\x74\x3d\x6a\x2c\x64\x2e\x73\x74\x61\x72\x74\x3d\x6b\x2c\x64\x2e\x65\x6e\x64\x3d\x65\x29\x29\x2c\x65\x7d\x76\x61\x72\x20\x66\x61\x3d\x7b\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x61\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x64\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2c\x65\x3d\x66\x61\x5b\x64\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x3f\x65\x3a\x28\x62\x3d\x63\x2e\x62\x6f\x64\x79\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x63\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x64\x29\x29\x2c\x65\x3d\x72\x2e\x63\x73\x73\x28\x62\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x2c\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x62\x29\x2c\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x65\x26\x26\x28\x65\x3d\x22\x62\x6c\x6f\x63\x6b\x22\x29\x2c\x66\x61\x5b\x64\x5d\x3d\x65\x2c\x65\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x61\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3d\x5b\x5d\x2c\x66\x3d\x30\x2c\x67\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x64\x3d\x61\x5b\x66\x5d\x2c\x64\x2e\x73\x74\x79\x6c\x65\x26\x26\x28\x63\x3d\x64\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x2c\x62\x3f\x28\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x63\x26\x26\x28\x65\x5b\x66\x5d\x3d\x56\x2e\x67\x65\x74\x28\x64\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x7c\x7c\x6e\x75\x6c\x6c\x2c\x65\x5b\x66\x5d\x7c\x7c\x28\x64\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x22\x22\x29\x29\x2c\x22\x22\x3d\x3d\x3d\x64\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x26\x26\x63\x61\x28\x64\x29\x26\x26\x28\x65\x5b\x66\x5d\x3d\x67\x61\x28\x64\x29\x29\x29\x3a\x22\x6e\x6f\x6e\x65\x22\x21\x3d\x3d\x63\x26\x26\x28\x65\x5b\x66\x5d\x3d\x22\x6e\x6f\x6e\x65\x22\x2c\x56\x2e\x73\x65\x74\x28\x64\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x2c\x63\x29\x29\x29\x3b\x66\x6f\x72\x28\x66\x3d\x30\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x6e\x75\x6c\x6c\x21\x3d\x65\x5b\x66\x5d\x26\x26\x28\x61\x5b\x66\x5d\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x65\x5b\x66\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x73\x68\x6f\x77\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x68\x61\x28\x74\x68\x69\x73\x2c\x21\x30\x29\x7d\x2c\x68\x69\x64\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x68\x61\x28\x74\x68\x69\x73\x29\x7d\x2c\x74\x6f\x67\x67\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x61\x3f\x74\x68\x69\x73\x2e\x73\x68\x6f\x77\x28\x29\x3a\x74\x68\x69\x73\x2e\x68\x69\x64\x65\x28\x29\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x61\x28\x74\x68\x69\x73\x29\x3f\x72\x28\x74\x68\x69\x73\x29\x2e\x73\x68\x6f\x77\x28\x29\x3a\x72\x28\x74\x68\x69\x73\x29\x2e\x68\x69\x64\x65\x28\x29\x7d\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x69\x61\x3d\x2f\x5e\x28\x3f\x3a\x63\x68\x65\x63\x6b\x62\x6f\x78\x7c\x72\x61\x64\x69\x6f\x29\x24\x2f\x69\x2c\x6a\x61\x3d\x2f\x3c\x28\x5b\x61\x2d\x7a\x5d\x5b\x5e\x5c\x2f\x5c\x30\x3e\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2b\x29\x2f\x69\x2c\x6b\x61\x3d\x2f\x5e\x24\x7c\x5c\x2f\x28\x3f\x3a\x6a\x61\x76\x61\x7c\x65\x63\x6d\x61\x29\x73\x63\x72\x69\x70\x74\x2f\x69\x2c\x6c\x61\x3d\x7b\x6f\x70\x74\x69\x6f\x6e\x3a\x5b\x31\x2c\x22\x3c\x73\x65\x6c\x65\x63\x74\x20\x6d\x75\x6c\x74\x69\x70\x6c\x65\x3d\x27\x6d\x75\x6c\x74\x69\x70\x6c\x65\x27\x3e\x22\x2c\x22\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x22\x5d\x2c\x74\x68\x65\x61\x64\x3a\x5b\x31\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x22\x2c\x22\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x63\x6f\x6c\x3a\x5b\x32\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x3c\x63\x6f\x6c\x67\x72\x6f\x75\x70\x3e\x22\x2c\x22\x3c\x2f\x63\x6f\x6c\x67\x72\x6f\x75\x70\x3e\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x74\x72\x3a\x5b\x32\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x3c\x74\x62\x6f\x64\x79\x3e\x22\x2c\x22\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x74\x64\x3a\x5b\x33\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x3c\x74\x62\x6f\x64\x79\x3e\x3c\x74\x72\x3e\x22\x2c\x22\x3c\x2f\x74\x72\x3e\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x5b\x30\x2c\x22\x22\x2c\x22\x22\x5d\x7d\x3b\x6c\x61\x2e\x6f\x70\x74\x67\x72\x6f\x75\x70\x3d\x6c\x61\x2e\x6f\x70\x74\x69\x6f\x6e\x2c\x6c\x61\x2e\x74\x62\x6f\x64\x79\x3d\x6c\x61\x2e\x74\x66\x6f\x6f\x74\x3d\x6c\x61\x2e\x63\x6f\x6c\x67\x72\x6f\x75\x70\x3d\x6c\x61\x2e\x63\x61\x70\x74\x69\x6f\x6e\x3d\x6c\x61\x2e\x74\x68\x65\x61\x64\x2c\x6c\x61\x2e\x74\x68\x3d\x6c\x61\x2e\x74\x64\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6d\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3f\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x62\x7c\x7c\x22\x2a\x22\x29\x3a\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x3f\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x62\x7c\x7c\x22\x2a\x22\x29\x3a\x5b\x5d\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x7c\x7c\x62\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2c\x62\x29\x3f\x72\x2e\x6d\x65\x72\x67\x65\x28\x5b\x61\x5d\x2c\x63\x29\x3a\x63\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x61\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x30\x2c\x64\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x56\x2e\x73\x65\x74\x28\x61\x5b\x63\x5d\x2c\x22\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x22\x2c\x21\x62\x7c\x7c\x56\x2e\x67\x65\x74\x28\x62\x5b\x63\x5d\x2c\x22\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x22\x29\x29\x7d\x76\x61\x72\x20\x6f\x61\x3d\x2f\x3c\x7c\x26\x23\x3f\x5c\x77\x2b\x3b\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x3d\x62\x2e\x63\x72\x65\x61\x74\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x46\x72\x61\x67\x6d\x65\x6e\x74\x28\x29\x2c\x6d\x3d\x5b\x5d\x2c\x6e\x3d\x30\x2c\x6f\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x6e\x3c\x6f\x3b\x6e\x2b\x2b\x29\x69\x66\x28\x66\x3d\x61\x5b\x6e\x5d\x2c\x66\x7c\x7c\x30\x3d\x3d\x3d\x66\x29\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x66\x29\x29\x72\x2e\x6d\x65\x72\x67\x65\x28\x6d\x2c\x66\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x5b\x66\x5d\x3a\x66\x29\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x6f\x61\x2e\x74\x65\x73\x74\x28\x66\x29\x29\x7b\x67\x3d\x67\x7c\x7c\x6c\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x29\x2c\x68\x3d\x28\x6a\x61\x2e\x65\x78\x65\x63\x28\x66\x29\x7c\x7c\x5b\x22\x22\x2c\x22\x22\x5d\x29\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x69\x3d\x6c\x61\x5b\x68\x5d\x7c\x7c\x6c\x61\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2c\x67\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x69\x5b\x31\x5d\x2b\x72\x2e\x68\x74\x6d\x6c\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x66\x29\x2b\x69\x5b\x32\x5d\x2c\x6b\x3d\x69\x5b\x30\x5d\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x67\x3d\x67\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x3b\x72\x2e\x6d\x65\x72\x67\x65\x28\x6d\x2c\x67\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x2c\x67\x3d\x6c\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x67\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x22\x7d\x65\x6c\x73\x65\x20\x6d\x2e\x70\x75\x73\x68\x28\x62\x2e\x63\x72\x65\x61\x74\x65\x54\x65\x78\x74\x4e\x6f\x64\x65\x28\x66\x29\x29\x3b\x6c\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x22\x2c\x6e\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x6d\x5b\x6e\x2b\x2b\x5d\x29\x69\x66\x28\x64\x26\x26\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x66\x2c\x64\x29\x3e\x2d\x31\x29\x65\x26\x26\x65\x2e\x70\x75\x73\x68\x28\x66\x29\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x6a\x3d\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x66\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x66\x29\x2c\x67\x3d\x6d\x61\x28\x6c\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x66\x29\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x2c\x6a\x26\x26\x6e\x61\x28\x67\x29\x2c\x63\x29\x7b\x6b\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x67\x5b\x6b\x2b\x2b\x5d\x29\x6b\x61\x2e\x74\x65\x73\x74\x28\x66\x2e\x74\x79\x70\x65\x7c\x7c\x22\x22\x29\x26\x26\x63\x2e\x70\x75\x73\x68\x28\x66\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6c\x7d\x21\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x46\x72\x61\x67\x6d\x65\x6e\x74\x28\x29\x2c\x62\x3d\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x29\x2c\x63\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x3b\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x2c\x22\x72\x61\x64\x69\x6f\x22\x29\x2c\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x68\x65\x63\x6b\x65\x64\x22\x2c\x22\x63\x68\x65\x63\x6b\x65\x64\x22\x29\x2c\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x6e\x61\x6d\x65\x22\x2c\x22\x74\x22\x29\x2c\x62\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x63\x29\x2c\x6f\x2e\x63\x68\x65\x63\x6b\x43\x6c\x6f\x6e\x65\x3d\x62\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x2e\x63\x68\x65\x63\x6b\x65\x64\x2c\x62\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x74\x65\x78\x74\x61\x72\x65\x61\x3e\x78\x3c\x2f\x74\x65\x78\x74\x61\x72\x65\x61\x3e\x22\x2c\x6f\x2e\x6e\x6f\x43\x6c\x6f\x6e\x65\x43\x68\x65\x63\x6b\x65\x64\x3d\x21\x21\x62\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x7d\x28\x29\x3b\x76\x61\x72\x20\x71\x61\x3d\x64\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x72\x61\x3d\x2f\x5e\x6b\x65\x79\x2f\x2c\x73\x61\x3d\x2f\x5e\x28\x3f\x3a\x6d\x6f\x75\x73\x65\x7c\x70\x6f\x69\x6e\x74\x65\x72\x7c\x63\x6f\x6e\x74\x65\x78\x74\x6d\x65\x6e\x75\x7c\x64\x72\x61\x67\x7c\x64\x72\x6f\x70\x29\x7c\x63\x6c\x69\x63\x6b\x2f\x2c\x74\x61\x3d\x2f\x5e\x28\x5b\x5e\x2e\x5d\x2a\x29\x28\x3f\x3a\x5c\x2e\x28\x2e\x2b\x29\x7c\x29\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x75\x61\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x76\x61\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x61\x28\x29\x7b\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x61\x63\x74\x69\x76\x65\x45\x6c\x65\x6d\x65\x6e\x74\x7d\x63\x61\x74\x63\x68\x28\x61\x29\x7b\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x78\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x2c\x68\x3b\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x29\x7b\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x26\x26\x28\x64\x3d\x64\x7c\x7c\x63\x2c\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x3b\x66\x6f\x72\x28\x68\x20\x69\x6e\x20\x62\x29\x78\x61\x28\x61\x2c\x68\x2c\x63\x2c\x64\x2c\x62\x5b\x68\x5d\x2c\x66\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x69\x66\x28\x6e\x75\x6c\x6c\x3d\x3d\x64\x26\x26\x6e\x75\x6c\x6c\x3d\x3d\x65\x3f\x28\x65\x3d\x63\x2c\x64\x3d\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x3a\x6e\x75\x6c\x6c\x3d\x3d\x65\x26\x26\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x3f\x28\x65\x3d\x64\x2c\x64\x3d\x76\x6f\x69\x64\x20\x30\x29\x3a\x28\x65\x3d\x64\x2c\x64\x3d\x63\x2c\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x29\x2c\x65\x3d\x3d\x3d\x21\x31\x29\x65\x3d\x76\x61\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x21\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x3b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x66\x26\x26\x28\x67\x3d\x65\x2c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x28\x29\x2e\x6f\x66\x66\x28\x61\x29\x2c\x67\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x7d\x2c\x65\x2e\x67\x75\x69\x64\x3d\x67\x2e\x67\x75\x69\x64\x7c\x7c\x28\x67\x2e\x67\x75\x69\x64\x3d\x72\x2e\x67\x75\x69\x64\x2b\x2b\x29\x29\x2c\x61\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x61\x64\x64\x28\x74\x68\x69\x73\x2c\x62\x2c\x65\x2c\x64\x2c\x63\x29\x7d\x29\x7d\x72\x2e\x65\x76\x65\x6e\x74\x3d\x7b\x67\x6c\x6f\x62\x61\x6c\x3a\x7b\x7d\x2c\x61\x64\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x2c\x71\x3d\x56\x2e\x67\x65\x74\x28\x61\x29\x3b\x69\x66\x28\x71\x29\x7b\x63\x2e\x68\x61\x6e\x64\x6c\x65\x72\x26\x26\x28\x66\x3d\x63\x2c\x63\x3d\x66\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2c\x65\x3d\x66\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x29\x2c\x65\x26\x26\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x28\x71\x61\x2c\x65\x29\x2c\x63\x2e\x67\x75\x69\x64\x7c\x7c\x28\x63\x2e\x67\x75\x69\x64\x3d\x72\x2e\x67\x75\x69\x64\x2b\x2b\x29\x2c\x28\x69\x3d\x71\x2e\x65\x76\x65\x6e\x74\x73\x29\x7c\x7c\x28\x69\x3d\x71\x2e\x65\x76\x65\x6e\x74\x73\x3d\x7b\x7d\x29\x2c\x28\x67\x3d\x71\x2e\x68\x61\x6e\x64\x6c\x65\x29\x7c\x7c\x28\x67\x3d\x71\x2e\x68\x61\x6e\x64\x6c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x72\x26\x26\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x21\x3d\x3d\x62\x2e\x74\x79\x70\x65\x3f\x72\x2e\x65\x76\x65\x6e\x74\x2e\x64\x69\x73\x70\x61\x74\x63\x68\x2e\x61\x70\x70\x6c\x79\x28\x61\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3a\x76\x6f\x69\x64\x20\x30\x7d\x29\x2c\x62\x3d\x28\x62\x7c\x7c\x22\x22\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x22\x22\x5d\x2c\x6a\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6a\x2d\x2d\x29\x68\x3d\x74\x61\x2e\x65\x78\x65\x63\x28\x62\x5b\x6a\x5d\x29\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x70\x3d\x68\x5b\x31\x5d\x2c\x6f\x3d\x28\x68\x5b\x32\x5d\x7c\x7c\x22\x22\x29\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x2e\x73\x6f\x72\x74\x28\x29\x2c\x6e\x26\x26\x28\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x6e\x5d\x7c\x7c\x7b\x7d\x2c\x6e\x3d\x28\x65\x3f\x6c\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x6c\x2e\x62\x69\x6e\x64\x54\x79\x70\x65\x29\x7c\x7c\x6e\x2c\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x6e\x5d\x7c\x7c\x7b\x7d\x2c\x6b\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x74\x79\x70\x65\x3a\x6e\x2c\x6f\x72\x69\x67\x54\x79\x70\x65\x3a\x70\x2c\x64\x61\x74\x61\x3a\x64\x2c\x68\x61\x6e\x64\x6c\x65\x72\x3a\x63\x2c\x67\x75\x69\x64\x3a\x63\x2e\x67\x75\x69\x64\x2c\x73\x65\x6c\x65\x63\x74\x6f\x72\x3a\x65\x2c\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x3a\x65\x26\x26\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x2e\x74\x65\x73\x74\x28\x65\x29\x2c\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x6f\x2e\x6a\x6f\x69\x6e\x28\x22\x2e\x22\x29\x7d\x2c\x66\x29\x2c\x28\x6d\x3d\x69\x5b\x6e\x5d\x29\x7c\x7c\x28\x6d\x3d\x69\x5b\x6e\x5d\x3d\x5b\x5d\x2c\x6d\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x3d\x30\x2c\x6c\x2e\x73\x65\x74\x75\x70\x26\x26\x6c\x2e\x73\x65\x74\x75\x70\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x64\x2c\x6f\x2c\x67\x29\x21\x3d\x3d\x21\x31\x7c\x7c\x61\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x26\x26\x61\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x6e\x2c\x67\x29\x29\x2c\x6c\x2e\x61\x64\x64\x26\x26\x28\x6c\x2e\x61\x64\x64\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6b\x29\x2c\x6b\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2e\x67\x75\x69\x64\x7c\x7c\x28\x6b\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2e\x67\x75\x69\x64\x3d\x63\x2e\x67\x75\x69\x64\x29\x29\x2c\x65\x3f\x6d\x2e\x73\x70\x6c\x69\x63\x65\x28\x6d\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x2b\x2b\x2c\x30\x2c\x6b\x29\x3a\x6d\x2e\x70\x75\x73\x68\x28\x6b\x29\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x67\x6c\x6f\x62\x61\x6c\x5b\x6e\x5d\x3d\x21\x30\x29\x7d\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x2c\x71\x3d\x56\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x26\x26\x56\x2e\x67\x65\x74\x28\x61\x29\x3b\x69\x66\x28\x71\x26\x26\x28\x69\x3d\x71\x2e\x65\x76\x65\x6e\x74\x73\x29\x29\x7b\x62\x3d\x28\x62\x7c\x7c\x22\x22\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x22\x22\x5d\x2c\x6a\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6a\x2d\x2d\x29\x69\x66\x28\x68\x3d\x74\x61\x2e\x65\x78\x65\x63\x28\x62\x5b\x6a\x5d\x29\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x70\x3d\x68\x5b\x31\x5d\x2c\x6f\x3d\x28\x68\x5b\x32\x5d\x7c\x7c\x22\x22\x29\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x2e\x73\x6f\x72\x74\x28\x29\x2c\x6e\x29\x7b\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x6e\x5d\x7c\x7c\x7b\x7d\x2c\x6e\x3d\x28\x64\x3f\x6c\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x6c\x2e\x62\x69\x6e\x64\x54\x79\x70\x65\x29\x7c\x7c\x6e\x2c\x6d\x3d\x69\x5b\x6e\x5d\x7c\x7c\x5b\x5d\x2c\x68\x3d\x68\x5b\x32\x5d\x26\x26\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x28\x5e\x7c\x5c\x5c\x2e\x29\x22\x2b\x6f\x2e\x6a\x6f\x69\x6e\x28\x22\x5c\x5c\x2e\x28\x3f\x3a\x2e\x2a\x5c\x5c\x2e\x7c\x29\x22\x29\x2b\x22\x28\x5c\x5c\x2e\x7c\x24\x29\x22\x29\x2c\x67\x3d\x66\x3d\x6d\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x66\x2d\x2d\x29\x6b\x3d\x6d\x5b\x66\x5d\x2c\x21\x65\x26\x26\x70\x21\x3d\x3d\x6b\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x7c\x7c\x63\x26\x26\x63\x2e\x67\x75\x69\x64\x21\x3d\x3d\x6b\x2e\x67\x75\x69\x64\x7c\x7c\x68\x26\x26\x21\x68\x2e\x74\x65\x73\x74\x28\x6b\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x29\x7c\x7c\x64\x26\x26\x64\x21\x3d\x3d\x6b\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x26\x26\x28\x22\x2a\x2a\x22\x21\x3d\x3d\x64\x7c\x7c\x21\x6b\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x29\x7c\x7c\x28\x6d\x2e\x73\x70\x6c\x69\x63\x65\x28\x66\x2c\x31\x29\x2c\x6b\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x26\x26\x6d\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x2d\x2d\x2c\x6c\x2e\x72\x65\x6d\x6f\x76\x65\x26\x26\x6c\x2e\x72\x65\x6d\x6f\x76\x65\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6b\x29\x29\x3b\x67\x26\x26\x21\x6d\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x6c\x2e\x74\x65\x61\x72\x64\x6f\x77\x6e\x26\x26\x6c\x2e\x74\x65\x61\x72\x64\x6f\x77\x6e\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6f\x2c\x71\x2e\x68\x61\x6e\x64\x6c\x65\x29\x21\x3d\x3d\x21\x31\x7c\x7c\x72\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x28\x61\x2c\x6e\x2c\x71\x2e\x68\x61\x6e\x64\x6c\x65\x29\x2c\x64\x65\x6c\x65\x74\x65\x20\x69\x5b\x6e\x5d\x29\x7d\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x6e\x20\x69\x6e\x20\x69\x29\x72\x2e\x65\x76\x65\x6e\x74\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x6e\x2b\x62\x5b\x6a\x5d\x2c\x63\x2c\x64\x2c\x21\x30\x29\x3b\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x69\x29\x26\x26\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x22\x68\x61\x6e\x64\x6c\x65\x20\x65\x76\x65\x6e\x74\x73\x22\x29\x7d\x7d\x2c\x64\x69\x73\x70\x61\x74\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x66\x69\x78\x28\x61\x29\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x3d\x6e\x65\x77\x20\x41\x72\x72\x61\x79\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x2c\x6a\x3d\x28\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x65\x76\x65\x6e\x74\x73\x22\x29\x7c\x7c\x7b\x7d\x29\x5b\x62\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x5b\x5d\x2c\x6b\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x62\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x7b\x7d\x3b\x66\x6f\x72\x28\x69\x5b\x30\x5d\x3d\x62\x2c\x63\x3d\x31\x3b\x63\x3c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x2b\x2b\x29\x69\x5b\x63\x5d\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x63\x5d\x3b\x69\x66\x28\x62\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x61\x72\x67\x65\x74\x3d\x74\x68\x69\x73\x2c\x21\x6b\x2e\x70\x72\x65\x44\x69\x73\x70\x61\x74\x63\x68\x7c\x7c\x6b\x2e\x70\x72\x65\x44\x69\x73\x70\x61\x74\x63\x68\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x29\x21\x3d\x3d\x21\x31\x29\x7b\x68\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x68\x61\x6e\x64\x6c\x65\x72\x73\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x2c\x6a\x29\x2c\x63\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x28\x66\x3d\x68\x5b\x63\x2b\x2b\x5d\x29\x26\x26\x21\x62\x2e\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x28\x29\x29\x7b\x62\x2e\x63\x75\x72\x72\x65\x6e\x74\x54\x61\x72\x67\x65\x74\x3d\x66\x2e\x65\x6c\x65\x6d\x2c\x64\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x28\x67\x3d\x66\x2e\x68\x61\x6e\x64\x6c\x65\x72\x73\x5b\x64\x2b\x2b\x5d\x29\x26\x26\x21\x62\x2e\x69\x73\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x28\x29\x29\x62\x2e\x72\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x26\x26\x21\x62\x2e\x72\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x2e\x74\x65\x73\x74\x28\x67\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x29\x7c\x7c\x28\x62\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x3d\x67\x2c\x62\x2e\x64\x61\x74\x61\x3d\x67\x2e\x64\x61\x74\x61\x2c\x65\x3d\x28\x28\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x67\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x5d\x7c\x7c\x7b\x7d\x29\x2e\x68\x61\x6e\x64\x6c\x65\x7c\x7c\x67\x2e\x68\x61\x6e\x64\x6c\x65\x72\x29\x2e\x61\x70\x70\x6c\x79\x28\x66\x2e\x65\x6c\x65\x6d\x2c\x69\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x65\x26\x26\x28\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x65\x29\x3d\x3d\x3d\x21\x31\x26\x26\x28\x62\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x2c\x62\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x29\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6b\x2e\x70\x6f\x73\x74\x44\x69\x73\x70\x61\x74\x63\x68\x26\x26\x6b\x2e\x70\x6f\x73\x74\x44\x69\x73\x70\x61\x74\x63\x68\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x29\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x7d\x7d\x2c\x68\x61\x6e\x64\x6c\x65\x72\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x5b\x5d\x2c\x69\x3d\x62\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x2c\x6a\x3d\x61\x2e\x74\x61\x72\x67\x65\x74\x3b\x69\x66\x28\x69\x26\x26\x6a\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x21\x28\x22\x63\x6c\x69\x63\x6b\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x26\x26\x61\x2e\x62\x75\x74\x74\x6f\x6e\x3e\x3d\x31\x29\x29\x66\x6f\x72\x28\x3b\x6a\x21\x3d\x3d\x74\x68\x69\x73\x3b\x6a\x3d\x6a\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x7c\x7c\x74\x68\x69\x73\x29\x69\x66\x28\x31\x3d\x3d\x3d\x6a\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x22\x63\x6c\x69\x63\x6b\x22\x21\x3d\x3d\x61\x2e\x74\x79\x70\x65\x7c\x7c\x6a\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x21\x3d\x3d\x21\x30\x29\x29\x7b\x66\x6f\x72\x28\x66\x3d\x5b\x5d\x2c\x67\x3d\x7b\x7d\x2c\x63\x3d\x30\x3b\x63\x3c\x69\x3b\x63\x2b\x2b\x29\x64\x3d\x62\x5b\x63\x5d\x2c\x65\x3d\x64\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x2b\x22\x20\x22\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x67\x5b\x65\x5d\x26\x26\x28\x67\x5b\x65\x5d\x3d\x64\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x3f\x72\x28\x65\x2c\x74\x68\x69\x73\x29\x2e\x69\x6e\x64\x65\x78\x28\x6a\x29\x3e\x2d\x31\x3a\x72\x2e\x66\x69\x6e\x64\x28\x65\x2c\x74\x68\x69\x73\x2c\x6e\x75\x6c\x6c\x2c\x5b\x6a\x5d\x29\x2e\x6c\x65\x6e\x67\x74\x68\x29\x2c\x67\x5b\x65\x5d\x26\x26\x66\x2e\x70\x75\x73\x68\x28\x64\x29\x3b\x66\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x7b\x65\x6c\x65\x6d\x3a\x6a\x2c\x68\x61\x6e\x64\x6c\x65\x72\x73\x3a\x66\x7d\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6a\x3d\x74\x68\x69\x73\x2c\x69\x3c\x62\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x7b\x65\x6c\x65\x6d\x3a\x6a\x2c\x68\x61\x6e\x64\x6c\x65\x72\x73\x3a\x62\x2e\x73\x6c\x69\x63\x65\x28\x69\x29\x7d\x29\x2c\x68\x7d\x2c\x61\x64\x64\x50\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x4f\x62\x6a\x65\x63\x74\x2e\x64\x65\x66\x69\x6e\x65\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x72\x2e\x45\x76\x65\x6e\x74\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2c\x61\x2c\x7b\x65\x6e\x75\x6d\x65\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x67\x65\x74\x3a\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x5b\x61\x5d\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x4f\x62\x6a\x65\x63\x74\x2e\x64\x65\x66\x69\x6e\x65\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x74\x68\x69\x73\x2c\x61\x2c\x7b\x65\x6e\x75\x6d\x65\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x77\x72\x69\x74\x61\x62\x6c\x65\x3a\x21\x30\x2c\x76\x61\x6c\x75\x65\x3a\x62\x7d\x29\x7d\x7d\x29\x7d\x2c\x66\x69\x78\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3f\x61\x3a\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x28\x61\x29\x7d\x2c\x73\x70\x65\x63\x69\x61\x6c\x3a\x7b\x6c\x6f\x61\x64\x3a\x7b\x6e\x6f\x42\x75\x62\x62\x6c\x65\x3a\x21\x30\x7d\x2c\x66\x6f\x63\x75\x73\x3a\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x21\x3d\x3d\x77\x61\x28\x29\x26\x26\x74\x68\x69\x73\x2e\x66\x6f\x63\x75\x73\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x66\x6f\x63\x75\x73\x28\x29\x2c\x21\x31\x7d\x2c\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x22\x66\x6f\x63\x75\x73\x69\x6e\x22\x7d\x2c\x62\x6c\x75\x72\x3a\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x3d\x3d\x3d\x77\x61\x28\x29\x26\x26\x74\x68\x69\x73\x2e\x62\x6c\x75\x72\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x62\x6c\x75\x72\x28\x29\x2c\x21\x31\x7d\x2c\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x22\x66\x6f\x63\x75\x73\x6f\x75\x74\x22\x7d\x2c\x63\x6c\x69\x63\x6b\x3a\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x26\x26\x74\x68\x69\x73\x2e\x63\x6c\x69\x63\x6b\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x74\x68\x69\x73\x2c\x22\x69\x6e\x70\x75\x74\x22\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x63\x6c\x69\x63\x6b\x28\x29\x2c\x21\x31\x7d\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2e\x74\x61\x72\x67\x65\x74\x2c\x22\x61\x22\x29\x7d\x7d\x2c\x62\x65\x66\x6f\x72\x65\x75\x6e\x6c\x6f\x61\x64\x3a\x7b\x70\x6f\x73\x74\x44\x69\x73\x70\x61\x74\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x61\x2e\x72\x65\x73\x75\x6c\x74\x26\x26\x61\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x26\x26\x28\x61\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x2e\x72\x65\x74\x75\x72\x6e\x56\x61\x6c\x75\x65\x3d\x61\x2e\x72\x65\x73\x75\x6c\x74\x29\x7d\x7d\x7d\x7d\x2c\x72\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x61\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x26\x26\x61\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x62\x2c\x63\x29\x7d\x2c\x72\x2e\x45\x76\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x6f\x66\x20\x72\x2e\x45\x76\x65\x6e\x74\x3f\x28\x61\x26\x26\x61\x2e\x74\x79\x70\x65\x3f\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3d\x61\x2c\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x3d\x61\x2e\x74\x79\x70\x65\x2c\x74\x68\x69\x73\x2e\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x3d\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x7c\x7c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x26\x26\x61\x2e\x72\x65\x74\x75\x72\x6e\x56\x61\x6c\x75\x65\x3d\x3d\x3d\x21\x31\x3f\x75\x61\x3a\x76\x61\x2c\x74\x68\x69\x73\x2e\x74\x61\x72\x67\x65\x74\x3d\x61\x2e\x74\x61\x72\x67\x65\x74\x26\x26\x33\x3d\x3d\x3d\x61\x2e\x74\x61\x72\x67\x65\x74\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x2e\x74\x61\x72\x67\x65\x74\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3a\x61\x2e\x74\x61\x72\x67\x65\x74\x2c\x74\x68\x69\x73\x2e\x63\x75\x72\x72\x65\x6e\x74\x54\x61\x72\x67\x65\x74\x3d\x61\x2e\x63\x75\x72\x72\x65\x6e\x74\x54\x61\x72\x67\x65\x74\x2c\x74\x68\x69\x73\x2e\x72\x65\x6c\x61\x74\x65\x64\x54\x61\x72\x67\x65\x74\x3d\x61\x2e\x72\x65\x6c\x61\x74\x65\x64\x54\x61\x72\x67\x65\x74\x29\x3a\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x3d\x61\x2c\x62\x26\x26\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x74\x68\x69\x73\x2c\x62\x29\x2c\x74\x68\x69\x73\x2e\x74\x69\x6d\x65\x53\x74\x61\x6d\x70\x3d\x61\x26\x26\x61\x2e\x74\x69\x6d\x65\x53\x74\x61\x6d\x70\x7c\x7c\x72\x2e\x6e\x6f\x77\x28\x29\x2c\x76\x6f\x69\x64\x28\x74\x68\x69\x73\x5b\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x21\x30\x29\x29\x3a\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x28\x61\x2c\x62\x29\x7d\x2c\x72\x2e\x45\x76\x65\x6e\x74\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x3a\x72\x2e\x45\x76\x65\x6e\x74\x2c\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x3a\x76\x61\x2c\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3a\x76\x61\x2c\x69\x73\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3a\x76\x61\x2c\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x3a\x21\x31\x2c\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3b\x74\x68\x69\x73\x2e\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x3d\x75\x61\x2c\x61\x26\x26\x21\x74\x68\x69\x73\x2e\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x26\x26\x61\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x7d\x2c\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3b\x74\x68\x69\x73\x2e\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3d\x75\x61\x2c\x61\x26\x26\x21\x74\x68\x69\x73\x2e\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x26\x26\x61\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x7d\x2c\x73\x74\x6f\x70\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3b\x74\x68\x69\x73\x2e\x69\x73\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3d\x75\x61\x2c\x61\x26\x26\x21\x74\x68\x69\x73\x2e\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x26\x26\x61\x2e\x73\x74\x6f\x70\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x2c\x74\x68\x69\x73\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x7d\x7d\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x61\x6c\x74\x4b\x65\x79\x3a\x21\x30\x2c\x62\x75\x62\x62\x6c\x65\x73\x3a\x21\x30\x2c\x63\x61\x6e\x63\x65\x6c\x61\x62\x6c\x65\x3a\x21\x30\x2c\x63\x68\x61\x6e\x67\x65\x64\x54\x6f\x75\x63\x68\x65\x73\x3a\x21\x30\x2c\x63\x74\x72\x6c\x4b\x65\x79\x3a\x21\x30\x2c\x64\x65\x74\x61\x69\x6c\x3a\x21\x30\x2c\x65\x76\x65\x6e\x74\x50\x68\x61\x73\x65\x3a\x21\x30\x2c\x6d\x65\x74\x61\x4b\x65\x79\x3a\x21\x30\x2c\x70\x61\x67\x65\x58\x3a\x21\x30\x2c\x70\x61\x67\x65\x59\x3a\x21\x30\x2c\x73\x68\x69\x66\x74\x4b\x65\x79\x3a\x21\x30\x2c\x76\x69\x65\x77\x3a\x21\x30\x2c\x22\x63\x68\x61\x72\x22\x3a\x21\x30\x2c\x63\x68\x61\x72\x43\x6f\x64\x65\x3a\x21\x30\x2c\x6b\x65\x79\x3a\x21\x30\x2c\x6b\x65\x79\x43\x6f\x64\x65\x3a\x21\x30\x2c\x62\x75\x74\x74\x6f\x6e\x3a\x21\x30\x2c\x62\x75\x74\x74\x6f\x6e\x73\x3a\x21\x30\x2c\x63\x6c\x69\x65\x6e\x74\x58\x3a\x21\x30\x2c\x63\x6c\x69\x65\x6e\x74\x59\x3a\x21\x30\x2c\x6f\x66\x66\x73\x65\x74\x58\x3a\x21\x30\x2c\x6f\x66\x66\x73\x65\x74\x59\x3a\x21\x30\x2c\x70\x6f\x69\x6e\x74\x65\x72\x49\x64\x3a\x21\x30\x2c\x70\x6f\x69\x6e\x74\x65\x72\x54\x79\x70\x65\x3a\x21\x30\x2c\x73\x63\x72\x65\x65\x6e\x58\x3a\x21\x30\x2c\x73\x63\x72\x65\x65\x6e\x59\x3a\x21\x30\x2c\x74\x61\x72\x67\x65\x74\x54\x6f\x75\x63\x68\x65\x73\x3a\x21\x30\x2c\x74\x6f\x45\x6c\x65\x6d\x65\x6e\x74\x3a\x21\x30\x2c\x74\x6f\x75\x63\x68\x65\x73\x3a\x21\x30\x2c\x77\x68\x69\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x62\x75\x74\x74\x6f\x6e\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x77\x68\x69\x63\x68\x26\x26\x72\x61\x2e\x74\x65\x73\x74\x28\x61\x2e\x74\x79\x70\x65\x29\x3f\x6e\x75\x6c\x6c\x21\x3d\x61\x2e\x63\x68\x61\x72\x43\x6f\x64\x65\x3f\x61\x2e\x63\x68\x61\x72\x43\x6f\x64\x65\x3a\x61\x2e\x6b\x65\x79\x43\x6f\x64\x65\x3a\x21\x61\x2e\x77\x68\x69\x63\x68\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x26\x26\x73\x61\x2e\x74\x65\x73\x74\x28\x61\x2e\x74\x79\x70\x65\x29\x3f\x31\x26\x62\x3f\x31\x3a\x32\x26\x62\x3f\x33\x3a\x34\x26\x62\x3f\x32\x3a\x30\x3a\x61\x2e\x77\x68\x69\x63\x68\x7d\x7d\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x61\x64\x64\x50\x72\x6f\x70\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x6d\x6f\x75\x73\x65\x65\x6e\x74\x65\x72\x3a\x22\x6d\x6f\x75\x73\x65\x6f\x76\x65\x72\x22\x2c\x6d\x6f\x75\x73\x65\x6c\x65\x61\x76\x65\x3a\x22\x6d\x6f\x75\x73\x65\x6f\x75\x74\x22\x2c\x70\x6f\x69\x6e\x74\x65\x72\x65\x6e\x74\x65\x72\x3a\x22\x70\x6f\x69\x6e\x74\x65\x72\x6f\x76\x65\x72\x22\x2c\x70\x6f\x69\x6e\x74\x65\x72\x6c\x65\x61\x76\x65\x3a\x22\x70\x6f\x69\x6e\x74\x65\x72\x6f\x75\x74\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x61\x5d\x3d\x7b\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x62\x2c\x62\x69\x6e\x64\x54\x79\x70\x65\x3a\x62\x2c\x68\x61\x6e\x64\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x74\x68\x69\x73\x2c\x65\x3d\x61\x2e\x72\x65\x6c\x61\x74\x65\x64\x54\x61\x72\x67\x65\x74\x2c\x66\x3d\x61\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x26\x26\x28\x65\x3d\x3d\x3d\x64\x7c\x7c\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x64\x2c\x65\x29\x29\x7c\x7c\x28\x61\x2e\x74\x79\x70\x65\x3d\x66\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x2c\x63\x3d\x66\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x61\x2e\x74\x79\x70\x65\x3d\x62\x29\x2c\x63\x7d\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x78\x61\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7d\x2c\x6f\x6e\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x78\x61\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x31\x29\x7d\x2c\x6f\x66\x66\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x3b\x69\x66\x28\x61\x26\x26\x61\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x26\x26\x61\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3d\x61\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x2c\x72\x28\x61\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x61\x72\x67\x65\x74\x29\x2e\x6f\x66\x66\x28\x64\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3f\x64\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x2b\x22\x2e\x22\x2b\x64\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x64\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x2c\x64\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x2c\x64\x2e\x68\x61\x6e\x64\x6c\x65\x72\x29\x2c\x74\x68\x69\x73\x3b\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x7b\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x61\x29\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x65\x2c\x62\x2c\x61\x5b\x65\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x72\x65\x74\x75\x72\x6e\x20\x62\x21\x3d\x3d\x21\x31\x26\x26\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x7c\x7c\x28\x63\x3d\x62\x2c\x62\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x63\x3d\x3d\x3d\x21\x31\x26\x26\x28\x63\x3d\x76\x61\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x72\x65\x6d\x6f\x76\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x63\x2c\x62\x29\x7d\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x79\x61\x3d\x2f\x3c\x28\x3f\x21\x61\x72\x65\x61\x7c\x62\x72\x7c\x63\x6f\x6c\x7c\x65\x6d\x62\x65\x64\x7c\x68\x72\x7c\x69\x6d\x67\x7c\x69\x6e\x70\x75\x74\x7c\x6c\x69\x6e\x6b\x7c\x6d\x65\x74\x61\x7c\x70\x61\x72\x61\x6d\x29\x28\x28\x5b\x61\x2d\x7a\x5d\x5b\x5e\x5c\x2f\x5c\x30\x3e\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2a\x29\x5b\x5e\x3e\x5d\x2a\x29\x5c\x2f\x3e\x2f\x67\x69\x2c\x7a\x61\x3d\x2f\x3c\x73\x63\x72\x69\x70\x74\x7c\x3c\x73\x74\x79\x6c\x65\x7c\x3c\x6c\x69\x6e\x6b\x2f\x69\x2c\x41\x61\x3d\x2f\x63\x68\x65\x63\x6b\x65\x64\x5c\x73\x2a\x28\x3f\x3a\x5b\x5e\x3d\x5d\x7c\x3d\x5c\x73\x2a\x2e\x63\x68\x65\x63\x6b\x65\x64\x2e\x29\x2f\x69\x2c\x42\x61\x3d\x2f\x5e\x74\x72\x75\x65\x5c\x2f\x28\x2e\x2a\x29\x2f\x2c\x43\x61\x3d\x2f\x5e\x5c\x73\x2a\x3c\x21\x28\x3f\x3a\x5c\x5b\x43\x44\x41\x54\x41\x5c\x5b\x7c\x2d\x2d\x29\x7c\x28\x3f\x3a\x5c\x5d\x5c\x5d\x7c\x2d\x2d\x29\x3e\x5c\x73\x2a\x24\x2f\x67\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x44\x61\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2c\x22\x74\x61\x62\x6c\x65\x22\x29\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x31\x31\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x62\x3a\x62\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x22\x74\x72\x22\x29\x3f\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x22\x74\x62\x6f\x64\x79\x22\x29\x5b\x30\x5d\x7c\x7c\x61\x3a\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x45\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x74\x79\x70\x65\x3d\x28\x6e\x75\x6c\x6c\x21\x3d\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x29\x29\x2b\x22\x2f\x22\x2b\x61\x2e\x74\x79\x70\x65\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x46\x61\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x42\x61\x2e\x65\x78\x65\x63\x28\x61\x2e\x74\x79\x70\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x61\x2e\x74\x79\x70\x65\x3d\x62\x5b\x31\x5d\x3a\x61\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x29\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x47\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x3b\x69\x66\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x69\x66\x28\x56\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x26\x26\x28\x66\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x29\x2c\x67\x3d\x56\x2e\x73\x65\x74\x28\x62\x2c\x66\x29\x2c\x6a\x3d\x66\x2e\x65\x76\x65\x6e\x74\x73\x29\x29\x7b\x64\x65\x6c\x65\x74\x65\x20\x67\x2e\x68\x61\x6e\x64\x6c\x65\x2c\x67\x2e\x65\x76\x65\x6e\x74\x73\x3d\x7b\x7d\x3b\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x6a\x29\x66\x6f\x72\x28\x63\x3d\x30\x2c\x64\x3d\x6a\x5b\x65\x5d\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x72\x2e\x65\x76\x65\x6e\x74\x2e\x61\x64\x64\x28\x62\x2c\x65\x2c\x6a\x5b\x65\x5d\x5b\x63\x5d\x29\x7d\x57\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x26\x26\x28\x68\x3d\x57\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x29\x2c\x69\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x68\x29\x2c\x57\x2e\x73\x65\x74\x28\x62\x2c\x69\x29\x29\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x48\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x63\x26\x26\x69\x61\x2e\x74\x65\x73\x74\x28\x61\x2e\x74\x79\x70\x65\x29\x3f\x62\x2e\x63\x68\x65\x63\x6b\x65\x64\x3d\x61\x2e\x63\x68\x65\x63\x6b\x65\x64\x3a\x22\x69\x6e\x70\x75\x74\x22\x21\x3d\x3d\x63\x26\x26\x22\x74\x65\x78\x74\x61\x72\x65\x61\x22\x21\x3d\x3d\x63\x7c\x7c\x28\x62\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x3d\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x49\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x62\x3d\x67\x2e\x61\x70\x70\x6c\x79\x28\x5b\x5d\x2c\x62\x29\x3b\x76\x61\x72\x20\x65\x2c\x66\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x3d\x30\x2c\x6d\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6e\x3d\x6d\x2d\x31\x2c\x71\x3d\x62\x5b\x30\x5d\x2c\x73\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x71\x29\x3b\x69\x66\x28\x73\x7c\x7c\x6d\x3e\x31\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x71\x26\x26\x21\x6f\x2e\x63\x68\x65\x63\x6b\x43\x6c\x6f\x6e\x65\x26\x26\x41\x61\x2e\x74\x65\x73\x74\x28\x71\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x61\x2e\x65\x71\x28\x65\x29\x3b\x73\x26\x26\x28\x62\x5b\x30\x5d\x3d\x71\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x65\x2c\x66\x2e\x68\x74\x6d\x6c\x28\x29\x29\x29\x2c\x49\x61\x28\x66\x2c\x62\x2c\x63\x2c\x64\x29\x7d\x29\x3b\x69\x66\x28\x6d\x26\x26\x28\x65\x3d\x70\x61\x28\x62\x2c\x61\x5b\x30\x5d\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x21\x31\x2c\x61\x2c\x64\x29\x2c\x66\x3d\x65\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x31\x3d\x3d\x3d\x65\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x65\x3d\x66\x29\x2c\x66\x7c\x7c\x64\x29\x29\x7b\x66\x6f\x72\x28\x68\x3d\x72\x2e\x6d\x61\x70\x28\x6d\x61\x28\x65\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x2c\x45\x61\x29\x2c\x69\x3d\x68\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x6c\x3c\x6d\x3b\x6c\x2b\x2b\x29\x6a\x3d\x65\x2c\x6c\x21\x3d\x3d\x6e\x26\x26\x28\x6a\x3d\x72\x2e\x63\x6c\x6f\x6e\x65\x28\x6a\x2c\x21\x30\x2c\x21\x30\x29\x2c\x69\x26\x26\x72\x2e\x6d\x65\x72\x67\x65\x28\x68\x2c\x6d\x61\x28\x6a\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x29\x29\x2c\x63\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x6c\x5d\x2c\x6a\x2c\x6c\x29\x3b\x69\x66\x28\x69\x29\x66\x6f\x72\x28\x6b\x3d\x68\x5b\x68\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x5d\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x72\x2e\x6d\x61\x70\x28\x68\x2c\x46\x61\x29\x2c\x6c\x3d\x30\x3b\x6c\x3c\x69\x3b\x6c\x2b\x2b\x29\x6a\x3d\x68\x5b\x6c\x5d\x2c\x6b\x61\x2e\x74\x65\x73\x74\x28\x6a\x2e\x74\x79\x70\x65\x7c\x7c\x22\x22\x29\x26\x26\x21\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x6a\x2c\x22\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x22\x29\x26\x26\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x6b\x2c\x6a\x29\x26\x26\x28\x6a\x2e\x73\x72\x63\x3f\x72\x2e\x5f\x65\x76\x61\x6c\x55\x72\x6c\x26\x26\x72\x2e\x5f\x65\x76\x61\x6c\x55\x72\x6c\x28\x6a\x2e\x73\x72\x63\x29\x3a\x70\x28\x6a\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x43\x61\x2c\x22\x22\x29\x2c\x6b\x29\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4a\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x2c\x65\x3d\x62\x3f\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x29\x3a\x61\x2c\x66\x3d\x30\x3b\x6e\x75\x6c\x6c\x21\x3d\x28\x64\x3d\x65\x5b\x66\x5d\x29\x3b\x66\x2b\x2b\x29\x63\x7c\x7c\x31\x21\x3d\x3d\x64\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x64\x29\x29\x2c\x64\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x28\x63\x26\x26\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x64\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x64\x29\x26\x26\x6e\x61\x28\x6d\x61\x28\x64\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x29\x2c\x64\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x64\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x74\x6d\x6c\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x79\x61\x2c\x22\x3c\x24\x31\x3e\x3c\x2f\x24\x32\x3e\x22\x29\x7d\x2c\x63\x6c\x6f\x6e\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x61\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2c\x69\x3d\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x61\x29\x3b\x69\x66\x28\x21\x28\x6f\x2e\x6e\x6f\x43\x6c\x6f\x6e\x65\x43\x68\x65\x63\x6b\x65\x64\x7c\x7c\x31\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x31\x31\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x28\x61\x29\x29\x29\x66\x6f\x72\x28\x67\x3d\x6d\x61\x28\x68\x29\x2c\x66\x3d\x6d\x61\x28\x61\x29\x2c\x64\x3d\x30\x2c\x65\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x48\x61\x28\x66\x5b\x64\x5d\x2c\x67\x5b\x64\x5d\x29\x3b\x69\x66\x28\x62\x29\x69\x66\x28\x63\x29\x66\x6f\x72\x28\x66\x3d\x66\x7c\x7c\x6d\x61\x28\x61\x29\x2c\x67\x3d\x67\x7c\x7c\x6d\x61\x28\x68\x29\x2c\x64\x3d\x30\x2c\x65\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x47\x61\x28\x66\x5b\x64\x5d\x2c\x67\x5b\x64\x5d\x29\x3b\x65\x6c\x73\x65\x20\x47\x61\x28\x61\x2c\x68\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x3d\x6d\x61\x28\x68\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x2c\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x26\x26\x6e\x61\x28\x67\x2c\x21\x69\x26\x26\x6d\x61\x28\x61\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x29\x2c\x68\x7d\x2c\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x2c\x66\x3d\x30\x3b\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x63\x3d\x61\x5b\x66\x5d\x29\x3b\x66\x2b\x2b\x29\x69\x66\x28\x54\x28\x63\x29\x29\x7b\x69\x66\x28\x62\x3d\x63\x5b\x56\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x29\x7b\x69\x66\x28\x62\x2e\x65\x76\x65\x6e\x74\x73\x29\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x62\x2e\x65\x76\x65\x6e\x74\x73\x29\x65\x5b\x64\x5d\x3f\x72\x2e\x65\x76\x65\x6e\x74\x2e\x72\x65\x6d\x6f\x76\x65\x28\x63\x2c\x64\x29\x3a\x72\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x28\x63\x2c\x64\x2c\x62\x2e\x68\x61\x6e\x64\x6c\x65\x29\x3b\x63\x5b\x56\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x76\x6f\x69\x64\x20\x30\x7d\x63\x5b\x57\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x26\x26\x28\x63\x5b\x57\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x76\x6f\x69\x64\x20\x30\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x64\x65\x74\x61\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x61\x28\x74\x68\x69\x73\x2c\x61\x2c\x21\x30\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x61\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x2c\x74\x65\x78\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x3f\x72\x2e\x74\x65\x78\x74\x28\x74\x68\x69\x73\x29\x3a\x74\x68\x69\x73\x2e\x65\x6d\x70\x74\x79\x28\x29\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x31\x21\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x31\x31\x21\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x39\x21\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x28\x74\x68\x69\x73\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x61\x29\x7d\x29\x7d\x2c\x6e\x75\x6c\x6c\x2c\x61\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x2c\x61\x70\x70\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x31\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x39\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x76\x61\x72\x20\x62\x3d\x44\x61\x28\x74\x68\x69\x73\x2c\x61\x29\x3b\x62\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x7d\x7d\x29\x7d\x2c\x70\x72\x65\x70\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x31\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x39\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x76\x61\x72\x20\x62\x3d\x44\x61\x28\x74\x68\x69\x73\x2c\x61\x29\x3b\x62\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x61\x2c\x62\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x7d\x7d\x29\x7d\x2c\x62\x65\x66\x6f\x72\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x61\x2c\x74\x68\x69\x73\x29\x7d\x29\x7d\x2c\x61\x66\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x61\x2c\x74\x68\x69\x73\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x7d\x29\x7d\x2c\x65\x6d\x70\x74\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x61\x2c\x62\x3d\x30\x3b\x6e\x75\x6c\x6c\x21\x3d\x28\x61\x3d\x74\x68\x69\x73\x5b\x62\x5d\x29\x3b\x62\x2b\x2b\x29\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x61\x2c\x21\x31\x29\x29\x2c\x61\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x63\x6c\x6f\x6e\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x61\x2c\x62\x3d\x6e\x75\x6c\x6c\x3d\x3d\x62\x3f\x61\x3a\x62\x2c\x74\x68\x69\x73\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x63\x6c\x6f\x6e\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x7d\x29\x7d\x2c\x68\x74\x6d\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x74\x68\x69\x73\x5b\x30\x5d\x7c\x7c\x7b\x7d\x2c\x63\x3d\x30\x2c\x64\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x26\x26\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x21\x7a\x61\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x21\x6c\x61\x5b\x28\x6a\x61\x2e\x65\x78\x65\x63\x28\x61\x29\x7c\x7c\x5b\x22\x22\x2c\x22\x22\x5d\x29\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x29\x7b\x61\x3d\x72\x2e\x68\x74\x6d\x6c\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x61\x29\x3b\x74\x72\x79\x7b\x66\x6f\x72\x28\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x62\x3d\x74\x68\x69\x73\x5b\x63\x5d\x7c\x7c\x7b\x7d\x2c\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x62\x2c\x21\x31\x29\x29\x2c\x62\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x61\x29\x3b\x62\x3d\x30\x7d\x63\x61\x74\x63\x68\x28\x65\x29\x7b\x7d\x7d\x62\x26\x26\x74\x68\x69\x73\x2e\x65\x6d\x70\x74\x79\x28\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x61\x29\x7d\x2c\x6e\x75\x6c\x6c\x2c\x61\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x2c\x72\x65\x70\x6c\x61\x63\x65\x57\x69\x74\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x5b\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x74\x68\x69\x73\x2c\x61\x29\x3c\x30\x26\x26\x28\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x74\x68\x69\x73\x29\x29\x2c\x63\x26\x26\x63\x2e\x72\x65\x70\x6c\x61\x63\x65\x43\x68\x69\x6c\x64\x28\x62\x2c\x74\x68\x69\x73\x29\x29\x7d\x2c\x61\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x61\x70\x70\x65\x6e\x64\x54\x6f\x3a\x22\x61\x70\x70\x65\x6e\x64\x22\x2c\x70\x72\x65\x70\x65\x6e\x64\x54\x6f\x3a\x22\x70\x72\x65\x70\x65\x6e\x64\x22\x2c\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x3a\x22\x62\x65\x66\x6f\x72\x65\x22\x2c\x69\x6e\x73\x65\x72\x74\x41\x66\x74\x65\x72\x3a\x22\x61\x66\x74\x65\x72\x22\x2c\x72\x65\x70\x6c\x61\x63\x65\x41\x6c\x6c\x3a\x22\x72\x65\x70\x6c\x61\x63\x65\x57\x69\x74\x68\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x72\x28\x61\x29\x2c\x66\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x2c\x67\x3d\x30\x3b\x67\x3c\x3d\x66\x3b\x67\x2b\x2b\x29\x63\x3d\x67\x3d\x3d\x3d\x66\x3f\x74\x68\x69\x73\x3a\x74\x68\x69\x73\x2e\x63\x6c\x6f\x6e\x65\x28\x21\x30\x29\x2c\x72\x28\x65\x5b\x67\x5d\x29\x5b\x62\x5d\x28\x63\x29\x2c\x68\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x63\x2e\x67\x65\x74\x28\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x64\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x4b\x61\x3d\x2f\x5e\x6d\x61\x72\x67\x69\x6e\x2f\x2c\x4c\x61\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x22\x2b\x5f\x2b\x22\x29\x28\x3f\x21\x70\x78\x29\x5b\x61\x2d\x7a\x25\x5d\x2b\x24\x22\x2c\x22\x69\x22\x29\x2c\x4d\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x63\x2e\x6f\x70\x65\x6e\x65\x72\x7c\x7c\x28\x63\x3d\x61\x29\x2c\x63\x2e\x67\x65\x74\x43\x6f\x6d\x70\x75\x74\x65\x64\x53\x74\x79\x6c\x65\x28\x62\x29\x7d\x3b\x21\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x28\x29\x7b\x69\x66\x28\x69\x29\x7b\x69\x2e\x73\x74\x79\x6c\x65\x2e\x63\x73\x73\x54\x65\x78\x74\x3d\x22\x62\x6f\x78\x2d\x73\x69\x7a\x69\x6e\x67\x3a\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x3b\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x72\x65\x6c\x61\x74\x69\x76\x65\x3b\x64\x69\x73\x70\x6c\x61\x79\x3a\x62\x6c\x6f\x63\x6b\x3b\x6d\x61\x72\x67\x69\x6e\x3a\x61\x75\x74\x6f\x3b\x62\x6f\x72\x64\x65\x72\x3a\x31\x70\x78\x3b\x70\x61\x64\x64\x69\x6e\x67\x3a\x31\x70\x78\x3b\x74\x6f\x70\x3a\x31\x25\x3b\x77\x69\x64\x74\x68\x3a\x35\x30\x25\x22\x2c\x69\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x22\x2c\x71\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x68\x29\x3b\x76\x61\x72\x20\x62\x3d\x61\x2e\x67\x65\x74\x43\x6f\x6d\x70\x75\x74\x65\x64\x53\x74\x79\x6c\x65\x28\x69\x29\x3b\x63\x3d\x22\x31\x25\x22\x21\x3d\x3d\x62\x2e\x74\x6f\x70\x2c\x67\x3d\x22\x32\x70\x78\x22\x3d\x3d\x3d\x62\x2e\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x2c\x65\x3d\x22\x34\x70\x78\x22\x3d\x3d\x3d\x62\x2e\x77\x69\x64\x74\x68\x2c\x69\x2e\x73\x74\x79\x6c\x65\x2e\x6d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x3d\x22\x35\x30\x25\x22\x2c\x66\x3d\x22\x34\x70\x78\x22\x3d\x3d\x3d\x62\x2e\x6d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x2c\x71\x61\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x68\x29\x2c\x69\x3d\x6e\x75\x6c\x6c\x7d\x7d\x76\x61\x72\x20\x63\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x2c\x69\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x3b\x69\x2e\x73\x74\x79\x6c\x65\x26\x26\x28\x69\x2e\x73\x74\x79\x6c\x65\x2e\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x43\x6c\x69\x70\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x2d\x62\x6f\x78\x22\x2c\x69\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x73\x74\x79\x6c\x65\x2e\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x43\x6c\x69\x70\x3d\x22\x22\x2c\x6f\x2e\x63\x6c\x65\x61\x72\x43\x6c\x6f\x6e\x65\x53\x74\x79\x6c\x65\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x2d\x62\x6f\x78\x22\x3d\x3d\x3d\x69\x2e\x73\x74\x79\x6c\x65\x2e\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x43\x6c\x69\x70\x2c\x68\x2e\x73\x74\x79\x6c\x65\x2e\x63\x73\x73\x54\x65\x78\x74\x3d\x22\x62\x6f\x72\x64\x65\x72\x3a\x30\x3b\x77\x69\x64\x74\x68\x3a\x38\x70\x78\x3b\x68\x65\x69\x67\x68\x74\x3a\x30\x3b\x74\x6f\x70\x3a\x30\x3b\x6c\x65\x66\x74\x3a\x2d\x39\x39\x39\x39\x70\x78\x3b\x70\x61\x64\x64\x69\x6e\x67\x3a\x30\x3b\x6d\x61\x72\x67\x69\x6e\x2d\x74\x6f\x70\x3a\x31\x70\x78\x3b\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x61\x62\x73\x6f\x6c\x75\x74\x65\x22\x2c\x68\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x69\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x6f\x2c\x7b\x70\x69\x78\x65\x6c\x50\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x63\x7d\x2c\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x52\x65\x6c\x69\x61\x62\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x65\x7d\x2c\x70\x69\x78\x65\x6c\x4d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x66\x7d\x2c\x72\x65\x6c\x69\x61\x62\x6c\x65\x4d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x67\x7d\x7d\x29\x29\x7d\x28\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4e\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x61\x2e\x73\x74\x79\x6c\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x63\x7c\x7c\x4d\x61\x28\x61\x29\x2c\x63\x26\x26\x28\x67\x3d\x63\x2e\x67\x65\x74\x50\x72\x6f\x70\x65\x72\x74\x79\x56\x61\x6c\x75\x65\x28\x62\x29\x7c\x7c\x63\x5b\x62\x5d\x2c\x22\x22\x21\x3d\x3d\x67\x7c\x7c\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x61\x29\x7c\x7c\x28\x67\x3d\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x62\x29\x29\x2c\x21\x6f\x2e\x70\x69\x78\x65\x6c\x4d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x28\x29\x26\x26\x4c\x61\x2e\x74\x65\x73\x74\x28\x67\x29\x26\x26\x4b\x61\x2e\x74\x65\x73\x74\x28\x62\x29\x26\x26\x28\x64\x3d\x68\x2e\x77\x69\x64\x74\x68\x2c\x65\x3d\x68\x2e\x6d\x69\x6e\x57\x69\x64\x74\x68\x2c\x66\x3d\x68\x2e\x6d\x61\x78\x57\x69\x64\x74\x68\x2c\x68\x2e\x6d\x69\x6e\x57\x69\x64\x74\x68\x3d\x68\x2e\x6d\x61\x78\x57\x69\x64\x74\x68\x3d\x68\x2e\x77\x69\x64\x74\x68\x3d\x67\x2c\x67\x3d\x63\x2e\x77\x69\x64\x74\x68\x2c\x68\x2e\x77\x69\x64\x74\x68\x3d\x64\x2c\x68\x2e\x6d\x69\x6e\x57\x69\x64\x74\x68\x3d\x65\x2c\x68\x2e\x6d\x61\x78\x57\x69\x64\x74\x68\x3d\x66\x29\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x67\x3f\x67\x2b\x22\x22\x3a\x67\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4f\x61\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x28\x29\x3f\x76\x6f\x69\x64\x20\x64\x65\x6c\x65\x74\x65\x20\x74\x68\x69\x73\x2e\x67\x65\x74\x3a\x28\x74\x68\x69\x73\x2e\x67\x65\x74\x3d\x62\x29\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x7d\x7d\x7d\x76\x61\x72\x20\x50\x61\x3d\x2f\x5e\x28\x6e\x6f\x6e\x65\x7c\x74\x61\x62\x6c\x65\x28\x3f\x21\x2d\x63\x5b\x65\x61\x5d\x29\x2e\x2b\x29\x2f\x2c\x51\x61\x3d\x7b\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x22\x61\x62\x73\x6f\x6c\x75\x74\x65\x22\x2c\x76\x69\x73\x69\x62\x69\x6c\x69\x74\x79\x3a\x22\x68\x69\x64\x64\x65\x6e\x22\x2c\x64\x69\x73\x70\x6c\x61\x79\x3a\x22\x62\x6c\x6f\x63\x6b\x22\x7d\x2c\x52\x61\x3d\x7b\x6c\x65\x74\x74\x65\x72\x53\x70\x61\x63\x69\x6e\x67\x3a\x22\x30\x22\x2c\x66\x6f\x6e\x74\x57\x65\x69\x67\x68\x74\x3a\x22\x34\x30\x30\x22\x7d\x2c\x53\x61\x3d\x5b\x22\x57\x65\x62\x6b\x69\x74\x22\x2c\x22\x4d\x6f\x7a\x22\x2c\x22\x6d\x73\x22\x5d\x2c\x54\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x2e\x73\x74\x79\x6c\x65\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x55\x61\x28\x61\x29\x7b\x69\x66\x28\x61\x20\x69\x6e\x20\x54\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x3b\x76\x61\x72\x20\x62\x3d\x61\x5b\x30\x5d\x2e\x74\x6f\x55\x70\x70\x65\x72\x43\x61\x73\x65\x28\x29\x2b\x61\x2e\x73\x6c\x69\x63\x65\x28\x31\x29\x2c\x63\x3d\x53\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x69\x66\x28\x61\x3d\x53\x61\x5b\x63\x5d\x2b\x62\x2c\x61\x20\x69\x6e\x20\x54\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x56\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x61\x61\x2e\x65\x78\x65\x63\x28\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3f\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x30\x2c\x64\x5b\x32\x5d\x2d\x28\x63\x7c\x7c\x30\x29\x29\x2b\x28\x64\x5b\x33\x5d\x7c\x7c\x22\x70\x78\x22\x29\x3a\x62\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x57\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x3d\x30\x3b\x66\x6f\x72\x28\x66\x3d\x63\x3d\x3d\x3d\x28\x64\x3f\x22\x62\x6f\x72\x64\x65\x72\x22\x3a\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x29\x3f\x34\x3a\x22\x77\x69\x64\x74\x68\x22\x3d\x3d\x3d\x62\x3f\x31\x3a\x30\x3b\x66\x3c\x34\x3b\x66\x2b\x3d\x32\x29\x22\x6d\x61\x72\x67\x69\x6e\x22\x3d\x3d\x3d\x63\x26\x26\x28\x67\x2b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x63\x2b\x62\x61\x5b\x66\x5d\x2c\x21\x30\x2c\x65\x29\x29\x2c\x64\x3f\x28\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3d\x3d\x3d\x63\x26\x26\x28\x67\x2d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x2b\x62\x61\x5b\x66\x5d\x2c\x21\x30\x2c\x65\x29\x29\x2c\x22\x6d\x61\x72\x67\x69\x6e\x22\x21\x3d\x3d\x63\x26\x26\x28\x67\x2d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x72\x64\x65\x72\x22\x2b\x62\x61\x5b\x66\x5d\x2b\x22\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x2c\x65\x29\x29\x29\x3a\x28\x67\x2b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x2b\x62\x61\x5b\x66\x5d\x2c\x21\x30\x2c\x65\x29\x2c\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x21\x3d\x3d\x63\x26\x26\x28\x67\x2b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x72\x64\x65\x72\x22\x2b\x62\x61\x5b\x66\x5d\x2b\x22\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x2c\x65\x29\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x58\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x3d\x21\x30\x2c\x66\x3d\x4d\x61\x28\x61\x29\x2c\x67\x3d\x22\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x22\x2c\x21\x31\x2c\x66\x29\x3b\x69\x66\x28\x61\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x64\x3d\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x5b\x62\x5d\x29\x2c\x64\x3c\x3d\x30\x7c\x7c\x6e\x75\x6c\x6c\x3d\x3d\x64\x29\x7b\x69\x66\x28\x64\x3d\x4e\x61\x28\x61\x2c\x62\x2c\x66\x29\x2c\x28\x64\x3c\x30\x7c\x7c\x6e\x75\x6c\x6c\x3d\x3d\x64\x29\x26\x26\x28\x64\x3d\x61\x2e\x73\x74\x79\x6c\x65\x5b\x62\x5d\x29\x2c\x4c\x61\x2e\x74\x65\x73\x74\x28\x64\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x65\x3d\x67\x26\x26\x28\x6f\x2e\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x52\x65\x6c\x69\x61\x62\x6c\x65\x28\x29\x7c\x7c\x64\x3d\x3d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x5b\x62\x5d\x29\x2c\x64\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x64\x29\x7c\x7c\x30\x7d\x72\x65\x74\x75\x72\x6e\x20\x64\x2b\x57\x61\x28\x61\x2c\x62\x2c\x63\x7c\x7c\x28\x67\x3f\x22\x62\x6f\x72\x64\x65\x72\x22\x3a\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x29\x2c\x65\x2c\x66\x29\x2b\x22\x70\x78\x22\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x63\x73\x73\x48\x6f\x6f\x6b\x73\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x4e\x61\x28\x61\x2c\x22\x6f\x70\x61\x63\x69\x74\x79\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x22\x3d\x3d\x3d\x63\x3f\x22\x31\x22\x3a\x63\x7d\x7d\x7d\x7d\x2c\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x3a\x7b\x61\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x49\x74\x65\x72\x61\x74\x69\x6f\x6e\x43\x6f\x75\x6e\x74\x3a\x21\x30\x2c\x63\x6f\x6c\x75\x6d\x6e\x43\x6f\x75\x6e\x74\x3a\x21\x30\x2c\x66\x69\x6c\x6c\x4f\x70\x61\x63\x69\x74\x79\x3a\x21\x30\x2c\x66\x6c\x65\x78\x47\x72\x6f\x77\x3a\x21\x30\x2c\x66\x6c\x65\x78\x53\x68\x72\x69\x6e\x6b\x3a\x21\x30\x2c\x66\x6f\x6e\x74\x57\x65\x69\x67\x68\x74\x3a\x21\x30\x2c\x6c\x69\x6e\x65\x48\x65\x69\x67\x68\x74\x3a\x21\x30\x2c\x6f\x70\x61\x63\x69\x74\x79\x3a\x21\x30\x2c\x6f\x72\x64\x65\x72\x3a\x21\x30\x2c\x6f\x72\x70\x68\x61\x6e\x73\x3a\x21\x30\x2c\x77\x69\x64\x6f\x77\x73\x3a\x21\x30\x2c\x7a\x49\x6e\x64\x65\x78\x3a\x21\x30\x2c\x7a\x6f\x6f\x6d\x3a\x21\x30\x7d\x2c\x63\x73\x73\x50\x72\x6f\x70\x73\x3a\x7b\x22\x66\x6c\x6f\x61\x74\x22\x3a\x22\x63\x73\x73\x46\x6c\x6f\x61\x74\x22\x7d\x2c\x73\x74\x79\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x69\x66\x28\x61\x26\x26\x33\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x38\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x73\x74\x79\x6c\x65\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x2c\x69\x3d\x61\x2e\x73\x74\x79\x6c\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x3d\x55\x61\x28\x68\x29\x7c\x7c\x68\x29\x2c\x67\x3d\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x7c\x7c\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x68\x5d\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x63\x3f\x67\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x67\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x65\x3d\x67\x2e\x67\x65\x74\x28\x61\x2c\x21\x31\x2c\x64\x29\x29\x3f\x65\x3a\x69\x5b\x62\x5d\x3a\x28\x66\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x66\x26\x26\x28\x65\x3d\x61\x61\x2e\x65\x78\x65\x63\x28\x63\x29\x29\x26\x26\x65\x5b\x31\x5d\x26\x26\x28\x63\x3d\x65\x61\x28\x61\x2c\x62\x2c\x65\x29\x2c\x66\x3d\x22\x6e\x75\x6d\x62\x65\x72\x22\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x63\x26\x26\x63\x3d\x3d\x3d\x63\x26\x26\x28\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x3d\x66\x26\x26\x28\x63\x2b\x3d\x65\x26\x26\x65\x5b\x33\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x68\x5d\x3f\x22\x22\x3a\x22\x70\x78\x22\x29\x29\x2c\x6f\x2e\x63\x6c\x65\x61\x72\x43\x6c\x6f\x6e\x65\x53\x74\x79\x6c\x65\x7c\x7c\x22\x22\x21\x3d\x3d\x63\x7c\x7c\x30\x21\x3d\x3d\x62\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x29\x7c\x7c\x28\x69\x5b\x62\x5d\x3d\x22\x69\x6e\x68\x65\x72\x69\x74\x22\x29\x2c\x67\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x67\x26\x26\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x28\x63\x3d\x67\x2e\x73\x65\x74\x28\x61\x2c\x63\x2c\x64\x29\x29\x7c\x7c\x28\x69\x5b\x62\x5d\x3d\x63\x29\x29\x2c\x76\x6f\x69\x64\x20\x30\x29\x7d\x7d\x2c\x63\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x3d\x55\x61\x28\x68\x29\x7c\x7c\x68\x29\x2c\x67\x3d\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x7c\x7c\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x68\x5d\x2c\x67\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x67\x26\x26\x28\x65\x3d\x67\x2e\x67\x65\x74\x28\x61\x2c\x21\x30\x2c\x63\x29\x29\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x65\x26\x26\x28\x65\x3d\x4e\x61\x28\x61\x2c\x62\x2c\x64\x29\x29\x2c\x22\x6e\x6f\x72\x6d\x61\x6c\x22\x3d\x3d\x3d\x65\x26\x26\x62\x20\x69\x6e\x20\x52\x61\x26\x26\x28\x65\x3d\x52\x61\x5b\x62\x5d\x29\x2c\x22\x22\x3d\x3d\x3d\x63\x7c\x7c\x63\x3f\x28\x66\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x65\x29\x2c\x63\x3d\x3d\x3d\x21\x30\x7c\x7c\x69\x73\x46\x69\x6e\x69\x74\x65\x28\x66\x29\x3f\x66\x7c\x7c\x30\x3a\x65\x29\x3a\x65\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x68\x65\x69\x67\x68\x74\x22\x2c\x22\x77\x69\x64\x74\x68\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x3d\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x69\x66\x28\x63\x29\x72\x65\x74\x75\x72\x6e\x21\x50\x61\x2e\x74\x65\x73\x74\x28\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x29\x7c\x7c\x61\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2e\x77\x69\x64\x74\x68\x3f\x58\x61\x28\x61\x2c\x62\x2c\x64\x29\x3a\x64\x61\x28\x61\x2c\x51\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x58\x61\x28\x61\x2c\x62\x2c\x64\x29\x7d\x29\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3d\x64\x26\x26\x4d\x61\x28\x61\x29\x2c\x67\x3d\x64\x26\x26\x57\x61\x28\x61\x2c\x62\x2c\x64\x2c\x22\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x22\x2c\x21\x31\x2c\x66\x29\x2c\x66\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x26\x26\x28\x65\x3d\x61\x61\x2e\x65\x78\x65\x63\x28\x63\x29\x29\x26\x26\x22\x70\x78\x22\x21\x3d\x3d\x28\x65\x5b\x33\x5d\x7c\x7c\x22\x70\x78\x22\x29\x26\x26\x28\x61\x2e\x73\x74\x79\x6c\x65\x5b\x62\x5d\x3d\x63\x2c\x63\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x29\x29\x2c\x56\x61\x28\x61\x2c\x63\x2c\x67\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x2e\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x3d\x4f\x61\x28\x6f\x2e\x72\x65\x6c\x69\x61\x62\x6c\x65\x4d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x62\x29\x72\x65\x74\x75\x72\x6e\x28\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x4e\x61\x28\x61\x2c\x22\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x22\x29\x29\x7c\x7c\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2e\x6c\x65\x66\x74\x2d\x64\x61\x28\x61\x2c\x7b\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x3a\x30\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2e\x6c\x65\x66\x74\x7d\x29\x29\x2b\x22\x70\x78\x22\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x6d\x61\x72\x67\x69\x6e\x3a\x22\x22\x2c\x70\x61\x64\x64\x69\x6e\x67\x3a\x22\x22\x2c\x62\x6f\x72\x64\x65\x72\x3a\x22\x57\x69\x64\x74\x68\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x61\x2b\x62\x5d\x3d\x7b\x65\x78\x70\x61\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x30\x2c\x65\x3d\x7b\x7d\x2c\x66\x3d\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x3f\x63\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x3a\x5b\x63\x5d\x3b\x64\x3c\x34\x3b\x64\x2b\x2b\x29\x65\x5b\x61\x2b\x62\x61\x5b\x64\x5d\x2b\x62\x5d\x3d\x66\x5b\x64\x5d\x7c\x7c\x66\x5b\x64\x2d\x32\x5d\x7c\x7c\x66\x5b\x30\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x7d\x2c\x4b\x61\x2e\x74\x65\x73\x74\x28\x61\x29\x7c\x7c\x28\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x61\x2b\x62\x5d\x2e\x73\x65\x74\x3d\x56\x61\x29\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x63\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x7b\x7d\x2c\x67\x3d\x30\x3b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x29\x7b\x66\x6f\x72\x28\x64\x3d\x4d\x61\x28\x61\x29\x2c\x65\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x67\x3c\x65\x3b\x67\x2b\x2b\x29\x66\x5b\x62\x5b\x67\x5d\x5d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x5b\x67\x5d\x2c\x21\x31\x2c\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x66\x7d\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x62\x2c\x63\x29\x3a\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x29\x7d\x2c\x61\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x29\x7d\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x59\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x69\x6e\x69\x74\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7d\x72\x2e\x54\x77\x65\x65\x6e\x3d\x59\x61\x2c\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x3a\x59\x61\x2c\x69\x6e\x69\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x29\x7b\x74\x68\x69\x73\x2e\x65\x6c\x65\x6d\x3d\x61\x2c\x74\x68\x69\x73\x2e\x70\x72\x6f\x70\x3d\x63\x2c\x74\x68\x69\x73\x2e\x65\x61\x73\x69\x6e\x67\x3d\x65\x7c\x7c\x72\x2e\x65\x61\x73\x69\x6e\x67\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x3d\x62\x2c\x74\x68\x69\x73\x2e\x73\x74\x61\x72\x74\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x77\x3d\x74\x68\x69\x73\x2e\x63\x75\x72\x28\x29\x2c\x74\x68\x69\x73\x2e\x65\x6e\x64\x3d\x64\x2c\x74\x68\x69\x73\x2e\x75\x6e\x69\x74\x3d\x66\x7c\x7c\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x63\x5d\x3f\x22\x22\x3a\x22\x70\x78\x22\x29\x7d\x2c\x63\x75\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x70\x72\x6f\x70\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x26\x26\x61\x2e\x67\x65\x74\x3f\x61\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x3a\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x7d\x2c\x72\x75\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x70\x72\x6f\x70\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3f\x74\x68\x69\x73\x2e\x70\x6f\x73\x3d\x62\x3d\x72\x2e\x65\x61\x73\x69\x6e\x67\x5b\x74\x68\x69\x73\x2e\x65\x61\x73\x69\x6e\x67\x5d\x28\x61\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x2a\x61\x2c\x30\x2c\x31\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x29\x3a\x74\x68\x69\x73\x2e\x70\x6f\x73\x3d\x62\x3d\x61\x2c\x74\x68\x69\x73\x2e\x6e\x6f\x77\x3d\x28\x74\x68\x69\x73\x2e\x65\x6e\x64\x2d\x74\x68\x69\x73\x2e\x73\x74\x61\x72\x74\x29\x2a\x62\x2b\x74\x68\x69\x73\x2e\x73\x74\x61\x72\x74\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x73\x74\x65\x70\x26\x26\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x73\x74\x65\x70\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2e\x65\x6c\x65\x6d\x2c\x74\x68\x69\x73\x2e\x6e\x6f\x77\x2c\x74\x68\x69\x73\x29\x2c\x63\x26\x26\x63\x2e\x73\x65\x74\x3f\x63\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x29\x3a\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x29\x2c\x74\x68\x69\x73\x7d\x7d\x2c\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x69\x6e\x69\x74\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2c\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x3d\x7b\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x72\x65\x74\x75\x72\x6e\x20\x31\x21\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x6e\x75\x6c\x6c\x21\x3d\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x26\x26\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x73\x74\x79\x6c\x65\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3f\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3a\x28\x62\x3d\x72\x2e\x63\x73\x73\x28\x61\x2e\x65\x6c\x65\x6d\x2c\x61\x2e\x70\x72\x6f\x70\x2c\x22\x22\x29\x2c\x62\x26\x26\x22\x61\x75\x74\x6f\x22\x21\x3d\x3d\x62\x3f\x62\x3a\x30\x29\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x66\x78\x2e\x73\x74\x65\x70\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3f\x72\x2e\x66\x78\x2e\x73\x74\x65\x70\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x28\x61\x29\x3a\x31\x21\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x73\x74\x79\x6c\x65\x5b\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x5d\x26\x26\x21\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3f\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3d\x61\x2e\x6e\x6f\x77\x3a\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2e\x65\x6c\x65\x6d\x2c\x61\x2e\x70\x72\x6f\x70\x2c\x61\x2e\x6e\x6f\x77\x2b\x61\x2e\x75\x6e\x69\x74\x29\x7d\x7d\x7d\x2c\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x3d\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x73\x63\x72\x6f\x6c\x6c\x4c\x65\x66\x74\x3d\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x2e\x65\x6c\x65\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x65\x6c\x65\x6d\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x28\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3d\x61\x2e\x6e\x6f\x77\x29\x7d\x7d\x2c\x72\x2e\x65\x61\x73\x69\x6e\x67\x3d\x7b\x6c\x69\x6e\x65\x61\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x2c\x73\x77\x69\x6e\x67\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x2e\x35\x2d\x4d\x61\x74\x68\x2e\x63\x6f\x73\x28\x61\x2a\x4d\x61\x74\x68\x2e\x50\x49\x29\x2f\x32\x7d\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x22\x73\x77\x69\x6e\x67\x22\x7d\x2c\x72\x2e\x66\x78\x3d\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x69\x6e\x69\x74\x2c\x72\x2e\x66\x78\x2e\x73\x74\x65\x70\x3d\x7b\x7d\x3b\x76\x61\x72\x20\x5a\x61\x2c\x24\x61\x2c\x5f\x61\x3d\x2f\x5e\x28\x3f\x3a\x74\x6f\x67\x67\x6c\x65\x7c\x73\x68\x6f\x77\x7c\x68\x69\x64\x65\x29\x24\x2f\x2c\x61\x62\x3d\x2f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x24\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x62\x28\x29\x7b\x24\x61\x26\x26\x28\x61\x2e\x72\x65\x71\x75\x65\x73\x74\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x28\x62\x62\x29\x2c\x72\x2e\x66\x78\x2e\x74\x69\x63\x6b\x28\x29\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x62\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x5a\x61\x3d\x76\x6f\x69\x64\x20\x30\x7d\x29\x2c\x5a\x61\x3d\x72\x2e\x6e\x6f\x77\x28\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x64\x62\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x7b\x68\x65\x69\x67\x68\x74\x3a\x61\x7d\x3b\x66\x6f\x72\x28\x62\x3d\x62\x3f\x31\x3a\x30\x3b\x64\x3c\x34\x3b\x64\x2b\x3d\x32\x2d\x62\x29\x63\x3d\x62\x61\x5b\x64\x5d\x2c\x65\x5b\x22\x6d\x61\x72\x67\x69\x6e\x22\x2b\x63\x5d\x3d\x65\x5b\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x2b\x63\x5d\x3d\x61\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x26\x26\x28\x65\x2e\x6f\x70\x61\x63\x69\x74\x79\x3d\x65\x2e\x77\x69\x64\x74\x68\x3d\x61\x29\x2c\x65\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x65\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x2c\x65\x3d\x28\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x62\x5d\x7c\x7c\x5b\x5d\x29\x2e\x63\x6f\x6e\x63\x61\x74\x28\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x22\x2a\x22\x5d\x29\x2c\x66\x3d\x30\x2c\x67\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x69\x66\x28\x64\x3d\x65\x5b\x66\x5d\x2e\x63\x61\x6c\x6c\x28\x63\x2c\x62\x2c\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x3d\x22\x77\x69\x64\x74\x68\x22\x69\x6e\x20\x62\x7c\x7c\x22\x68\x65\x69\x67\x68\x74\x22\x69\x6e\x20\x62\x2c\x6d\x3d\x74\x68\x69\x73\x2c\x6e\x3d\x7b\x7d\x2c\x6f\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2c\x70\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x63\x61\x28\x61\x29\x2c\x71\x3d\x56\x2e\x67\x65\x74\x28\x61\x2c\x22\x66\x78\x73\x68\x6f\x77\x22\x29\x3b\x63\x2e\x71\x75\x65\x75\x65\x7c\x7c\x28\x67\x3d\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x61\x2c\x22\x66\x78\x22\x29\x2c\x6e\x75\x6c\x6c\x3d\x3d\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x26\x26\x28\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x3d\x30\x2c\x68\x3d\x67\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x2c\x67\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x7c\x7c\x68\x28\x29\x7d\x29\x2c\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x2b\x2b\x2c\x6d\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6d\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x2d\x2d\x2c\x72\x2e\x71\x75\x65\x75\x65\x28\x61\x2c\x22\x66\x78\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x67\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x28\x29\x7d\x29\x7d\x29\x29\x3b\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x62\x29\x69\x66\x28\x65\x3d\x62\x5b\x64\x5d\x2c\x5f\x61\x2e\x74\x65\x73\x74\x28\x65\x29\x29\x7b\x69\x66\x28\x64\x65\x6c\x65\x74\x65\x20\x62\x5b\x64\x5d\x2c\x66\x3d\x66\x7c\x7c\x22\x74\x6f\x67\x67\x6c\x65\x22\x3d\x3d\x3d\x65\x2c\x65\x3d\x3d\x3d\x28\x70\x3f\x22\x68\x69\x64\x65\x22\x3a\x22\x73\x68\x6f\x77\x22\x29\x29\x7b\x69\x66\x28\x22\x73\x68\x6f\x77\x22\x21\x3d\x3d\x65\x7c\x7c\x21\x71\x7c\x7c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x71\x5b\x64\x5d\x29\x63\x6f\x6e\x74\x69\x6e\x75\x65\x3b\x70\x3d\x21\x30\x7d\x6e\x5b\x64\x5d\x3d\x71\x26\x26\x71\x5b\x64\x5d\x7c\x7c\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x64\x29\x7d\x69\x66\x28\x69\x3d\x21\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x62\x29\x2c\x69\x7c\x7c\x21\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x6e\x29\x29\x7b\x6c\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3d\x5b\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x58\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x59\x5d\x2c\x6a\x3d\x71\x26\x26\x71\x2e\x64\x69\x73\x70\x6c\x61\x79\x2c\x6e\x75\x6c\x6c\x3d\x3d\x6a\x26\x26\x28\x6a\x3d\x56\x2e\x67\x65\x74\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x29\x2c\x6b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x2c\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x6b\x26\x26\x28\x6a\x3f\x6b\x3d\x6a\x3a\x28\x68\x61\x28\x5b\x61\x5d\x2c\x21\x30\x29\x2c\x6a\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x7c\x7c\x6a\x2c\x6b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x2c\x68\x61\x28\x5b\x61\x5d\x29\x29\x29\x2c\x28\x22\x69\x6e\x6c\x69\x6e\x65\x22\x3d\x3d\x3d\x6b\x7c\x7c\x22\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x22\x3d\x3d\x3d\x6b\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x6a\x29\x26\x26\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x66\x6c\x6f\x61\x74\x22\x29\x26\x26\x28\x69\x7c\x7c\x28\x6d\x2e\x64\x6f\x6e\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6f\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x6a\x7d\x29\x2c\x6e\x75\x6c\x6c\x3d\x3d\x6a\x26\x26\x28\x6b\x3d\x6f\x2e\x64\x69\x73\x70\x6c\x61\x79\x2c\x6a\x3d\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x6b\x3f\x22\x22\x3a\x6b\x29\x29\x2c\x6f\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x22\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x22\x29\x29\x2c\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x26\x26\x28\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3d\x22\x68\x69\x64\x64\x65\x6e\x22\x2c\x6d\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3d\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x5b\x30\x5d\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x58\x3d\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x5b\x31\x5d\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x59\x3d\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x5b\x32\x5d\x7d\x29\x29\x2c\x69\x3d\x21\x31\x3b\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x6e\x29\x69\x7c\x7c\x28\x71\x3f\x22\x68\x69\x64\x64\x65\x6e\x22\x69\x6e\x20\x71\x26\x26\x28\x70\x3d\x71\x2e\x68\x69\x64\x64\x65\x6e\x29\x3a\x71\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x22\x66\x78\x73\x68\x6f\x77\x22\x2c\x7b\x64\x69\x73\x70\x6c\x61\x79\x3a\x6a\x7d\x29\x2c\x66\x26\x26\x28\x71\x2e\x68\x69\x64\x64\x65\x6e\x3d\x21\x70\x29\x2c\x70\x26\x26\x68\x61\x28\x5b\x61\x5d\x2c\x21\x30\x29\x2c\x6d\x2e\x64\x6f\x6e\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x70\x7c\x7c\x68\x61\x28\x5b\x61\x5d\x29\x2c\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x22\x66\x78\x73\x68\x6f\x77\x22\x29\x3b\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x6e\x29\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x64\x2c\x6e\x5b\x64\x5d\x29\x7d\x29\x29\x2c\x69\x3d\x65\x62\x28\x70\x3f\x71\x5b\x64\x5d\x3a\x30\x2c\x64\x2c\x6d\x29\x2c\x64\x20\x69\x6e\x20\x71\x7c\x7c\x28\x71\x5b\x64\x5d\x3d\x69\x2e\x73\x74\x61\x72\x74\x2c\x70\x26\x26\x28\x69\x2e\x65\x6e\x64\x3d\x69\x2e\x73\x74\x61\x72\x74\x2c\x69\x2e\x73\x74\x61\x72\x74\x3d\x30\x29\x29\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x62\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x3b\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x61\x29\x69\x66\x28\x64\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x63\x29\x2c\x65\x3d\x62\x5b\x64\x5d\x2c\x66\x3d\x61\x5b\x63\x5d\x2c\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x66\x29\x26\x26\x28\x65\x3d\x66\x5b\x31\x5d\x2c\x66\x3d\x61\x5b\x63\x5d\x3d\x66\x5b\x30\x5d\x29\x2c\x63\x21\x3d\x3d\x64\x26\x26\x28\x61\x5b\x64\x5d\x3d\x66\x2c\x64\x65\x6c\x65\x74\x65\x20\x61\x5b\x63\x5d\x29\x2c\x67\x3d\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x64\x5d\x2c\x67\x26\x26\x22\x65\x78\x70\x61\x6e\x64\x22\x69\x6e\x20\x67\x29\x7b\x66\x3d\x67\x2e\x65\x78\x70\x61\x6e\x64\x28\x66\x29\x2c\x64\x65\x6c\x65\x74\x65\x20\x61\x5b\x64\x5d\x3b\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x66\x29\x63\x20\x69\x6e\x20\x61\x7c\x7c\x28\x61\x5b\x63\x5d\x3d\x66\x5b\x63\x5d\x2c\x62\x5b\x63\x5d\x3d\x65\x29\x7d\x65\x6c\x73\x65\x20\x62\x5b\x64\x5d\x3d\x65\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x30\x2c\x67\x3d\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x68\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x64\x65\x6c\x65\x74\x65\x20\x69\x2e\x65\x6c\x65\x6d\x7d\x29\x2c\x69\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x65\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x3d\x5a\x61\x7c\x7c\x63\x62\x28\x29\x2c\x63\x3d\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x30\x2c\x6a\x2e\x73\x74\x61\x72\x74\x54\x69\x6d\x65\x2b\x6a\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x2d\x62\x29\x2c\x64\x3d\x63\x2f\x6a\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x7c\x7c\x30\x2c\x66\x3d\x31\x2d\x64\x2c\x67\x3d\x30\x2c\x69\x3d\x6a\x2e\x74\x77\x65\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x67\x3c\x69\x3b\x67\x2b\x2b\x29\x6a\x2e\x74\x77\x65\x65\x6e\x73\x5b\x67\x5d\x2e\x72\x75\x6e\x28\x66\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x68\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x66\x2c\x63\x5d\x29\x2c\x66\x3c\x31\x26\x26\x69\x3f\x63\x3a\x28\x68\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x5d\x29\x2c\x21\x31\x29\x7d\x2c\x6a\x3d\x68\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x7b\x65\x6c\x65\x6d\x3a\x61\x2c\x70\x72\x6f\x70\x73\x3a\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x62\x29\x2c\x6f\x70\x74\x73\x3a\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x21\x30\x2c\x7b\x73\x70\x65\x63\x69\x61\x6c\x45\x61\x73\x69\x6e\x67\x3a\x7b\x7d\x2c\x65\x61\x73\x69\x6e\x67\x3a\x72\x2e\x65\x61\x73\x69\x6e\x67\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x7d\x2c\x63\x29\x2c\x6f\x72\x69\x67\x69\x6e\x61\x6c\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x62\x2c\x6f\x72\x69\x67\x69\x6e\x61\x6c\x4f\x70\x74\x69\x6f\x6e\x73\x3a\x63\x2c\x73\x74\x61\x72\x74\x54\x69\x6d\x65\x3a\x5a\x61\x7c\x7c\x63\x62\x28\x29\x2c\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x63\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x2c\x74\x77\x65\x65\x6e\x73\x3a\x5b\x5d\x2c\x63\x72\x65\x61\x74\x65\x54\x77\x65\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x72\x2e\x54\x77\x65\x65\x6e\x28\x61\x2c\x6a\x2e\x6f\x70\x74\x73\x2c\x62\x2c\x63\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x70\x65\x63\x69\x61\x6c\x45\x61\x73\x69\x6e\x67\x5b\x62\x5d\x7c\x7c\x6a\x2e\x6f\x70\x74\x73\x2e\x65\x61\x73\x69\x6e\x67\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6a\x2e\x74\x77\x65\x65\x6e\x73\x2e\x70\x75\x73\x68\x28\x64\x29\x2c\x64\x7d\x2c\x73\x74\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x30\x2c\x64\x3d\x62\x3f\x6a\x2e\x74\x77\x65\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x30\x3b\x69\x66\x28\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x3b\x66\x6f\x72\x28\x65\x3d\x21\x30\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x6a\x2e\x74\x77\x65\x65\x6e\x73\x5b\x63\x5d\x2e\x72\x75\x6e\x28\x31\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x28\x68\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x31\x2c\x30\x5d\x29\x2c\x68\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x62\x5d\x29\x29\x3a\x68\x2e\x72\x65\x6a\x65\x63\x74\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x62\x5d\x29\x2c\x74\x68\x69\x73\x7d\x7d\x29\x2c\x6b\x3d\x6a\x2e\x70\x72\x6f\x70\x73\x3b\x66\x6f\x72\x28\x67\x62\x28\x6b\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x70\x65\x63\x69\x61\x6c\x45\x61\x73\x69\x6e\x67\x29\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x69\x66\x28\x64\x3d\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x5b\x66\x5d\x2e\x63\x61\x6c\x6c\x28\x6a\x2c\x61\x2c\x6b\x2c\x6a\x2e\x6f\x70\x74\x73\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x2e\x73\x74\x6f\x70\x29\x26\x26\x28\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x6a\x2e\x65\x6c\x65\x6d\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x71\x75\x65\x75\x65\x29\x2e\x73\x74\x6f\x70\x3d\x72\x2e\x70\x72\x6f\x78\x79\x28\x64\x2e\x73\x74\x6f\x70\x2c\x64\x29\x29\x2c\x64\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6d\x61\x70\x28\x6b\x2c\x65\x62\x2c\x6a\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x74\x61\x72\x74\x29\x26\x26\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x74\x61\x72\x74\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6a\x29\x2c\x72\x2e\x66\x78\x2e\x74\x69\x6d\x65\x72\x28\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x69\x2c\x7b\x65\x6c\x65\x6d\x3a\x61\x2c\x61\x6e\x69\x6d\x3a\x6a\x2c\x71\x75\x65\x75\x65\x3a\x6a\x2e\x6f\x70\x74\x73\x2e\x71\x75\x65\x75\x65\x7d\x29\x29\x2c\x6a\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x29\x2e\x64\x6f\x6e\x65\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x64\x6f\x6e\x65\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x29\x2e\x66\x61\x69\x6c\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x66\x61\x69\x6c\x29\x2e\x61\x6c\x77\x61\x79\x73\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x61\x6c\x77\x61\x79\x73\x29\x7d\x72\x2e\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x68\x62\x2c\x7b\x74\x77\x65\x65\x6e\x65\x72\x73\x3a\x7b\x22\x2a\x22\x3a\x5b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x68\x69\x73\x2e\x63\x72\x65\x61\x74\x65\x54\x77\x65\x65\x6e\x28\x61\x2c\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x61\x28\x63\x2e\x65\x6c\x65\x6d\x2c\x61\x2c\x61\x61\x2e\x65\x78\x65\x63\x28\x62\x29\x2c\x63\x29\x2c\x63\x7d\x5d\x7d\x2c\x74\x77\x65\x65\x6e\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x28\x62\x3d\x61\x2c\x61\x3d\x5b\x22\x2a\x22\x5d\x29\x3a\x61\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x3b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x63\x3d\x61\x5b\x64\x5d\x2c\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x63\x5d\x3d\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x63\x5d\x7c\x7c\x5b\x5d\x2c\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x63\x5d\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x62\x29\x7d\x2c\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x3a\x5b\x66\x62\x5d\x2c\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x62\x3f\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x61\x29\x3a\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x2e\x70\x75\x73\x68\x28\x61\x29\x7d\x7d\x29\x2c\x72\x2e\x73\x70\x65\x65\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x65\x3d\x61\x26\x26\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x61\x29\x3a\x7b\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3a\x63\x7c\x7c\x21\x63\x26\x26\x62\x7c\x7c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x26\x26\x61\x2c\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x61\x2c\x65\x61\x73\x69\x6e\x67\x3a\x63\x26\x26\x62\x7c\x7c\x62\x26\x26\x21\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x26\x26\x62\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x66\x78\x2e\x6f\x66\x66\x7c\x7c\x64\x2e\x68\x69\x64\x64\x65\x6e\x3f\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3d\x30\x3a\x22\x6e\x75\x6d\x62\x65\x72\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x26\x26\x28\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x20\x69\x6e\x20\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x3f\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3d\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x5b\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x5d\x3a\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3d\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x65\x2e\x71\x75\x65\x75\x65\x26\x26\x65\x2e\x71\x75\x65\x75\x65\x21\x3d\x3d\x21\x30\x7c\x7c\x28\x65\x2e\x71\x75\x65\x75\x65\x3d\x22\x66\x78\x22\x29\x2c\x65\x2e\x6f\x6c\x64\x3d\x65\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x2c\x65\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2e\x6f\x6c\x64\x29\x26\x26\x65\x2e\x6f\x6c\x64\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x2c\x65\x2e\x71\x75\x65\x75\x65\x26\x26\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x65\x2e\x71\x75\x65\x75\x65\x29\x7d\x2c\x65\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x66\x61\x64\x65\x54\x6f\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x66\x69\x6c\x74\x65\x72\x28\x63\x61\x29\x2e\x63\x73\x73\x28\x22\x6f\x70\x61\x63\x69\x74\x79\x22\x2c\x30\x29\x2e\x73\x68\x6f\x77\x28\x29\x2e\x65\x6e\x64\x28\x29\x2e\x61\x6e\x69\x6d\x61\x74\x65\x28\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x62\x7d\x2c\x61\x2c\x63\x2c\x64\x29\x7d\x2c\x61\x6e\x69\x6d\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x2c\x66\x3d\x72\x2e\x73\x70\x65\x65\x64\x28\x62\x2c\x63\x2c\x64\x29\x2c\x67\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x3d\x68\x62\x28\x74\x68\x69\x73\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x61\x29\x2c\x66\x29\x3b\x28\x65\x7c\x7c\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x66\x69\x6e\x69\x73\x68\x22\x29\x29\x26\x26\x62\x2e\x73\x74\x6f\x70\x28\x21\x30\x29\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x66\x69\x6e\x69\x73\x68\x3d\x67\x2c\x65\x7c\x7c\x66\x2e\x71\x75\x65\x75\x65\x3d\x3d\x3d\x21\x31\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x67\x29\x3a\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x66\x2e\x71\x75\x65\x75\x65\x2c\x67\x29\x7d\x2c\x73\x74\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x73\x74\x6f\x70\x3b\x64\x65\x6c\x65\x74\x65\x20\x61\x2e\x73\x74\x6f\x70\x2c\x62\x28\x63\x29\x7d\x3b\x72\x65\x74\x75\x72\x6e\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x61\x2c\x61\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x62\x26\x26\x61\x21\x3d\x3d\x21\x31\x26\x26\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x61\x7c\x7c\x22\x66\x78\x22\x2c\x5b\x5d\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x3d\x21\x30\x2c\x65\x3d\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x61\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x2c\x66\x3d\x72\x2e\x74\x69\x6d\x65\x72\x73\x2c\x67\x3d\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x3b\x69\x66\x28\x65\x29\x67\x5b\x65\x5d\x26\x26\x67\x5b\x65\x5d\x2e\x73\x74\x6f\x70\x26\x26\x64\x28\x67\x5b\x65\x5d\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x67\x29\x67\x5b\x65\x5d\x26\x26\x67\x5b\x65\x5d\x2e\x73\x74\x6f\x70\x26\x26\x61\x62\x2e\x74\x65\x73\x74\x28\x65\x29\x26\x26\x64\x28\x67\x5b\x65\x5d\x29\x3b\x66\x6f\x72\x28\x65\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x65\x2d\x2d\x3b\x29\x66\x5b\x65\x5d\x2e\x65\x6c\x65\x6d\x21\x3d\x3d\x74\x68\x69\x73\x7c\x7c\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x66\x5b\x65\x5d\x2e\x71\x75\x65\x75\x65\x21\x3d\x3d\x61\x7c\x7c\x28\x66\x5b\x65\x5d\x2e\x61\x6e\x69\x6d\x2e\x73\x74\x6f\x70\x28\x63\x29\x2c\x62\x3d\x21\x31\x2c\x66\x2e\x73\x70\x6c\x69\x63\x65\x28\x65\x2c\x31\x29\x29\x3b\x21\x62\x26\x26\x63\x7c\x7c\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x2c\x66\x69\x6e\x69\x73\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x21\x3d\x3d\x21\x31\x26\x26\x28\x61\x3d\x61\x7c\x7c\x22\x66\x78\x22\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x2c\x64\x3d\x63\x5b\x61\x2b\x22\x71\x75\x65\x75\x65\x22\x5d\x2c\x65\x3d\x63\x5b\x61\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x5d\x2c\x66\x3d\x72\x2e\x74\x69\x6d\x65\x72\x73\x2c\x67\x3d\x64\x3f\x64\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x30\x3b\x66\x6f\x72\x28\x63\x2e\x66\x69\x6e\x69\x73\x68\x3d\x21\x30\x2c\x72\x2e\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x5b\x5d\x29\x2c\x65\x26\x26\x65\x2e\x73\x74\x6f\x70\x26\x26\x65\x2e\x73\x74\x6f\x70\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x21\x30\x29\x2c\x62\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x62\x2d\x2d\x3b\x29\x66\x5b\x62\x5d\x2e\x65\x6c\x65\x6d\x3d\x3d\x3d\x74\x68\x69\x73\x26\x26\x66\x5b\x62\x5d\x2e\x71\x75\x65\x75\x65\x3d\x3d\x3d\x61\x26\x26\x28\x66\x5b\x62\x5d\x2e\x61\x6e\x69\x6d\x2e\x73\x74\x6f\x70\x28\x21\x30\x29\x2c\x66\x2e\x73\x70\x6c\x69\x63\x65\x28\x62\x2c\x31\x29\x29\x3b\x66\x6f\x72\x28\x62\x3d\x30\x3b\x62\x3c\x67\x3b\x62\x2b\x2b\x29\x64\x5b\x62\x5d\x26\x26\x64\x5b\x62\x5d\x2e\x66\x69\x6e\x69\x73\x68\x26\x26\x64\x5b\x62\x5d\x2e\x66\x69\x6e\x69\x73\x68\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x3b\x64\x65\x6c\x65\x74\x65\x20\x63\x2e\x66\x69\x6e\x69\x73\x68\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x74\x6f\x67\x67\x6c\x65\x22\x2c\x22\x73\x68\x6f\x77\x22\x2c\x22\x68\x69\x64\x65\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x2e\x66\x6e\x5b\x62\x5d\x3b\x72\x2e\x66\x6e\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x7c\x7c\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x63\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3a\x74\x68\x69\x73\x2e\x61\x6e\x69\x6d\x61\x74\x65\x28\x64\x62\x28\x62\x2c\x21\x30\x29\x2c\x61\x2c\x64\x2c\x65\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x73\x6c\x69\x64\x65\x44\x6f\x77\x6e\x3a\x64\x62\x28\x22\x73\x68\x6f\x77\x22\x29\x2c\x73\x6c\x69\x64\x65\x55\x70\x3a\x64\x62\x28\x22\x68\x69\x64\x65\x22\x29\x2c\x73\x6c\x69\x64\x65\x54\x6f\x67\x67\x6c\x65\x3a\x64\x62\x28\x22\x74\x6f\x67\x67\x6c\x65\x22\x29\x2c\x66\x61\x64\x65\x49\x6e\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x22\x73\x68\x6f\x77\x22\x7d\x2c\x66\x61\x64\x65\x4f\x75\x74\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x22\x68\x69\x64\x65\x22\x7d\x2c\x66\x61\x64\x65\x54\x6f\x67\x67\x6c\x65\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x22\x74\x6f\x67\x67\x6c\x65\x22\x7d\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x61\x6e\x69\x6d\x61\x74\x65\x28\x62\x2c\x61\x2c\x63\x2c\x64\x29\x7d\x7d\x29\x2c\x72\x2e\x74\x69\x6d\x65\x72\x73\x3d\x5b\x5d\x2c\x72\x2e\x66\x78\x2e\x74\x69\x63\x6b\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x2c\x62\x3d\x30\x2c\x63\x3d\x72\x2e\x74\x69\x6d\x65\x72\x73\x3b\x66\x6f\x72\x28\x5a\x61\x3d\x72\x2e\x6e\x6f\x77\x28\x29\x3b\x62\x3c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x62\x2b\x2b\x29\x61\x3d\x63\x5b\x62\x5d\x2c\x61\x28\x29\x7c\x7c\x63\x5b\x62\x5d\x21\x3d\x3d\x61\x7c\x7c\x63\x2e\x73\x70\x6c\x69\x63\x65\x28\x62\x2d\x2d\x2c\x31\x29\x3b\x63\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x72\x2e\x66\x78\x2e\x73\x74\x6f\x70\x28\x29\x2c\x5a\x61\x3d\x76\x6f\x69\x64\x20\x30\x7d\x2c\x72\x2e\x66\x78\x2e\x74\x69\x6d\x65\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x74\x69\x6d\x65\x72\x73\x2e\x70\x75\x73\x68\x28\x61\x29\x2c\x61\x28\x29\x3f\x72\x2e\x66\x78\x2e\x73\x74\x61\x72\x74\x28\x29\x3a\x72\x2e\x74\x69\x6d\x65\x72\x73\x2e\x70\x6f\x70\x28\x29\x7d\x2c\x72\x2e\x66\x78\x2e\x69\x6e\x74\x65\x72\x76\x61\x6c\x3d\x31\x33\x2c\x72\x2e\x66\x78\x2e\x73\x74\x61\x72\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x24\x61\x7c\x7c\x28\x24\x61\x3d\x61\x2e\x72\x65\x71\x75\x65\x73\x74\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x3f\x61\x2e\x72\x65\x71\x75\x65\x73\x74\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x28\x62\x62\x29\x3a\x61\x2e\x73\x65\x74\x49\x6e\x74\x65\x72\x76\x61\x6c\x28\x72\x2e\x66\x78\x2e\x74\x69\x63\x6b\x2c\x72\x2e\x66\x78\x2e\x69\x6e\x74\x65\x72\x76\x61\x6c\x29\x29\x7d\x2c\x72\x2e\x66\x78\x2e\x73\x74\x6f\x70\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x61\x2e\x63\x61\x6e\x63\x65\x6c\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x3f\x61\x2e\x63\x61\x6e\x63\x65\x6c\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x28\x24\x61\x29\x3a\x61\x2e\x63\x6c\x65\x61\x72\x49\x6e\x74\x65\x72\x76\x61\x6c\x28\x24\x61\x29\x2c\x24\x61\x3d\x6e\x75\x6c\x6c\x7d\x2c\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x3d\x7b\x73\x6c\x6f\x77\x3a\x36\x30\x30\x2c\x66\x61\x73\x74\x3a\x32\x30\x30\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x34\x30\x30\x7d\x2c\x72\x2e\x66\x6e\x2e\x64\x65\x6c\x61\x79\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x66\x78\x3f\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x5b\x62\x5d\x7c\x7c\x62\x3a\x62\x2c\x63\x3d\x63\x7c\x7c\x22\x66\x78\x22\x2c\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x2c\x62\x29\x3b\x64\x2e\x73\x74\x6f\x70\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x61\x2e\x63\x6c\x65\x61\x72\x54\x69\x6d\x65\x6f\x75\x74\x28\x65\x29\x7d\x7d\x29\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x2c\x62\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x73\x65\x6c\x65\x63\x74\x22\x29\x2c\x63\x3d\x62\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x6f\x70\x74\x69\x6f\x6e\x22\x29\x29\x3b\x61\x2e\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x2c\x6f\x2e\x63\x68\x65\x63\x6b\x4f\x6e\x3d\x22\x22\x21\x3d\x3d\x61\x2e\x76\x61\x6c\x75\x65\x2c\x6f\x2e\x6f\x70\x74\x53\x65\x6c\x65\x63\x74\x65\x64\x3d\x63\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x2c\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x2c\x61\x2e\x76\x61\x6c\x75\x65\x3d\x22\x74\x22\x2c\x61\x2e\x74\x79\x70\x65\x3d\x22\x72\x61\x64\x69\x6f\x22\x2c\x6f\x2e\x72\x61\x64\x69\x6f\x56\x61\x6c\x75\x65\x3d\x22\x74\x22\x3d\x3d\x3d\x61\x2e\x76\x61\x6c\x75\x65\x7d\x28\x29\x3b\x76\x61\x72\x20\x69\x62\x2c\x6a\x62\x3d\x72\x2e\x65\x78\x70\x72\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x72\x2e\x61\x74\x74\x72\x2c\x61\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3b\x69\x66\x28\x33\x21\x3d\x3d\x66\x26\x26\x38\x21\x3d\x3d\x66\x26\x26\x32\x21\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x3f\x72\x2e\x70\x72\x6f\x70\x28\x61\x2c\x62\x2c\x63\x29\x3a\x28\x31\x3d\x3d\x3d\x66\x26\x26\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x28\x61\x29\x7c\x7c\x28\x65\x3d\x72\x2e\x61\x74\x74\x72\x48\x6f\x6f\x6b\x73\x5b\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7c\x7c\x28\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x62\x6f\x6f\x6c\x2e\x74\x65\x73\x74\x28\x62\x29\x3f\x69\x62\x3a\x76\x6f\x69\x64\x20\x30\x29\x29\x2c\x0a\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x6e\x75\x6c\x6c\x3d\x3d\x3d\x63\x3f\x76\x6f\x69\x64\x20\x72\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x28\x61\x2c\x62\x29\x3a\x65\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x73\x65\x74\x28\x61\x2c\x63\x2c\x62\x29\x29\x3f\x64\x3a\x28\x61\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x62\x2c\x63\x2b\x22\x22\x29\x2c\x63\x29\x3a\x65\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x29\x3f\x64\x3a\x28\x64\x3d\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x28\x61\x2c\x62\x29\x2c\x6e\x75\x6c\x6c\x3d\x3d\x64\x3f\x76\x6f\x69\x64\x20\x30\x3a\x64\x29\x29\x7d\x2c\x61\x74\x74\x72\x48\x6f\x6f\x6b\x73\x3a\x7b\x74\x79\x70\x65\x3a\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x21\x6f\x2e\x72\x61\x64\x69\x6f\x56\x61\x6c\x75\x65\x26\x26\x22\x72\x61\x64\x69\x6f\x22\x3d\x3d\x3d\x62\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2c\x22\x69\x6e\x70\x75\x74\x22\x29\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x76\x61\x6c\x75\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x2c\x62\x29\x2c\x63\x26\x26\x28\x61\x2e\x76\x61\x6c\x75\x65\x3d\x63\x29\x2c\x62\x7d\x7d\x7d\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x62\x26\x26\x62\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x3b\x69\x66\x28\x65\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x77\x68\x69\x6c\x65\x28\x63\x3d\x65\x5b\x64\x2b\x2b\x5d\x29\x61\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x63\x29\x7d\x7d\x29\x2c\x69\x62\x3d\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x3d\x3d\x21\x31\x3f\x72\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x28\x61\x2c\x63\x29\x3a\x61\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x63\x2c\x63\x29\x2c\x63\x7d\x7d\x2c\x72\x2e\x65\x61\x63\x68\x28\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x62\x6f\x6f\x6c\x2e\x73\x6f\x75\x72\x63\x65\x2e\x6d\x61\x74\x63\x68\x28\x2f\x5c\x77\x2b\x2f\x67\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x6a\x62\x5b\x62\x5d\x7c\x7c\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x3b\x6a\x62\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x7c\x7c\x28\x66\x3d\x6a\x62\x5b\x67\x5d\x2c\x6a\x62\x5b\x67\x5d\x3d\x65\x2c\x65\x3d\x6e\x75\x6c\x6c\x21\x3d\x63\x28\x61\x2c\x62\x2c\x64\x29\x3f\x67\x3a\x6e\x75\x6c\x6c\x2c\x6a\x62\x5b\x67\x5d\x3d\x66\x29\x2c\x65\x7d\x7d\x29\x3b\x76\x61\x72\x20\x6b\x62\x3d\x2f\x5e\x28\x3f\x3a\x69\x6e\x70\x75\x74\x7c\x73\x65\x6c\x65\x63\x74\x7c\x74\x65\x78\x74\x61\x72\x65\x61\x7c\x62\x75\x74\x74\x6f\x6e\x29\x24\x2f\x69\x2c\x6c\x62\x3d\x2f\x5e\x28\x3f\x3a\x61\x7c\x61\x72\x65\x61\x29\x24\x2f\x69\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x70\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x72\x2e\x70\x72\x6f\x70\x2c\x61\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x50\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x64\x65\x6c\x65\x74\x65\x20\x74\x68\x69\x73\x5b\x72\x2e\x70\x72\x6f\x70\x46\x69\x78\x5b\x61\x5d\x7c\x7c\x61\x5d\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x70\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3b\x69\x66\x28\x33\x21\x3d\x3d\x66\x26\x26\x38\x21\x3d\x3d\x66\x26\x26\x32\x21\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x66\x26\x26\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x28\x61\x29\x7c\x7c\x28\x62\x3d\x72\x2e\x70\x72\x6f\x70\x46\x69\x78\x5b\x62\x5d\x7c\x7c\x62\x2c\x65\x3d\x72\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x65\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x73\x65\x74\x28\x61\x2c\x63\x2c\x62\x29\x29\x3f\x64\x3a\x61\x5b\x62\x5d\x3d\x63\x3a\x65\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x29\x3f\x64\x3a\x61\x5b\x62\x5d\x7d\x2c\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x3a\x7b\x74\x61\x62\x49\x6e\x64\x65\x78\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x28\x61\x2c\x22\x74\x61\x62\x69\x6e\x64\x65\x78\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x70\x61\x72\x73\x65\x49\x6e\x74\x28\x62\x2c\x31\x30\x29\x3a\x6b\x62\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x7c\x7c\x6c\x62\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x26\x26\x61\x2e\x68\x72\x65\x66\x3f\x30\x3a\x2d\x31\x7d\x7d\x7d\x2c\x70\x72\x6f\x70\x46\x69\x78\x3a\x7b\x22\x66\x6f\x72\x22\x3a\x22\x68\x74\x6d\x6c\x46\x6f\x72\x22\x2c\x22\x63\x6c\x61\x73\x73\x22\x3a\x22\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x7d\x7d\x29\x2c\x6f\x2e\x6f\x70\x74\x53\x65\x6c\x65\x63\x74\x65\x64\x7c\x7c\x28\x72\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x6e\x75\x6c\x6c\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x62\x26\x26\x28\x62\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x74\x61\x62\x49\x6e\x64\x65\x78\x22\x2c\x22\x72\x65\x61\x64\x4f\x6e\x6c\x79\x22\x2c\x22\x6d\x61\x78\x4c\x65\x6e\x67\x74\x68\x22\x2c\x22\x63\x65\x6c\x6c\x53\x70\x61\x63\x69\x6e\x67\x22\x2c\x22\x63\x65\x6c\x6c\x50\x61\x64\x64\x69\x6e\x67\x22\x2c\x22\x72\x6f\x77\x53\x70\x61\x6e\x22\x2c\x22\x63\x6f\x6c\x53\x70\x61\x6e\x22\x2c\x22\x75\x73\x65\x4d\x61\x70\x22\x2c\x22\x66\x72\x61\x6d\x65\x42\x6f\x72\x64\x65\x72\x22\x2c\x22\x63\x6f\x6e\x74\x65\x6e\x74\x45\x64\x69\x74\x61\x62\x6c\x65\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x70\x72\x6f\x70\x46\x69\x78\x5b\x74\x68\x69\x73\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x74\x68\x69\x73\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6d\x62\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x6a\x6f\x69\x6e\x28\x22\x20\x22\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x62\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x26\x26\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x29\x7c\x7c\x22\x22\x7d\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x64\x64\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x3d\x30\x3b\x69\x66\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x2c\x6e\x62\x28\x74\x68\x69\x73\x29\x29\x29\x7d\x29\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x61\x29\x7b\x62\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x74\x68\x69\x73\x5b\x69\x2b\x2b\x5d\x29\x69\x66\x28\x65\x3d\x6e\x62\x28\x63\x29\x2c\x64\x3d\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x22\x20\x22\x2b\x6d\x62\x28\x65\x29\x2b\x22\x20\x22\x29\x7b\x67\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x62\x5b\x67\x2b\x2b\x5d\x29\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x20\x22\x2b\x66\x2b\x22\x20\x22\x29\x3c\x30\x26\x26\x28\x64\x2b\x3d\x66\x2b\x22\x20\x22\x29\x3b\x68\x3d\x6d\x62\x28\x64\x29\x2c\x65\x21\x3d\x3d\x68\x26\x26\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x68\x29\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x3d\x30\x3b\x69\x66\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x2c\x6e\x62\x28\x74\x68\x69\x73\x29\x29\x29\x7d\x29\x3b\x69\x66\x28\x21\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x61\x74\x74\x72\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x22\x22\x29\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x61\x29\x7b\x62\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x74\x68\x69\x73\x5b\x69\x2b\x2b\x5d\x29\x69\x66\x28\x65\x3d\x6e\x62\x28\x63\x29\x2c\x64\x3d\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x22\x20\x22\x2b\x6d\x62\x28\x65\x29\x2b\x22\x20\x22\x29\x7b\x67\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x62\x5b\x67\x2b\x2b\x5d\x29\x77\x68\x69\x6c\x65\x28\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x20\x22\x2b\x66\x2b\x22\x20\x22\x29\x3e\x2d\x31\x29\x64\x3d\x64\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x22\x20\x22\x2b\x66\x2b\x22\x20\x22\x2c\x22\x20\x22\x29\x3b\x68\x3d\x6d\x62\x28\x64\x29\x2c\x65\x21\x3d\x3d\x68\x26\x26\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x68\x29\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x74\x6f\x67\x67\x6c\x65\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3b\x72\x65\x74\x75\x72\x6e\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x63\x3f\x62\x3f\x74\x68\x69\x73\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x61\x29\x3a\x74\x68\x69\x73\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x61\x29\x3a\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x74\x6f\x67\x67\x6c\x65\x43\x6c\x61\x73\x73\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x63\x2c\x6e\x62\x28\x74\x68\x69\x73\x29\x2c\x62\x29\x2c\x62\x29\x7d\x29\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x2c\x64\x2c\x65\x2c\x66\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x63\x29\x7b\x64\x3d\x30\x2c\x65\x3d\x72\x28\x74\x68\x69\x73\x29\x2c\x66\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x77\x68\x69\x6c\x65\x28\x62\x3d\x66\x5b\x64\x2b\x2b\x5d\x29\x65\x2e\x68\x61\x73\x43\x6c\x61\x73\x73\x28\x62\x29\x3f\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x62\x29\x3a\x65\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x62\x29\x7d\x65\x6c\x73\x65\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x61\x26\x26\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x21\x3d\x3d\x63\x7c\x7c\x28\x62\x3d\x6e\x62\x28\x74\x68\x69\x73\x29\x2c\x62\x26\x26\x56\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x5f\x5f\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x5f\x5f\x22\x2c\x62\x29\x2c\x74\x68\x69\x73\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x26\x26\x74\x68\x69\x73\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x62\x7c\x7c\x61\x3d\x3d\x3d\x21\x31\x3f\x22\x22\x3a\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x5f\x5f\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x5f\x5f\x22\x29\x7c\x7c\x22\x22\x29\x29\x7d\x29\x7d\x2c\x68\x61\x73\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x3d\x30\x3b\x62\x3d\x22\x20\x22\x2b\x61\x2b\x22\x20\x22\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x74\x68\x69\x73\x5b\x64\x2b\x2b\x5d\x29\x69\x66\x28\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x22\x20\x22\x2b\x6d\x62\x28\x6e\x62\x28\x63\x29\x29\x2b\x22\x20\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x62\x29\x3e\x2d\x31\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x7d\x29\x3b\x76\x61\x72\x20\x6f\x62\x3d\x2f\x5c\x72\x2f\x67\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x76\x61\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x3d\x74\x68\x69\x73\x5b\x30\x5d\x3b\x7b\x69\x66\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x76\x61\x72\x20\x65\x3b\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x65\x3d\x64\x3f\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x63\x2c\x72\x28\x74\x68\x69\x73\x29\x2e\x76\x61\x6c\x28\x29\x29\x3a\x61\x2c\x6e\x75\x6c\x6c\x3d\x3d\x65\x3f\x65\x3d\x22\x22\x3a\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x3f\x65\x2b\x3d\x22\x22\x3a\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x65\x29\x26\x26\x28\x65\x3d\x72\x2e\x6d\x61\x70\x28\x65\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x22\x22\x3a\x61\x2b\x22\x22\x7d\x29\x29\x2c\x62\x3d\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x2c\x62\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x62\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x65\x2c\x22\x76\x61\x6c\x75\x65\x22\x29\x7c\x7c\x28\x74\x68\x69\x73\x2e\x76\x61\x6c\x75\x65\x3d\x65\x29\x29\x7d\x29\x3b\x69\x66\x28\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x65\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x65\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x2c\x62\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x62\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x63\x3d\x62\x2e\x67\x65\x74\x28\x65\x2c\x22\x76\x61\x6c\x75\x65\x22\x29\x29\x3f\x63\x3a\x28\x63\x3d\x65\x2e\x76\x61\x6c\x75\x65\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x3f\x63\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x6f\x62\x2c\x22\x22\x29\x3a\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x22\x22\x3a\x63\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x3a\x7b\x6f\x70\x74\x69\x6f\x6e\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x28\x61\x2c\x22\x76\x61\x6c\x75\x65\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x62\x3f\x62\x3a\x6d\x62\x28\x72\x2e\x74\x65\x78\x74\x28\x61\x29\x29\x7d\x7d\x2c\x73\x65\x6c\x65\x63\x74\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x3d\x61\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2c\x66\x3d\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x67\x3d\x22\x73\x65\x6c\x65\x63\x74\x2d\x6f\x6e\x65\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x2c\x68\x3d\x67\x3f\x6e\x75\x6c\x6c\x3a\x5b\x5d\x2c\x69\x3d\x67\x3f\x66\x2b\x31\x3a\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x6f\x72\x28\x64\x3d\x66\x3c\x30\x3f\x69\x3a\x67\x3f\x66\x3a\x30\x3b\x64\x3c\x69\x3b\x64\x2b\x2b\x29\x69\x66\x28\x63\x3d\x65\x5b\x64\x5d\x2c\x28\x63\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x7c\x7c\x64\x3d\x3d\x3d\x66\x29\x26\x26\x21\x63\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x26\x26\x28\x21\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x7c\x7c\x21\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x22\x6f\x70\x74\x67\x72\x6f\x75\x70\x22\x29\x29\x29\x7b\x69\x66\x28\x62\x3d\x72\x28\x63\x29\x2e\x76\x61\x6c\x28\x29\x2c\x67\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3b\x68\x2e\x70\x75\x73\x68\x28\x62\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x68\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3d\x61\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2c\x66\x3d\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x62\x29\x2c\x67\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x64\x3d\x65\x5b\x67\x5d\x2c\x28\x64\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x2e\x67\x65\x74\x28\x64\x29\x2c\x66\x29\x3e\x2d\x31\x29\x26\x26\x28\x63\x3d\x21\x30\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7c\x7c\x28\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x3d\x2d\x31\x29\x2c\x66\x7d\x7d\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x72\x61\x64\x69\x6f\x22\x2c\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x5d\x3d\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x68\x65\x63\x6b\x65\x64\x3d\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x72\x28\x61\x29\x2e\x76\x61\x6c\x28\x29\x2c\x62\x29\x3e\x2d\x31\x7d\x7d\x2c\x6f\x2e\x63\x68\x65\x63\x6b\x4f\x6e\x7c\x7c\x28\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x5d\x2e\x67\x65\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x76\x61\x6c\x75\x65\x22\x29\x3f\x22\x6f\x6e\x22\x3a\x61\x2e\x76\x61\x6c\x75\x65\x7d\x29\x7d\x29\x3b\x76\x61\x72\x20\x70\x62\x3d\x2f\x5e\x28\x3f\x3a\x66\x6f\x63\x75\x73\x69\x6e\x66\x6f\x63\x75\x73\x7c\x66\x6f\x63\x75\x73\x6f\x75\x74\x62\x6c\x75\x72\x29\x24\x2f\x3b\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x72\x2e\x65\x76\x65\x6e\x74\x2c\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6d\x2c\x6e\x2c\x6f\x3d\x5b\x65\x7c\x7c\x64\x5d\x2c\x70\x3d\x6c\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x22\x74\x79\x70\x65\x22\x29\x3f\x62\x2e\x74\x79\x70\x65\x3a\x62\x2c\x71\x3d\x6c\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x22\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x22\x29\x3f\x62\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x3a\x5b\x5d\x3b\x69\x66\x28\x68\x3d\x69\x3d\x65\x3d\x65\x7c\x7c\x64\x2c\x33\x21\x3d\x3d\x65\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x38\x21\x3d\x3d\x65\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x21\x70\x62\x2e\x74\x65\x73\x74\x28\x70\x2b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x29\x26\x26\x28\x70\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x2e\x22\x29\x3e\x2d\x31\x26\x26\x28\x71\x3d\x70\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x2c\x70\x3d\x71\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x71\x2e\x73\x6f\x72\x74\x28\x29\x29\x2c\x6b\x3d\x70\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x3a\x22\x29\x3c\x30\x26\x26\x22\x6f\x6e\x22\x2b\x70\x2c\x62\x3d\x62\x5b\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3f\x62\x3a\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x28\x70\x2c\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x62\x29\x2c\x62\x2e\x69\x73\x54\x72\x69\x67\x67\x65\x72\x3d\x66\x3f\x32\x3a\x33\x2c\x62\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3d\x71\x2e\x6a\x6f\x69\x6e\x28\x22\x2e\x22\x29\x2c\x62\x2e\x72\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3d\x62\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3f\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x28\x5e\x7c\x5c\x5c\x2e\x29\x22\x2b\x71\x2e\x6a\x6f\x69\x6e\x28\x22\x5c\x5c\x2e\x28\x3f\x3a\x2e\x2a\x5c\x5c\x2e\x7c\x29\x22\x29\x2b\x22\x28\x5c\x5c\x2e\x7c\x24\x29\x22\x29\x3a\x6e\x75\x6c\x6c\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x76\x6f\x69\x64\x20\x30\x2c\x62\x2e\x74\x61\x72\x67\x65\x74\x7c\x7c\x28\x62\x2e\x74\x61\x72\x67\x65\x74\x3d\x65\x29\x2c\x63\x3d\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x5b\x62\x5d\x3a\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x63\x2c\x5b\x62\x5d\x29\x2c\x6e\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x70\x5d\x7c\x7c\x7b\x7d\x2c\x66\x7c\x7c\x21\x6e\x2e\x74\x72\x69\x67\x67\x65\x72\x7c\x7c\x6e\x2e\x74\x72\x69\x67\x67\x65\x72\x2e\x61\x70\x70\x6c\x79\x28\x65\x2c\x63\x29\x21\x3d\x3d\x21\x31\x29\x29\x7b\x69\x66\x28\x21\x66\x26\x26\x21\x6e\x2e\x6e\x6f\x42\x75\x62\x62\x6c\x65\x26\x26\x21\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x65\x29\x29\x7b\x66\x6f\x72\x28\x6a\x3d\x6e\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x7c\x7c\x70\x2c\x70\x62\x2e\x74\x65\x73\x74\x28\x6a\x2b\x70\x29\x7c\x7c\x28\x68\x3d\x68\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x3b\x68\x3b\x68\x3d\x68\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x6f\x2e\x70\x75\x73\x68\x28\x68\x29\x2c\x69\x3d\x68\x3b\x69\x3d\x3d\x3d\x28\x65\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x64\x29\x26\x26\x6f\x2e\x70\x75\x73\x68\x28\x69\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x7c\x7c\x69\x2e\x70\x61\x72\x65\x6e\x74\x57\x69\x6e\x64\x6f\x77\x7c\x7c\x61\x29\x7d\x67\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x28\x68\x3d\x6f\x5b\x67\x2b\x2b\x5d\x29\x26\x26\x21\x62\x2e\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x28\x29\x29\x62\x2e\x74\x79\x70\x65\x3d\x67\x3e\x31\x3f\x6a\x3a\x6e\x2e\x62\x69\x6e\x64\x54\x79\x70\x65\x7c\x7c\x70\x2c\x6d\x3d\x28\x56\x2e\x67\x65\x74\x28\x68\x2c\x22\x65\x76\x65\x6e\x74\x73\x22\x29\x7c\x7c\x7b\x7d\x29\x5b\x62\x2e\x74\x79\x70\x65\x5d\x26\x26\x56\x2e\x67\x65\x74\x28\x68\x2c\x22\x68\x61\x6e\x64\x6c\x65\x22\x29\x2c\x6d\x26\x26\x6d\x2e\x61\x70\x70\x6c\x79\x28\x68\x2c\x63\x29\x2c\x6d\x3d\x6b\x26\x26\x68\x5b\x6b\x5d\x2c\x6d\x26\x26\x6d\x2e\x61\x70\x70\x6c\x79\x26\x26\x54\x28\x68\x29\x26\x26\x28\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x6d\x2e\x61\x70\x70\x6c\x79\x28\x68\x2c\x63\x29\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x3d\x3d\x21\x31\x26\x26\x62\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x74\x79\x70\x65\x3d\x70\x2c\x66\x7c\x7c\x62\x2e\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x28\x29\x7c\x7c\x6e\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x26\x26\x6e\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2e\x61\x70\x70\x6c\x79\x28\x6f\x2e\x70\x6f\x70\x28\x29\x2c\x63\x29\x21\x3d\x3d\x21\x31\x7c\x7c\x21\x54\x28\x65\x29\x7c\x7c\x6b\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x5b\x70\x5d\x29\x26\x26\x21\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x65\x29\x26\x26\x28\x69\x3d\x65\x5b\x6b\x5d\x2c\x69\x26\x26\x28\x65\x5b\x6b\x5d\x3d\x6e\x75\x6c\x6c\x29\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x3d\x70\x2c\x65\x5b\x70\x5d\x28\x29\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x3d\x76\x6f\x69\x64\x20\x30\x2c\x69\x26\x26\x28\x65\x5b\x6b\x5d\x3d\x69\x29\x29\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x7d\x7d\x2c\x73\x69\x6d\x75\x6c\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x2c\x63\x2c\x7b\x74\x79\x70\x65\x3a\x61\x2c\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x3a\x21\x30\x7d\x29\x3b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x64\x2c\x6e\x75\x6c\x6c\x2c\x62\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x61\x2c\x62\x2c\x74\x68\x69\x73\x29\x7d\x29\x7d\x2c\x74\x72\x69\x67\x67\x65\x72\x48\x61\x6e\x64\x6c\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x68\x69\x73\x5b\x30\x5d\x3b\x69\x66\x28\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x61\x2c\x62\x2c\x63\x2c\x21\x30\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x22\x62\x6c\x75\x72\x20\x66\x6f\x63\x75\x73\x20\x66\x6f\x63\x75\x73\x69\x6e\x20\x66\x6f\x63\x75\x73\x6f\x75\x74\x20\x72\x65\x73\x69\x7a\x65\x20\x73\x63\x72\x6f\x6c\x6c\x20\x63\x6c\x69\x63\x6b\x20\x64\x62\x6c\x63\x6c\x69\x63\x6b\x20\x6d\x6f\x75\x73\x65\x64\x6f\x77\x6e\x20\x6d\x6f\x75\x73\x65\x75\x70\x20\x6d\x6f\x75\x73\x65\x6d\x6f\x76\x65\x20\x6d\x6f\x75\x73\x65\x6f\x76\x65\x72\x20\x6d\x6f\x75\x73\x65\x6f\x75\x74\x20\x6d\x6f\x75\x73\x65\x65\x6e\x74\x65\x72\x20\x6d\x6f\x75\x73\x65\x6c\x65\x61\x76\x65\x20\x63\x68\x61\x6e\x67\x65\x20\x73\x65\x6c\x65\x63\x74\x20\x73\x75\x62\x6d\x69\x74\x20\x6b\x65\x79\x64\x6f\x77\x6e\x20\x6b\x65\x79\x70\x72\x65\x73\x73\x20\x6b\x65\x79\x75\x70\x20\x63\x6f\x6e\x74\x65\x78\x74\x6d\x65\x6e\x75\x22\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x3f\x74\x68\x69\x73\x2e\x6f\x6e\x28\x62\x2c\x6e\x75\x6c\x6c\x2c\x61\x2c\x63\x29\x3a\x74\x68\x69\x73\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x62\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x6f\x76\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6d\x6f\x75\x73\x65\x65\x6e\x74\x65\x72\x28\x61\x29\x2e\x6d\x6f\x75\x73\x65\x6c\x65\x61\x76\x65\x28\x62\x7c\x7c\x61\x29\x7d\x7d\x29\x2c\x6f\x2e\x66\x6f\x63\x75\x73\x69\x6e\x3d\x22\x6f\x6e\x66\x6f\x63\x75\x73\x69\x6e\x22\x69\x6e\x20\x61\x2c\x6f\x2e\x66\x6f\x63\x75\x73\x69\x6e\x7c\x7c\x72\x2e\x65\x61\x63\x68\x28\x7b\x66\x6f\x63\x75\x73\x3a\x22\x66\x6f\x63\x75\x73\x69\x6e\x22\x2c\x62\x6c\x75\x72\x3a\x22\x66\x6f\x63\x75\x73\x6f\x75\x74\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x69\x6d\x75\x6c\x61\x74\x65\x28\x62\x2c\x61\x2e\x74\x61\x72\x67\x65\x74\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x66\x69\x78\x28\x61\x29\x29\x7d\x3b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x62\x5d\x3d\x7b\x73\x65\x74\x75\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x64\x3d\x74\x68\x69\x73\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x74\x68\x69\x73\x2c\x65\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x29\x3b\x65\x7c\x7c\x64\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x61\x2c\x63\x2c\x21\x30\x29\x2c\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x2c\x28\x65\x7c\x7c\x30\x29\x2b\x31\x29\x7d\x2c\x74\x65\x61\x72\x64\x6f\x77\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x64\x3d\x74\x68\x69\x73\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x74\x68\x69\x73\x2c\x65\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x29\x2d\x31\x3b\x65\x3f\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x2c\x65\x29\x3a\x28\x64\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x61\x2c\x63\x2c\x21\x30\x29\x2c\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x64\x2c\x62\x29\x29\x7d\x7d\x7d\x29\x3b\x76\x61\x72\x20\x71\x62\x3d\x61\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2c\x72\x62\x3d\x72\x2e\x6e\x6f\x77\x28\x29\x2c\x73\x62\x3d\x2f\x5c\x3f\x2f\x3b\x72\x2e\x70\x61\x72\x73\x65\x58\x4d\x4c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x69\x66\x28\x21\x62\x7c\x7c\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3b\x74\x72\x79\x7b\x63\x3d\x28\x6e\x65\x77\x20\x61\x2e\x44\x4f\x4d\x50\x61\x72\x73\x65\x72\x29\x2e\x70\x61\x72\x73\x65\x46\x72\x6f\x6d\x53\x74\x72\x69\x6e\x67\x28\x62\x2c\x22\x74\x65\x78\x74\x2f\x78\x6d\x6c\x22\x29\x7d\x63\x61\x74\x63\x68\x28\x64\x29\x7b\x63\x3d\x76\x6f\x69\x64\x20\x30\x7d\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x21\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x22\x70\x61\x72\x73\x65\x72\x65\x72\x72\x6f\x72\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x72\x2e\x65\x72\x72\x6f\x72\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x58\x4d\x4c\x3a\x20\x22\x2b\x62\x29\x2c\x63\x7d\x3b\x76\x61\x72\x20\x74\x62\x3d\x2f\x5c\x5b\x5c\x5d\x24\x2f\x2c\x75\x62\x3d\x2f\x5c\x72\x3f\x5c\x6e\x2f\x67\x2c\x76\x62\x3d\x2f\x5e\x28\x3f\x3a\x73\x75\x62\x6d\x69\x74\x7c\x62\x75\x74\x74\x6f\x6e\x7c\x69\x6d\x61\x67\x65\x7c\x72\x65\x73\x65\x74\x7c\x66\x69\x6c\x65\x29\x24\x2f\x69\x2c\x77\x62\x3d\x2f\x5e\x28\x3f\x3a\x69\x6e\x70\x75\x74\x7c\x73\x65\x6c\x65\x63\x74\x7c\x74\x65\x78\x74\x61\x72\x65\x61\x7c\x6b\x65\x79\x67\x65\x6e\x29\x2f\x69\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x78\x62\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x29\x72\x2e\x65\x61\x63\x68\x28\x62\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x65\x29\x7b\x63\x7c\x7c\x74\x62\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x64\x28\x61\x2c\x65\x29\x3a\x78\x62\x28\x61\x2b\x22\x5b\x22\x2b\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x65\x3f\x62\x3a\x22\x22\x29\x2b\x22\x5d\x22\x2c\x65\x2c\x63\x2c\x64\x29\x7d\x29\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x63\x7c\x7c\x22\x6f\x62\x6a\x65\x63\x74\x22\x21\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x62\x29\x29\x64\x28\x61\x2c\x62\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x62\x29\x78\x62\x28\x61\x2b\x22\x5b\x22\x2b\x65\x2b\x22\x5d\x22\x2c\x62\x5b\x65\x5d\x2c\x63\x2c\x64\x29\x7d\x72\x2e\x70\x61\x72\x61\x6d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x62\x28\x29\x3a\x62\x3b\x64\x5b\x64\x2e\x6c\x65\x6e\x67\x74\x68\x5d\x3d\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x43\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x28\x61\x29\x2b\x22\x3d\x22\x2b\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x43\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x28\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x22\x22\x3a\x63\x29\x7d\x3b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x61\x29\x7c\x7c\x61\x2e\x6a\x71\x75\x65\x72\x79\x26\x26\x21\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x29\x72\x2e\x65\x61\x63\x68\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x65\x28\x74\x68\x69\x73\x2e\x6e\x61\x6d\x65\x2c\x74\x68\x69\x73\x2e\x76\x61\x6c\x75\x65\x29\x7d\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x61\x29\x78\x62\x28\x63\x2c\x61\x5b\x63\x5d\x2c\x62\x2c\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x6a\x6f\x69\x6e\x28\x22\x26\x22\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x73\x65\x72\x69\x61\x6c\x69\x7a\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x70\x61\x72\x61\x6d\x28\x74\x68\x69\x73\x2e\x73\x65\x72\x69\x61\x6c\x69\x7a\x65\x41\x72\x72\x61\x79\x28\x29\x29\x7d\x2c\x73\x65\x72\x69\x61\x6c\x69\x7a\x65\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x72\x2e\x70\x72\x6f\x70\x28\x74\x68\x69\x73\x2c\x22\x65\x6c\x65\x6d\x65\x6e\x74\x73\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x61\x29\x3a\x74\x68\x69\x73\x7d\x29\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6e\x61\x6d\x65\x26\x26\x21\x72\x28\x74\x68\x69\x73\x29\x2e\x69\x73\x28\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x26\x26\x77\x62\x2e\x74\x65\x73\x74\x28\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x26\x26\x21\x76\x62\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x28\x74\x68\x69\x73\x2e\x63\x68\x65\x63\x6b\x65\x64\x7c\x7c\x21\x69\x61\x2e\x74\x65\x73\x74\x28\x61\x29\x29\x7d\x29\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x28\x74\x68\x69\x73\x29\x2e\x76\x61\x6c\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x6e\x75\x6c\x6c\x3a\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x63\x29\x3f\x72\x2e\x6d\x61\x70\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x7b\x6e\x61\x6d\x65\x3a\x62\x2e\x6e\x61\x6d\x65\x2c\x76\x61\x6c\x75\x65\x3a\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x75\x62\x2c\x22\x5c\x72\x5c\x6e\x22\x29\x7d\x7d\x29\x3a\x7b\x6e\x61\x6d\x65\x3a\x62\x2e\x6e\x61\x6d\x65\x2c\x76\x61\x6c\x75\x65\x3a\x63\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x75\x62\x2c\x22\x5c\x72\x5c\x6e\x22\x29\x7d\x7d\x29\x2e\x67\x65\x74\x28\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x79\x62\x3d\x2f\x25\x32\x30\x2f\x67\x2c\x7a\x62\x3d\x2f\x23\x2e\x2a\x24\x2f\x2c\x41\x62\x3d\x2f\x28\x5b\x3f\x26\x5d\x29\x5f\x3d\x5b\x5e\x26\x5d\x2a\x2f\x2c\x42\x62\x3d\x2f\x5e\x28\x2e\x2a\x3f\x29\x3a\x5b\x20\x5c\x74\x5d\x2a\x28\x5b\x5e\x5c\x72\x5c\x6e\x5d\x2a\x29\x24\x2f\x67\x6d\x2c\x43\x62\x3d\x2f\x5e\x28\x3f\x3a\x61\x62\x6f\x75\x74\x7c\x61\x70\x70\x7c\x61\x70\x70\x2d\x73\x74\x6f\x72\x61\x67\x65\x7c\x2e\x2b\x2d\x65\x78\x74\x65\x6e\x73\x69\x6f\x6e\x7c\x66\x69\x6c\x65\x7c\x72\x65\x73\x7c\x77\x69\x64\x67\x65\x74\x29\x3a\x24\x2f\x2c\x44\x62\x3d\x2f\x5e\x28\x3f\x3a\x47\x45\x54\x7c\x48\x45\x41\x44\x29\x24\x2f\x2c\x45\x62\x3d\x2f\x5e\x5c\x2f\x5c\x2f\x2f\x2c\x46\x62\x3d\x7b\x7d\x2c\x47\x62\x3d\x7b\x7d\x2c\x48\x62\x3d\x22\x2a\x2f\x22\x2e\x63\x6f\x6e\x63\x61\x74\x28\x22\x2a\x22\x29\x2c\x49\x62\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x61\x22\x29\x3b\x49\x62\x2e\x68\x72\x65\x66\x3d\x71\x62\x2e\x68\x72\x65\x66\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4a\x62\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x22\x2a\x22\x29\x3b\x76\x61\x72\x20\x64\x2c\x65\x3d\x30\x2c\x66\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x69\x66\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x29\x77\x68\x69\x6c\x65\x28\x64\x3d\x66\x5b\x65\x2b\x2b\x5d\x29\x22\x2b\x22\x3d\x3d\x3d\x64\x5b\x30\x5d\x3f\x28\x64\x3d\x64\x2e\x73\x6c\x69\x63\x65\x28\x31\x29\x7c\x7c\x22\x2a\x22\x2c\x28\x61\x5b\x64\x5d\x3d\x61\x5b\x64\x5d\x7c\x7c\x5b\x5d\x29\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x63\x29\x29\x3a\x28\x61\x5b\x64\x5d\x3d\x61\x5b\x64\x5d\x7c\x7c\x5b\x5d\x29\x2e\x70\x75\x73\x68\x28\x63\x29\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4b\x62\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x7b\x7d\x2c\x66\x3d\x61\x3d\x3d\x3d\x47\x62\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x28\x68\x29\x7b\x76\x61\x72\x20\x69\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x5b\x68\x5d\x3d\x21\x30\x2c\x72\x2e\x65\x61\x63\x68\x28\x61\x5b\x68\x5d\x7c\x7c\x5b\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x68\x29\x7b\x76\x61\x72\x20\x6a\x3d\x68\x28\x62\x2c\x63\x2c\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x6a\x7c\x7c\x66\x7c\x7c\x65\x5b\x6a\x5d\x3f\x66\x3f\x21\x28\x69\x3d\x6a\x29\x3a\x76\x6f\x69\x64\x20\x30\x3a\x28\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x6a\x29\x2c\x67\x28\x6a\x29\x2c\x21\x31\x29\x7d\x29\x2c\x69\x7d\x72\x65\x74\x75\x72\x6e\x20\x67\x28\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x29\x7c\x7c\x21\x65\x5b\x22\x2a\x22\x5d\x26\x26\x67\x28\x22\x2a\x22\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4c\x62\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3d\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2e\x66\x6c\x61\x74\x4f\x70\x74\x69\x6f\x6e\x73\x7c\x7c\x7b\x7d\x3b\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x62\x29\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x5b\x63\x5d\x26\x26\x28\x28\x65\x5b\x63\x5d\x3f\x61\x3a\x64\x7c\x7c\x28\x64\x3d\x7b\x7d\x29\x29\x5b\x63\x5d\x3d\x62\x5b\x63\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x26\x26\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x21\x30\x2c\x61\x2c\x64\x29\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4d\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x61\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x2c\x69\x3d\x61\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x3b\x77\x68\x69\x6c\x65\x28\x22\x2a\x22\x3d\x3d\x3d\x69\x5b\x30\x5d\x29\x69\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x64\x26\x26\x28\x64\x3d\x61\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x7c\x7c\x62\x2e\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x22\x29\x29\x3b\x69\x66\x28\x64\x29\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x68\x29\x69\x66\x28\x68\x5b\x65\x5d\x26\x26\x68\x5b\x65\x5d\x2e\x74\x65\x73\x74\x28\x64\x29\x29\x7b\x69\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x65\x29\x3b\x62\x72\x65\x61\x6b\x7d\x69\x66\x28\x69\x5b\x30\x5d\x69\x6e\x20\x63\x29\x66\x3d\x69\x5b\x30\x5d\x3b\x65\x6c\x73\x65\x7b\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x63\x29\x7b\x69\x66\x28\x21\x69\x5b\x30\x5d\x7c\x7c\x61\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x5b\x65\x2b\x22\x20\x22\x2b\x69\x5b\x30\x5d\x5d\x29\x7b\x66\x3d\x65\x3b\x62\x72\x65\x61\x6b\x7d\x67\x7c\x7c\x28\x67\x3d\x65\x29\x7d\x66\x3d\x66\x7c\x7c\x67\x7d\x69\x66\x28\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x66\x21\x3d\x3d\x69\x5b\x30\x5d\x26\x26\x69\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x66\x29\x2c\x63\x5b\x66\x5d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4e\x62\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x3d\x7b\x7d\x2c\x6b\x3d\x61\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x2e\x73\x6c\x69\x63\x65\x28\x29\x3b\x69\x66\x28\x6b\x5b\x31\x5d\x29\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x61\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x29\x6a\x5b\x67\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x61\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x5b\x67\x5d\x3b\x66\x3d\x6b\x2e\x73\x68\x69\x66\x74\x28\x29\x3b\x77\x68\x69\x6c\x65\x28\x66\x29\x69\x66\x28\x61\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x46\x69\x65\x6c\x64\x73\x5b\x66\x5d\x26\x26\x28\x63\x5b\x61\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x46\x69\x65\x6c\x64\x73\x5b\x66\x5d\x5d\x3d\x62\x29\x2c\x21\x69\x26\x26\x64\x26\x26\x61\x2e\x64\x61\x74\x61\x46\x69\x6c\x74\x65\x72\x26\x26\x28\x62\x3d\x61\x2e\x64\x61\x74\x61\x46\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x29\x29\x2c\x69\x3d\x66\x2c\x66\x3d\x6b\x2e\x73\x68\x69\x66\x74\x28\x29\x29\x69\x66\x28\x22\x2a\x22\x3d\x3d\x3d\x66\x29\x66\x3d\x69\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x22\x2a\x22\x21\x3d\x3d\x69\x26\x26\x69\x21\x3d\x3d\x66\x29\x7b\x69\x66\x28\x67\x3d\x6a\x5b\x69\x2b\x22\x20\x22\x2b\x66\x5d\x7c\x7c\x6a\x5b\x22\x2a\x20\x22\x2b\x66\x5d\x2c\x21\x67\x29\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x6a\x29\x69\x66\x28\x68\x3d\x65\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x2c\x68\x5b\x31\x5d\x3d\x3d\x3d\x66\x26\x26\x28\x67\x3d\x6a\x5b\x69\x2b\x22\x20\x22\x2b\x68\x5b\x30\x5d\x5d\x7c\x7c\x6a\x5b\x22\x2a\x20\x22\x2b\x68\x5b\x30\x5d\x5d\x29\x29\x7b\x67\x3d\x3d\x3d\x21\x30\x3f\x67\x3d\x6a\x5b\x65\x5d\x3a\x6a\x5b\x65\x5d\x21\x3d\x3d\x21\x30\x26\x26\x28\x66\x3d\x68\x5b\x30\x5d\x2c\x6b\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x68\x5b\x31\x5d\x29\x29\x3b\x62\x72\x65\x61\x6b\x7d\x69\x66\x28\x67\x21\x3d\x3d\x21\x30\x29\x69\x66\x28\x67\x26\x26\x61\x5b\x22\x74\x68\x72\x6f\x77\x73\x22\x5d\x29\x62\x3d\x67\x28\x62\x29\x3b\x65\x6c\x73\x65\x20\x74\x72\x79\x7b\x62\x3d\x67\x28\x62\x29\x7d\x63\x61\x74\x63\x68\x28\x6c\x29\x7b\x72\x65\x74\x75\x72\x6e\x7b\x73\x74\x61\x74\x65\x3a\x22\x70\x61\x72\x73\x65\x72\x65\x72\x72\x6f\x72\x22\x2c\x65\x72\x72\x6f\x72\x3a\x67\x3f\x6c\x3a\x22\x4e\x6f\x20\x63\x6f\x6e\x76\x65\x72\x73\x69\x6f\x6e\x20\x66\x72\x6f\x6d\x20\x22\x2b\x69\x2b\x22\x20\x74\x6f\x20\x22\x2b\x66\x7d\x7d\x7d\x72\x65\x74\x75\x72\x6e\x7b\x73\x74\x61\x74\x65\x3a\x22\x73\x75\x63\x63\x65\x73\x73\x22\x2c\x64\x61\x74\x61\x3a\x62\x7d\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x63\x74\x69\x76\x65\x3a\x30\x2c\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x3a\x7b\x7d\x2c\x65\x74\x61\x67\x3a\x7b\x7d\x2c\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x3a\x7b\x75\x72\x6c\x3a\x71\x62\x2e\x68\x72\x65\x66\x2c\x74\x79\x70\x65\x3a\x22\x47\x45\x54\x22\x2c\x69\x73\x4c\x6f\x63\x61\x6c\x3a\x43\x62\x2e\x74\x65\x73\x74\x28\x71\x62\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x29\x2c\x67\x6c\x6f\x62\x61\x6c\x3a\x21\x30\x2c\x70\x72\x6f\x63\x65\x73\x73\x44\x61\x74\x61\x3a\x21\x30\x2c\x61\x73\x79\x6e\x63\x3a\x21\x30\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x3a\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x77\x77\x77\x2d\x66\x6f\x72\x6d\x2d\x75\x72\x6c\x65\x6e\x63\x6f\x64\x65\x64\x3b\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x55\x54\x46\x2d\x38\x22\x2c\x61\x63\x63\x65\x70\x74\x73\x3a\x7b\x22\x2a\x22\x3a\x48\x62\x2c\x74\x65\x78\x74\x3a\x22\x74\x65\x78\x74\x2f\x70\x6c\x61\x69\x6e\x22\x2c\x68\x74\x6d\x6c\x3a\x22\x74\x65\x78\x74\x2f\x68\x74\x6d\x6c\x22\x2c\x78\x6d\x6c\x3a\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x6d\x6c\x2c\x20\x74\x65\x78\x74\x2f\x78\x6d\x6c\x22\x2c\x6a\x73\x6f\x6e\x3a\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x2c\x20\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x22\x7d\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x7b\x78\x6d\x6c\x3a\x2f\x5c\x62\x78\x6d\x6c\x5c\x62\x2f\x2c\x68\x74\x6d\x6c\x3a\x2f\x5c\x62\x68\x74\x6d\x6c\x2f\x2c\x6a\x73\x6f\x6e\x3a\x2f\x5c\x62\x6a\x73\x6f\x6e\x5c\x62\x2f\x7d\x2c\x72\x65\x73\x70\x6f\x6e\x73\x65\x46\x69\x65\x6c\x64\x73\x3a\x7b\x78\x6d\x6c\x3a\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x58\x4d\x4c\x22\x2c\x74\x65\x78\x74\x3a\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x22\x2c\x6a\x73\x6f\x6e\x3a\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x4a\x53\x4f\x4e\x22\x7d\x2c\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x3a\x7b\x22\x2a\x20\x74\x65\x78\x74\x22\x3a\x53\x74\x72\x69\x6e\x67\x2c\x22\x74\x65\x78\x74\x20\x68\x74\x6d\x6c\x22\x3a\x21\x30\x2c\x22\x74\x65\x78\x74\x20\x6a\x73\x6f\x6e\x22\x3a\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x2c\x22\x74\x65\x78\x74\x20\x78\x6d\x6c\x22\x3a\x72\x2e\x70\x61\x72\x73\x65\x58\x4d\x4c\x7d\x2c\x66\x6c\x61\x74\x4f\x70\x74\x69\x6f\x6e\x73\x3a\x7b\x75\x72\x6c\x3a\x21\x30\x2c\x63\x6f\x6e\x74\x65\x78\x74\x3a\x21\x30\x7d\x7d\x2c\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x4c\x62\x28\x4c\x62\x28\x61\x2c\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x29\x2c\x62\x29\x3a\x4c\x62\x28\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2c\x61\x29\x7d\x2c\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x3a\x4a\x62\x28\x46\x62\x29\x2c\x61\x6a\x61\x78\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x3a\x4a\x62\x28\x47\x62\x29\x2c\x61\x6a\x61\x78\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x63\x3d\x63\x7c\x7c\x7b\x7d\x3b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x3d\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x28\x7b\x7d\x2c\x63\x29\x2c\x70\x3d\x6f\x2e\x63\x6f\x6e\x74\x65\x78\x74\x7c\x7c\x6f\x2c\x71\x3d\x6f\x2e\x63\x6f\x6e\x74\x65\x78\x74\x26\x26\x28\x70\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x70\x2e\x6a\x71\x75\x65\x72\x79\x29\x3f\x72\x28\x70\x29\x3a\x72\x2e\x65\x76\x65\x6e\x74\x2c\x73\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2c\x74\x3d\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x75\x3d\x6f\x2e\x73\x74\x61\x74\x75\x73\x43\x6f\x64\x65\x7c\x7c\x7b\x7d\x2c\x76\x3d\x7b\x7d\x2c\x77\x3d\x7b\x7d\x2c\x78\x3d\x22\x63\x61\x6e\x63\x65\x6c\x65\x64\x22\x2c\x79\x3d\x7b\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x3a\x30\x2c\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x69\x66\x28\x6b\x29\x7b\x69\x66\x28\x21\x68\x29\x7b\x68\x3d\x7b\x7d\x3b\x77\x68\x69\x6c\x65\x28\x62\x3d\x42\x62\x2e\x65\x78\x65\x63\x28\x67\x29\x29\x68\x5b\x62\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x62\x5b\x32\x5d\x7d\x62\x3d\x68\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7d\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x62\x3f\x6e\x75\x6c\x6c\x3a\x62\x7d\x2c\x67\x65\x74\x41\x6c\x6c\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6b\x3f\x67\x3a\x6e\x75\x6c\x6c\x7d\x2c\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x6b\x26\x26\x28\x61\x3d\x77\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x77\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7c\x7c\x61\x2c\x76\x5b\x61\x5d\x3d\x62\x29\x2c\x74\x68\x69\x73\x7d\x2c\x6f\x76\x65\x72\x72\x69\x64\x65\x4d\x69\x6d\x65\x54\x79\x70\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x6b\x26\x26\x28\x6f\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x3d\x61\x29\x2c\x74\x68\x69\x73\x7d\x2c\x73\x74\x61\x74\x75\x73\x43\x6f\x64\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x69\x66\x28\x61\x29\x69\x66\x28\x6b\x29\x79\x2e\x61\x6c\x77\x61\x79\x73\x28\x61\x5b\x79\x2e\x73\x74\x61\x74\x75\x73\x5d\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x62\x20\x69\x6e\x20\x61\x29\x75\x5b\x62\x5d\x3d\x5b\x75\x5b\x62\x5d\x2c\x61\x5b\x62\x5d\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x61\x62\x6f\x72\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x7c\x7c\x78\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x26\x26\x65\x2e\x61\x62\x6f\x72\x74\x28\x62\x29\x2c\x41\x28\x30\x2c\x62\x29\x2c\x74\x68\x69\x73\x7d\x7d\x3b\x69\x66\x28\x73\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x79\x29\x2c\x6f\x2e\x75\x72\x6c\x3d\x28\x28\x62\x7c\x7c\x6f\x2e\x75\x72\x6c\x7c\x7c\x71\x62\x2e\x68\x72\x65\x66\x29\x2b\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x45\x62\x2c\x71\x62\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2b\x22\x2f\x2f\x22\x29\x2c\x6f\x2e\x74\x79\x70\x65\x3d\x63\x2e\x6d\x65\x74\x68\x6f\x64\x7c\x7c\x63\x2e\x74\x79\x70\x65\x7c\x7c\x6f\x2e\x6d\x65\x74\x68\x6f\x64\x7c\x7c\x6f\x2e\x74\x79\x70\x65\x2c\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x3d\x28\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x7c\x7c\x22\x2a\x22\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x22\x22\x5d\x2c\x6e\x75\x6c\x6c\x3d\x3d\x6f\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x29\x7b\x6a\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x61\x22\x29\x3b\x74\x72\x79\x7b\x6a\x2e\x68\x72\x65\x66\x3d\x6f\x2e\x75\x72\x6c\x2c\x6a\x2e\x68\x72\x65\x66\x3d\x6a\x2e\x68\x72\x65\x66\x2c\x6f\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x3d\x49\x62\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2b\x22\x2f\x2f\x22\x2b\x49\x62\x2e\x68\x6f\x73\x74\x21\x3d\x6a\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2b\x22\x2f\x2f\x22\x2b\x6a\x2e\x68\x6f\x73\x74\x7d\x63\x61\x74\x63\x68\x28\x7a\x29\x7b\x6f\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x3d\x21\x30\x7d\x7d\x69\x66\x28\x6f\x2e\x64\x61\x74\x61\x26\x26\x6f\x2e\x70\x72\x6f\x63\x65\x73\x73\x44\x61\x74\x61\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x6f\x2e\x64\x61\x74\x61\x26\x26\x28\x6f\x2e\x64\x61\x74\x61\x3d\x72\x2e\x70\x61\x72\x61\x6d\x28\x6f\x2e\x64\x61\x74\x61\x2c\x6f\x2e\x74\x72\x61\x64\x69\x74\x69\x6f\x6e\x61\x6c\x29\x29\x2c\x4b\x62\x28\x46\x62\x2c\x6f\x2c\x63\x2c\x79\x29\x2c\x6b\x29\x72\x65\x74\x75\x72\x6e\x20\x79\x3b\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x26\x26\x6f\x2e\x67\x6c\x6f\x62\x61\x6c\x2c\x6c\x26\x26\x30\x3d\x3d\x3d\x72\x2e\x61\x63\x74\x69\x76\x65\x2b\x2b\x26\x26\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x53\x74\x61\x72\x74\x22\x29\x2c\x6f\x2e\x74\x79\x70\x65\x3d\x6f\x2e\x74\x79\x70\x65\x2e\x74\x6f\x55\x70\x70\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x6f\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x21\x44\x62\x2e\x74\x65\x73\x74\x28\x6f\x2e\x74\x79\x70\x65\x29\x2c\x66\x3d\x6f\x2e\x75\x72\x6c\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x7a\x62\x2c\x22\x22\x29\x2c\x6f\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x3f\x6f\x2e\x64\x61\x74\x61\x26\x26\x6f\x2e\x70\x72\x6f\x63\x65\x73\x73\x44\x61\x74\x61\x26\x26\x30\x3d\x3d\x3d\x28\x6f\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x7c\x7c\x22\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x77\x77\x77\x2d\x66\x6f\x72\x6d\x2d\x75\x72\x6c\x65\x6e\x63\x6f\x64\x65\x64\x22\x29\x26\x26\x28\x6f\x2e\x64\x61\x74\x61\x3d\x6f\x2e\x64\x61\x74\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x79\x62\x2c\x22\x2b\x22\x29\x29\x3a\x28\x6e\x3d\x6f\x2e\x75\x72\x6c\x2e\x73\x6c\x69\x63\x65\x28\x66\x2e\x6c\x65\x6e\x67\x74\x68\x29\x2c\x6f\x2e\x64\x61\x74\x61\x26\x26\x28\x66\x2b\x3d\x28\x73\x62\x2e\x74\x65\x73\x74\x28\x66\x29\x3f\x22\x26\x22\x3a\x22\x3f\x22\x29\x2b\x6f\x2e\x64\x61\x74\x61\x2c\x64\x65\x6c\x65\x74\x65\x20\x6f\x2e\x64\x61\x74\x61\x29\x2c\x6f\x2e\x63\x61\x63\x68\x65\x3d\x3d\x3d\x21\x31\x26\x26\x28\x66\x3d\x66\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x41\x62\x2c\x22\x24\x31\x22\x29\x2c\x6e\x3d\x28\x73\x62\x2e\x74\x65\x73\x74\x28\x66\x29\x3f\x22\x26\x22\x3a\x22\x3f\x22\x29\x2b\x22\x5f\x3d\x22\x2b\x72\x62\x2b\x2b\x20\x2b\x6e\x29\x2c\x6f\x2e\x75\x72\x6c\x3d\x66\x2b\x6e\x29\x2c\x6f\x2e\x69\x66\x4d\x6f\x64\x69\x66\x69\x65\x64\x26\x26\x28\x72\x2e\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x5b\x66\x5d\x26\x26\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x49\x66\x2d\x4d\x6f\x64\x69\x66\x69\x65\x64\x2d\x53\x69\x6e\x63\x65\x22\x2c\x72\x2e\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x5b\x66\x5d\x29\x2c\x72\x2e\x65\x74\x61\x67\x5b\x66\x5d\x26\x26\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x49\x66\x2d\x4e\x6f\x6e\x65\x2d\x4d\x61\x74\x63\x68\x22\x2c\x72\x2e\x65\x74\x61\x67\x5b\x66\x5d\x29\x29\x2c\x28\x6f\x2e\x64\x61\x74\x61\x26\x26\x6f\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x26\x26\x6f\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x21\x3d\x3d\x21\x31\x7c\x7c\x63\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x29\x26\x26\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x22\x2c\x6f\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x29\x2c\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x41\x63\x63\x65\x70\x74\x22\x2c\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x26\x26\x6f\x2e\x61\x63\x63\x65\x70\x74\x73\x5b\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x5d\x3f\x6f\x2e\x61\x63\x63\x65\x70\x74\x73\x5b\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x5d\x2b\x28\x22\x2a\x22\x21\x3d\x3d\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x3f\x22\x2c\x20\x22\x2b\x48\x62\x2b\x22\x3b\x20\x71\x3d\x30\x2e\x30\x31\x22\x3a\x22\x22\x29\x3a\x6f\x2e\x61\x63\x63\x65\x70\x74\x73\x5b\x22\x2a\x22\x5d\x29\x3b\x66\x6f\x72\x28\x6d\x20\x69\x6e\x20\x6f\x2e\x68\x65\x61\x64\x65\x72\x73\x29\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x6d\x2c\x6f\x2e\x68\x65\x61\x64\x65\x72\x73\x5b\x6d\x5d\x29\x3b\x69\x66\x28\x6f\x2e\x62\x65\x66\x6f\x72\x65\x53\x65\x6e\x64\x26\x26\x28\x6f\x2e\x62\x65\x66\x6f\x72\x65\x53\x65\x6e\x64\x2e\x63\x61\x6c\x6c\x28\x70\x2c\x79\x2c\x6f\x29\x3d\x3d\x3d\x21\x31\x7c\x7c\x6b\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x79\x2e\x61\x62\x6f\x72\x74\x28\x29\x3b\x69\x66\x28\x78\x3d\x22\x61\x62\x6f\x72\x74\x22\x2c\x74\x2e\x61\x64\x64\x28\x6f\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x29\x2c\x79\x2e\x64\x6f\x6e\x65\x28\x6f\x2e\x73\x75\x63\x63\x65\x73\x73\x29\x2c\x79\x2e\x66\x61\x69\x6c\x28\x6f\x2e\x65\x72\x72\x6f\x72\x29\x2c\x65\x3d\x4b\x62\x28\x47\x62\x2c\x6f\x2c\x63\x2c\x79\x29\x29\x7b\x69\x66\x28\x79\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x3d\x31\x2c\x6c\x26\x26\x71\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x53\x65\x6e\x64\x22\x2c\x5b\x79\x2c\x6f\x5d\x29\x2c\x6b\x29\x72\x65\x74\x75\x72\x6e\x20\x79\x3b\x6f\x2e\x61\x73\x79\x6e\x63\x26\x26\x6f\x2e\x74\x69\x6d\x65\x6f\x75\x74\x3e\x30\x26\x26\x28\x69\x3d\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x79\x2e\x61\x62\x6f\x72\x74\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x7d\x2c\x6f\x2e\x74\x69\x6d\x65\x6f\x75\x74\x29\x29\x3b\x74\x72\x79\x7b\x6b\x3d\x21\x31\x2c\x65\x2e\x73\x65\x6e\x64\x28\x76\x2c\x41\x29\x7d\x63\x61\x74\x63\x68\x28\x7a\x29\x7b\x69\x66\x28\x6b\x29\x74\x68\x72\x6f\x77\x20\x7a\x3b\x41\x28\x2d\x31\x2c\x7a\x29\x7d\x7d\x65\x6c\x73\x65\x20\x41\x28\x2d\x31\x2c\x22\x4e\x6f\x20\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x22\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x41\x28\x62\x2c\x63\x2c\x64\x2c\x68\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6d\x2c\x6e\x2c\x76\x2c\x77\x2c\x78\x3d\x63\x3b\x6b\x7c\x7c\x28\x6b\x3d\x21\x30\x2c\x69\x26\x26\x61\x2e\x63\x6c\x65\x61\x72\x54\x69\x6d\x65\x6f\x75\x74\x28\x69\x29\x2c\x65\x3d\x76\x6f\x69\x64\x20\x30\x2c\x67\x3d\x68\x7c\x7c\x22\x22\x2c\x79\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x3d\x62\x3e\x30\x3f\x34\x3a\x30\x2c\x6a\x3d\x62\x3e\x3d\x32\x30\x30\x26\x26\x62\x3c\x33\x30\x30\x7c\x7c\x33\x30\x34\x3d\x3d\x3d\x62\x2c\x64\x26\x26\x28\x76\x3d\x4d\x62\x28\x6f\x2c\x79\x2c\x64\x29\x29\x2c\x76\x3d\x4e\x62\x28\x6f\x2c\x76\x2c\x79\x2c\x6a\x29\x2c\x6a\x3f\x28\x6f\x2e\x69\x66\x4d\x6f\x64\x69\x66\x69\x65\x64\x26\x26\x28\x77\x3d\x79\x2e\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x28\x22\x4c\x61\x73\x74\x2d\x4d\x6f\x64\x69\x66\x69\x65\x64\x22\x29\x2c\x77\x26\x26\x28\x72\x2e\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x5b\x66\x5d\x3d\x77\x29\x2c\x77\x3d\x79\x2e\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x28\x22\x65\x74\x61\x67\x22\x29\x2c\x77\x26\x26\x28\x72\x2e\x65\x74\x61\x67\x5b\x66\x5d\x3d\x77\x29\x29\x2c\x32\x30\x34\x3d\x3d\x3d\x62\x7c\x7c\x22\x48\x45\x41\x44\x22\x3d\x3d\x3d\x6f\x2e\x74\x79\x70\x65\x3f\x78\x3d\x22\x6e\x6f\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3a\x33\x30\x34\x3d\x3d\x3d\x62\x3f\x78\x3d\x22\x6e\x6f\x74\x6d\x6f\x64\x69\x66\x69\x65\x64\x22\x3a\x28\x78\x3d\x76\x2e\x73\x74\x61\x74\x65\x2c\x6d\x3d\x76\x2e\x64\x61\x74\x61\x2c\x6e\x3d\x76\x2e\x65\x72\x72\x6f\x72\x2c\x6a\x3d\x21\x6e\x29\x29\x3a\x28\x6e\x3d\x78\x2c\x21\x62\x26\x26\x78\x7c\x7c\x28\x78\x3d\x22\x65\x72\x72\x6f\x72\x22\x2c\x62\x3c\x30\x26\x26\x28\x62\x3d\x30\x29\x29\x29\x2c\x79\x2e\x73\x74\x61\x74\x75\x73\x3d\x62\x2c\x79\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x3d\x28\x63\x7c\x7c\x78\x29\x2b\x22\x22\x2c\x6a\x3f\x73\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x70\x2c\x5b\x6d\x2c\x78\x2c\x79\x5d\x29\x3a\x73\x2e\x72\x65\x6a\x65\x63\x74\x57\x69\x74\x68\x28\x70\x2c\x5b\x79\x2c\x78\x2c\x6e\x5d\x29\x2c\x79\x2e\x73\x74\x61\x74\x75\x73\x43\x6f\x64\x65\x28\x75\x29\x2c\x75\x3d\x76\x6f\x69\x64\x20\x30\x2c\x6c\x26\x26\x71\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x6a\x3f\x22\x61\x6a\x61\x78\x53\x75\x63\x63\x65\x73\x73\x22\x3a\x22\x61\x6a\x61\x78\x45\x72\x72\x6f\x72\x22\x2c\x5b\x79\x2c\x6f\x2c\x6a\x3f\x6d\x3a\x6e\x5d\x29\x2c\x74\x2e\x66\x69\x72\x65\x57\x69\x74\x68\x28\x70\x2c\x5b\x79\x2c\x78\x5d\x29\x2c\x6c\x26\x26\x28\x71\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x43\x6f\x6d\x70\x6c\x65\x74\x65\x22\x2c\x5b\x79\x2c\x6f\x5d\x29\x2c\x2d\x2d\x72\x2e\x61\x63\x74\x69\x76\x65\x7c\x7c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x53\x74\x6f\x70\x22\x29\x29\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x79\x7d\x2c\x67\x65\x74\x4a\x53\x4f\x4e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x65\x74\x28\x61\x2c\x62\x2c\x63\x2c\x22\x6a\x73\x6f\x6e\x22\x29\x7d\x2c\x67\x65\x74\x53\x63\x72\x69\x70\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x65\x74\x28\x61\x2c\x76\x6f\x69\x64\x20\x30\x2c\x62\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x67\x65\x74\x22\x2c\x22\x70\x6f\x73\x74\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x26\x26\x28\x65\x3d\x65\x7c\x7c\x64\x2c\x64\x3d\x63\x2c\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x72\x2e\x61\x6a\x61\x78\x28\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x75\x72\x6c\x3a\x61\x2c\x74\x79\x70\x65\x3a\x62\x2c\x64\x61\x74\x61\x54\x79\x70\x65\x3a\x65\x2c\x64\x61\x74\x61\x3a\x63\x2c\x73\x75\x63\x63\x65\x73\x73\x3a\x64\x7d\x2c\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x26\x26\x61\x29\x29\x7d\x7d\x29\x2c\x72\x2e\x5f\x65\x76\x61\x6c\x55\x72\x6c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x61\x6a\x61\x78\x28\x7b\x75\x72\x6c\x3a\x61\x2c\x74\x79\x70\x65\x3a\x22\x47\x45\x54\x22\x2c\x64\x61\x74\x61\x54\x79\x70\x65\x3a\x22\x73\x63\x72\x69\x70\x74\x22\x2c\x63\x61\x63\x68\x65\x3a\x21\x30\x2c\x61\x73\x79\x6e\x63\x3a\x21\x31\x2c\x67\x6c\x6f\x62\x61\x6c\x3a\x21\x31\x2c\x22\x74\x68\x72\x6f\x77\x73\x22\x3a\x21\x30\x7d\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x77\x72\x61\x70\x41\x6c\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x5b\x30\x5d\x26\x26\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x26\x26\x28\x61\x3d\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x5b\x30\x5d\x29\x29\x2c\x62\x3d\x72\x28\x61\x2c\x74\x68\x69\x73\x5b\x30\x5d\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x29\x2e\x65\x71\x28\x30\x29\x2e\x63\x6c\x6f\x6e\x65\x28\x21\x30\x29\x2c\x74\x68\x69\x73\x5b\x30\x5d\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x74\x68\x69\x73\x5b\x30\x5d\x29\x2c\x62\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x3b\x77\x68\x69\x6c\x65\x28\x61\x2e\x66\x69\x72\x73\x74\x45\x6c\x65\x6d\x65\x6e\x74\x43\x68\x69\x6c\x64\x29\x61\x3d\x61\x2e\x66\x69\x72\x73\x74\x45\x6c\x65\x6d\x65\x6e\x74\x43\x68\x69\x6c\x64\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x74\x68\x69\x73\x29\x29\x2c\x74\x68\x69\x73\x7d\x2c\x77\x72\x61\x70\x49\x6e\x6e\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x77\x72\x61\x70\x49\x6e\x6e\x65\x72\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x29\x29\x7d\x29\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x28\x74\x68\x69\x73\x29\x2c\x63\x3d\x62\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x28\x29\x3b\x63\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x63\x2e\x77\x72\x61\x70\x41\x6c\x6c\x28\x61\x29\x3a\x62\x2e\x61\x70\x70\x65\x6e\x64\x28\x61\x29\x7d\x29\x7d\x2c\x77\x72\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x77\x72\x61\x70\x41\x6c\x6c\x28\x62\x3f\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x63\x29\x3a\x61\x29\x7d\x29\x7d\x2c\x75\x6e\x77\x72\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x28\x61\x29\x2e\x6e\x6f\x74\x28\x22\x62\x6f\x64\x79\x22\x29\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x57\x69\x74\x68\x28\x74\x68\x69\x73\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x7d\x29\x2c\x74\x68\x69\x73\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x68\x69\x64\x64\x65\x6e\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x76\x69\x73\x69\x62\x6c\x65\x28\x61\x29\x7d\x2c\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x76\x69\x73\x69\x62\x6c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x28\x61\x2e\x6f\x66\x66\x73\x65\x74\x57\x69\x64\x74\x68\x7c\x7c\x61\x2e\x6f\x66\x66\x73\x65\x74\x48\x65\x69\x67\x68\x74\x7c\x7c\x61\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x2c\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2e\x78\x68\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x61\x2e\x58\x4d\x4c\x48\x74\x74\x70\x52\x65\x71\x75\x65\x73\x74\x7d\x63\x61\x74\x63\x68\x28\x62\x29\x7b\x7d\x7d\x3b\x76\x61\x72\x20\x4f\x62\x3d\x7b\x30\x3a\x32\x30\x30\x2c\x31\x32\x32\x33\x3a\x32\x30\x34\x7d\x2c\x50\x62\x3d\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2e\x78\x68\x72\x28\x29\x3b\x6f\x2e\x63\x6f\x72\x73\x3d\x21\x21\x50\x62\x26\x26\x22\x77\x69\x74\x68\x43\x72\x65\x64\x65\x6e\x74\x69\x61\x6c\x73\x22\x69\x6e\x20\x50\x62\x2c\x6f\x2e\x61\x6a\x61\x78\x3d\x50\x62\x3d\x21\x21\x50\x62\x2c\x72\x2e\x61\x6a\x61\x78\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3b\x69\x66\x28\x6f\x2e\x63\x6f\x72\x73\x7c\x7c\x50\x62\x26\x26\x21\x62\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x29\x72\x65\x74\x75\x72\x6e\x7b\x73\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x2c\x68\x3d\x62\x2e\x78\x68\x72\x28\x29\x3b\x69\x66\x28\x68\x2e\x6f\x70\x65\x6e\x28\x62\x2e\x74\x79\x70\x65\x2c\x62\x2e\x75\x72\x6c\x2c\x62\x2e\x61\x73\x79\x6e\x63\x2c\x62\x2e\x75\x73\x65\x72\x6e\x61\x6d\x65\x2c\x62\x2e\x70\x61\x73\x73\x77\x6f\x72\x64\x29\x2c\x62\x2e\x78\x68\x72\x46\x69\x65\x6c\x64\x73\x29\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x62\x2e\x78\x68\x72\x46\x69\x65\x6c\x64\x73\x29\x68\x5b\x67\x5d\x3d\x62\x2e\x78\x68\x72\x46\x69\x65\x6c\x64\x73\x5b\x67\x5d\x3b\x62\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x26\x26\x68\x2e\x6f\x76\x65\x72\x72\x69\x64\x65\x4d\x69\x6d\x65\x54\x79\x70\x65\x26\x26\x68\x2e\x6f\x76\x65\x72\x72\x69\x64\x65\x4d\x69\x6d\x65\x54\x79\x70\x65\x28\x62\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x29\x2c\x62\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x7c\x7c\x65\x5b\x22\x58\x2d\x52\x65\x71\x75\x65\x73\x74\x65\x64\x2d\x57\x69\x74\x68\x22\x5d\x7c\x7c\x28\x65\x5b\x22\x58\x2d\x52\x65\x71\x75\x65\x73\x74\x65\x64\x2d\x57\x69\x74\x68\x22\x5d\x3d\x22\x58\x4d\x4c\x48\x74\x74\x70\x52\x65\x71\x75\x65\x73\x74\x22\x29\x3b\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x65\x29\x68\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x67\x2c\x65\x5b\x67\x5d\x29\x3b\x63\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x28\x63\x3d\x64\x3d\x68\x2e\x6f\x6e\x6c\x6f\x61\x64\x3d\x68\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x3d\x68\x2e\x6f\x6e\x61\x62\x6f\x72\x74\x3d\x68\x2e\x6f\x6e\x72\x65\x61\x64\x79\x73\x74\x61\x74\x65\x63\x68\x61\x6e\x67\x65\x3d\x6e\x75\x6c\x6c\x2c\x22\x61\x62\x6f\x72\x74\x22\x3d\x3d\x3d\x61\x3f\x68\x2e\x61\x62\x6f\x72\x74\x28\x29\x3a\x22\x65\x72\x72\x6f\x72\x22\x3d\x3d\x3d\x61\x3f\x22\x6e\x75\x6d\x62\x65\x72\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x68\x2e\x73\x74\x61\x74\x75\x73\x3f\x66\x28\x30\x2c\x22\x65\x72\x72\x6f\x72\x22\x29\x3a\x66\x28\x68\x2e\x73\x74\x61\x74\x75\x73\x2c\x68\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x29\x3a\x66\x28\x4f\x62\x5b\x68\x2e\x73\x74\x61\x74\x75\x73\x5d\x7c\x7c\x68\x2e\x73\x74\x61\x74\x75\x73\x2c\x68\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x2c\x22\x74\x65\x78\x74\x22\x21\x3d\x3d\x28\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x79\x70\x65\x7c\x7c\x22\x74\x65\x78\x74\x22\x29\x7c\x7c\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x3f\x7b\x62\x69\x6e\x61\x72\x79\x3a\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x7d\x3a\x7b\x74\x65\x78\x74\x3a\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x7d\x2c\x68\x2e\x67\x65\x74\x41\x6c\x6c\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x73\x28\x29\x29\x29\x7d\x7d\x2c\x68\x2e\x6f\x6e\x6c\x6f\x61\x64\x3d\x63\x28\x29\x2c\x64\x3d\x68\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x3d\x63\x28\x22\x65\x72\x72\x6f\x72\x22\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x68\x2e\x6f\x6e\x61\x62\x6f\x72\x74\x3f\x68\x2e\x6f\x6e\x61\x62\x6f\x72\x74\x3d\x64\x3a\x68\x2e\x6f\x6e\x72\x65\x61\x64\x79\x73\x74\x61\x74\x65\x63\x68\x61\x6e\x67\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x34\x3d\x3d\x3d\x68\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x26\x26\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x64\x28\x29\x7d\x29\x7d\x2c\x63\x3d\x63\x28\x22\x61\x62\x6f\x72\x74\x22\x29\x3b\x74\x72\x79\x7b\x68\x2e\x73\x65\x6e\x64\x28\x62\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x26\x26\x62\x2e\x64\x61\x74\x61\x7c\x7c\x6e\x75\x6c\x6c\x29\x7d\x63\x61\x74\x63\x68\x28\x69\x29\x7b\x69\x66\x28\x63\x29\x74\x68\x72\x6f\x77\x20\x69\x7d\x7d\x2c\x61\x62\x6f\x72\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x63\x28\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x26\x26\x28\x61\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x2e\x73\x63\x72\x69\x70\x74\x3d\x21\x31\x29\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x28\x7b\x61\x63\x63\x65\x70\x74\x73\x3a\x7b\x73\x63\x72\x69\x70\x74\x3a\x22\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x2c\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x2c\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x65\x63\x6d\x61\x73\x63\x72\x69\x70\x74\x2c\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x65\x63\x6d\x61\x73\x63\x72\x69\x70\x74\x22\x7d\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x7b\x73\x63\x72\x69\x70\x74\x3a\x2f\x5c\x62\x28\x3f\x3a\x6a\x61\x76\x61\x7c\x65\x63\x6d\x61\x29\x73\x63\x72\x69\x70\x74\x5c\x62\x2f\x7d\x2c\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x3a\x7b\x22\x74\x65\x78\x74\x20\x73\x63\x72\x69\x70\x74\x22\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x28\x61\x29\x2c\x61\x7d\x7d\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x22\x73\x63\x72\x69\x70\x74\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x2e\x63\x61\x63\x68\x65\x26\x26\x28\x61\x2e\x63\x61\x63\x68\x65\x3d\x21\x31\x29\x2c\x61\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x26\x26\x28\x61\x2e\x74\x79\x70\x65\x3d\x22\x47\x45\x54\x22\x29\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x28\x22\x73\x63\x72\x69\x70\x74\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x61\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3b\x72\x65\x74\x75\x72\x6e\x7b\x73\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2c\x66\x29\x7b\x62\x3d\x72\x28\x22\x3c\x73\x63\x72\x69\x70\x74\x3e\x22\x29\x2e\x70\x72\x6f\x70\x28\x7b\x63\x68\x61\x72\x73\x65\x74\x3a\x61\x2e\x73\x63\x72\x69\x70\x74\x43\x68\x61\x72\x73\x65\x74\x2c\x73\x72\x63\x3a\x61\x2e\x75\x72\x6c\x7d\x29\x2e\x6f\x6e\x28\x22\x6c\x6f\x61\x64\x20\x65\x72\x72\x6f\x72\x22\x2c\x63\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x62\x2e\x72\x65\x6d\x6f\x76\x65\x28\x29\x2c\x63\x3d\x6e\x75\x6c\x6c\x2c\x61\x26\x26\x66\x28\x22\x65\x72\x72\x6f\x72\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x3f\x34\x30\x34\x3a\x32\x30\x30\x2c\x61\x2e\x74\x79\x70\x65\x29\x7d\x29\x2c\x64\x2e\x68\x65\x61\x64\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x5b\x30\x5d\x29\x7d\x2c\x61\x62\x6f\x72\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x63\x28\x29\x7d\x7d\x7d\x7d\x29\x3b\x76\x61\x72\x20\x51\x62\x3d\x5b\x5d\x2c\x52\x62\x3d\x2f\x28\x3d\x29\x5c\x3f\x28\x3f\x3d\x26\x7c\x24\x29\x7c\x5c\x3f\x5c\x3f\x2f\x3b\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x28\x7b\x6a\x73\x6f\x6e\x70\x3a\x22\x63\x61\x6c\x6c\x62\x61\x63\x6b\x22\x2c\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x51\x62\x2e\x70\x6f\x70\x28\x29\x7c\x7c\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x2b\x22\x5f\x22\x2b\x72\x62\x2b\x2b\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x5b\x61\x5d\x3d\x21\x30\x2c\x61\x7d\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x22\x6a\x73\x6f\x6e\x20\x6a\x73\x6f\x6e\x70\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x62\x2e\x6a\x73\x6f\x6e\x70\x21\x3d\x3d\x21\x31\x26\x26\x28\x52\x62\x2e\x74\x65\x73\x74\x28\x62\x2e\x75\x72\x6c\x29\x3f\x22\x75\x72\x6c\x22\x3a\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x64\x61\x74\x61\x26\x26\x30\x3d\x3d\x3d\x28\x62\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x7c\x7c\x22\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x77\x77\x77\x2d\x66\x6f\x72\x6d\x2d\x75\x72\x6c\x65\x6e\x63\x6f\x64\x65\x64\x22\x29\x26\x26\x52\x62\x2e\x74\x65\x73\x74\x28\x62\x2e\x64\x61\x74\x61\x29\x26\x26\x22\x64\x61\x74\x61\x22\x29\x3b\x69\x66\x28\x68\x7c\x7c\x22\x6a\x73\x6f\x6e\x70\x22\x3d\x3d\x3d\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x29\x72\x65\x74\x75\x72\x6e\x20\x65\x3d\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x29\x3f\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x28\x29\x3a\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x2c\x68\x3f\x62\x5b\x68\x5d\x3d\x62\x5b\x68\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x52\x62\x2c\x22\x24\x31\x22\x2b\x65\x29\x3a\x62\x2e\x6a\x73\x6f\x6e\x70\x21\x3d\x3d\x21\x31\x26\x26\x28\x62\x2e\x75\x72\x6c\x2b\x3d\x28\x73\x62\x2e\x74\x65\x73\x74\x28\x62\x2e\x75\x72\x6c\x29\x3f\x22\x26\x22\x3a\x22\x3f\x22\x29\x2b\x62\x2e\x6a\x73\x6f\x6e\x70\x2b\x22\x3d\x22\x2b\x65\x29\x2c\x62\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x5b\x22\x73\x63\x72\x69\x70\x74\x20\x6a\x73\x6f\x6e\x22\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x67\x7c\x7c\x72\x2e\x65\x72\x72\x6f\x72\x28\x65\x2b\x22\x20\x77\x61\x73\x20\x6e\x6f\x74\x20\x63\x61\x6c\x6c\x65\x64\x22\x29\x2c\x67\x5b\x30\x5d\x7d\x2c\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x3d\x22\x6a\x73\x6f\x6e\x22\x2c\x66\x3d\x61\x5b\x65\x5d\x2c\x61\x5b\x65\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x7d\x2c\x64\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x66\x3f\x72\x28\x61\x29\x2e\x72\x65\x6d\x6f\x76\x65\x50\x72\x6f\x70\x28\x65\x29\x3a\x61\x5b\x65\x5d\x3d\x66\x2c\x62\x5b\x65\x5d\x26\x26\x28\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x3d\x63\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x2c\x51\x62\x2e\x70\x75\x73\x68\x28\x65\x29\x29\x2c\x67\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x29\x26\x26\x66\x28\x67\x5b\x30\x5d\x29\x2c\x67\x3d\x66\x3d\x76\x6f\x69\x64\x20\x30\x7d\x29\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x7d\x29\x2c\x6f\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x64\x2e\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x28\x22\x22\x29\x2e\x62\x6f\x64\x79\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x66\x6f\x72\x6d\x3e\x3c\x2f\x66\x6f\x72\x6d\x3e\x3c\x66\x6f\x72\x6d\x3e\x3c\x2f\x66\x6f\x72\x6d\x3e\x22\x2c\x32\x3d\x3d\x3d\x61\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x28\x29\x2c\x72\x2e\x70\x61\x72\x73\x65\x48\x54\x4d\x4c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x72\x65\x74\x75\x72\x6e\x5b\x5d\x3b\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x21\x31\x29\x3b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x7c\x7c\x28\x6f\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3f\x28\x62\x3d\x64\x2e\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x28\x22\x22\x29\x2c\x65\x3d\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x62\x61\x73\x65\x22\x29\x2c\x65\x2e\x68\x72\x65\x66\x3d\x64\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x72\x65\x66\x2c\x62\x2e\x68\x65\x61\x64\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x65\x29\x29\x3a\x62\x3d\x64\x29\x2c\x66\x3d\x42\x2e\x65\x78\x65\x63\x28\x61\x29\x2c\x67\x3d\x21\x63\x26\x26\x5b\x5d\x2c\x66\x3f\x5b\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x66\x5b\x31\x5d\x29\x5d\x3a\x28\x66\x3d\x70\x61\x28\x5b\x61\x5d\x2c\x62\x2c\x67\x29\x2c\x67\x26\x26\x67\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x72\x28\x67\x29\x2e\x72\x65\x6d\x6f\x76\x65\x28\x29\x2c\x72\x2e\x6d\x65\x72\x67\x65\x28\x5b\x5d\x2c\x66\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x6c\x6f\x61\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x3d\x74\x68\x69\x73\x2c\x68\x3d\x61\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x20\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x68\x3e\x2d\x31\x26\x26\x28\x64\x3d\x6d\x62\x28\x61\x2e\x73\x6c\x69\x63\x65\x28\x68\x29\x29\x2c\x61\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x68\x29\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x28\x63\x3d\x62\x2c\x62\x3d\x76\x6f\x69\x64\x20\x30\x29\x3a\x62\x26\x26\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x65\x3d\x22\x50\x4f\x53\x54\x22\x29\x2c\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x26\x26\x72\x2e\x61\x6a\x61\x78\x28\x7b\x75\x72\x6c\x3a\x61\x2c\x74\x79\x70\x65\x3a\x65\x7c\x7c\x22\x47\x45\x54\x22\x2c\x64\x61\x74\x61\x54\x79\x70\x65\x3a\x22\x68\x74\x6d\x6c\x22\x2c\x64\x61\x74\x61\x3a\x62\x7d\x29\x2e\x64\x6f\x6e\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x67\x2e\x68\x74\x6d\x6c\x28\x64\x3f\x72\x28\x22\x3c\x64\x69\x76\x3e\x22\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x72\x2e\x70\x61\x72\x73\x65\x48\x54\x4d\x4c\x28\x61\x29\x29\x2e\x66\x69\x6e\x64\x28\x64\x29\x3a\x61\x29\x7d\x29\x2e\x61\x6c\x77\x61\x79\x73\x28\x63\x26\x26\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x67\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x66\x7c\x7c\x5b\x61\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x2c\x62\x2c\x61\x5d\x29\x7d\x29\x7d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x61\x6a\x61\x78\x53\x74\x61\x72\x74\x22\x2c\x22\x61\x6a\x61\x78\x53\x74\x6f\x70\x22\x2c\x22\x61\x6a\x61\x78\x43\x6f\x6d\x70\x6c\x65\x74\x65\x22\x2c\x22\x61\x6a\x61\x78\x45\x72\x72\x6f\x72\x22\x2c\x22\x61\x6a\x61\x78\x53\x75\x63\x63\x65\x73\x73\x22\x2c\x22\x61\x6a\x61\x78\x53\x65\x6e\x64\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x6e\x28\x62\x2c\x61\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x61\x6e\x69\x6d\x61\x74\x65\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x72\x65\x70\x28\x72\x2e\x74\x69\x6d\x65\x72\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x2e\x65\x6c\x65\x6d\x7d\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x53\x62\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x61\x29\x3f\x61\x3a\x39\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x7d\x72\x2e\x6f\x66\x66\x73\x65\x74\x3d\x7b\x73\x65\x74\x4f\x66\x66\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x6f\x73\x69\x74\x69\x6f\x6e\x22\x29\x2c\x6c\x3d\x72\x28\x61\x29\x2c\x6d\x3d\x7b\x7d\x3b\x22\x73\x74\x61\x74\x69\x63\x22\x3d\x3d\x3d\x6b\x26\x26\x28\x61\x2e\x73\x74\x79\x6c\x65\x2e\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3d\x22\x72\x65\x6c\x61\x74\x69\x76\x65\x22\x29\x2c\x68\x3d\x6c\x2e\x6f\x66\x66\x73\x65\x74\x28\x29\x2c\x66\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x74\x6f\x70\x22\x29\x2c\x69\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x6c\x65\x66\x74\x22\x29\x2c\x6a\x3d\x28\x22\x61\x62\x73\x6f\x6c\x75\x74\x65\x22\x3d\x3d\x3d\x6b\x7c\x7c\x22\x66\x69\x78\x65\x64\x22\x3d\x3d\x3d\x6b\x29\x26\x26\x28\x66\x2b\x69\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x61\x75\x74\x6f\x22\x29\x3e\x2d\x31\x2c\x6a\x3f\x28\x64\x3d\x6c\x2e\x70\x6f\x73\x69\x74\x69\x6f\x6e\x28\x29\x2c\x67\x3d\x64\x2e\x74\x6f\x70\x2c\x65\x3d\x64\x2e\x6c\x65\x66\x74\x29\x3a\x28\x67\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x66\x29\x7c\x7c\x30\x2c\x65\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x69\x29\x7c\x7c\x30\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x26\x26\x28\x62\x3d\x62\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x63\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x68\x29\x29\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x62\x2e\x74\x6f\x70\x26\x26\x28\x6d\x2e\x74\x6f\x70\x3d\x62\x2e\x74\x6f\x70\x2d\x68\x2e\x74\x6f\x70\x2b\x67\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x62\x2e\x6c\x65\x66\x74\x26\x26\x28\x6d\x2e\x6c\x65\x66\x74\x3d\x62\x2e\x6c\x65\x66\x74\x2d\x68\x2e\x6c\x65\x66\x74\x2b\x65\x29\x2c\x22\x75\x73\x69\x6e\x67\x22\x69\x6e\x20\x62\x3f\x62\x2e\x75\x73\x69\x6e\x67\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6d\x29\x3a\x6c\x2e\x63\x73\x73\x28\x6d\x29\x7d\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x6f\x66\x66\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x3f\x74\x68\x69\x73\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x2e\x6f\x66\x66\x73\x65\x74\x2e\x73\x65\x74\x4f\x66\x66\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x7d\x29\x3b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x74\x68\x69\x73\x5b\x30\x5d\x3b\x69\x66\x28\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x28\x64\x3d\x66\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2c\x64\x2e\x77\x69\x64\x74\x68\x7c\x7c\x64\x2e\x68\x65\x69\x67\x68\x74\x3f\x28\x65\x3d\x66\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x63\x3d\x53\x62\x28\x65\x29\x2c\x62\x3d\x65\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x7b\x74\x6f\x70\x3a\x64\x2e\x74\x6f\x70\x2b\x63\x2e\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x2d\x62\x2e\x63\x6c\x69\x65\x6e\x74\x54\x6f\x70\x2c\x6c\x65\x66\x74\x3a\x64\x2e\x6c\x65\x66\x74\x2b\x63\x2e\x70\x61\x67\x65\x58\x4f\x66\x66\x73\x65\x74\x2d\x62\x2e\x63\x6c\x69\x65\x6e\x74\x4c\x65\x66\x74\x7d\x29\x3a\x64\x29\x3a\x7b\x74\x6f\x70\x3a\x30\x2c\x6c\x65\x66\x74\x3a\x30\x7d\x7d\x2c\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x5b\x30\x5d\x29\x7b\x76\x61\x72\x20\x61\x2c\x62\x2c\x63\x3d\x74\x68\x69\x73\x5b\x30\x5d\x2c\x64\x3d\x7b\x74\x6f\x70\x3a\x30\x2c\x6c\x65\x66\x74\x3a\x30\x7d\x3b\x72\x65\x74\x75\x72\x6e\x22\x66\x69\x78\x65\x64\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x63\x2c\x22\x70\x6f\x73\x69\x74\x69\x6f\x6e\x22\x29\x3f\x62\x3d\x63\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x3a\x28\x61\x3d\x74\x68\x69\x73\x2e\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x28\x29\x2c\x62\x3d\x74\x68\x69\x73\x2e\x6f\x66\x66\x73\x65\x74\x28\x29\x2c\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x5b\x30\x5d\x2c\x22\x68\x74\x6d\x6c\x22\x29\x7c\x7c\x28\x64\x3d\x61\x2e\x6f\x66\x66\x73\x65\x74\x28\x29\x29\x2c\x64\x3d\x7b\x74\x6f\x70\x3a\x64\x2e\x74\x6f\x70\x2b\x72\x2e\x63\x73\x73\x28\x61\x5b\x30\x5d\x2c\x22\x62\x6f\x72\x64\x65\x72\x54\x6f\x70\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x29\x2c\x6c\x65\x66\x74\x3a\x64\x2e\x6c\x65\x66\x74\x2b\x72\x2e\x63\x73\x73\x28\x61\x5b\x30\x5d\x2c\x22\x62\x6f\x72\x64\x65\x72\x4c\x65\x66\x74\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x29\x7d\x29\x2c\x7b\x74\x6f\x70\x3a\x62\x2e\x74\x6f\x70\x2d\x64\x2e\x74\x6f\x70\x2d\x72\x2e\x63\x73\x73\x28\x63\x2c\x22\x6d\x61\x72\x67\x69\x6e\x54\x6f\x70\x22\x2c\x21\x30\x29\x2c\x6c\x65\x66\x74\x3a\x62\x2e\x6c\x65\x66\x74\x2d\x64\x2e\x6c\x65\x66\x74\x2d\x72\x2e\x63\x73\x73\x28\x63\x2c\x22\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x22\x2c\x21\x30\x29\x7d\x7d\x7d\x2c\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x3b\x77\x68\x69\x6c\x65\x28\x61\x26\x26\x22\x73\x74\x61\x74\x69\x63\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x6f\x73\x69\x74\x69\x6f\x6e\x22\x29\x29\x61\x3d\x61\x2e\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7c\x7c\x71\x61\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x73\x63\x72\x6f\x6c\x6c\x4c\x65\x66\x74\x3a\x22\x70\x61\x67\x65\x58\x4f\x66\x66\x73\x65\x74\x22\x2c\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x3a\x22\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x22\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x22\x3d\x3d\x3d\x62\x3b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x53\x62\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x65\x3f\x66\x3f\x66\x5b\x62\x5d\x3a\x61\x5b\x64\x5d\x3a\x76\x6f\x69\x64\x28\x66\x3f\x66\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x28\x63\x3f\x66\x2e\x70\x61\x67\x65\x58\x4f\x66\x66\x73\x65\x74\x3a\x65\x2c\x63\x3f\x65\x3a\x66\x2e\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x29\x3a\x61\x5b\x64\x5d\x3d\x65\x29\x7d\x2c\x61\x2c\x64\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x74\x6f\x70\x22\x2c\x22\x6c\x65\x66\x74\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x3d\x4f\x61\x28\x6f\x2e\x70\x69\x78\x65\x6c\x50\x6f\x73\x69\x74\x69\x6f\x6e\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x69\x66\x28\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x4e\x61\x28\x61\x2c\x62\x29\x2c\x4c\x61\x2e\x74\x65\x73\x74\x28\x63\x29\x3f\x72\x28\x61\x29\x2e\x70\x6f\x73\x69\x74\x69\x6f\x6e\x28\x29\x5b\x62\x5d\x2b\x22\x70\x78\x22\x3a\x63\x7d\x29\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x48\x65\x69\x67\x68\x74\x3a\x22\x68\x65\x69\x67\x68\x74\x22\x2c\x57\x69\x64\x74\x68\x3a\x22\x77\x69\x64\x74\x68\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x65\x61\x63\x68\x28\x7b\x70\x61\x64\x64\x69\x6e\x67\x3a\x22\x69\x6e\x6e\x65\x72\x22\x2b\x61\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x62\x2c\x22\x22\x3a\x22\x6f\x75\x74\x65\x72\x22\x2b\x61\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x72\x2e\x66\x6e\x5b\x64\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x63\x7c\x7c\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x29\x2c\x68\x3d\x63\x7c\x7c\x28\x65\x3d\x3d\x3d\x21\x30\x7c\x7c\x66\x3d\x3d\x3d\x21\x30\x3f\x22\x6d\x61\x72\x67\x69\x6e\x22\x3a\x22\x62\x6f\x72\x64\x65\x72\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x62\x29\x3f\x30\x3d\x3d\x3d\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x6f\x75\x74\x65\x72\x22\x29\x3f\x62\x5b\x22\x69\x6e\x6e\x65\x72\x22\x2b\x61\x5d\x3a\x62\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x5b\x22\x63\x6c\x69\x65\x6e\x74\x22\x2b\x61\x5d\x3a\x39\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x28\x66\x3d\x62\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x62\x2e\x62\x6f\x64\x79\x5b\x22\x73\x63\x72\x6f\x6c\x6c\x22\x2b\x61\x5d\x2c\x66\x5b\x22\x73\x63\x72\x6f\x6c\x6c\x22\x2b\x61\x5d\x2c\x62\x2e\x62\x6f\x64\x79\x5b\x22\x6f\x66\x66\x73\x65\x74\x22\x2b\x61\x5d\x2c\x66\x5b\x22\x6f\x66\x66\x73\x65\x74\x22\x2b\x61\x5d\x2c\x66\x5b\x22\x63\x6c\x69\x65\x6e\x74\x22\x2b\x61\x5d\x29\x29\x3a\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x65\x3f\x72\x2e\x63\x73\x73\x28\x62\x2c\x63\x2c\x68\x29\x3a\x72\x2e\x73\x74\x79\x6c\x65\x28\x62\x2c\x63\x2c\x65\x2c\x68\x29\x7d\x2c\x62\x2c\x67\x3f\x65\x3a\x76\x6f\x69\x64\x20\x30\x2c\x67\x29\x7d\x7d\x29\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x62\x69\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x6e\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x62\x2c\x63\x29\x7d\x2c\x75\x6e\x62\x69\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x62\x29\x7d\x2c\x64\x65\x6c\x65\x67\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x6e\x28\x62\x2c\x61\x2c\x63\x2c\x64\x29\x7d\x2c\x75\x6e\x64\x65\x6c\x65\x67\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x61\x2c\x22\x2a\x2a\x22\x29\x3a\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x62\x2c\x61\x7c\x7c\x22\x2a\x2a\x22\x2c\x63\x29\x7d\x7d\x29\x2c\x72\x2e\x70\x61\x72\x73\x65\x4a\x53\x4f\x4e\x3d\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x2c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x64\x65\x66\x69\x6e\x65\x26\x26\x64\x65\x66\x69\x6e\x65\x2e\x61\x6d\x64\x26\x26\x64\x65\x66\x69\x6e\x65\x28\x22\x6a\x71\x75\x65\x72\x79\x22\x2c\x5b\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x7d\x29\x3b\x76\x61\x72\x20\x54\x62\x3d\x61\x2e\x6a\x51\x75\x65\x72\x79\x2c\x55\x62\x3d\x61\x2e\x24\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6e\x6f\x43\x6f\x6e\x66\x6c\x69\x63\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x24\x3d\x3d\x3d\x72\x26\x26\x28\x61\x2e\x24\x3d\x55\x62\x29\x2c\x62\x26\x26\x61\x2e\x6a\x51\x75\x65\x72\x79\x3d\x3d\x3d\x72\x26\x26\x28\x61\x2e\x6a\x51\x75\x65\x72\x79\x3d\x54\x62\x29\x2c\x72\x7d\x2c\x62\x7c\x7c\x28\x61\x2e\x6a\x51\x75\x65\x72\x79\x3d\x61\x2e\x24\x3d\x72\x29\x2c\x72\x7d\x29\x3b\x0a\x0a\x0a\x2f\x2f\x2f\x20\x64\x72\x61\x77\x20\x62\x61\x73\x69\x63\x20\x64\x6f\x63\x0a\x0a\x76\x61\x72\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x3b\x0a\x76\x61\x72\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x47\x72\x6f\x75\x70\x20\x3d\x20\x2d\x31\x3b\x0a\x76\x61\x72\x20\x77\x73\x6f\x63\x6b\x20\x3d\x20\x6e\x65\x77\x20\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x28\x22\x77\x73\x3a\x2f\x2f\x22\x2b\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x6f\x73\x74\x2b\x22\x2f\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x22\x29\x3b\x0a\x0a\x24\x2e\x67\x65\x74\x4a\x53\x4f\x4e\x28\x20\x22\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2e\x6a\x73\x6f\x6e\x22\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x20\x3d\x20\x64\x61\x74\x61\x3b\x0a\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x73\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x2f\x2f\x20\x63\x72\x65\x61\x74\x65\x20\x62\x75\x74\x74\x6f\x6e\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x20\x67\x72\x6f\x75\x70\x0a\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x64\x61\x74\x61\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x24\x28\x22\x3c\x6c\x69\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x22\x67\x22\x2b\x6b\x65\x79\x2c\x20\x74\x65\x78\x74\x3a\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x67\x42\x75\x74\x74\x6f\x6e\x22\x7d\x29\x2e\x63\x6c\x69\x63\x6b\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x20\x73\x68\x6f\x77\x47\x72\x6f\x75\x70\x28\x6b\x65\x79\x29\x3b\x20\x7d\x29\x2e\x61\x70\x70\x65\x6e\x64\x54\x6f\x28\x24\x28\x22\x23\x67\x72\x6f\x75\x70\x73\x22\x29\x29\x20\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x2f\x2f\x20\x61\x64\x64\x20\x44\x49\x56\x73\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x67\x72\x6f\x75\x70\x73\x2c\x20\x61\x6c\x6c\x20\x68\x69\x64\x64\x65\x6e\x20\x62\x79\x20\x64\x65\x66\x61\x75\x6c\x74\x0a\x20\x20\x24\x28\x22\x23\x63\x6c\x69\x65\x6e\x74\x73\x22\x29\x2e\x68\x74\x6d\x6c\x28\x22\x22\x29\x3b\x0a\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x20\x3d\x20\x6b\x65\x79\x3b\x20\x2f\x2f\x22\x63\x67\x72\x6f\x75\x70\x5f\x22\x20\x2b\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x20\x3d\x20\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x2c\x20\x74\x65\x78\x74\x3a\x20\x22\x22\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x47\x72\x6f\x75\x70\x22\x7d\x29\x20\x3b\x0a\x20\x20\x20\x20\x24\x28\x22\x23\x63\x6c\x69\x65\x6e\x74\x73\x22\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x67\x72\x6f\x75\x70\x44\x69\x76\x29\x3b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x5b\x6b\x65\x79\x5d\x2e\x48\x6f\x73\x74\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x2c\x20\x76\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x20\x3d\x20\x24\x28\x22\x3c\x6c\x69\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x76\x2c\x20\x74\x65\x78\x74\x3a\x20\x76\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x42\x75\x74\x74\x6f\x6e\x22\x7d\x29\x20\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x22\x23\x22\x2b\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x69\x74\x65\x6d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x2f\x2f\x20\x67\x65\x74\x2f\x73\x65\x74\x20\x69\x6e\x69\x74\x69\x61\x6c\x20\x73\x74\x61\x74\x65\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x75\x70\x6f\x6e\x20\x70\x61\x67\x65\x20\x6c\x6f\x61\x64\x0a\x20\x20\x24\x2e\x67\x65\x74\x4a\x53\x4f\x4e\x28\x20\x22\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x53\x74\x61\x74\x69\x2e\x6a\x73\x6f\x6e\x22\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x64\x61\x74\x61\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x68\x6f\x73\x74\x2c\x20\x68\x6f\x73\x74\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x65\x6c\x65\x63\x74\x6f\x72\x20\x3d\x20\x68\x6f\x73\x74\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x2f\x5c\x2e\x2f\x67\x2c\x22\x5c\x5c\x2e\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x22\x23\x22\x2b\x73\x65\x6c\x65\x63\x74\x6f\x72\x29\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x2e\x53\x74\x61\x74\x75\x73\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x7d\x29\x3b\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x47\x72\x6f\x75\x70\x28\x6e\x29\x20\x7b\x0a\x20\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x47\x72\x6f\x75\x70\x20\x3d\x20\x6e\x3b\x0a\x20\x20\x24\x28\x22\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x64\x69\x76\x2e\x63\x47\x72\x6f\x75\x70\x22\x29\x2e\x68\x69\x64\x65\x28\x29\x3b\x0a\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x20\x3d\x20\x6e\x3b\x20\x2f\x2f\x22\x63\x67\x72\x6f\x75\x70\x5f\x22\x20\x2b\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x5b\x6e\x5d\x2e\x4e\x61\x6d\x65\x3b\x0a\x20\x20\x24\x28\x22\x23\x22\x2b\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x29\x2e\x73\x68\x6f\x77\x28\x29\x3b\x0a\x7d\x0a\x0a\x77\x73\x6f\x63\x6b\x2e\x6f\x6e\x63\x6c\x6f\x73\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x2f\x2f\x61\x6c\x65\x72\x74\x28\x22\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x20\x63\x6c\x6f\x73\x65\x64\x20\x2d\x20\x73\x65\x72\x76\x65\x72\x20\x67\x6f\x6e\x65\x2e\x2e\x2e\x3f\x22\x29\x3b\x0a\x20\x20\x24\x28\x20\x22\x23\x77\x65\x62\x73\x6f\x63\x6b\x42\x72\x6f\x6b\x65\x6e\x22\x20\x29\x2e\x73\x68\x6f\x77\x28\x29\x3b\x0a\x7d\x3b\x0a\x0a\x77\x73\x6f\x63\x6b\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x61\x6c\x65\x72\x74\x28\x22\x43\x61\x6e\x6e\x6f\x74\x20\x6f\x70\x65\x6e\x20\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x22\x29\x3b\x0a\x7d\x3b\x0a\x0a\x77\x73\x6f\x63\x6b\x2e\x6f\x6e\x6d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x3d\x20\x24\x2e\x70\x61\x72\x73\x65\x4a\x53\x4f\x4e\x28\x20\x65\x76\x65\x6e\x74\x2e\x64\x61\x74\x61\x20\x29\x3b\x0a\x20\x20\x76\x61\x72\x20\x68\x6f\x73\x74\x61\x64\x64\x72\x20\x3d\x20\x64\x61\x74\x61\x2e\x41\x64\x64\x72\x65\x73\x73\x3b\x0a\x20\x20\x76\x61\x72\x20\x73\x65\x6c\x65\x63\x74\x6f\x72\x20\x3d\x20\x68\x6f\x73\x74\x61\x64\x64\x72\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x2f\x5c\x2e\x2f\x67\x2c\x22\x5c\x5c\x2e\x22\x29\x3b\x0a\x20\x20\x24\x28\x22\x23\x22\x2b\x73\x65\x6c\x65\x63\x74\x6f\x72\x29\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x22\x4e\x4f\x4e\x45\x20\x42\x55\x53\x59\x20\x57\x41\x49\x54\x20\x45\x52\x52\x4f\x52\x20\x44\x4f\x4e\x45\x22\x29\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x64\x61\x74\x61\x2e\x53\x74\x61\x74\x75\x73\x29\x3b\x0a\x7d\x0a"
+var _assetsAppJs = "\x2f\x2a\x21\x20\x6a\x51\x75\x65\x72\x79\x20\x76\x33\x2e\x31\x2e\x31\x20\x7c\x20\x28\x63\x29\x20\x6a\x51\x75\x65\x72\x79\x20\x46\x6f\x75\x6e\x64\x61\x74\x69\x6f\x6e\x20\x7c\x20\x6a\x71\x75\x65\x72\x79\x2e\x6f\x72\x67\x2f\x6c\x69\x63\x65\x6e\x73\x65\x20\x2a\x2f\x0a\x21\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x22\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x22\x3b\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x6d\x6f\x64\x75\x6c\x65\x26\x26\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x6d\x6f\x64\x75\x6c\x65\x2e\x65\x78\x70\x6f\x72\x74\x73\x3f\x6d\x6f\x64\x75\x6c\x65\x2e\x65\x78\x70\x6f\x72\x74\x73\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x3f\x62\x28\x61\x2c\x21\x30\x29\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x21\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x29\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x45\x72\x72\x6f\x72\x28\x22\x6a\x51\x75\x65\x72\x79\x20\x72\x65\x71\x75\x69\x72\x65\x73\x20\x61\x20\x77\x69\x6e\x64\x6f\x77\x20\x77\x69\x74\x68\x20\x61\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x61\x29\x7d\x3a\x62\x28\x61\x29\x7d\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x77\x69\x6e\x64\x6f\x77\x3f\x77\x69\x6e\x64\x6f\x77\x3a\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x22\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x22\x3b\x76\x61\x72\x20\x63\x3d\x5b\x5d\x2c\x64\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x65\x3d\x4f\x62\x6a\x65\x63\x74\x2e\x67\x65\x74\x50\x72\x6f\x74\x6f\x74\x79\x70\x65\x4f\x66\x2c\x66\x3d\x63\x2e\x73\x6c\x69\x63\x65\x2c\x67\x3d\x63\x2e\x63\x6f\x6e\x63\x61\x74\x2c\x68\x3d\x63\x2e\x70\x75\x73\x68\x2c\x69\x3d\x63\x2e\x69\x6e\x64\x65\x78\x4f\x66\x2c\x6a\x3d\x7b\x7d\x2c\x6b\x3d\x6a\x2e\x74\x6f\x53\x74\x72\x69\x6e\x67\x2c\x6c\x3d\x6a\x2e\x68\x61\x73\x4f\x77\x6e\x50\x72\x6f\x70\x65\x72\x74\x79\x2c\x6d\x3d\x6c\x2e\x74\x6f\x53\x74\x72\x69\x6e\x67\x2c\x6e\x3d\x6d\x2e\x63\x61\x6c\x6c\x28\x4f\x62\x6a\x65\x63\x74\x29\x2c\x6f\x3d\x7b\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x28\x61\x2c\x62\x29\x7b\x62\x3d\x62\x7c\x7c\x64\x3b\x76\x61\x72\x20\x63\x3d\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x73\x63\x72\x69\x70\x74\x22\x29\x3b\x63\x2e\x74\x65\x78\x74\x3d\x61\x2c\x62\x2e\x68\x65\x61\x64\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x63\x29\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x63\x29\x7d\x76\x61\x72\x20\x71\x3d\x22\x33\x2e\x31\x2e\x31\x22\x2c\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x72\x2e\x66\x6e\x2e\x69\x6e\x69\x74\x28\x61\x2c\x62\x29\x7d\x2c\x73\x3d\x2f\x5e\x5b\x5c\x73\x5c\x75\x46\x45\x46\x46\x5c\x78\x41\x30\x5d\x2b\x7c\x5b\x5c\x73\x5c\x75\x46\x45\x46\x46\x5c\x78\x41\x30\x5d\x2b\x24\x2f\x67\x2c\x74\x3d\x2f\x5e\x2d\x6d\x73\x2d\x2f\x2c\x75\x3d\x2f\x2d\x28\x5b\x61\x2d\x7a\x5d\x29\x2f\x67\x2c\x76\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x74\x6f\x55\x70\x70\x65\x72\x43\x61\x73\x65\x28\x29\x7d\x3b\x72\x2e\x66\x6e\x3d\x72\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x6a\x71\x75\x65\x72\x79\x3a\x71\x2c\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x3a\x72\x2c\x6c\x65\x6e\x67\x74\x68\x3a\x30\x2c\x74\x6f\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x7d\x2c\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x66\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x3a\x61\x3c\x30\x3f\x74\x68\x69\x73\x5b\x61\x2b\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x5d\x3a\x74\x68\x69\x73\x5b\x61\x5d\x7d\x2c\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x6d\x65\x72\x67\x65\x28\x74\x68\x69\x73\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x28\x29\x2c\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x3d\x74\x68\x69\x73\x2c\x62\x7d\x2c\x65\x61\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x2c\x6d\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x72\x2e\x6d\x61\x70\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x63\x2c\x62\x29\x7d\x29\x29\x7d\x2c\x73\x6c\x69\x63\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x66\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x29\x7d\x2c\x66\x69\x72\x73\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x71\x28\x30\x29\x7d\x2c\x6c\x61\x73\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x71\x28\x2d\x31\x29\x7d\x2c\x65\x71\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x2b\x61\x2b\x28\x61\x3c\x30\x3f\x62\x3a\x30\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x63\x3e\x3d\x30\x26\x26\x63\x3c\x62\x3f\x5b\x74\x68\x69\x73\x5b\x63\x5d\x5d\x3a\x5b\x5d\x29\x7d\x2c\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x7c\x7c\x74\x68\x69\x73\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x28\x29\x7d\x2c\x70\x75\x73\x68\x3a\x68\x2c\x73\x6f\x72\x74\x3a\x63\x2e\x73\x6f\x72\x74\x2c\x73\x70\x6c\x69\x63\x65\x3a\x63\x2e\x73\x70\x6c\x69\x63\x65\x7d\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x3d\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x30\x5d\x7c\x7c\x7b\x7d\x2c\x68\x3d\x31\x2c\x69\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6a\x3d\x21\x31\x3b\x66\x6f\x72\x28\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x67\x26\x26\x28\x6a\x3d\x67\x2c\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x68\x5d\x7c\x7c\x7b\x7d\x2c\x68\x2b\x2b\x29\x2c\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x67\x7c\x7c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x67\x29\x7c\x7c\x28\x67\x3d\x7b\x7d\x29\x2c\x68\x3d\x3d\x3d\x69\x26\x26\x28\x67\x3d\x74\x68\x69\x73\x2c\x68\x2d\x2d\x29\x3b\x68\x3c\x69\x3b\x68\x2b\x2b\x29\x69\x66\x28\x6e\x75\x6c\x6c\x21\x3d\x28\x61\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x68\x5d\x29\x29\x66\x6f\x72\x28\x62\x20\x69\x6e\x20\x61\x29\x63\x3d\x67\x5b\x62\x5d\x2c\x64\x3d\x61\x5b\x62\x5d\x2c\x67\x21\x3d\x3d\x64\x26\x26\x28\x6a\x26\x26\x64\x26\x26\x28\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x64\x29\x7c\x7c\x28\x65\x3d\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x64\x29\x29\x29\x3f\x28\x65\x3f\x28\x65\x3d\x21\x31\x2c\x66\x3d\x63\x26\x26\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x63\x29\x3f\x63\x3a\x5b\x5d\x29\x3a\x66\x3d\x63\x26\x26\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x63\x29\x3f\x63\x3a\x7b\x7d\x2c\x67\x5b\x62\x5d\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x6a\x2c\x66\x2c\x64\x29\x29\x3a\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x64\x26\x26\x28\x67\x5b\x62\x5d\x3d\x64\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x7d\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x65\x78\x70\x61\x6e\x64\x6f\x3a\x22\x6a\x51\x75\x65\x72\x79\x22\x2b\x28\x71\x2b\x4d\x61\x74\x68\x2e\x72\x61\x6e\x64\x6f\x6d\x28\x29\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x2f\x5c\x44\x2f\x67\x2c\x22\x22\x29\x2c\x69\x73\x52\x65\x61\x64\x79\x3a\x21\x30\x2c\x65\x72\x72\x6f\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x45\x72\x72\x6f\x72\x28\x61\x29\x7d\x2c\x6e\x6f\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x7d\x2c\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x61\x29\x7d\x2c\x69\x73\x41\x72\x72\x61\x79\x3a\x41\x72\x72\x61\x79\x2e\x69\x73\x41\x72\x72\x61\x79\x2c\x69\x73\x57\x69\x6e\x64\x6f\x77\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x61\x3d\x3d\x3d\x61\x2e\x77\x69\x6e\x64\x6f\x77\x7d\x2c\x69\x73\x4e\x75\x6d\x65\x72\x69\x63\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x74\x79\x70\x65\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x28\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x3d\x62\x7c\x7c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x62\x29\x26\x26\x21\x69\x73\x4e\x61\x4e\x28\x61\x2d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x61\x29\x29\x7d\x2c\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3b\x72\x65\x74\x75\x72\x6e\x21\x28\x21\x61\x7c\x7c\x22\x5b\x6f\x62\x6a\x65\x63\x74\x20\x4f\x62\x6a\x65\x63\x74\x5d\x22\x21\x3d\x3d\x6b\x2e\x63\x61\x6c\x6c\x28\x61\x29\x29\x26\x26\x28\x21\x28\x62\x3d\x65\x28\x61\x29\x29\x7c\x7c\x28\x63\x3d\x6c\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x22\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x22\x29\x26\x26\x62\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x2c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x26\x26\x6d\x2e\x63\x61\x6c\x6c\x28\x63\x29\x3d\x3d\x3d\x6e\x29\x29\x7d\x2c\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x66\x6f\x72\x28\x62\x20\x69\x6e\x20\x61\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x2c\x74\x79\x70\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x61\x2b\x22\x22\x3a\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x7c\x7c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x6a\x5b\x6b\x2e\x63\x61\x6c\x6c\x28\x61\x29\x5d\x7c\x7c\x22\x6f\x62\x6a\x65\x63\x74\x22\x3a\x74\x79\x70\x65\x6f\x66\x20\x61\x7d\x2c\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x70\x28\x61\x29\x7d\x2c\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x74\x2c\x22\x6d\x73\x2d\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x75\x2c\x76\x29\x7d\x2c\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x26\x26\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x7d\x2c\x65\x61\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x3b\x69\x66\x28\x77\x28\x61\x29\x29\x7b\x66\x6f\x72\x28\x63\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x63\x3b\x64\x2b\x2b\x29\x69\x66\x28\x62\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x64\x5d\x2c\x64\x2c\x61\x5b\x64\x5d\x29\x3d\x3d\x3d\x21\x31\x29\x62\x72\x65\x61\x6b\x7d\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x61\x29\x69\x66\x28\x62\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x64\x5d\x2c\x64\x2c\x61\x5b\x64\x5d\x29\x3d\x3d\x3d\x21\x31\x29\x62\x72\x65\x61\x6b\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x2c\x74\x72\x69\x6d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x22\x22\x3a\x28\x61\x2b\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x73\x2c\x22\x22\x29\x7d\x2c\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x7c\x7c\x5b\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x28\x77\x28\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x29\x3f\x72\x2e\x6d\x65\x72\x67\x65\x28\x63\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x5b\x61\x5d\x3a\x61\x29\x3a\x68\x2e\x63\x61\x6c\x6c\x28\x63\x2c\x61\x29\x29\x2c\x63\x7d\x2c\x69\x6e\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x62\x3f\x2d\x31\x3a\x69\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x61\x2c\x63\x29\x7d\x2c\x6d\x65\x72\x67\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x2b\x62\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x64\x3d\x30\x2c\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x63\x3b\x64\x2b\x2b\x29\x61\x5b\x65\x2b\x2b\x5d\x3d\x62\x5b\x64\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x65\x2c\x61\x7d\x2c\x67\x72\x65\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x2c\x65\x3d\x5b\x5d\x2c\x66\x3d\x30\x2c\x67\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x68\x3d\x21\x63\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x64\x3d\x21\x62\x28\x61\x5b\x66\x5d\x2c\x66\x29\x2c\x64\x21\x3d\x3d\x68\x26\x26\x65\x2e\x70\x75\x73\x68\x28\x61\x5b\x66\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x2c\x6d\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x30\x2c\x68\x3d\x5b\x5d\x3b\x69\x66\x28\x77\x28\x61\x29\x29\x66\x6f\x72\x28\x64\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x3c\x64\x3b\x66\x2b\x2b\x29\x65\x3d\x62\x28\x61\x5b\x66\x5d\x2c\x66\x2c\x63\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x65\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x65\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x66\x20\x69\x6e\x20\x61\x29\x65\x3d\x62\x28\x61\x5b\x66\x5d\x2c\x66\x2c\x63\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x65\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x61\x70\x70\x6c\x79\x28\x5b\x5d\x2c\x68\x29\x7d\x2c\x67\x75\x69\x64\x3a\x31\x2c\x70\x72\x6f\x78\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x61\x5b\x62\x5d\x2c\x62\x3d\x61\x2c\x61\x3d\x63\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3d\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x32\x29\x2c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x61\x70\x70\x6c\x79\x28\x62\x7c\x7c\x74\x68\x69\x73\x2c\x64\x2e\x63\x6f\x6e\x63\x61\x74\x28\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x29\x29\x7d\x2c\x65\x2e\x67\x75\x69\x64\x3d\x61\x2e\x67\x75\x69\x64\x3d\x61\x2e\x67\x75\x69\x64\x7c\x7c\x72\x2e\x67\x75\x69\x64\x2b\x2b\x2c\x65\x7d\x2c\x6e\x6f\x77\x3a\x44\x61\x74\x65\x2e\x6e\x6f\x77\x2c\x73\x75\x70\x70\x6f\x72\x74\x3a\x6f\x7d\x29\x2c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x53\x79\x6d\x62\x6f\x6c\x26\x26\x28\x72\x2e\x66\x6e\x5b\x53\x79\x6d\x62\x6f\x6c\x2e\x69\x74\x65\x72\x61\x74\x6f\x72\x5d\x3d\x63\x5b\x53\x79\x6d\x62\x6f\x6c\x2e\x69\x74\x65\x72\x61\x74\x6f\x72\x5d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x22\x42\x6f\x6f\x6c\x65\x61\x6e\x20\x4e\x75\x6d\x62\x65\x72\x20\x53\x74\x72\x69\x6e\x67\x20\x46\x75\x6e\x63\x74\x69\x6f\x6e\x20\x41\x72\x72\x61\x79\x20\x44\x61\x74\x65\x20\x52\x65\x67\x45\x78\x70\x20\x4f\x62\x6a\x65\x63\x74\x20\x45\x72\x72\x6f\x72\x20\x53\x79\x6d\x62\x6f\x6c\x22\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x6a\x5b\x22\x5b\x6f\x62\x6a\x65\x63\x74\x20\x22\x2b\x62\x2b\x22\x5d\x22\x5d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x21\x21\x61\x26\x26\x22\x6c\x65\x6e\x67\x74\x68\x22\x69\x6e\x20\x61\x26\x26\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x72\x2e\x74\x79\x70\x65\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x21\x3d\x3d\x63\x26\x26\x21\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x61\x29\x26\x26\x28\x22\x61\x72\x72\x61\x79\x22\x3d\x3d\x3d\x63\x7c\x7c\x30\x3d\x3d\x3d\x62\x7c\x7c\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x62\x3e\x30\x26\x26\x62\x2d\x31\x20\x69\x6e\x20\x61\x29\x7d\x76\x61\x72\x20\x78\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x2c\x71\x2c\x72\x2c\x73\x2c\x74\x2c\x75\x3d\x22\x73\x69\x7a\x7a\x6c\x65\x22\x2b\x31\x2a\x6e\x65\x77\x20\x44\x61\x74\x65\x2c\x76\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x77\x3d\x30\x2c\x78\x3d\x30\x2c\x79\x3d\x68\x61\x28\x29\x2c\x7a\x3d\x68\x61\x28\x29\x2c\x41\x3d\x68\x61\x28\x29\x2c\x42\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x26\x26\x28\x6c\x3d\x21\x30\x29\x2c\x30\x7d\x2c\x43\x3d\x7b\x7d\x2e\x68\x61\x73\x4f\x77\x6e\x50\x72\x6f\x70\x65\x72\x74\x79\x2c\x44\x3d\x5b\x5d\x2c\x45\x3d\x44\x2e\x70\x6f\x70\x2c\x46\x3d\x44\x2e\x70\x75\x73\x68\x2c\x47\x3d\x44\x2e\x70\x75\x73\x68\x2c\x48\x3d\x44\x2e\x73\x6c\x69\x63\x65\x2c\x49\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x30\x2c\x64\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x69\x66\x28\x61\x5b\x63\x5d\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3b\x72\x65\x74\x75\x72\x6e\x2d\x31\x7d\x2c\x4a\x3d\x22\x63\x68\x65\x63\x6b\x65\x64\x7c\x73\x65\x6c\x65\x63\x74\x65\x64\x7c\x61\x73\x79\x6e\x63\x7c\x61\x75\x74\x6f\x66\x6f\x63\x75\x73\x7c\x61\x75\x74\x6f\x70\x6c\x61\x79\x7c\x63\x6f\x6e\x74\x72\x6f\x6c\x73\x7c\x64\x65\x66\x65\x72\x7c\x64\x69\x73\x61\x62\x6c\x65\x64\x7c\x68\x69\x64\x64\x65\x6e\x7c\x69\x73\x6d\x61\x70\x7c\x6c\x6f\x6f\x70\x7c\x6d\x75\x6c\x74\x69\x70\x6c\x65\x7c\x6f\x70\x65\x6e\x7c\x72\x65\x61\x64\x6f\x6e\x6c\x79\x7c\x72\x65\x71\x75\x69\x72\x65\x64\x7c\x73\x63\x6f\x70\x65\x64\x22\x2c\x4b\x3d\x22\x5b\x5c\x5c\x78\x32\x30\x5c\x5c\x74\x5c\x5c\x72\x5c\x5c\x6e\x5c\x5c\x66\x5d\x22\x2c\x4c\x3d\x22\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5c\x5c\x77\x2d\x5d\x7c\x5b\x5e\x5c\x30\x2d\x5c\x5c\x78\x61\x30\x5d\x29\x2b\x22\x2c\x4d\x3d\x22\x5c\x5c\x5b\x22\x2b\x4b\x2b\x22\x2a\x28\x22\x2b\x4c\x2b\x22\x29\x28\x3f\x3a\x22\x2b\x4b\x2b\x22\x2a\x28\x5b\x2a\x5e\x24\x7c\x21\x7e\x5d\x3f\x3d\x29\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x27\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x27\x5d\x29\x2a\x29\x27\x7c\x5c\x22\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x5c\x22\x5d\x29\x2a\x29\x5c\x22\x7c\x28\x22\x2b\x4c\x2b\x22\x29\x29\x7c\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x5d\x22\x2c\x4e\x3d\x22\x3a\x28\x22\x2b\x4c\x2b\x22\x29\x28\x3f\x3a\x5c\x5c\x28\x28\x28\x27\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x27\x5d\x29\x2a\x29\x27\x7c\x5c\x22\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x5c\x22\x5d\x29\x2a\x29\x5c\x22\x29\x7c\x28\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x28\x29\x5b\x5c\x5c\x5d\x5d\x7c\x22\x2b\x4d\x2b\x22\x29\x2a\x29\x7c\x2e\x2a\x29\x5c\x5c\x29\x7c\x29\x22\x2c\x4f\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x4b\x2b\x22\x2b\x22\x2c\x22\x67\x22\x29\x2c\x50\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2b\x7c\x28\x28\x3f\x3a\x5e\x7c\x5b\x5e\x5c\x5c\x5c\x5c\x5d\x29\x28\x3f\x3a\x5c\x5c\x5c\x5c\x2e\x29\x2a\x29\x22\x2b\x4b\x2b\x22\x2b\x24\x22\x2c\x22\x67\x22\x29\x2c\x51\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2a\x2c\x22\x2b\x4b\x2b\x22\x2a\x22\x29\x2c\x52\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2a\x28\x5b\x3e\x2b\x7e\x5d\x7c\x22\x2b\x4b\x2b\x22\x29\x22\x2b\x4b\x2b\x22\x2a\x22\x29\x2c\x53\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x3d\x22\x2b\x4b\x2b\x22\x2a\x28\x5b\x5e\x5c\x5c\x5d\x27\x5c\x22\x5d\x2a\x3f\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x5d\x22\x2c\x22\x67\x22\x29\x2c\x54\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x4e\x29\x2c\x55\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4c\x2b\x22\x24\x22\x29\x2c\x56\x3d\x7b\x49\x44\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x23\x28\x22\x2b\x4c\x2b\x22\x29\x22\x29\x2c\x43\x4c\x41\x53\x53\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x5c\x5c\x2e\x28\x22\x2b\x4c\x2b\x22\x29\x22\x29\x2c\x54\x41\x47\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x22\x2b\x4c\x2b\x22\x7c\x5b\x2a\x5d\x29\x22\x29\x2c\x41\x54\x54\x52\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4d\x29\x2c\x50\x53\x45\x55\x44\x4f\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4e\x29\x2c\x43\x48\x49\x4c\x44\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x3a\x28\x6f\x6e\x6c\x79\x7c\x66\x69\x72\x73\x74\x7c\x6c\x61\x73\x74\x7c\x6e\x74\x68\x7c\x6e\x74\x68\x2d\x6c\x61\x73\x74\x29\x2d\x28\x63\x68\x69\x6c\x64\x7c\x6f\x66\x2d\x74\x79\x70\x65\x29\x28\x3f\x3a\x5c\x5c\x28\x22\x2b\x4b\x2b\x22\x2a\x28\x65\x76\x65\x6e\x7c\x6f\x64\x64\x7c\x28\x28\x5b\x2b\x2d\x5d\x7c\x29\x28\x5c\x5c\x64\x2a\x29\x6e\x7c\x29\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x28\x5b\x2b\x2d\x5d\x7c\x29\x22\x2b\x4b\x2b\x22\x2a\x28\x5c\x5c\x64\x2b\x29\x7c\x29\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x29\x7c\x29\x22\x2c\x22\x69\x22\x29\x2c\x62\x6f\x6f\x6c\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x3f\x3a\x22\x2b\x4a\x2b\x22\x29\x24\x22\x2c\x22\x69\x22\x29\x2c\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x3a\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x22\x2b\x4b\x2b\x22\x2a\x5b\x3e\x2b\x7e\x5d\x7c\x3a\x28\x65\x76\x65\x6e\x7c\x6f\x64\x64\x7c\x65\x71\x7c\x67\x74\x7c\x6c\x74\x7c\x6e\x74\x68\x7c\x66\x69\x72\x73\x74\x7c\x6c\x61\x73\x74\x29\x28\x3f\x3a\x5c\x5c\x28\x22\x2b\x4b\x2b\x22\x2a\x28\x28\x3f\x3a\x2d\x5c\x5c\x64\x29\x3f\x5c\x5c\x64\x2a\x29\x22\x2b\x4b\x2b\x22\x2a\x5c\x5c\x29\x7c\x29\x28\x3f\x3d\x5b\x5e\x2d\x5d\x7c\x24\x29\x22\x2c\x22\x69\x22\x29\x7d\x2c\x57\x3d\x2f\x5e\x28\x3f\x3a\x69\x6e\x70\x75\x74\x7c\x73\x65\x6c\x65\x63\x74\x7c\x74\x65\x78\x74\x61\x72\x65\x61\x7c\x62\x75\x74\x74\x6f\x6e\x29\x24\x2f\x69\x2c\x58\x3d\x2f\x5e\x68\x5c\x64\x24\x2f\x69\x2c\x59\x3d\x2f\x5e\x5b\x5e\x7b\x5d\x2b\x5c\x7b\x5c\x73\x2a\x5c\x5b\x6e\x61\x74\x69\x76\x65\x20\x5c\x77\x2f\x2c\x5a\x3d\x2f\x5e\x28\x3f\x3a\x23\x28\x5b\x5c\x77\x2d\x5d\x2b\x29\x7c\x28\x5c\x77\x2b\x29\x7c\x5c\x2e\x28\x5b\x5c\x77\x2d\x5d\x2b\x29\x29\x24\x2f\x2c\x24\x3d\x2f\x5b\x2b\x7e\x5d\x2f\x2c\x5f\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5c\x5c\x5c\x5c\x28\x5b\x5c\x5c\x64\x61\x2d\x66\x5d\x7b\x31\x2c\x36\x7d\x22\x2b\x4b\x2b\x22\x3f\x7c\x28\x22\x2b\x4b\x2b\x22\x29\x7c\x2e\x29\x22\x2c\x22\x69\x67\x22\x29\x2c\x61\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x22\x30\x78\x22\x2b\x62\x2d\x36\x35\x35\x33\x36\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x21\x3d\x3d\x64\x7c\x7c\x63\x3f\x62\x3a\x64\x3c\x30\x3f\x53\x74\x72\x69\x6e\x67\x2e\x66\x72\x6f\x6d\x43\x68\x61\x72\x43\x6f\x64\x65\x28\x64\x2b\x36\x35\x35\x33\x36\x29\x3a\x53\x74\x72\x69\x6e\x67\x2e\x66\x72\x6f\x6d\x43\x68\x61\x72\x43\x6f\x64\x65\x28\x64\x3e\x3e\x31\x30\x7c\x35\x35\x32\x39\x36\x2c\x31\x30\x32\x33\x26\x64\x7c\x35\x36\x33\x32\x30\x29\x7d\x2c\x62\x61\x3d\x2f\x28\x5b\x5c\x30\x2d\x5c\x78\x31\x66\x5c\x78\x37\x66\x5d\x7c\x5e\x2d\x3f\x5c\x64\x29\x7c\x5e\x2d\x24\x7c\x5b\x5e\x5c\x30\x2d\x5c\x78\x31\x66\x5c\x78\x37\x66\x2d\x5c\x75\x46\x46\x46\x46\x5c\x77\x2d\x5d\x2f\x67\x2c\x63\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x22\x5c\x30\x22\x3d\x3d\x3d\x61\x3f\x22\x5c\x75\x66\x66\x66\x64\x22\x3a\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x2d\x31\x29\x2b\x22\x5c\x5c\x22\x2b\x61\x2e\x63\x68\x61\x72\x43\x6f\x64\x65\x41\x74\x28\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x29\x2e\x74\x6f\x53\x74\x72\x69\x6e\x67\x28\x31\x36\x29\x2b\x22\x20\x22\x3a\x22\x5c\x5c\x22\x2b\x61\x7d\x2c\x64\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6d\x28\x29\x7d\x2c\x65\x61\x3d\x74\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x21\x30\x26\x26\x28\x22\x66\x6f\x72\x6d\x22\x69\x6e\x20\x61\x7c\x7c\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x61\x29\x7d\x2c\x7b\x64\x69\x72\x3a\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x2c\x6e\x65\x78\x74\x3a\x22\x6c\x65\x67\x65\x6e\x64\x22\x7d\x29\x3b\x74\x72\x79\x7b\x47\x2e\x61\x70\x70\x6c\x79\x28\x44\x3d\x48\x2e\x63\x61\x6c\x6c\x28\x76\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x2c\x76\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x2c\x44\x5b\x76\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x2e\x6c\x65\x6e\x67\x74\x68\x5d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x63\x61\x74\x63\x68\x28\x66\x61\x29\x7b\x47\x3d\x7b\x61\x70\x70\x6c\x79\x3a\x44\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x46\x2e\x61\x70\x70\x6c\x79\x28\x61\x2c\x48\x2e\x63\x61\x6c\x6c\x28\x62\x29\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x64\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x61\x5b\x63\x2b\x2b\x5d\x3d\x62\x5b\x64\x2b\x2b\x5d\x29\x3b\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x63\x2d\x31\x7d\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x61\x28\x61\x2c\x62\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x68\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6f\x2c\x72\x2c\x73\x3d\x62\x26\x26\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x77\x3d\x62\x3f\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3a\x39\x3b\x69\x66\x28\x64\x3d\x64\x7c\x7c\x5b\x5d\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x7c\x7c\x21\x61\x7c\x7c\x31\x21\x3d\x3d\x77\x26\x26\x39\x21\x3d\x3d\x77\x26\x26\x31\x31\x21\x3d\x3d\x77\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x69\x66\x28\x21\x65\x26\x26\x28\x28\x62\x3f\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x62\x3a\x76\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x62\x29\x2c\x62\x3d\x62\x7c\x7c\x6e\x2c\x70\x29\x29\x7b\x69\x66\x28\x31\x31\x21\x3d\x3d\x77\x26\x26\x28\x6c\x3d\x5a\x2e\x65\x78\x65\x63\x28\x61\x29\x29\x29\x69\x66\x28\x66\x3d\x6c\x5b\x31\x5d\x29\x7b\x69\x66\x28\x39\x3d\x3d\x3d\x77\x29\x7b\x69\x66\x28\x21\x28\x6a\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x66\x29\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x69\x66\x28\x6a\x2e\x69\x64\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x70\x75\x73\x68\x28\x6a\x29\x2c\x64\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x73\x26\x26\x28\x6a\x3d\x73\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x66\x29\x29\x26\x26\x74\x28\x62\x2c\x6a\x29\x26\x26\x6a\x2e\x69\x64\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x70\x75\x73\x68\x28\x6a\x29\x2c\x64\x7d\x65\x6c\x73\x65\x7b\x69\x66\x28\x6c\x5b\x32\x5d\x29\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x61\x29\x29\x2c\x64\x3b\x69\x66\x28\x28\x66\x3d\x6c\x5b\x33\x5d\x29\x26\x26\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x28\x66\x29\x29\x2c\x64\x7d\x69\x66\x28\x63\x2e\x71\x73\x61\x26\x26\x21\x41\x5b\x61\x2b\x22\x20\x22\x5d\x26\x26\x28\x21\x71\x7c\x7c\x21\x71\x2e\x74\x65\x73\x74\x28\x61\x29\x29\x29\x7b\x69\x66\x28\x31\x21\x3d\x3d\x77\x29\x73\x3d\x62\x2c\x72\x3d\x61\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x7b\x28\x6b\x3d\x62\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x29\x29\x3f\x6b\x3d\x6b\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x62\x61\x2c\x63\x61\x29\x3a\x62\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x2c\x6b\x3d\x75\x29\x2c\x6f\x3d\x67\x28\x61\x29\x2c\x68\x3d\x6f\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x68\x2d\x2d\x29\x6f\x5b\x68\x5d\x3d\x22\x23\x22\x2b\x6b\x2b\x22\x20\x22\x2b\x73\x61\x28\x6f\x5b\x68\x5d\x29\x3b\x72\x3d\x6f\x2e\x6a\x6f\x69\x6e\x28\x22\x2c\x22\x29\x2c\x73\x3d\x24\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x71\x61\x28\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x7c\x7c\x62\x7d\x69\x66\x28\x72\x29\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x73\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x72\x29\x29\x2c\x64\x7d\x63\x61\x74\x63\x68\x28\x78\x29\x7b\x7d\x66\x69\x6e\x61\x6c\x6c\x79\x7b\x6b\x3d\x3d\x3d\x75\x26\x26\x62\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x29\x7d\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x69\x28\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x24\x31\x22\x29\x2c\x62\x2c\x64\x2c\x65\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x61\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x5b\x5d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x28\x63\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x70\x75\x73\x68\x28\x63\x2b\x22\x20\x22\x29\x3e\x64\x2e\x63\x61\x63\x68\x65\x4c\x65\x6e\x67\x74\x68\x26\x26\x64\x65\x6c\x65\x74\x65\x20\x62\x5b\x61\x2e\x73\x68\x69\x66\x74\x28\x29\x5d\x2c\x62\x5b\x63\x2b\x22\x20\x22\x5d\x3d\x65\x7d\x72\x65\x74\x75\x72\x6e\x20\x62\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x69\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x75\x5d\x3d\x21\x30\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6a\x61\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x6e\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x66\x69\x65\x6c\x64\x73\x65\x74\x22\x29\x3b\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x61\x28\x62\x29\x7d\x63\x61\x74\x63\x68\x28\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x66\x69\x6e\x61\x6c\x6c\x79\x7b\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x62\x29\x2c\x62\x3d\x6e\x75\x6c\x6c\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6b\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x73\x70\x6c\x69\x74\x28\x22\x7c\x22\x29\x2c\x65\x3d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x65\x2d\x2d\x29\x64\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x5b\x63\x5b\x65\x5d\x5d\x3d\x62\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x26\x26\x61\x2c\x64\x3d\x63\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x73\x6f\x75\x72\x63\x65\x49\x6e\x64\x65\x78\x2d\x62\x2e\x73\x6f\x75\x72\x63\x65\x49\x6e\x64\x65\x78\x3b\x69\x66\x28\x64\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x69\x66\x28\x63\x29\x77\x68\x69\x6c\x65\x28\x63\x3d\x63\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x69\x66\x28\x63\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x2d\x31\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x31\x3a\x2d\x31\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6d\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x63\x26\x26\x62\x2e\x74\x79\x70\x65\x3d\x3d\x3d\x61\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x28\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x63\x7c\x7c\x22\x62\x75\x74\x74\x6f\x6e\x22\x3d\x3d\x3d\x63\x29\x26\x26\x62\x2e\x74\x79\x70\x65\x3d\x3d\x3d\x61\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x66\x6f\x72\x6d\x22\x69\x6e\x20\x62\x3f\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x21\x31\x3f\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x62\x3f\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3f\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x3a\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x3a\x62\x2e\x69\x73\x44\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x7c\x7c\x62\x2e\x69\x73\x44\x69\x73\x61\x62\x6c\x65\x64\x21\x3d\x3d\x21\x61\x26\x26\x65\x61\x28\x62\x29\x3d\x3d\x3d\x61\x3a\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x3a\x22\x6c\x61\x62\x65\x6c\x22\x69\x6e\x20\x62\x26\x26\x62\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x3d\x3d\x61\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x2b\x62\x2c\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3d\x61\x28\x5b\x5d\x2c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x62\x29\x2c\x67\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x63\x5b\x65\x3d\x66\x5b\x67\x5d\x5d\x26\x26\x28\x63\x5b\x65\x5d\x3d\x21\x28\x64\x5b\x65\x5d\x3d\x63\x5b\x65\x5d\x29\x29\x7d\x29\x7d\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x71\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x26\x26\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x26\x26\x61\x7d\x63\x3d\x67\x61\x2e\x73\x75\x70\x70\x6f\x72\x74\x3d\x7b\x7d\x2c\x66\x3d\x67\x61\x2e\x69\x73\x58\x4d\x4c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x26\x26\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x3b\x72\x65\x74\x75\x72\x6e\x21\x21\x62\x26\x26\x22\x48\x54\x4d\x4c\x22\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x7d\x2c\x6d\x3d\x67\x61\x2e\x73\x65\x74\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x65\x2c\x67\x3d\x61\x3f\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x3a\x76\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x21\x3d\x3d\x6e\x26\x26\x39\x3d\x3d\x3d\x67\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x67\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x3f\x28\x6e\x3d\x67\x2c\x6f\x3d\x6e\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x70\x3d\x21\x66\x28\x6e\x29\x2c\x76\x21\x3d\x3d\x6e\x26\x26\x28\x65\x3d\x6e\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x29\x26\x26\x65\x2e\x74\x6f\x70\x21\x3d\x3d\x65\x26\x26\x28\x65\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x3f\x65\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x75\x6e\x6c\x6f\x61\x64\x22\x2c\x64\x61\x2c\x21\x31\x29\x3a\x65\x2e\x61\x74\x74\x61\x63\x68\x45\x76\x65\x6e\x74\x26\x26\x65\x2e\x61\x74\x74\x61\x63\x68\x45\x76\x65\x6e\x74\x28\x22\x6f\x6e\x75\x6e\x6c\x6f\x61\x64\x22\x2c\x64\x61\x29\x29\x2c\x63\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3d\x22\x69\x22\x2c\x21\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x29\x7d\x29\x2c\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x6e\x2e\x63\x72\x65\x61\x74\x65\x43\x6f\x6d\x6d\x65\x6e\x74\x28\x22\x22\x29\x29\x2c\x21\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x22\x2a\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x29\x2c\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3d\x59\x2e\x74\x65\x73\x74\x28\x6e\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x2c\x63\x2e\x67\x65\x74\x42\x79\x49\x64\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6f\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x2e\x69\x64\x3d\x75\x2c\x21\x6e\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x4e\x61\x6d\x65\x7c\x7c\x21\x6e\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x4e\x61\x6d\x65\x28\x75\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x29\x2c\x63\x2e\x67\x65\x74\x42\x79\x49\x64\x3f\x28\x64\x2e\x66\x69\x6c\x74\x65\x72\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x69\x64\x22\x29\x3d\x3d\x3d\x62\x7d\x7d\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x26\x26\x70\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3f\x5b\x63\x5d\x3a\x5b\x5d\x7d\x7d\x29\x3a\x28\x64\x2e\x66\x69\x6c\x74\x65\x72\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x63\x3d\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x26\x26\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x22\x69\x64\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x63\x2e\x76\x61\x6c\x75\x65\x3d\x3d\x3d\x62\x7d\x7d\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x49\x44\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x26\x26\x70\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x61\x29\x3b\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x63\x3d\x66\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x22\x69\x64\x22\x29\x2c\x63\x26\x26\x63\x2e\x76\x61\x6c\x75\x65\x3d\x3d\x3d\x61\x29\x72\x65\x74\x75\x72\x6e\x5b\x66\x5d\x3b\x65\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x4e\x61\x6d\x65\x28\x61\x29\x2c\x64\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x65\x5b\x64\x2b\x2b\x5d\x29\x69\x66\x28\x63\x3d\x66\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x22\x69\x64\x22\x29\x2c\x63\x26\x26\x63\x2e\x76\x61\x6c\x75\x65\x3d\x3d\x3d\x61\x29\x72\x65\x74\x75\x72\x6e\x5b\x66\x5d\x7d\x72\x65\x74\x75\x72\x6e\x5b\x5d\x7d\x7d\x29\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x54\x41\x47\x3d\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3f\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x61\x29\x3a\x63\x2e\x71\x73\x61\x3f\x62\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x61\x29\x3a\x76\x6f\x69\x64\x20\x30\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x30\x2c\x66\x3d\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x61\x29\x3b\x69\x66\x28\x22\x2a\x22\x3d\x3d\x3d\x61\x29\x7b\x77\x68\x69\x6c\x65\x28\x63\x3d\x66\x5b\x65\x2b\x2b\x5d\x29\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x64\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x72\x65\x74\x75\x72\x6e\x20\x66\x7d\x2c\x64\x2e\x66\x69\x6e\x64\x2e\x43\x4c\x41\x53\x53\x3d\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x70\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x43\x6c\x61\x73\x73\x4e\x61\x6d\x65\x28\x61\x29\x7d\x2c\x72\x3d\x5b\x5d\x2c\x71\x3d\x5b\x5d\x2c\x28\x63\x2e\x71\x73\x61\x3d\x59\x2e\x74\x65\x73\x74\x28\x6e\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x29\x29\x26\x26\x28\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x6f\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x61\x20\x69\x64\x3d\x27\x22\x2b\x75\x2b\x22\x27\x3e\x3c\x2f\x61\x3e\x3c\x73\x65\x6c\x65\x63\x74\x20\x69\x64\x3d\x27\x22\x2b\x75\x2b\x22\x2d\x5c\x72\x5c\x5c\x27\x20\x6d\x73\x61\x6c\x6c\x6f\x77\x63\x61\x70\x74\x75\x72\x65\x3d\x27\x27\x3e\x3c\x6f\x70\x74\x69\x6f\x6e\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x27\x27\x3e\x3c\x2f\x6f\x70\x74\x69\x6f\x6e\x3e\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x22\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x6d\x73\x61\x6c\x6c\x6f\x77\x63\x61\x70\x74\x75\x72\x65\x5e\x3d\x27\x27\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x5b\x2a\x5e\x24\x5d\x3d\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x27\x27\x7c\x5c\x22\x5c\x22\x29\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x73\x65\x6c\x65\x63\x74\x65\x64\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x5c\x5c\x5b\x22\x2b\x4b\x2b\x22\x2a\x28\x3f\x3a\x76\x61\x6c\x75\x65\x7c\x22\x2b\x4a\x2b\x22\x29\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x69\x64\x7e\x3d\x22\x2b\x75\x2b\x22\x2d\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x7e\x3d\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x3a\x63\x68\x65\x63\x6b\x65\x64\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x3a\x63\x68\x65\x63\x6b\x65\x64\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x61\x23\x22\x2b\x75\x2b\x22\x2b\x2a\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x71\x2e\x70\x75\x73\x68\x28\x22\x2e\x23\x2e\x2b\x5b\x2b\x7e\x5d\x22\x29\x7d\x29\x2c\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x61\x20\x68\x72\x65\x66\x3d\x27\x27\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x27\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3e\x3c\x2f\x61\x3e\x3c\x73\x65\x6c\x65\x63\x74\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x27\x64\x69\x73\x61\x62\x6c\x65\x64\x27\x3e\x3c\x6f\x70\x74\x69\x6f\x6e\x2f\x3e\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x22\x3b\x76\x61\x72\x20\x62\x3d\x6e\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x3b\x62\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x2c\x22\x68\x69\x64\x64\x65\x6e\x22\x29\x2c\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x29\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x6e\x61\x6d\x65\x22\x2c\x22\x44\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x5b\x6e\x61\x6d\x65\x3d\x64\x5d\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x6e\x61\x6d\x65\x22\x2b\x4b\x2b\x22\x2a\x5b\x2a\x5e\x24\x7c\x21\x7e\x5d\x3f\x3d\x22\x29\x2c\x32\x21\x3d\x3d\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x3a\x65\x6e\x61\x62\x6c\x65\x64\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x3a\x65\x6e\x61\x62\x6c\x65\x64\x22\x2c\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x2c\x6f\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x3d\x21\x30\x2c\x32\x21\x3d\x3d\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x71\x2e\x70\x75\x73\x68\x28\x22\x3a\x65\x6e\x61\x62\x6c\x65\x64\x22\x2c\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x2c\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x22\x2a\x2c\x3a\x78\x22\x29\x2c\x71\x2e\x70\x75\x73\x68\x28\x22\x2c\x2e\x2a\x3a\x22\x29\x7d\x29\x29\x2c\x28\x63\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x3d\x59\x2e\x74\x65\x73\x74\x28\x73\x3d\x6f\x2e\x6d\x61\x74\x63\x68\x65\x73\x7c\x7c\x6f\x2e\x77\x65\x62\x6b\x69\x74\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x6f\x2e\x6d\x6f\x7a\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x6f\x2e\x6f\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x6f\x2e\x6d\x73\x4d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x29\x29\x26\x26\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x63\x2e\x64\x69\x73\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x4d\x61\x74\x63\x68\x3d\x73\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x22\x2a\x22\x29\x2c\x73\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x22\x5b\x73\x21\x3d\x27\x27\x5d\x3a\x78\x22\x29\x2c\x72\x2e\x70\x75\x73\x68\x28\x22\x21\x3d\x22\x2c\x4e\x29\x7d\x29\x2c\x71\x3d\x71\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x71\x2e\x6a\x6f\x69\x6e\x28\x22\x7c\x22\x29\x29\x2c\x72\x3d\x72\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x72\x2e\x6a\x6f\x69\x6e\x28\x22\x7c\x22\x29\x29\x2c\x62\x3d\x59\x2e\x74\x65\x73\x74\x28\x6f\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x29\x2c\x74\x3d\x62\x7c\x7c\x59\x2e\x74\x65\x73\x74\x28\x6f\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x29\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x39\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x3a\x61\x2c\x64\x3d\x62\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x64\x7c\x7c\x21\x28\x21\x64\x7c\x7c\x31\x21\x3d\x3d\x64\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x21\x28\x63\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3f\x63\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x64\x29\x3a\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x26\x26\x31\x36\x26\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x64\x29\x29\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x62\x29\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x69\x66\x28\x62\x3d\x3d\x3d\x61\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x2c\x42\x3d\x62\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x61\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x6c\x3d\x21\x30\x2c\x30\x3b\x76\x61\x72\x20\x64\x3d\x21\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x2d\x21\x62\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3f\x64\x3a\x28\x64\x3d\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x3d\x3d\x3d\x28\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x62\x29\x3f\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x62\x29\x3a\x31\x2c\x31\x26\x64\x7c\x7c\x21\x63\x2e\x73\x6f\x72\x74\x44\x65\x74\x61\x63\x68\x65\x64\x26\x26\x62\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x61\x29\x3d\x3d\x3d\x64\x3f\x61\x3d\x3d\x3d\x6e\x7c\x7c\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x3d\x3d\x76\x26\x26\x74\x28\x76\x2c\x61\x29\x3f\x2d\x31\x3a\x62\x3d\x3d\x3d\x6e\x7c\x7c\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x3d\x3d\x76\x26\x26\x74\x28\x76\x2c\x62\x29\x3f\x31\x3a\x6b\x3f\x49\x28\x6b\x2c\x61\x29\x2d\x49\x28\x6b\x2c\x62\x29\x3a\x30\x3a\x34\x26\x64\x3f\x2d\x31\x3a\x31\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x61\x3d\x3d\x3d\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x6c\x3d\x21\x30\x2c\x30\x3b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x66\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x67\x3d\x5b\x61\x5d\x2c\x68\x3d\x5b\x62\x5d\x3b\x69\x66\x28\x21\x65\x7c\x7c\x21\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x6e\x3f\x2d\x31\x3a\x62\x3d\x3d\x3d\x6e\x3f\x31\x3a\x65\x3f\x2d\x31\x3a\x66\x3f\x31\x3a\x6b\x3f\x49\x28\x6b\x2c\x61\x29\x2d\x49\x28\x6b\x2c\x62\x29\x3a\x30\x3b\x69\x66\x28\x65\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x6c\x61\x28\x61\x2c\x62\x29\x3b\x63\x3d\x61\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x67\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x63\x29\x3b\x63\x3d\x62\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x68\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x63\x29\x3b\x77\x68\x69\x6c\x65\x28\x67\x5b\x64\x5d\x3d\x3d\x3d\x68\x5b\x64\x5d\x29\x64\x2b\x2b\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3f\x6c\x61\x28\x67\x5b\x64\x5d\x2c\x68\x5b\x64\x5d\x29\x3a\x67\x5b\x64\x5d\x3d\x3d\x3d\x76\x3f\x2d\x31\x3a\x68\x5b\x64\x5d\x3d\x3d\x3d\x76\x3f\x31\x3a\x30\x7d\x2c\x6e\x29\x3a\x6e\x7d\x2c\x67\x61\x2e\x6d\x61\x74\x63\x68\x65\x73\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x67\x61\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x6e\x75\x6c\x6c\x2c\x62\x29\x7d\x2c\x67\x61\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x61\x29\x2c\x62\x3d\x62\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x53\x2c\x22\x3d\x27\x24\x31\x27\x5d\x22\x29\x2c\x63\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x26\x26\x70\x26\x26\x21\x41\x5b\x62\x2b\x22\x20\x22\x5d\x26\x26\x28\x21\x72\x7c\x7c\x21\x72\x2e\x74\x65\x73\x74\x28\x62\x29\x29\x26\x26\x28\x21\x71\x7c\x7c\x21\x71\x2e\x74\x65\x73\x74\x28\x62\x29\x29\x29\x74\x72\x79\x7b\x76\x61\x72\x20\x64\x3d\x73\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x62\x29\x3b\x69\x66\x28\x64\x7c\x7c\x63\x2e\x64\x69\x73\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x4d\x61\x74\x63\x68\x7c\x7c\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x26\x26\x31\x31\x21\x3d\x3d\x61\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x63\x61\x74\x63\x68\x28\x65\x29\x7b\x7d\x72\x65\x74\x75\x72\x6e\x20\x67\x61\x28\x62\x2c\x6e\x2c\x6e\x75\x6c\x6c\x2c\x5b\x61\x5d\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x7d\x2c\x67\x61\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x61\x29\x2c\x74\x28\x61\x2c\x62\x29\x7d\x2c\x67\x61\x2e\x61\x74\x74\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x61\x29\x21\x3d\x3d\x6e\x26\x26\x6d\x28\x61\x29\x3b\x76\x61\x72\x20\x65\x3d\x64\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x5b\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x2c\x66\x3d\x65\x26\x26\x43\x2e\x63\x61\x6c\x6c\x28\x64\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x2c\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x3f\x65\x28\x61\x2c\x62\x2c\x21\x70\x29\x3a\x76\x6f\x69\x64\x20\x30\x3b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x66\x3f\x66\x3a\x63\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x7c\x7c\x21\x70\x3f\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x62\x29\x3a\x28\x66\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x62\x29\x29\x26\x26\x66\x2e\x73\x70\x65\x63\x69\x66\x69\x65\x64\x3f\x66\x2e\x76\x61\x6c\x75\x65\x3a\x6e\x75\x6c\x6c\x7d\x2c\x67\x61\x2e\x65\x73\x63\x61\x70\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x28\x61\x2b\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x62\x61\x2c\x63\x61\x29\x7d\x2c\x67\x61\x2e\x65\x72\x72\x6f\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x45\x72\x72\x6f\x72\x28\x22\x53\x79\x6e\x74\x61\x78\x20\x65\x72\x72\x6f\x72\x2c\x20\x75\x6e\x72\x65\x63\x6f\x67\x6e\x69\x7a\x65\x64\x20\x65\x78\x70\x72\x65\x73\x73\x69\x6f\x6e\x3a\x20\x22\x2b\x61\x29\x7d\x2c\x67\x61\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x30\x2c\x66\x3d\x30\x3b\x69\x66\x28\x6c\x3d\x21\x63\x2e\x64\x65\x74\x65\x63\x74\x44\x75\x70\x6c\x69\x63\x61\x74\x65\x73\x2c\x6b\x3d\x21\x63\x2e\x73\x6f\x72\x74\x53\x74\x61\x62\x6c\x65\x26\x26\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x2c\x61\x2e\x73\x6f\x72\x74\x28\x42\x29\x2c\x6c\x29\x7b\x77\x68\x69\x6c\x65\x28\x62\x3d\x61\x5b\x66\x2b\x2b\x5d\x29\x62\x3d\x3d\x3d\x61\x5b\x66\x5d\x26\x26\x28\x65\x3d\x64\x2e\x70\x75\x73\x68\x28\x66\x29\x29\x3b\x77\x68\x69\x6c\x65\x28\x65\x2d\x2d\x29\x61\x2e\x73\x70\x6c\x69\x63\x65\x28\x64\x5b\x65\x5d\x2c\x31\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6b\x3d\x6e\x75\x6c\x6c\x2c\x61\x7d\x2c\x65\x3d\x67\x61\x2e\x67\x65\x74\x54\x65\x78\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x22\x22\x2c\x64\x3d\x30\x2c\x66\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3b\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x31\x3d\x3d\x3d\x66\x7c\x7c\x39\x3d\x3d\x3d\x66\x7c\x7c\x31\x31\x3d\x3d\x3d\x66\x29\x7b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3b\x66\x6f\x72\x28\x61\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x3b\x61\x3b\x61\x3d\x61\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x63\x2b\x3d\x65\x28\x61\x29\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x33\x3d\x3d\x3d\x66\x7c\x7c\x34\x3d\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x56\x61\x6c\x75\x65\x7d\x65\x6c\x73\x65\x20\x77\x68\x69\x6c\x65\x28\x62\x3d\x61\x5b\x64\x2b\x2b\x5d\x29\x63\x2b\x3d\x65\x28\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x2c\x64\x3d\x67\x61\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x73\x3d\x7b\x63\x61\x63\x68\x65\x4c\x65\x6e\x67\x74\x68\x3a\x35\x30\x2c\x63\x72\x65\x61\x74\x65\x50\x73\x65\x75\x64\x6f\x3a\x69\x61\x2c\x6d\x61\x74\x63\x68\x3a\x56\x2c\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x3a\x7b\x7d\x2c\x66\x69\x6e\x64\x3a\x7b\x7d\x2c\x72\x65\x6c\x61\x74\x69\x76\x65\x3a\x7b\x22\x3e\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x2c\x66\x69\x72\x73\x74\x3a\x21\x30\x7d\x2c\x22\x20\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x7d\x2c\x22\x2b\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x66\x69\x72\x73\x74\x3a\x21\x30\x7d\x2c\x22\x7e\x22\x3a\x7b\x64\x69\x72\x3a\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x7d\x7d\x2c\x70\x72\x65\x46\x69\x6c\x74\x65\x72\x3a\x7b\x41\x54\x54\x52\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x31\x5d\x3d\x61\x5b\x31\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x61\x5b\x33\x5d\x3d\x28\x61\x5b\x33\x5d\x7c\x7c\x61\x5b\x34\x5d\x7c\x7c\x61\x5b\x35\x5d\x7c\x7c\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x22\x7e\x3d\x22\x3d\x3d\x3d\x61\x5b\x32\x5d\x26\x26\x28\x61\x5b\x33\x5d\x3d\x22\x20\x22\x2b\x61\x5b\x33\x5d\x2b\x22\x20\x22\x29\x2c\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x34\x29\x7d\x2c\x43\x48\x49\x4c\x44\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x31\x5d\x3d\x61\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x22\x6e\x74\x68\x22\x3d\x3d\x3d\x61\x5b\x31\x5d\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x33\x29\x3f\x28\x61\x5b\x33\x5d\x7c\x7c\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x61\x5b\x30\x5d\x29\x2c\x61\x5b\x34\x5d\x3d\x2b\x28\x61\x5b\x34\x5d\x3f\x61\x5b\x35\x5d\x2b\x28\x61\x5b\x36\x5d\x7c\x7c\x31\x29\x3a\x32\x2a\x28\x22\x65\x76\x65\x6e\x22\x3d\x3d\x3d\x61\x5b\x33\x5d\x7c\x7c\x22\x6f\x64\x64\x22\x3d\x3d\x3d\x61\x5b\x33\x5d\x29\x29\x2c\x61\x5b\x35\x5d\x3d\x2b\x28\x61\x5b\x37\x5d\x2b\x61\x5b\x38\x5d\x7c\x7c\x22\x6f\x64\x64\x22\x3d\x3d\x3d\x61\x5b\x33\x5d\x29\x29\x3a\x61\x5b\x33\x5d\x26\x26\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x61\x5b\x30\x5d\x29\x2c\x61\x7d\x2c\x50\x53\x45\x55\x44\x4f\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x21\x61\x5b\x36\x5d\x26\x26\x61\x5b\x32\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x56\x2e\x43\x48\x49\x4c\x44\x2e\x74\x65\x73\x74\x28\x61\x5b\x30\x5d\x29\x3f\x6e\x75\x6c\x6c\x3a\x28\x61\x5b\x33\x5d\x3f\x61\x5b\x32\x5d\x3d\x61\x5b\x34\x5d\x7c\x7c\x61\x5b\x35\x5d\x7c\x7c\x22\x22\x3a\x63\x26\x26\x54\x2e\x74\x65\x73\x74\x28\x63\x29\x26\x26\x28\x62\x3d\x67\x28\x63\x2c\x21\x30\x29\x29\x26\x26\x28\x62\x3d\x63\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x29\x22\x2c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x62\x29\x2d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x26\x26\x28\x61\x5b\x30\x5d\x3d\x61\x5b\x30\x5d\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x62\x29\x2c\x61\x5b\x32\x5d\x3d\x63\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x62\x29\x29\x2c\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x33\x29\x29\x7d\x7d\x2c\x66\x69\x6c\x74\x65\x72\x3a\x7b\x54\x41\x47\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x2a\x22\x3d\x3d\x3d\x61\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x26\x26\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x62\x7d\x7d\x2c\x43\x4c\x41\x53\x53\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x79\x5b\x61\x2b\x22\x20\x22\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x7c\x7c\x28\x62\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x28\x5e\x7c\x22\x2b\x4b\x2b\x22\x29\x22\x2b\x61\x2b\x22\x28\x22\x2b\x4b\x2b\x22\x7c\x24\x29\x22\x29\x29\x26\x26\x79\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x74\x65\x73\x74\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x26\x26\x61\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x7c\x7c\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x26\x26\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x29\x7c\x7c\x22\x22\x29\x7d\x29\x7d\x2c\x41\x54\x54\x52\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x67\x61\x2e\x61\x74\x74\x72\x28\x64\x2c\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x65\x3f\x22\x21\x3d\x22\x3d\x3d\x3d\x62\x3a\x21\x62\x7c\x7c\x28\x65\x2b\x3d\x22\x22\x2c\x22\x3d\x22\x3d\x3d\x3d\x62\x3f\x65\x3d\x3d\x3d\x63\x3a\x22\x21\x3d\x22\x3d\x3d\x3d\x62\x3f\x65\x21\x3d\x3d\x63\x3a\x22\x5e\x3d\x22\x3d\x3d\x3d\x62\x3f\x63\x26\x26\x30\x3d\x3d\x3d\x65\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x63\x29\x3a\x22\x2a\x3d\x22\x3d\x3d\x3d\x62\x3f\x63\x26\x26\x65\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x63\x29\x3e\x2d\x31\x3a\x22\x24\x3d\x22\x3d\x3d\x3d\x62\x3f\x63\x26\x26\x65\x2e\x73\x6c\x69\x63\x65\x28\x2d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x3d\x3d\x3d\x63\x3a\x22\x7e\x3d\x22\x3d\x3d\x3d\x62\x3f\x28\x22\x20\x22\x2b\x65\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x4f\x2c\x22\x20\x22\x29\x2b\x22\x20\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x63\x29\x3e\x2d\x31\x3a\x22\x7c\x3d\x22\x3d\x3d\x3d\x62\x26\x26\x28\x65\x3d\x3d\x3d\x63\x7c\x7c\x65\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2b\x31\x29\x3d\x3d\x3d\x63\x2b\x22\x2d\x22\x29\x29\x7d\x7d\x2c\x43\x48\x49\x4c\x44\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x22\x6e\x74\x68\x22\x21\x3d\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x33\x29\x2c\x67\x3d\x22\x6c\x61\x73\x74\x22\x21\x3d\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x2d\x34\x29\x2c\x68\x3d\x22\x6f\x66\x2d\x74\x79\x70\x65\x22\x3d\x3d\x3d\x62\x3b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x64\x26\x26\x30\x3d\x3d\x3d\x65\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x69\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x3d\x66\x21\x3d\x3d\x67\x3f\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x3a\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x71\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x72\x3d\x68\x26\x26\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x73\x3d\x21\x69\x26\x26\x21\x68\x2c\x74\x3d\x21\x31\x3b\x69\x66\x28\x71\x29\x7b\x69\x66\x28\x66\x29\x7b\x77\x68\x69\x6c\x65\x28\x70\x29\x7b\x6d\x3d\x62\x3b\x77\x68\x69\x6c\x65\x28\x6d\x3d\x6d\x5b\x70\x5d\x29\x69\x66\x28\x68\x3f\x6d\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x72\x3a\x31\x3d\x3d\x3d\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x6f\x3d\x70\x3d\x22\x6f\x6e\x6c\x79\x22\x3d\x3d\x3d\x61\x26\x26\x21\x6f\x26\x26\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x7d\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x69\x66\x28\x6f\x3d\x5b\x67\x3f\x71\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x3a\x71\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x5d\x2c\x67\x26\x26\x73\x29\x7b\x6d\x3d\x71\x2c\x6c\x3d\x6d\x5b\x75\x5d\x7c\x7c\x28\x6d\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x6a\x3d\x6b\x5b\x61\x5d\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x6a\x5b\x30\x5d\x3d\x3d\x3d\x77\x26\x26\x6a\x5b\x31\x5d\x2c\x74\x3d\x6e\x26\x26\x6a\x5b\x32\x5d\x2c\x6d\x3d\x6e\x26\x26\x71\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x5b\x6e\x5d\x3b\x77\x68\x69\x6c\x65\x28\x6d\x3d\x2b\x2b\x6e\x26\x26\x6d\x26\x26\x6d\x5b\x70\x5d\x7c\x7c\x28\x74\x3d\x6e\x3d\x30\x29\x7c\x7c\x6f\x2e\x70\x6f\x70\x28\x29\x29\x69\x66\x28\x31\x3d\x3d\x3d\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x2b\x2b\x74\x26\x26\x6d\x3d\x3d\x3d\x62\x29\x7b\x6b\x5b\x61\x5d\x3d\x5b\x77\x2c\x6e\x2c\x74\x5d\x3b\x62\x72\x65\x61\x6b\x7d\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x73\x26\x26\x28\x6d\x3d\x62\x2c\x6c\x3d\x6d\x5b\x75\x5d\x7c\x7c\x28\x6d\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x6a\x3d\x6b\x5b\x61\x5d\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x6a\x5b\x30\x5d\x3d\x3d\x3d\x77\x26\x26\x6a\x5b\x31\x5d\x2c\x74\x3d\x6e\x29\x2c\x74\x3d\x3d\x3d\x21\x31\x29\x77\x68\x69\x6c\x65\x28\x6d\x3d\x2b\x2b\x6e\x26\x26\x6d\x26\x26\x6d\x5b\x70\x5d\x7c\x7c\x28\x74\x3d\x6e\x3d\x30\x29\x7c\x7c\x6f\x2e\x70\x6f\x70\x28\x29\x29\x69\x66\x28\x28\x68\x3f\x6d\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3d\x3d\x3d\x72\x3a\x31\x3d\x3d\x3d\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x26\x26\x2b\x2b\x74\x26\x26\x28\x73\x26\x26\x28\x6c\x3d\x6d\x5b\x75\x5d\x7c\x7c\x28\x6d\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x6d\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x6b\x5b\x61\x5d\x3d\x5b\x77\x2c\x74\x5d\x29\x2c\x6d\x3d\x3d\x3d\x62\x29\x29\x62\x72\x65\x61\x6b\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x2d\x3d\x65\x2c\x74\x3d\x3d\x3d\x64\x7c\x7c\x74\x25\x64\x3d\x3d\x3d\x30\x26\x26\x74\x2f\x64\x3e\x3d\x30\x7d\x7d\x7d\x2c\x50\x53\x45\x55\x44\x4f\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x65\x3d\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x5b\x61\x5d\x7c\x7c\x64\x2e\x73\x65\x74\x46\x69\x6c\x74\x65\x72\x73\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7c\x7c\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x22\x75\x6e\x73\x75\x70\x70\x6f\x72\x74\x65\x64\x20\x70\x73\x65\x75\x64\x6f\x3a\x20\x22\x2b\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x5b\x75\x5d\x3f\x65\x28\x62\x29\x3a\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x28\x63\x3d\x5b\x61\x2c\x61\x2c\x22\x22\x2c\x62\x5d\x2c\x64\x2e\x73\x65\x74\x46\x69\x6c\x74\x65\x72\x73\x2e\x68\x61\x73\x4f\x77\x6e\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x3f\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x66\x3d\x65\x28\x61\x2c\x62\x29\x2c\x67\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x64\x3d\x49\x28\x61\x2c\x66\x5b\x67\x5d\x29\x2c\x61\x5b\x64\x5d\x3d\x21\x28\x63\x5b\x64\x5d\x3d\x66\x5b\x67\x5d\x29\x7d\x29\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x28\x61\x2c\x30\x2c\x63\x29\x7d\x29\x3a\x65\x7d\x7d\x2c\x70\x73\x65\x75\x64\x6f\x73\x3a\x7b\x6e\x6f\x74\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x5b\x5d\x2c\x63\x3d\x5b\x5d\x2c\x64\x3d\x68\x28\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x24\x31\x22\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x5b\x75\x5d\x3f\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x3d\x64\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x65\x2c\x5b\x5d\x29\x2c\x68\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x68\x2d\x2d\x29\x28\x66\x3d\x67\x5b\x68\x5d\x29\x26\x26\x28\x61\x5b\x68\x5d\x3d\x21\x28\x62\x5b\x68\x5d\x3d\x66\x29\x29\x7d\x29\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x65\x2c\x66\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x5b\x30\x5d\x3d\x61\x2c\x64\x28\x62\x2c\x6e\x75\x6c\x6c\x2c\x66\x2c\x63\x29\x2c\x62\x5b\x30\x5d\x3d\x6e\x75\x6c\x6c\x2c\x21\x63\x2e\x70\x6f\x70\x28\x29\x7d\x7d\x29\x2c\x68\x61\x73\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x67\x61\x28\x61\x2c\x62\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x7d\x7d\x29\x2c\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x28\x62\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x7c\x7c\x62\x2e\x69\x6e\x6e\x65\x72\x54\x65\x78\x74\x7c\x7c\x65\x28\x62\x29\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x61\x29\x3e\x2d\x31\x7d\x7d\x29\x2c\x6c\x61\x6e\x67\x3a\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x55\x2e\x74\x65\x73\x74\x28\x61\x7c\x7c\x22\x22\x29\x7c\x7c\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x22\x75\x6e\x73\x75\x70\x70\x6f\x72\x74\x65\x64\x20\x6c\x61\x6e\x67\x3a\x20\x22\x2b\x61\x29\x2c\x61\x3d\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x64\x6f\x20\x69\x66\x28\x63\x3d\x70\x3f\x62\x2e\x6c\x61\x6e\x67\x3a\x62\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x78\x6d\x6c\x3a\x6c\x61\x6e\x67\x22\x29\x7c\x7c\x62\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x6c\x61\x6e\x67\x22\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x63\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x63\x3d\x3d\x3d\x61\x7c\x7c\x30\x3d\x3d\x3d\x63\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x61\x2b\x22\x2d\x22\x29\x3b\x77\x68\x69\x6c\x65\x28\x28\x62\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x26\x26\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x7d\x29\x2c\x74\x61\x72\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x26\x26\x61\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x61\x73\x68\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x63\x2e\x73\x6c\x69\x63\x65\x28\x31\x29\x3d\x3d\x3d\x62\x2e\x69\x64\x7d\x2c\x72\x6f\x6f\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x6f\x7d\x2c\x66\x6f\x63\x75\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x6e\x2e\x61\x63\x74\x69\x76\x65\x45\x6c\x65\x6d\x65\x6e\x74\x26\x26\x28\x21\x6e\x2e\x68\x61\x73\x46\x6f\x63\x75\x73\x7c\x7c\x6e\x2e\x68\x61\x73\x46\x6f\x63\x75\x73\x28\x29\x29\x26\x26\x21\x21\x28\x61\x2e\x74\x79\x70\x65\x7c\x7c\x61\x2e\x68\x72\x65\x66\x7c\x7c\x7e\x61\x2e\x74\x61\x62\x49\x6e\x64\x65\x78\x29\x7d\x2c\x65\x6e\x61\x62\x6c\x65\x64\x3a\x6f\x61\x28\x21\x31\x29\x2c\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x6f\x61\x28\x21\x30\x29\x2c\x63\x68\x65\x63\x6b\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x62\x26\x26\x21\x21\x61\x2e\x63\x68\x65\x63\x6b\x65\x64\x7c\x7c\x22\x6f\x70\x74\x69\x6f\x6e\x22\x3d\x3d\x3d\x62\x26\x26\x21\x21\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x7d\x2c\x73\x65\x6c\x65\x63\x74\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x3d\x3d\x21\x30\x7d\x2c\x65\x6d\x70\x74\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x6f\x72\x28\x61\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x3b\x61\x3b\x61\x3d\x61\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x69\x66\x28\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3c\x36\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x2c\x70\x61\x72\x65\x6e\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x65\x6d\x70\x74\x79\x28\x61\x29\x7d\x2c\x68\x65\x61\x64\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x58\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x7d\x2c\x69\x6e\x70\x75\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x57\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x7d\x2c\x62\x75\x74\x74\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x62\x26\x26\x22\x62\x75\x74\x74\x6f\x6e\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x7c\x7c\x22\x62\x75\x74\x74\x6f\x6e\x22\x3d\x3d\x3d\x62\x7d\x2c\x74\x65\x78\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x72\x65\x74\x75\x72\x6e\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x26\x26\x22\x74\x65\x78\x74\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x26\x26\x28\x6e\x75\x6c\x6c\x3d\x3d\x28\x62\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x29\x29\x7c\x7c\x22\x74\x65\x78\x74\x22\x3d\x3d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x7d\x2c\x66\x69\x72\x73\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x5b\x30\x5d\x7d\x29\x2c\x6c\x61\x73\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x5b\x62\x2d\x31\x5d\x7d\x29\x2c\x65\x71\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x5b\x63\x3c\x30\x3f\x63\x2b\x62\x3a\x63\x5d\x7d\x29\x2c\x65\x76\x65\x6e\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x30\x3b\x63\x3c\x62\x3b\x63\x2b\x3d\x32\x29\x61\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2c\x6f\x64\x64\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x31\x3b\x63\x3c\x62\x3b\x63\x2b\x3d\x32\x29\x61\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2c\x6c\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x63\x3c\x30\x3f\x63\x2b\x62\x3a\x63\x3b\x2d\x2d\x64\x3e\x3d\x30\x3b\x29\x61\x2e\x70\x75\x73\x68\x28\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2c\x67\x74\x3a\x70\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x63\x3c\x30\x3f\x63\x2b\x62\x3a\x63\x3b\x2b\x2b\x64\x3c\x62\x3b\x29\x61\x2e\x70\x75\x73\x68\x28\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x7d\x7d\x2c\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x6e\x74\x68\x3d\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x65\x71\x3b\x66\x6f\x72\x28\x62\x20\x69\x6e\x7b\x72\x61\x64\x69\x6f\x3a\x21\x30\x2c\x63\x68\x65\x63\x6b\x62\x6f\x78\x3a\x21\x30\x2c\x66\x69\x6c\x65\x3a\x21\x30\x2c\x70\x61\x73\x73\x77\x6f\x72\x64\x3a\x21\x30\x2c\x69\x6d\x61\x67\x65\x3a\x21\x30\x7d\x29\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x5b\x62\x5d\x3d\x6d\x61\x28\x62\x29\x3b\x66\x6f\x72\x28\x62\x20\x69\x6e\x7b\x73\x75\x62\x6d\x69\x74\x3a\x21\x30\x2c\x72\x65\x73\x65\x74\x3a\x21\x30\x7d\x29\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x5b\x62\x5d\x3d\x6e\x61\x28\x62\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x61\x28\x29\x7b\x7d\x72\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x64\x2e\x66\x69\x6c\x74\x65\x72\x73\x3d\x64\x2e\x70\x73\x65\x75\x64\x6f\x73\x2c\x64\x2e\x73\x65\x74\x46\x69\x6c\x74\x65\x72\x73\x3d\x6e\x65\x77\x20\x72\x61\x2c\x67\x3d\x67\x61\x2e\x74\x6f\x6b\x65\x6e\x69\x7a\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x3d\x7a\x5b\x61\x2b\x22\x20\x22\x5d\x3b\x69\x66\x28\x6b\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x30\x3a\x6b\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x3b\x68\x3d\x61\x2c\x69\x3d\x5b\x5d\x2c\x6a\x3d\x64\x2e\x70\x72\x65\x46\x69\x6c\x74\x65\x72\x3b\x77\x68\x69\x6c\x65\x28\x68\x29\x7b\x63\x26\x26\x21\x28\x65\x3d\x51\x2e\x65\x78\x65\x63\x28\x68\x29\x29\x7c\x7c\x28\x65\x26\x26\x28\x68\x3d\x68\x2e\x73\x6c\x69\x63\x65\x28\x65\x5b\x30\x5d\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7c\x7c\x68\x29\x2c\x69\x2e\x70\x75\x73\x68\x28\x66\x3d\x5b\x5d\x29\x29\x2c\x63\x3d\x21\x31\x2c\x28\x65\x3d\x52\x2e\x65\x78\x65\x63\x28\x68\x29\x29\x26\x26\x28\x63\x3d\x65\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x66\x2e\x70\x75\x73\x68\x28\x7b\x76\x61\x6c\x75\x65\x3a\x63\x2c\x74\x79\x70\x65\x3a\x65\x5b\x30\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x20\x22\x29\x7d\x29\x2c\x68\x3d\x68\x2e\x73\x6c\x69\x63\x65\x28\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x29\x3b\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x64\x2e\x66\x69\x6c\x74\x65\x72\x29\x21\x28\x65\x3d\x56\x5b\x67\x5d\x2e\x65\x78\x65\x63\x28\x68\x29\x29\x7c\x7c\x6a\x5b\x67\x5d\x26\x26\x21\x28\x65\x3d\x6a\x5b\x67\x5d\x28\x65\x29\x29\x7c\x7c\x28\x63\x3d\x65\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x66\x2e\x70\x75\x73\x68\x28\x7b\x76\x61\x6c\x75\x65\x3a\x63\x2c\x74\x79\x70\x65\x3a\x67\x2c\x6d\x61\x74\x63\x68\x65\x73\x3a\x65\x7d\x29\x2c\x68\x3d\x68\x2e\x73\x6c\x69\x63\x65\x28\x63\x2e\x6c\x65\x6e\x67\x74\x68\x29\x29\x3b\x69\x66\x28\x21\x63\x29\x62\x72\x65\x61\x6b\x7d\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x68\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x68\x3f\x67\x61\x2e\x65\x72\x72\x6f\x72\x28\x61\x29\x3a\x7a\x28\x61\x2c\x69\x29\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x61\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x3d\x30\x2c\x63\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x64\x3d\x22\x22\x3b\x62\x3c\x63\x3b\x62\x2b\x2b\x29\x64\x2b\x3d\x61\x5b\x62\x5d\x2e\x76\x61\x6c\x75\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x74\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x62\x2e\x64\x69\x72\x2c\x65\x3d\x62\x2e\x6e\x65\x78\x74\x2c\x66\x3d\x65\x7c\x7c\x64\x2c\x67\x3d\x63\x26\x26\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x3d\x3d\x3d\x66\x2c\x68\x3d\x78\x2b\x2b\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x66\x69\x72\x73\x74\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x65\x29\x7b\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x5b\x64\x5d\x29\x69\x66\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x67\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x28\x62\x2c\x63\x2c\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x69\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x3d\x5b\x77\x2c\x68\x5d\x3b\x69\x66\x28\x69\x29\x7b\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x5b\x64\x5d\x29\x69\x66\x28\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x67\x29\x26\x26\x61\x28\x62\x2c\x63\x2c\x69\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x65\x6c\x73\x65\x20\x77\x68\x69\x6c\x65\x28\x62\x3d\x62\x5b\x64\x5d\x29\x69\x66\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x67\x29\x69\x66\x28\x6c\x3d\x62\x5b\x75\x5d\x7c\x7c\x28\x62\x5b\x75\x5d\x3d\x7b\x7d\x29\x2c\x6b\x3d\x6c\x5b\x62\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x7c\x7c\x28\x6c\x5b\x62\x2e\x75\x6e\x69\x71\x75\x65\x49\x44\x5d\x3d\x7b\x7d\x29\x2c\x65\x26\x26\x65\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x62\x3d\x62\x5b\x64\x5d\x7c\x7c\x62\x3b\x65\x6c\x73\x65\x7b\x69\x66\x28\x28\x6a\x3d\x6b\x5b\x66\x5d\x29\x26\x26\x6a\x5b\x30\x5d\x3d\x3d\x3d\x77\x26\x26\x6a\x5b\x31\x5d\x3d\x3d\x3d\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x6d\x5b\x32\x5d\x3d\x6a\x5b\x32\x5d\x3b\x69\x66\x28\x6b\x5b\x66\x5d\x3d\x6d\x2c\x6d\x5b\x32\x5d\x3d\x61\x28\x62\x2c\x63\x2c\x69\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x75\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x65\x2d\x2d\x29\x69\x66\x28\x21\x61\x5b\x65\x5d\x28\x62\x2c\x63\x2c\x64\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x3a\x61\x5b\x30\x5d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x76\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x30\x2c\x65\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x67\x61\x28\x61\x2c\x62\x5b\x64\x5d\x2c\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x66\x2c\x67\x3d\x5b\x5d\x2c\x68\x3d\x30\x2c\x69\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6a\x3d\x6e\x75\x6c\x6c\x21\x3d\x62\x3b\x68\x3c\x69\x3b\x68\x2b\x2b\x29\x28\x66\x3d\x61\x5b\x68\x5d\x29\x26\x26\x28\x63\x26\x26\x21\x63\x28\x66\x2c\x64\x2c\x65\x29\x7c\x7c\x28\x67\x2e\x70\x75\x73\x68\x28\x66\x29\x2c\x6a\x26\x26\x62\x2e\x70\x75\x73\x68\x28\x68\x29\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x78\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x26\x26\x21\x64\x5b\x75\x5d\x26\x26\x28\x64\x3d\x78\x61\x28\x64\x29\x29\x2c\x65\x26\x26\x21\x65\x5b\x75\x5d\x26\x26\x28\x65\x3d\x78\x61\x28\x65\x2c\x66\x29\x29\x2c\x69\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x2c\x67\x2c\x68\x2c\x69\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x3d\x5b\x5d\x2c\x6e\x3d\x5b\x5d\x2c\x6f\x3d\x67\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x70\x3d\x66\x7c\x7c\x76\x61\x28\x62\x7c\x7c\x22\x2a\x22\x2c\x68\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x5b\x68\x5d\x3a\x68\x2c\x5b\x5d\x29\x2c\x71\x3d\x21\x61\x7c\x7c\x21\x66\x26\x26\x62\x3f\x70\x3a\x77\x61\x28\x70\x2c\x6d\x2c\x61\x2c\x68\x2c\x69\x29\x2c\x72\x3d\x63\x3f\x65\x7c\x7c\x28\x66\x3f\x61\x3a\x6f\x7c\x7c\x64\x29\x3f\x5b\x5d\x3a\x67\x3a\x71\x3b\x69\x66\x28\x63\x26\x26\x63\x28\x71\x2c\x72\x2c\x68\x2c\x69\x29\x2c\x64\x29\x7b\x6a\x3d\x77\x61\x28\x72\x2c\x6e\x29\x2c\x64\x28\x6a\x2c\x5b\x5d\x2c\x68\x2c\x69\x29\x2c\x6b\x3d\x6a\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x28\x6c\x3d\x6a\x5b\x6b\x5d\x29\x26\x26\x28\x72\x5b\x6e\x5b\x6b\x5d\x5d\x3d\x21\x28\x71\x5b\x6e\x5b\x6b\x5d\x5d\x3d\x6c\x29\x29\x7d\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x65\x7c\x7c\x61\x29\x7b\x69\x66\x28\x65\x29\x7b\x6a\x3d\x5b\x5d\x2c\x6b\x3d\x72\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x28\x6c\x3d\x72\x5b\x6b\x5d\x29\x26\x26\x6a\x2e\x70\x75\x73\x68\x28\x71\x5b\x6b\x5d\x3d\x6c\x29\x3b\x65\x28\x6e\x75\x6c\x6c\x2c\x72\x3d\x5b\x5d\x2c\x6a\x2c\x69\x29\x7d\x6b\x3d\x72\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x28\x6c\x3d\x72\x5b\x6b\x5d\x29\x26\x26\x28\x6a\x3d\x65\x3f\x49\x28\x66\x2c\x6c\x29\x3a\x6d\x5b\x6b\x5d\x29\x3e\x2d\x31\x26\x26\x28\x66\x5b\x6a\x5d\x3d\x21\x28\x67\x5b\x6a\x5d\x3d\x6c\x29\x29\x7d\x7d\x65\x6c\x73\x65\x20\x72\x3d\x77\x61\x28\x72\x3d\x3d\x3d\x67\x3f\x72\x2e\x73\x70\x6c\x69\x63\x65\x28\x6f\x2c\x72\x2e\x6c\x65\x6e\x67\x74\x68\x29\x3a\x72\x29\x2c\x65\x3f\x65\x28\x6e\x75\x6c\x6c\x2c\x67\x2c\x72\x2c\x69\x29\x3a\x47\x2e\x61\x70\x70\x6c\x79\x28\x67\x2c\x72\x29\x7d\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x79\x61\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x2c\x63\x2c\x65\x2c\x66\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x67\x3d\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x61\x5b\x30\x5d\x2e\x74\x79\x70\x65\x5d\x2c\x68\x3d\x67\x7c\x7c\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x22\x20\x22\x5d\x2c\x69\x3d\x67\x3f\x31\x3a\x30\x2c\x6b\x3d\x74\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x7d\x2c\x68\x2c\x21\x30\x29\x2c\x6c\x3d\x74\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x28\x62\x2c\x61\x29\x3e\x2d\x31\x7d\x2c\x68\x2c\x21\x30\x29\x2c\x6d\x3d\x5b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x21\x67\x26\x26\x28\x64\x7c\x7c\x63\x21\x3d\x3d\x6a\x29\x7c\x7c\x28\x28\x62\x3d\x63\x29\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x6b\x28\x61\x2c\x63\x2c\x64\x29\x3a\x6c\x28\x61\x2c\x63\x2c\x64\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x6e\x75\x6c\x6c\x2c\x65\x7d\x5d\x3b\x69\x3c\x66\x3b\x69\x2b\x2b\x29\x69\x66\x28\x63\x3d\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x61\x5b\x69\x5d\x2e\x74\x79\x70\x65\x5d\x29\x6d\x3d\x5b\x74\x61\x28\x75\x61\x28\x6d\x29\x2c\x63\x29\x5d\x3b\x65\x6c\x73\x65\x7b\x69\x66\x28\x63\x3d\x64\x2e\x66\x69\x6c\x74\x65\x72\x5b\x61\x5b\x69\x5d\x2e\x74\x79\x70\x65\x5d\x2e\x61\x70\x70\x6c\x79\x28\x6e\x75\x6c\x6c\x2c\x61\x5b\x69\x5d\x2e\x6d\x61\x74\x63\x68\x65\x73\x29\x2c\x63\x5b\x75\x5d\x29\x7b\x66\x6f\x72\x28\x65\x3d\x2b\x2b\x69\x3b\x65\x3c\x66\x3b\x65\x2b\x2b\x29\x69\x66\x28\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x61\x5b\x65\x5d\x2e\x74\x79\x70\x65\x5d\x29\x62\x72\x65\x61\x6b\x3b\x72\x65\x74\x75\x72\x6e\x20\x78\x61\x28\x69\x3e\x31\x26\x26\x75\x61\x28\x6d\x29\x2c\x69\x3e\x31\x26\x26\x73\x61\x28\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x69\x2d\x31\x29\x2e\x63\x6f\x6e\x63\x61\x74\x28\x7b\x76\x61\x6c\x75\x65\x3a\x22\x20\x22\x3d\x3d\x3d\x61\x5b\x69\x2d\x32\x5d\x2e\x74\x79\x70\x65\x3f\x22\x2a\x22\x3a\x22\x22\x7d\x29\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x50\x2c\x22\x24\x31\x22\x29\x2c\x63\x2c\x69\x3c\x65\x26\x26\x79\x61\x28\x61\x2e\x73\x6c\x69\x63\x65\x28\x69\x2c\x65\x29\x29\x2c\x65\x3c\x66\x26\x26\x79\x61\x28\x61\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x65\x29\x29\x2c\x65\x3c\x66\x26\x26\x73\x61\x28\x61\x29\x29\x7d\x6d\x2e\x70\x75\x73\x68\x28\x63\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x75\x61\x28\x6d\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x7a\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x2c\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x2c\x66\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6b\x29\x7b\x76\x61\x72\x20\x6c\x2c\x6f\x2c\x71\x2c\x72\x3d\x30\x2c\x73\x3d\x22\x30\x22\x2c\x74\x3d\x66\x26\x26\x5b\x5d\x2c\x75\x3d\x5b\x5d\x2c\x76\x3d\x6a\x2c\x78\x3d\x66\x7c\x7c\x65\x26\x26\x64\x2e\x66\x69\x6e\x64\x2e\x54\x41\x47\x28\x22\x2a\x22\x2c\x6b\x29\x2c\x79\x3d\x77\x2b\x3d\x6e\x75\x6c\x6c\x3d\x3d\x76\x3f\x31\x3a\x4d\x61\x74\x68\x2e\x72\x61\x6e\x64\x6f\x6d\x28\x29\x7c\x7c\x2e\x31\x2c\x7a\x3d\x78\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x6f\x72\x28\x6b\x26\x26\x28\x6a\x3d\x67\x3d\x3d\x3d\x6e\x7c\x7c\x67\x7c\x7c\x6b\x29\x3b\x73\x21\x3d\x3d\x7a\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x28\x6c\x3d\x78\x5b\x73\x5d\x29\x3b\x73\x2b\x2b\x29\x7b\x69\x66\x28\x65\x26\x26\x6c\x29\x7b\x6f\x3d\x30\x2c\x67\x7c\x7c\x6c\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x3d\x3d\x6e\x7c\x7c\x28\x6d\x28\x6c\x29\x2c\x68\x3d\x21\x70\x29\x3b\x77\x68\x69\x6c\x65\x28\x71\x3d\x61\x5b\x6f\x2b\x2b\x5d\x29\x69\x66\x28\x71\x28\x6c\x2c\x67\x7c\x7c\x6e\x2c\x68\x29\x29\x7b\x69\x2e\x70\x75\x73\x68\x28\x6c\x29\x3b\x62\x72\x65\x61\x6b\x7d\x6b\x26\x26\x28\x77\x3d\x79\x29\x7d\x63\x26\x26\x28\x28\x6c\x3d\x21\x71\x26\x26\x6c\x29\x26\x26\x72\x2d\x2d\x2c\x66\x26\x26\x74\x2e\x70\x75\x73\x68\x28\x6c\x29\x29\x7d\x69\x66\x28\x72\x2b\x3d\x73\x2c\x63\x26\x26\x73\x21\x3d\x3d\x72\x29\x7b\x6f\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x71\x3d\x62\x5b\x6f\x2b\x2b\x5d\x29\x71\x28\x74\x2c\x75\x2c\x67\x2c\x68\x29\x3b\x69\x66\x28\x66\x29\x7b\x69\x66\x28\x72\x3e\x30\x29\x77\x68\x69\x6c\x65\x28\x73\x2d\x2d\x29\x74\x5b\x73\x5d\x7c\x7c\x75\x5b\x73\x5d\x7c\x7c\x28\x75\x5b\x73\x5d\x3d\x45\x2e\x63\x61\x6c\x6c\x28\x69\x29\x29\x3b\x75\x3d\x77\x61\x28\x75\x29\x7d\x47\x2e\x61\x70\x70\x6c\x79\x28\x69\x2c\x75\x29\x2c\x6b\x26\x26\x21\x66\x26\x26\x75\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x26\x26\x72\x2b\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x26\x26\x67\x61\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x69\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6b\x26\x26\x28\x77\x3d\x79\x2c\x6a\x3d\x76\x29\x2c\x74\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3f\x69\x61\x28\x66\x29\x3a\x66\x7d\x72\x65\x74\x75\x72\x6e\x20\x68\x3d\x67\x61\x2e\x63\x6f\x6d\x70\x69\x6c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x5b\x5d\x2c\x66\x3d\x41\x5b\x61\x2b\x22\x20\x22\x5d\x3b\x69\x66\x28\x21\x66\x29\x7b\x62\x7c\x7c\x28\x62\x3d\x67\x28\x61\x29\x29\x2c\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x66\x3d\x79\x61\x28\x62\x5b\x63\x5d\x29\x2c\x66\x5b\x75\x5d\x3f\x64\x2e\x70\x75\x73\x68\x28\x66\x29\x3a\x65\x2e\x70\x75\x73\x68\x28\x66\x29\x3b\x66\x3d\x41\x28\x61\x2c\x7a\x61\x28\x65\x2c\x64\x29\x29\x2c\x66\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x3d\x61\x7d\x72\x65\x74\x75\x72\x6e\x20\x66\x7d\x2c\x69\x3d\x67\x61\x2e\x73\x65\x6c\x65\x63\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x3d\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x61\x2c\x6e\x3d\x21\x65\x26\x26\x67\x28\x61\x3d\x6d\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x7c\x7c\x61\x29\x3b\x69\x66\x28\x63\x3d\x63\x7c\x7c\x5b\x5d\x2c\x31\x3d\x3d\x3d\x6e\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7b\x69\x66\x28\x69\x3d\x6e\x5b\x30\x5d\x3d\x6e\x5b\x30\x5d\x2e\x73\x6c\x69\x63\x65\x28\x30\x29\x2c\x69\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x32\x26\x26\x22\x49\x44\x22\x3d\x3d\x3d\x28\x6a\x3d\x69\x5b\x30\x5d\x29\x2e\x74\x79\x70\x65\x26\x26\x39\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x70\x26\x26\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x69\x5b\x31\x5d\x2e\x74\x79\x70\x65\x5d\x29\x7b\x69\x66\x28\x62\x3d\x28\x64\x2e\x66\x69\x6e\x64\x2e\x49\x44\x28\x6a\x2e\x6d\x61\x74\x63\x68\x65\x73\x5b\x30\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x62\x29\x7c\x7c\x5b\x5d\x29\x5b\x30\x5d\x2c\x21\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3b\x6d\x26\x26\x28\x62\x3d\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x2c\x61\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x69\x2e\x73\x68\x69\x66\x74\x28\x29\x2e\x76\x61\x6c\x75\x65\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x66\x3d\x56\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x30\x3a\x69\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x66\x2d\x2d\x29\x7b\x69\x66\x28\x6a\x3d\x69\x5b\x66\x5d\x2c\x64\x2e\x72\x65\x6c\x61\x74\x69\x76\x65\x5b\x6b\x3d\x6a\x2e\x74\x79\x70\x65\x5d\x29\x62\x72\x65\x61\x6b\x3b\x69\x66\x28\x28\x6c\x3d\x64\x2e\x66\x69\x6e\x64\x5b\x6b\x5d\x29\x26\x26\x28\x65\x3d\x6c\x28\x6a\x2e\x6d\x61\x74\x63\x68\x65\x73\x5b\x30\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x5f\x2c\x61\x61\x29\x2c\x24\x2e\x74\x65\x73\x74\x28\x69\x5b\x30\x5d\x2e\x74\x79\x70\x65\x29\x26\x26\x71\x61\x28\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x7c\x7c\x62\x29\x29\x29\x7b\x69\x66\x28\x69\x2e\x73\x70\x6c\x69\x63\x65\x28\x66\x2c\x31\x29\x2c\x61\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x73\x61\x28\x69\x29\x2c\x21\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x47\x2e\x61\x70\x70\x6c\x79\x28\x63\x2c\x65\x29\x2c\x63\x3b\x62\x72\x65\x61\x6b\x7d\x7d\x7d\x72\x65\x74\x75\x72\x6e\x28\x6d\x7c\x7c\x68\x28\x61\x2c\x6e\x29\x29\x28\x65\x2c\x62\x2c\x21\x70\x2c\x63\x2c\x21\x62\x7c\x7c\x24\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x71\x61\x28\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x7c\x7c\x62\x29\x2c\x63\x7d\x2c\x63\x2e\x73\x6f\x72\x74\x53\x74\x61\x62\x6c\x65\x3d\x75\x2e\x73\x70\x6c\x69\x74\x28\x22\x22\x29\x2e\x73\x6f\x72\x74\x28\x42\x29\x2e\x6a\x6f\x69\x6e\x28\x22\x22\x29\x3d\x3d\x3d\x75\x2c\x63\x2e\x64\x65\x74\x65\x63\x74\x44\x75\x70\x6c\x69\x63\x61\x74\x65\x73\x3d\x21\x21\x6c\x2c\x6d\x28\x29\x2c\x63\x2e\x73\x6f\x72\x74\x44\x65\x74\x61\x63\x68\x65\x64\x3d\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x26\x61\x2e\x63\x6f\x6d\x70\x61\x72\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x50\x6f\x73\x69\x74\x69\x6f\x6e\x28\x6e\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x66\x69\x65\x6c\x64\x73\x65\x74\x22\x29\x29\x7d\x29\x2c\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x61\x20\x68\x72\x65\x66\x3d\x27\x23\x27\x3e\x3c\x2f\x61\x3e\x22\x2c\x22\x23\x22\x3d\x3d\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x68\x72\x65\x66\x22\x29\x7d\x29\x7c\x7c\x6b\x61\x28\x22\x74\x79\x70\x65\x7c\x68\x72\x65\x66\x7c\x68\x65\x69\x67\x68\x74\x7c\x77\x69\x64\x74\x68\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x69\x66\x28\x21\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x62\x2c\x22\x74\x79\x70\x65\x22\x3d\x3d\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3f\x31\x3a\x32\x29\x7d\x29\x2c\x63\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x26\x26\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x69\x6e\x70\x75\x74\x2f\x3e\x22\x2c\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x76\x61\x6c\x75\x65\x22\x2c\x22\x22\x29\x2c\x22\x22\x3d\x3d\x3d\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x76\x61\x6c\x75\x65\x22\x29\x7d\x29\x7c\x7c\x6b\x61\x28\x22\x76\x61\x6c\x75\x65\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x69\x66\x28\x21\x63\x26\x26\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x7d\x29\x2c\x6a\x61\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x7d\x29\x7c\x7c\x6b\x61\x28\x4a\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x69\x66\x28\x21\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x62\x5d\x3d\x3d\x3d\x21\x30\x3f\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3a\x28\x64\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x4e\x6f\x64\x65\x28\x62\x29\x29\x26\x26\x64\x2e\x73\x70\x65\x63\x69\x66\x69\x65\x64\x3f\x64\x2e\x76\x61\x6c\x75\x65\x3a\x6e\x75\x6c\x6c\x7d\x29\x2c\x67\x61\x7d\x28\x61\x29\x3b\x72\x2e\x66\x69\x6e\x64\x3d\x78\x2c\x72\x2e\x65\x78\x70\x72\x3d\x78\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x73\x2c\x72\x2e\x65\x78\x70\x72\x5b\x22\x3a\x22\x5d\x3d\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2c\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x3d\x72\x2e\x75\x6e\x69\x71\x75\x65\x3d\x78\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x2c\x72\x2e\x74\x65\x78\x74\x3d\x78\x2e\x67\x65\x74\x54\x65\x78\x74\x2c\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x3d\x78\x2e\x69\x73\x58\x4d\x4c\x2c\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x3d\x78\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x2c\x72\x2e\x65\x73\x63\x61\x70\x65\x53\x65\x6c\x65\x63\x74\x6f\x72\x3d\x78\x2e\x65\x73\x63\x61\x70\x65\x3b\x76\x61\x72\x20\x79\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x5b\x5d\x2c\x65\x3d\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3b\x77\x68\x69\x6c\x65\x28\x28\x61\x3d\x61\x5b\x62\x5d\x29\x26\x26\x39\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x69\x66\x28\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x69\x66\x28\x65\x26\x26\x72\x28\x61\x29\x2e\x69\x73\x28\x63\x29\x29\x62\x72\x65\x61\x6b\x3b\x64\x2e\x70\x75\x73\x68\x28\x61\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x2c\x7a\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x5b\x5d\x3b\x61\x3b\x61\x3d\x61\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x21\x3d\x3d\x62\x26\x26\x63\x2e\x70\x75\x73\x68\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x2c\x41\x3d\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x2c\x42\x3d\x2f\x5e\x3c\x28\x5b\x61\x2d\x7a\x5d\x5b\x5e\x5c\x2f\x5c\x30\x3e\x3a\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2a\x29\x5b\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2a\x5c\x2f\x3f\x3e\x28\x3f\x3a\x3c\x5c\x2f\x5c\x31\x3e\x7c\x29\x24\x2f\x69\x2c\x43\x3d\x2f\x5e\x2e\x5b\x5e\x3a\x23\x5c\x5b\x5c\x2e\x2c\x5d\x2a\x24\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x44\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x62\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x64\x2c\x61\x29\x21\x3d\x3d\x63\x7d\x29\x3a\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x21\x3d\x3d\x63\x7d\x29\x3a\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x3f\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x69\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x61\x29\x3e\x2d\x31\x21\x3d\x3d\x63\x7d\x29\x3a\x43\x2e\x74\x65\x73\x74\x28\x62\x29\x3f\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x2c\x63\x29\x3a\x28\x62\x3d\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x29\x2c\x72\x2e\x67\x72\x65\x70\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x69\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x61\x29\x3e\x2d\x31\x21\x3d\x3d\x63\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x29\x29\x7d\x72\x2e\x66\x69\x6c\x74\x65\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x62\x5b\x30\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x28\x61\x3d\x22\x3a\x6e\x6f\x74\x28\x22\x2b\x61\x2b\x22\x29\x22\x29\x2c\x31\x3d\x3d\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x31\x3d\x3d\x3d\x64\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x28\x64\x2c\x61\x29\x3f\x5b\x64\x5d\x3a\x5b\x5d\x3a\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x28\x61\x2c\x72\x2e\x67\x72\x65\x70\x28\x62\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x29\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x66\x69\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x65\x3d\x74\x68\x69\x73\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x72\x28\x61\x29\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x62\x3d\x30\x3b\x62\x3c\x64\x3b\x62\x2b\x2b\x29\x69\x66\x28\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x65\x5b\x62\x5d\x2c\x74\x68\x69\x73\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x29\x29\x3b\x66\x6f\x72\x28\x63\x3d\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x5b\x5d\x29\x2c\x62\x3d\x30\x3b\x62\x3c\x64\x3b\x62\x2b\x2b\x29\x72\x2e\x66\x69\x6e\x64\x28\x61\x2c\x65\x5b\x62\x5d\x2c\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3e\x31\x3f\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x63\x29\x3a\x63\x7d\x2c\x66\x69\x6c\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x44\x28\x74\x68\x69\x73\x2c\x61\x7c\x7c\x5b\x5d\x2c\x21\x31\x29\x29\x7d\x2c\x6e\x6f\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x44\x28\x74\x68\x69\x73\x2c\x61\x7c\x7c\x5b\x5d\x2c\x21\x30\x29\x29\x7d\x2c\x69\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x44\x28\x74\x68\x69\x73\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x41\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x72\x28\x61\x29\x3a\x61\x7c\x7c\x5b\x5d\x2c\x21\x31\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x7d\x29\x3b\x76\x61\x72\x20\x45\x2c\x46\x3d\x2f\x5e\x28\x3f\x3a\x5c\x73\x2a\x28\x3c\x5b\x5c\x77\x5c\x57\x5d\x2b\x3e\x29\x5b\x5e\x3e\x5d\x2a\x7c\x23\x28\x5b\x5c\x77\x2d\x5d\x2b\x29\x29\x24\x2f\x2c\x47\x3d\x72\x2e\x66\x6e\x2e\x69\x6e\x69\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3b\x69\x66\x28\x21\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x3b\x69\x66\x28\x63\x3d\x63\x7c\x7c\x45\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x7b\x69\x66\x28\x65\x3d\x22\x3c\x22\x3d\x3d\x3d\x61\x5b\x30\x5d\x26\x26\x22\x3e\x22\x3d\x3d\x3d\x61\x5b\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x5d\x26\x26\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x3d\x33\x3f\x5b\x6e\x75\x6c\x6c\x2c\x61\x2c\x6e\x75\x6c\x6c\x5d\x3a\x46\x2e\x65\x78\x65\x63\x28\x61\x29\x2c\x21\x65\x7c\x7c\x21\x65\x5b\x31\x5d\x26\x26\x62\x29\x72\x65\x74\x75\x72\x6e\x21\x62\x7c\x7c\x62\x2e\x6a\x71\x75\x65\x72\x79\x3f\x28\x62\x7c\x7c\x63\x29\x2e\x66\x69\x6e\x64\x28\x61\x29\x3a\x74\x68\x69\x73\x2e\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x28\x62\x29\x2e\x66\x69\x6e\x64\x28\x61\x29\x3b\x69\x66\x28\x65\x5b\x31\x5d\x29\x7b\x69\x66\x28\x62\x3d\x62\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x6f\x66\x20\x72\x3f\x62\x5b\x30\x5d\x3a\x62\x2c\x72\x2e\x6d\x65\x72\x67\x65\x28\x74\x68\x69\x73\x2c\x72\x2e\x70\x61\x72\x73\x65\x48\x54\x4d\x4c\x28\x65\x5b\x31\x5d\x2c\x62\x26\x26\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x62\x3a\x64\x2c\x21\x30\x29\x29\x2c\x42\x2e\x74\x65\x73\x74\x28\x65\x5b\x31\x5d\x29\x26\x26\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x62\x29\x29\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x62\x29\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x74\x68\x69\x73\x5b\x65\x5d\x29\x3f\x74\x68\x69\x73\x5b\x65\x5d\x28\x62\x5b\x65\x5d\x29\x3a\x74\x68\x69\x73\x2e\x61\x74\x74\x72\x28\x65\x2c\x62\x5b\x65\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x72\x65\x74\x75\x72\x6e\x20\x66\x3d\x64\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x65\x5b\x32\x5d\x29\x2c\x66\x26\x26\x28\x74\x68\x69\x73\x5b\x30\x5d\x3d\x66\x2c\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x31\x29\x2c\x74\x68\x69\x73\x7d\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x28\x74\x68\x69\x73\x5b\x30\x5d\x3d\x61\x2c\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3d\x31\x2c\x74\x68\x69\x73\x29\x3a\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x2e\x72\x65\x61\x64\x79\x3f\x63\x2e\x72\x65\x61\x64\x79\x28\x61\x29\x3a\x61\x28\x72\x29\x3a\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x61\x2c\x74\x68\x69\x73\x29\x7d\x3b\x47\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x72\x2e\x66\x6e\x2c\x45\x3d\x72\x28\x64\x29\x3b\x76\x61\x72\x20\x48\x3d\x2f\x5e\x28\x3f\x3a\x70\x61\x72\x65\x6e\x74\x73\x7c\x70\x72\x65\x76\x28\x3f\x3a\x55\x6e\x74\x69\x6c\x7c\x41\x6c\x6c\x29\x29\x2f\x2c\x49\x3d\x7b\x63\x68\x69\x6c\x64\x72\x65\x6e\x3a\x21\x30\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x21\x30\x2c\x6e\x65\x78\x74\x3a\x21\x30\x2c\x70\x72\x65\x76\x3a\x21\x30\x7d\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x61\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x28\x61\x2c\x74\x68\x69\x73\x29\x2c\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x61\x3d\x30\x3b\x61\x3c\x63\x3b\x61\x2b\x2b\x29\x69\x66\x28\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x74\x68\x69\x73\x2c\x62\x5b\x61\x5d\x29\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x29\x7d\x2c\x63\x6c\x6f\x73\x65\x73\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x66\x3d\x5b\x5d\x2c\x67\x3d\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x72\x28\x61\x29\x3b\x69\x66\x28\x21\x41\x2e\x74\x65\x73\x74\x28\x61\x29\x29\x66\x6f\x72\x28\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x66\x6f\x72\x28\x63\x3d\x74\x68\x69\x73\x5b\x64\x5d\x3b\x63\x26\x26\x63\x21\x3d\x3d\x62\x3b\x63\x3d\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x69\x66\x28\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3c\x31\x31\x26\x26\x28\x67\x3f\x67\x2e\x69\x6e\x64\x65\x78\x28\x63\x29\x3e\x2d\x31\x3a\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x28\x63\x2c\x61\x29\x29\x29\x7b\x66\x2e\x70\x75\x73\x68\x28\x63\x29\x3b\x62\x72\x65\x61\x6b\x7d\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x66\x29\x3a\x66\x29\x7d\x2c\x69\x6e\x64\x65\x78\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x69\x2e\x63\x61\x6c\x6c\x28\x72\x28\x61\x29\x2c\x74\x68\x69\x73\x5b\x30\x5d\x29\x3a\x69\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x61\x2e\x6a\x71\x75\x65\x72\x79\x3f\x61\x5b\x30\x5d\x3a\x61\x29\x3a\x74\x68\x69\x73\x5b\x30\x5d\x26\x26\x74\x68\x69\x73\x5b\x30\x5d\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3f\x74\x68\x69\x73\x2e\x66\x69\x72\x73\x74\x28\x29\x2e\x70\x72\x65\x76\x41\x6c\x6c\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x2d\x31\x7d\x2c\x61\x64\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x72\x2e\x6d\x65\x72\x67\x65\x28\x74\x68\x69\x73\x2e\x67\x65\x74\x28\x29\x2c\x72\x28\x61\x2c\x62\x29\x29\x29\x29\x7d\x2c\x61\x64\x64\x42\x61\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x61\x64\x64\x28\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x74\x68\x69\x73\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x3a\x74\x68\x69\x73\x2e\x70\x72\x65\x76\x4f\x62\x6a\x65\x63\x74\x2e\x66\x69\x6c\x74\x65\x72\x28\x61\x29\x29\x7d\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4a\x28\x61\x2c\x62\x29\x7b\x77\x68\x69\x6c\x65\x28\x28\x61\x3d\x61\x5b\x62\x5d\x29\x26\x26\x31\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x72\x2e\x65\x61\x63\x68\x28\x7b\x70\x61\x72\x65\x6e\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x26\x26\x31\x31\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x62\x3a\x6e\x75\x6c\x6c\x7d\x2c\x70\x61\x72\x65\x6e\x74\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x29\x7d\x2c\x70\x61\x72\x65\x6e\x74\x73\x55\x6e\x74\x69\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x22\x2c\x63\x29\x7d\x2c\x6e\x65\x78\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x28\x61\x2c\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x70\x72\x65\x76\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x28\x61\x2c\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x6e\x65\x78\x74\x41\x6c\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x70\x72\x65\x76\x41\x6c\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x29\x7d\x2c\x6e\x65\x78\x74\x55\x6e\x74\x69\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x63\x29\x7d\x2c\x70\x72\x65\x76\x55\x6e\x74\x69\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x79\x28\x61\x2c\x22\x70\x72\x65\x76\x69\x6f\x75\x73\x53\x69\x62\x6c\x69\x6e\x67\x22\x2c\x63\x29\x7d\x2c\x73\x69\x62\x6c\x69\x6e\x67\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x7a\x28\x28\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x7c\x7c\x7b\x7d\x29\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x61\x29\x7d\x2c\x63\x68\x69\x6c\x64\x72\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x7a\x28\x61\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x7d\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x72\x2e\x6d\x65\x72\x67\x65\x28\x5b\x5d\x2c\x61\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x7d\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x72\x2e\x6d\x61\x70\x28\x74\x68\x69\x73\x2c\x62\x2c\x63\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x55\x6e\x74\x69\x6c\x22\x21\x3d\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x2d\x35\x29\x26\x26\x28\x64\x3d\x63\x29\x2c\x64\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x64\x26\x26\x28\x65\x3d\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x64\x2c\x65\x29\x29\x2c\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x26\x26\x28\x49\x5b\x61\x5d\x7c\x7c\x72\x2e\x75\x6e\x69\x71\x75\x65\x53\x6f\x72\x74\x28\x65\x29\x2c\x48\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x65\x2e\x72\x65\x76\x65\x72\x73\x65\x28\x29\x29\x2c\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x65\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x4b\x3d\x2f\x5b\x5e\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2b\x2f\x67\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4c\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x7b\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x62\x5b\x63\x5d\x3d\x21\x30\x7d\x29\x2c\x62\x7d\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x3d\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x4c\x28\x61\x29\x3a\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x61\x29\x3b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x5b\x5d\x2c\x67\x3d\x5b\x5d\x2c\x68\x3d\x2d\x31\x2c\x69\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x65\x3d\x61\x2e\x6f\x6e\x63\x65\x2c\x64\x3d\x62\x3d\x21\x30\x3b\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x68\x3d\x2d\x31\x29\x7b\x63\x3d\x67\x2e\x73\x68\x69\x66\x74\x28\x29\x3b\x77\x68\x69\x6c\x65\x28\x2b\x2b\x68\x3c\x66\x2e\x6c\x65\x6e\x67\x74\x68\x29\x66\x5b\x68\x5d\x2e\x61\x70\x70\x6c\x79\x28\x63\x5b\x30\x5d\x2c\x63\x5b\x31\x5d\x29\x3d\x3d\x3d\x21\x31\x26\x26\x61\x2e\x73\x74\x6f\x70\x4f\x6e\x46\x61\x6c\x73\x65\x26\x26\x28\x68\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x21\x31\x29\x7d\x61\x2e\x6d\x65\x6d\x6f\x72\x79\x7c\x7c\x28\x63\x3d\x21\x31\x29\x2c\x62\x3d\x21\x31\x2c\x65\x26\x26\x28\x66\x3d\x63\x3f\x5b\x5d\x3a\x22\x22\x29\x7d\x2c\x6a\x3d\x7b\x61\x64\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x26\x26\x28\x63\x26\x26\x21\x62\x26\x26\x28\x68\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x2c\x67\x2e\x70\x75\x73\x68\x28\x63\x29\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x64\x28\x62\x29\x7b\x72\x2e\x65\x61\x63\x68\x28\x62\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x3f\x61\x2e\x75\x6e\x69\x71\x75\x65\x26\x26\x6a\x2e\x68\x61\x73\x28\x63\x29\x7c\x7c\x66\x2e\x70\x75\x73\x68\x28\x63\x29\x3a\x63\x26\x26\x63\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x63\x29\x26\x26\x64\x28\x63\x29\x7d\x29\x7d\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x63\x26\x26\x21\x62\x26\x26\x69\x28\x29\x29\x2c\x74\x68\x69\x73\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x77\x68\x69\x6c\x65\x28\x28\x63\x3d\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x62\x2c\x66\x2c\x63\x29\x29\x3e\x2d\x31\x29\x66\x2e\x73\x70\x6c\x69\x63\x65\x28\x63\x2c\x31\x29\x2c\x63\x3c\x3d\x68\x26\x26\x68\x2d\x2d\x7d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x68\x61\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x61\x2c\x66\x29\x3e\x2d\x31\x3a\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x7d\x2c\x65\x6d\x70\x74\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x26\x26\x28\x66\x3d\x5b\x5d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x64\x69\x73\x61\x62\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x3d\x67\x3d\x5b\x5d\x2c\x66\x3d\x63\x3d\x22\x22\x2c\x74\x68\x69\x73\x7d\x2c\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x66\x7d\x2c\x6c\x6f\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x3d\x67\x3d\x5b\x5d\x2c\x63\x7c\x7c\x62\x7c\x7c\x28\x66\x3d\x63\x3d\x22\x22\x29\x2c\x74\x68\x69\x73\x7d\x2c\x6c\x6f\x63\x6b\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x65\x7d\x2c\x66\x69\x72\x65\x57\x69\x74\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x7c\x7c\x28\x63\x3d\x63\x7c\x7c\x5b\x5d\x2c\x63\x3d\x5b\x61\x2c\x63\x2e\x73\x6c\x69\x63\x65\x3f\x63\x2e\x73\x6c\x69\x63\x65\x28\x29\x3a\x63\x5d\x2c\x67\x2e\x70\x75\x73\x68\x28\x63\x29\x2c\x62\x7c\x7c\x69\x28\x29\x29\x2c\x74\x68\x69\x73\x7d\x2c\x66\x69\x72\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6a\x2e\x66\x69\x72\x65\x57\x69\x74\x68\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x74\x68\x69\x73\x7d\x2c\x66\x69\x72\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x64\x7d\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x6a\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4d\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4e\x28\x61\x29\x7b\x74\x68\x72\x6f\x77\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4f\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x74\x72\x79\x7b\x61\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x3d\x61\x2e\x70\x72\x6f\x6d\x69\x73\x65\x29\x3f\x64\x2e\x63\x61\x6c\x6c\x28\x61\x29\x2e\x64\x6f\x6e\x65\x28\x62\x29\x2e\x66\x61\x69\x6c\x28\x63\x29\x3a\x61\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x3d\x61\x2e\x74\x68\x65\x6e\x29\x3f\x64\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x62\x2c\x63\x29\x3a\x62\x2e\x63\x61\x6c\x6c\x28\x76\x6f\x69\x64\x20\x30\x2c\x61\x29\x7d\x63\x61\x74\x63\x68\x28\x61\x29\x7b\x63\x2e\x63\x61\x6c\x6c\x28\x76\x6f\x69\x64\x20\x30\x2c\x61\x29\x7d\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x44\x65\x66\x65\x72\x72\x65\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x5b\x5b\x22\x6e\x6f\x74\x69\x66\x79\x22\x2c\x22\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x32\x5d\x2c\x5b\x22\x72\x65\x73\x6f\x6c\x76\x65\x22\x2c\x22\x64\x6f\x6e\x65\x22\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x30\x2c\x22\x72\x65\x73\x6f\x6c\x76\x65\x64\x22\x5d\x2c\x5b\x22\x72\x65\x6a\x65\x63\x74\x22\x2c\x22\x66\x61\x69\x6c\x22\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x31\x2c\x22\x72\x65\x6a\x65\x63\x74\x65\x64\x22\x5d\x5d\x2c\x64\x3d\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x2c\x65\x3d\x7b\x73\x74\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x2c\x61\x6c\x77\x61\x79\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x64\x6f\x6e\x65\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2e\x66\x61\x69\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x74\x68\x69\x73\x7d\x2c\x22\x63\x61\x74\x63\x68\x22\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x65\x2e\x74\x68\x65\x6e\x28\x6e\x75\x6c\x6c\x2c\x61\x29\x7d\x2c\x70\x69\x70\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x2e\x65\x61\x63\x68\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x5b\x64\x5b\x34\x5d\x5d\x29\x26\x26\x61\x5b\x64\x5b\x34\x5d\x5d\x3b\x66\x5b\x64\x5b\x31\x5d\x5d\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x65\x26\x26\x65\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3b\x61\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2e\x70\x72\x6f\x6d\x69\x73\x65\x29\x3f\x61\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x28\x62\x2e\x6e\x6f\x74\x69\x66\x79\x29\x2e\x64\x6f\x6e\x65\x28\x62\x2e\x72\x65\x73\x6f\x6c\x76\x65\x29\x2e\x66\x61\x69\x6c\x28\x62\x2e\x72\x65\x6a\x65\x63\x74\x29\x3a\x62\x5b\x64\x5b\x30\x5d\x2b\x22\x57\x69\x74\x68\x22\x5d\x28\x74\x68\x69\x73\x2c\x65\x3f\x5b\x61\x5d\x3a\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x7d\x29\x7d\x29\x2c\x61\x3d\x6e\x75\x6c\x6c\x7d\x29\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x7d\x2c\x74\x68\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x30\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x28\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x68\x3d\x74\x68\x69\x73\x2c\x69\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x6a\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x2c\x6a\x3b\x69\x66\x28\x21\x28\x62\x3c\x66\x29\x29\x7b\x69\x66\x28\x61\x3d\x64\x2e\x61\x70\x70\x6c\x79\x28\x68\x2c\x69\x29\x2c\x61\x3d\x3d\x3d\x63\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x29\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x54\x79\x70\x65\x45\x72\x72\x6f\x72\x28\x22\x54\x68\x65\x6e\x61\x62\x6c\x65\x20\x73\x65\x6c\x66\x2d\x72\x65\x73\x6f\x6c\x75\x74\x69\x6f\x6e\x22\x29\x3b\x6a\x3d\x61\x26\x26\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x7c\x7c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x26\x26\x61\x2e\x74\x68\x65\x6e\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6a\x29\x3f\x65\x3f\x6a\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x67\x28\x66\x2c\x63\x2c\x4d\x2c\x65\x29\x2c\x67\x28\x66\x2c\x63\x2c\x4e\x2c\x65\x29\x29\x3a\x28\x66\x2b\x2b\x2c\x6a\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x67\x28\x66\x2c\x63\x2c\x4d\x2c\x65\x29\x2c\x67\x28\x66\x2c\x63\x2c\x4e\x2c\x65\x29\x2c\x67\x28\x66\x2c\x63\x2c\x4d\x2c\x63\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x29\x29\x29\x3a\x28\x64\x21\x3d\x3d\x4d\x26\x26\x28\x68\x3d\x76\x6f\x69\x64\x20\x30\x2c\x69\x3d\x5b\x61\x5d\x29\x2c\x28\x65\x7c\x7c\x63\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x29\x28\x68\x2c\x69\x29\x29\x7d\x7d\x2c\x6b\x3d\x65\x3f\x6a\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x74\x72\x79\x7b\x6a\x28\x29\x7d\x63\x61\x74\x63\x68\x28\x61\x29\x7b\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x48\x6f\x6f\x6b\x26\x26\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x48\x6f\x6f\x6b\x28\x61\x2c\x6b\x2e\x73\x74\x61\x63\x6b\x54\x72\x61\x63\x65\x29\x2c\x62\x2b\x31\x3e\x3d\x66\x26\x26\x28\x64\x21\x3d\x3d\x4e\x26\x26\x28\x68\x3d\x76\x6f\x69\x64\x20\x30\x2c\x69\x3d\x5b\x61\x5d\x29\x2c\x63\x2e\x72\x65\x6a\x65\x63\x74\x57\x69\x74\x68\x28\x68\x2c\x69\x29\x29\x7d\x7d\x3b\x62\x3f\x6b\x28\x29\x3a\x28\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x67\x65\x74\x53\x74\x61\x63\x6b\x48\x6f\x6f\x6b\x26\x26\x28\x6b\x2e\x73\x74\x61\x63\x6b\x54\x72\x61\x63\x65\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x67\x65\x74\x53\x74\x61\x63\x6b\x48\x6f\x6f\x6b\x28\x29\x29\x2c\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x6b\x29\x29\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x63\x5b\x30\x5d\x5b\x33\x5d\x2e\x61\x64\x64\x28\x67\x28\x30\x2c\x61\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x29\x3f\x65\x3a\x4d\x2c\x61\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x29\x29\x2c\x63\x5b\x31\x5d\x5b\x33\x5d\x2e\x61\x64\x64\x28\x67\x28\x30\x2c\x61\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x62\x3a\x4d\x29\x29\x2c\x63\x5b\x32\x5d\x5b\x33\x5d\x2e\x61\x64\x64\x28\x67\x28\x30\x2c\x61\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x3f\x64\x3a\x4e\x29\x29\x7d\x29\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x7d\x2c\x70\x72\x6f\x6d\x69\x73\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x61\x3f\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x61\x2c\x65\x29\x3a\x65\x7d\x7d\x2c\x66\x3d\x7b\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x61\x63\x68\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x67\x3d\x62\x5b\x32\x5d\x2c\x68\x3d\x62\x5b\x35\x5d\x3b\x65\x5b\x62\x5b\x31\x5d\x5d\x3d\x67\x2e\x61\x64\x64\x2c\x68\x26\x26\x67\x2e\x61\x64\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x64\x3d\x68\x7d\x2c\x63\x5b\x33\x2d\x61\x5d\x5b\x32\x5d\x2e\x64\x69\x73\x61\x62\x6c\x65\x2c\x63\x5b\x30\x5d\x5b\x32\x5d\x2e\x6c\x6f\x63\x6b\x29\x2c\x67\x2e\x61\x64\x64\x28\x62\x5b\x33\x5d\x2e\x66\x69\x72\x65\x29\x2c\x66\x5b\x62\x5b\x30\x5d\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x5b\x62\x5b\x30\x5d\x2b\x22\x57\x69\x74\x68\x22\x5d\x28\x74\x68\x69\x73\x3d\x3d\x3d\x66\x3f\x76\x6f\x69\x64\x20\x30\x3a\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x74\x68\x69\x73\x7d\x2c\x66\x5b\x62\x5b\x30\x5d\x2b\x22\x57\x69\x74\x68\x22\x5d\x3d\x67\x2e\x66\x69\x72\x65\x57\x69\x74\x68\x7d\x29\x2c\x65\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x66\x29\x2c\x62\x26\x26\x62\x2e\x63\x61\x6c\x6c\x28\x66\x2c\x66\x29\x2c\x66\x7d\x2c\x77\x68\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x63\x3d\x62\x2c\x64\x3d\x41\x72\x72\x61\x79\x28\x63\x29\x2c\x65\x3d\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x67\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2c\x68\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x64\x5b\x61\x5d\x3d\x74\x68\x69\x73\x2c\x65\x5b\x61\x5d\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x3f\x66\x2e\x63\x61\x6c\x6c\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3a\x63\x2c\x2d\x2d\x62\x7c\x7c\x67\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x64\x2c\x65\x29\x7d\x7d\x3b\x69\x66\x28\x62\x3c\x3d\x31\x26\x26\x28\x4f\x28\x61\x2c\x67\x2e\x64\x6f\x6e\x65\x28\x68\x28\x63\x29\x29\x2e\x72\x65\x73\x6f\x6c\x76\x65\x2c\x67\x2e\x72\x65\x6a\x65\x63\x74\x29\x2c\x22\x70\x65\x6e\x64\x69\x6e\x67\x22\x3d\x3d\x3d\x67\x2e\x73\x74\x61\x74\x65\x28\x29\x7c\x7c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x5b\x63\x5d\x26\x26\x65\x5b\x63\x5d\x2e\x74\x68\x65\x6e\x29\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x74\x68\x65\x6e\x28\x29\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x4f\x28\x65\x5b\x63\x5d\x2c\x68\x28\x63\x29\x2c\x67\x2e\x72\x65\x6a\x65\x63\x74\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x50\x3d\x2f\x5e\x28\x45\x76\x61\x6c\x7c\x49\x6e\x74\x65\x72\x6e\x61\x6c\x7c\x52\x61\x6e\x67\x65\x7c\x52\x65\x66\x65\x72\x65\x6e\x63\x65\x7c\x53\x79\x6e\x74\x61\x78\x7c\x54\x79\x70\x65\x7c\x55\x52\x49\x29\x45\x72\x72\x6f\x72\x24\x2f\x3b\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x2e\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x48\x6f\x6f\x6b\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x61\x2e\x63\x6f\x6e\x73\x6f\x6c\x65\x26\x26\x61\x2e\x63\x6f\x6e\x73\x6f\x6c\x65\x2e\x77\x61\x72\x6e\x26\x26\x62\x26\x26\x50\x2e\x74\x65\x73\x74\x28\x62\x2e\x6e\x61\x6d\x65\x29\x26\x26\x61\x2e\x63\x6f\x6e\x73\x6f\x6c\x65\x2e\x77\x61\x72\x6e\x28\x22\x6a\x51\x75\x65\x72\x79\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x20\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x3a\x20\x22\x2b\x62\x2e\x6d\x65\x73\x73\x61\x67\x65\x2c\x62\x2e\x73\x74\x61\x63\x6b\x2c\x63\x29\x7d\x2c\x72\x2e\x72\x65\x61\x64\x79\x45\x78\x63\x65\x70\x74\x69\x6f\x6e\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x74\x68\x72\x6f\x77\x20\x62\x7d\x29\x7d\x3b\x76\x61\x72\x20\x51\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x3b\x72\x2e\x66\x6e\x2e\x72\x65\x61\x64\x79\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x51\x2e\x74\x68\x65\x6e\x28\x61\x29\x5b\x22\x63\x61\x74\x63\x68\x22\x5d\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x72\x65\x61\x64\x79\x45\x78\x63\x65\x70\x74\x69\x6f\x6e\x28\x61\x29\x7d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x69\x73\x52\x65\x61\x64\x79\x3a\x21\x31\x2c\x72\x65\x61\x64\x79\x57\x61\x69\x74\x3a\x31\x2c\x68\x6f\x6c\x64\x52\x65\x61\x64\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x3f\x72\x2e\x72\x65\x61\x64\x79\x57\x61\x69\x74\x2b\x2b\x3a\x72\x2e\x72\x65\x61\x64\x79\x28\x21\x30\x29\x7d\x2c\x72\x65\x61\x64\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x28\x61\x3d\x3d\x3d\x21\x30\x3f\x2d\x2d\x72\x2e\x72\x65\x61\x64\x79\x57\x61\x69\x74\x3a\x72\x2e\x69\x73\x52\x65\x61\x64\x79\x29\x7c\x7c\x28\x72\x2e\x69\x73\x52\x65\x61\x64\x79\x3d\x21\x30\x2c\x61\x21\x3d\x3d\x21\x30\x26\x26\x2d\x2d\x72\x2e\x72\x65\x61\x64\x79\x57\x61\x69\x74\x3e\x30\x7c\x7c\x51\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x64\x2c\x5b\x72\x5d\x29\x29\x7d\x7d\x29\x2c\x72\x2e\x72\x65\x61\x64\x79\x2e\x74\x68\x65\x6e\x3d\x51\x2e\x74\x68\x65\x6e\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x52\x28\x29\x7b\x64\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x44\x4f\x4d\x43\x6f\x6e\x74\x65\x6e\x74\x4c\x6f\x61\x64\x65\x64\x22\x2c\x52\x29\x2c\x0a\x61\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x6c\x6f\x61\x64\x22\x2c\x52\x29\x2c\x72\x2e\x72\x65\x61\x64\x79\x28\x29\x7d\x22\x63\x6f\x6d\x70\x6c\x65\x74\x65\x22\x3d\x3d\x3d\x64\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x7c\x7c\x22\x6c\x6f\x61\x64\x69\x6e\x67\x22\x21\x3d\x3d\x64\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x26\x26\x21\x64\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2e\x64\x6f\x53\x63\x72\x6f\x6c\x6c\x3f\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x72\x2e\x72\x65\x61\x64\x79\x29\x3a\x28\x64\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x44\x4f\x4d\x43\x6f\x6e\x74\x65\x6e\x74\x4c\x6f\x61\x64\x65\x64\x22\x2c\x52\x29\x2c\x61\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x22\x6c\x6f\x61\x64\x22\x2c\x52\x29\x29\x3b\x76\x61\x72\x20\x53\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x29\x7b\x76\x61\x72\x20\x68\x3d\x30\x2c\x69\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6a\x3d\x6e\x75\x6c\x6c\x3d\x3d\x63\x3b\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x63\x29\x29\x7b\x65\x3d\x21\x30\x3b\x66\x6f\x72\x28\x68\x20\x69\x6e\x20\x63\x29\x53\x28\x61\x2c\x62\x2c\x68\x2c\x63\x5b\x68\x5d\x2c\x21\x30\x2c\x66\x2c\x67\x29\x7d\x65\x6c\x73\x65\x20\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x64\x26\x26\x28\x65\x3d\x21\x30\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x7c\x7c\x28\x67\x3d\x21\x30\x29\x2c\x6a\x26\x26\x28\x67\x3f\x28\x62\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x64\x29\x2c\x62\x3d\x6e\x75\x6c\x6c\x29\x3a\x28\x6a\x3d\x62\x2c\x62\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6a\x2e\x63\x61\x6c\x6c\x28\x72\x28\x61\x29\x2c\x63\x29\x7d\x29\x29\x2c\x62\x29\x29\x66\x6f\x72\x28\x3b\x68\x3c\x69\x3b\x68\x2b\x2b\x29\x62\x28\x61\x5b\x68\x5d\x2c\x63\x2c\x67\x3f\x64\x3a\x64\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x68\x5d\x2c\x68\x2c\x62\x28\x61\x5b\x68\x5d\x2c\x63\x29\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x3f\x61\x3a\x6a\x3f\x62\x2e\x63\x61\x6c\x6c\x28\x61\x29\x3a\x69\x3f\x62\x28\x61\x5b\x30\x5d\x2c\x63\x29\x3a\x66\x7d\x2c\x54\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x39\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x21\x2b\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x55\x28\x29\x7b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x3d\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x2b\x55\x2e\x75\x69\x64\x2b\x2b\x7d\x55\x2e\x75\x69\x64\x3d\x31\x2c\x55\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x63\x61\x63\x68\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x7c\x7c\x28\x62\x3d\x7b\x7d\x2c\x54\x28\x61\x29\x26\x26\x28\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x62\x3a\x4f\x62\x6a\x65\x63\x74\x2e\x64\x65\x66\x69\x6e\x65\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x61\x2c\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x2c\x7b\x76\x61\x6c\x75\x65\x3a\x62\x2c\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x3a\x21\x30\x7d\x29\x29\x29\x2c\x62\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x3d\x74\x68\x69\x73\x2e\x63\x61\x63\x68\x65\x28\x61\x29\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x29\x65\x5b\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x5d\x3d\x63\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x62\x29\x65\x5b\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x64\x29\x5d\x3d\x62\x5b\x64\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x2c\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x3f\x74\x68\x69\x73\x2e\x63\x61\x63\x68\x65\x28\x61\x29\x3a\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x26\x26\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x5b\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x5d\x7d\x2c\x61\x63\x63\x65\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x7c\x7c\x62\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x63\x3f\x74\x68\x69\x73\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x3a\x28\x74\x68\x69\x73\x2e\x73\x65\x74\x28\x61\x2c\x62\x2c\x63\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x63\x3a\x62\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x64\x29\x7b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x29\x7b\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x3f\x62\x3d\x62\x2e\x6d\x61\x70\x28\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x29\x3a\x28\x62\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x2c\x62\x3d\x62\x20\x69\x6e\x20\x64\x3f\x5b\x62\x5d\x3a\x62\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x29\x2c\x63\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x64\x65\x6c\x65\x74\x65\x20\x64\x5b\x62\x5b\x63\x5d\x5d\x7d\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x7c\x7c\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x64\x29\x29\x26\x26\x28\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x76\x6f\x69\x64\x20\x30\x3a\x64\x65\x6c\x65\x74\x65\x20\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x29\x7d\x7d\x2c\x68\x61\x73\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x5b\x74\x68\x69\x73\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x26\x26\x21\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x62\x29\x7d\x7d\x3b\x76\x61\x72\x20\x56\x3d\x6e\x65\x77\x20\x55\x2c\x57\x3d\x6e\x65\x77\x20\x55\x2c\x58\x3d\x2f\x5e\x28\x3f\x3a\x5c\x7b\x5b\x5c\x77\x5c\x57\x5d\x2a\x5c\x7d\x7c\x5c\x5b\x5b\x5c\x77\x5c\x57\x5d\x2a\x5c\x5d\x29\x24\x2f\x2c\x59\x3d\x2f\x5b\x41\x2d\x5a\x5d\x2f\x67\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x5a\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x74\x72\x75\x65\x22\x3d\x3d\x3d\x61\x7c\x7c\x22\x66\x61\x6c\x73\x65\x22\x21\x3d\x3d\x61\x26\x26\x28\x22\x6e\x75\x6c\x6c\x22\x3d\x3d\x3d\x61\x3f\x6e\x75\x6c\x6c\x3a\x61\x3d\x3d\x3d\x2b\x61\x2b\x22\x22\x3f\x2b\x61\x3a\x58\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x28\x61\x29\x3a\x61\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x24\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x63\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x69\x66\x28\x64\x3d\x22\x64\x61\x74\x61\x2d\x22\x2b\x62\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x59\x2c\x22\x2d\x24\x26\x22\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x63\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x64\x29\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x29\x7b\x74\x72\x79\x7b\x63\x3d\x5a\x28\x63\x29\x7d\x63\x61\x74\x63\x68\x28\x65\x29\x7b\x7d\x57\x2e\x73\x65\x74\x28\x61\x2c\x62\x2c\x63\x29\x7d\x65\x6c\x73\x65\x20\x63\x3d\x76\x6f\x69\x64\x20\x30\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x61\x73\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x57\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x7c\x7c\x56\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x7d\x2c\x64\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x57\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x62\x2c\x63\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x57\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x62\x29\x7d\x2c\x5f\x64\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x62\x2c\x63\x29\x7d\x2c\x5f\x72\x65\x6d\x6f\x76\x65\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x62\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x64\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x74\x68\x69\x73\x5b\x30\x5d\x2c\x67\x3d\x66\x26\x26\x66\x2e\x61\x74\x74\x72\x69\x62\x75\x74\x65\x73\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x65\x3d\x57\x2e\x67\x65\x74\x28\x66\x29\x2c\x31\x3d\x3d\x3d\x66\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x21\x56\x2e\x67\x65\x74\x28\x66\x2c\x22\x68\x61\x73\x44\x61\x74\x61\x41\x74\x74\x72\x73\x22\x29\x29\x29\x7b\x63\x3d\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x67\x5b\x63\x5d\x26\x26\x28\x64\x3d\x67\x5b\x63\x5d\x2e\x6e\x61\x6d\x65\x2c\x30\x3d\x3d\x3d\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x64\x61\x74\x61\x2d\x22\x29\x26\x26\x28\x64\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x64\x2e\x73\x6c\x69\x63\x65\x28\x35\x29\x29\x2c\x24\x28\x66\x2c\x64\x2c\x65\x5b\x64\x5d\x29\x29\x29\x3b\x56\x2e\x73\x65\x74\x28\x66\x2c\x22\x68\x61\x73\x44\x61\x74\x61\x41\x74\x74\x72\x73\x22\x2c\x21\x30\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x72\x65\x74\x75\x72\x6e\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x57\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x3a\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x69\x66\x28\x66\x26\x26\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x29\x7b\x69\x66\x28\x63\x3d\x57\x2e\x67\x65\x74\x28\x66\x2c\x61\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3b\x69\x66\x28\x63\x3d\x24\x28\x66\x2c\x61\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x7d\x65\x6c\x73\x65\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x57\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x7d\x29\x7d\x2c\x6e\x75\x6c\x6c\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x2c\x6e\x75\x6c\x6c\x2c\x21\x30\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x57\x2e\x72\x65\x6d\x6f\x76\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3b\x69\x66\x28\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x28\x62\x7c\x7c\x22\x66\x78\x22\x29\x2b\x22\x71\x75\x65\x75\x65\x22\x2c\x64\x3d\x56\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x2c\x63\x26\x26\x28\x21\x64\x7c\x7c\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x63\x29\x3f\x64\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x62\x2c\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x63\x29\x29\x3a\x64\x2e\x70\x75\x73\x68\x28\x63\x29\x29\x2c\x64\x7c\x7c\x5b\x5d\x7d\x2c\x64\x65\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x62\x3d\x62\x7c\x7c\x22\x66\x78\x22\x3b\x76\x61\x72\x20\x63\x3d\x72\x2e\x71\x75\x65\x75\x65\x28\x61\x2c\x62\x29\x2c\x64\x3d\x63\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x65\x3d\x63\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x66\x3d\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x61\x2c\x62\x29\x2c\x67\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x61\x2c\x62\x29\x7d\x3b\x22\x69\x6e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x3d\x3d\x3d\x65\x26\x26\x28\x65\x3d\x63\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x64\x2d\x2d\x29\x2c\x65\x26\x26\x28\x22\x66\x78\x22\x3d\x3d\x3d\x62\x26\x26\x63\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x22\x69\x6e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x29\x2c\x64\x65\x6c\x65\x74\x65\x20\x66\x2e\x73\x74\x6f\x70\x2c\x65\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x67\x2c\x66\x29\x29\x2c\x21\x64\x26\x26\x66\x26\x26\x66\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x28\x29\x7d\x2c\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x3b\x72\x65\x74\x75\x72\x6e\x20\x56\x2e\x67\x65\x74\x28\x61\x2c\x63\x29\x7c\x7c\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x63\x2c\x7b\x65\x6d\x70\x74\x79\x3a\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2e\x61\x64\x64\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x5b\x62\x2b\x22\x71\x75\x65\x75\x65\x22\x2c\x63\x5d\x29\x7d\x29\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x32\x3b\x72\x65\x74\x75\x72\x6e\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x28\x62\x3d\x61\x2c\x61\x3d\x22\x66\x78\x22\x2c\x63\x2d\x2d\x29\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3c\x63\x3f\x72\x2e\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x5b\x30\x5d\x2c\x61\x29\x3a\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x3f\x74\x68\x69\x73\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x2e\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x3b\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x74\x68\x69\x73\x2c\x61\x29\x2c\x22\x66\x78\x22\x3d\x3d\x3d\x61\x26\x26\x22\x69\x6e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x21\x3d\x3d\x63\x5b\x30\x5d\x26\x26\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x2c\x64\x65\x71\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x2c\x63\x6c\x65\x61\x72\x51\x75\x65\x75\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x61\x7c\x7c\x22\x66\x78\x22\x2c\x5b\x5d\x29\x7d\x2c\x70\x72\x6f\x6d\x69\x73\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x31\x2c\x65\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2c\x66\x3d\x74\x68\x69\x73\x2c\x67\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x68\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x2d\x2d\x64\x7c\x7c\x65\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x66\x2c\x5b\x66\x5d\x29\x7d\x3b\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x28\x62\x3d\x61\x2c\x61\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x61\x3d\x61\x7c\x7c\x22\x66\x78\x22\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x63\x3d\x56\x2e\x67\x65\x74\x28\x66\x5b\x67\x5d\x2c\x61\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x29\x2c\x63\x26\x26\x63\x2e\x65\x6d\x70\x74\x79\x26\x26\x28\x64\x2b\x2b\x2c\x63\x2e\x65\x6d\x70\x74\x79\x2e\x61\x64\x64\x28\x68\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x68\x28\x29\x2c\x65\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x62\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x5f\x3d\x2f\x5b\x2b\x2d\x5d\x3f\x28\x3f\x3a\x5c\x64\x2a\x5c\x2e\x7c\x29\x5c\x64\x2b\x28\x3f\x3a\x5b\x65\x45\x5d\x5b\x2b\x2d\x5d\x3f\x5c\x64\x2b\x7c\x29\x2f\x2e\x73\x6f\x75\x72\x63\x65\x2c\x61\x61\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x3f\x3a\x28\x5b\x2b\x2d\x5d\x29\x3d\x7c\x29\x28\x22\x2b\x5f\x2b\x22\x29\x28\x5b\x61\x2d\x7a\x25\x5d\x2a\x29\x24\x22\x2c\x22\x69\x22\x29\x2c\x62\x61\x3d\x5b\x22\x54\x6f\x70\x22\x2c\x22\x52\x69\x67\x68\x74\x22\x2c\x22\x42\x6f\x74\x74\x6f\x6d\x22\x2c\x22\x4c\x65\x66\x74\x22\x5d\x2c\x63\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x62\x7c\x7c\x61\x2c\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x7c\x7c\x22\x22\x3d\x3d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x26\x26\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x61\x29\x26\x26\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x7d\x2c\x64\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x3d\x7b\x7d\x3b\x66\x6f\x72\x28\x66\x20\x69\x6e\x20\x62\x29\x67\x5b\x66\x5d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x5b\x66\x5d\x2c\x61\x2e\x73\x74\x79\x6c\x65\x5b\x66\x5d\x3d\x62\x5b\x66\x5d\x3b\x65\x3d\x63\x2e\x61\x70\x70\x6c\x79\x28\x61\x2c\x64\x7c\x7c\x5b\x5d\x29\x3b\x66\x6f\x72\x28\x66\x20\x69\x6e\x20\x62\x29\x61\x2e\x73\x74\x79\x6c\x65\x5b\x66\x5d\x3d\x67\x5b\x66\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x65\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3d\x31\x2c\x67\x3d\x32\x30\x2c\x68\x3d\x64\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x63\x75\x72\x28\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x2c\x22\x22\x29\x7d\x2c\x69\x3d\x68\x28\x29\x2c\x6a\x3d\x63\x26\x26\x63\x5b\x33\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x62\x5d\x3f\x22\x22\x3a\x22\x70\x78\x22\x29\x2c\x6b\x3d\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x62\x5d\x7c\x7c\x22\x70\x78\x22\x21\x3d\x3d\x6a\x26\x26\x2b\x69\x29\x26\x26\x61\x61\x2e\x65\x78\x65\x63\x28\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x29\x29\x3b\x69\x66\x28\x6b\x26\x26\x6b\x5b\x33\x5d\x21\x3d\x3d\x6a\x29\x7b\x6a\x3d\x6a\x7c\x7c\x6b\x5b\x33\x5d\x2c\x63\x3d\x63\x7c\x7c\x5b\x5d\x2c\x6b\x3d\x2b\x69\x7c\x7c\x31\x3b\x64\x6f\x20\x66\x3d\x66\x7c\x7c\x22\x2e\x35\x22\x2c\x6b\x2f\x3d\x66\x2c\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x62\x2c\x6b\x2b\x6a\x29\x3b\x77\x68\x69\x6c\x65\x28\x66\x21\x3d\x3d\x28\x66\x3d\x68\x28\x29\x2f\x69\x29\x26\x26\x31\x21\x3d\x3d\x66\x26\x26\x2d\x2d\x67\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x28\x6b\x3d\x2b\x6b\x7c\x7c\x2b\x69\x7c\x7c\x30\x2c\x65\x3d\x63\x5b\x31\x5d\x3f\x6b\x2b\x28\x63\x5b\x31\x5d\x2b\x31\x29\x2a\x63\x5b\x32\x5d\x3a\x2b\x63\x5b\x32\x5d\x2c\x64\x26\x26\x28\x64\x2e\x75\x6e\x69\x74\x3d\x6a\x2c\x64\x2e\x73\x74\x61\x72\x74\x3d\x6b\x2c\x64\x2e\x65\x6e\x64\x3d\x65\x29\x29\x2c\x65\x7d\x76\x61\x72\x20\x66\x61\x3d\x7b\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x61\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x64\x3d\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2c\x65\x3d\x66\x61\x5b\x64\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x3f\x65\x3a\x28\x62\x3d\x63\x2e\x62\x6f\x64\x79\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x63\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x64\x29\x29\x2c\x65\x3d\x72\x2e\x63\x73\x73\x28\x62\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x2c\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x62\x29\x2c\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x65\x26\x26\x28\x65\x3d\x22\x62\x6c\x6f\x63\x6b\x22\x29\x2c\x66\x61\x5b\x64\x5d\x3d\x65\x2c\x65\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x61\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3d\x5b\x5d\x2c\x66\x3d\x30\x2c\x67\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x64\x3d\x61\x5b\x66\x5d\x2c\x64\x2e\x73\x74\x79\x6c\x65\x26\x26\x28\x63\x3d\x64\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x2c\x62\x3f\x28\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x63\x26\x26\x28\x65\x5b\x66\x5d\x3d\x56\x2e\x67\x65\x74\x28\x64\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x7c\x7c\x6e\x75\x6c\x6c\x2c\x65\x5b\x66\x5d\x7c\x7c\x28\x64\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x22\x22\x29\x29\x2c\x22\x22\x3d\x3d\x3d\x64\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x26\x26\x63\x61\x28\x64\x29\x26\x26\x28\x65\x5b\x66\x5d\x3d\x67\x61\x28\x64\x29\x29\x29\x3a\x22\x6e\x6f\x6e\x65\x22\x21\x3d\x3d\x63\x26\x26\x28\x65\x5b\x66\x5d\x3d\x22\x6e\x6f\x6e\x65\x22\x2c\x56\x2e\x73\x65\x74\x28\x64\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x2c\x63\x29\x29\x29\x3b\x66\x6f\x72\x28\x66\x3d\x30\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x6e\x75\x6c\x6c\x21\x3d\x65\x5b\x66\x5d\x26\x26\x28\x61\x5b\x66\x5d\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x65\x5b\x66\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x73\x68\x6f\x77\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x68\x61\x28\x74\x68\x69\x73\x2c\x21\x30\x29\x7d\x2c\x68\x69\x64\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x68\x61\x28\x74\x68\x69\x73\x29\x7d\x2c\x74\x6f\x67\x67\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x61\x3f\x74\x68\x69\x73\x2e\x73\x68\x6f\x77\x28\x29\x3a\x74\x68\x69\x73\x2e\x68\x69\x64\x65\x28\x29\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x61\x28\x74\x68\x69\x73\x29\x3f\x72\x28\x74\x68\x69\x73\x29\x2e\x73\x68\x6f\x77\x28\x29\x3a\x72\x28\x74\x68\x69\x73\x29\x2e\x68\x69\x64\x65\x28\x29\x7d\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x69\x61\x3d\x2f\x5e\x28\x3f\x3a\x63\x68\x65\x63\x6b\x62\x6f\x78\x7c\x72\x61\x64\x69\x6f\x29\x24\x2f\x69\x2c\x6a\x61\x3d\x2f\x3c\x28\x5b\x61\x2d\x7a\x5d\x5b\x5e\x5c\x2f\x5c\x30\x3e\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2b\x29\x2f\x69\x2c\x6b\x61\x3d\x2f\x5e\x24\x7c\x5c\x2f\x28\x3f\x3a\x6a\x61\x76\x61\x7c\x65\x63\x6d\x61\x29\x73\x63\x72\x69\x70\x74\x2f\x69\x2c\x6c\x61\x3d\x7b\x6f\x70\x74\x69\x6f\x6e\x3a\x5b\x31\x2c\x22\x3c\x73\x65\x6c\x65\x63\x74\x20\x6d\x75\x6c\x74\x69\x70\x6c\x65\x3d\x27\x6d\x75\x6c\x74\x69\x70\x6c\x65\x27\x3e\x22\x2c\x22\x3c\x2f\x73\x65\x6c\x65\x63\x74\x3e\x22\x5d\x2c\x74\x68\x65\x61\x64\x3a\x5b\x31\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x22\x2c\x22\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x63\x6f\x6c\x3a\x5b\x32\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x3c\x63\x6f\x6c\x67\x72\x6f\x75\x70\x3e\x22\x2c\x22\x3c\x2f\x63\x6f\x6c\x67\x72\x6f\x75\x70\x3e\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x74\x72\x3a\x5b\x32\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x3c\x74\x62\x6f\x64\x79\x3e\x22\x2c\x22\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x74\x64\x3a\x5b\x33\x2c\x22\x3c\x74\x61\x62\x6c\x65\x3e\x3c\x74\x62\x6f\x64\x79\x3e\x3c\x74\x72\x3e\x22\x2c\x22\x3c\x2f\x74\x72\x3e\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x22\x5d\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x5b\x30\x2c\x22\x22\x2c\x22\x22\x5d\x7d\x3b\x6c\x61\x2e\x6f\x70\x74\x67\x72\x6f\x75\x70\x3d\x6c\x61\x2e\x6f\x70\x74\x69\x6f\x6e\x2c\x6c\x61\x2e\x74\x62\x6f\x64\x79\x3d\x6c\x61\x2e\x74\x66\x6f\x6f\x74\x3d\x6c\x61\x2e\x63\x6f\x6c\x67\x72\x6f\x75\x70\x3d\x6c\x61\x2e\x63\x61\x70\x74\x69\x6f\x6e\x3d\x6c\x61\x2e\x74\x68\x65\x61\x64\x2c\x6c\x61\x2e\x74\x68\x3d\x6c\x61\x2e\x74\x64\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6d\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x3f\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x62\x7c\x7c\x22\x2a\x22\x29\x3a\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x3f\x61\x2e\x71\x75\x65\x72\x79\x53\x65\x6c\x65\x63\x74\x6f\x72\x41\x6c\x6c\x28\x62\x7c\x7c\x22\x2a\x22\x29\x3a\x5b\x5d\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x62\x7c\x7c\x62\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2c\x62\x29\x3f\x72\x2e\x6d\x65\x72\x67\x65\x28\x5b\x61\x5d\x2c\x63\x29\x3a\x63\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x61\x28\x61\x2c\x62\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x3d\x30\x2c\x64\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x56\x2e\x73\x65\x74\x28\x61\x5b\x63\x5d\x2c\x22\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x22\x2c\x21\x62\x7c\x7c\x56\x2e\x67\x65\x74\x28\x62\x5b\x63\x5d\x2c\x22\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x22\x29\x29\x7d\x76\x61\x72\x20\x6f\x61\x3d\x2f\x3c\x7c\x26\x23\x3f\x5c\x77\x2b\x3b\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x3d\x62\x2e\x63\x72\x65\x61\x74\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x46\x72\x61\x67\x6d\x65\x6e\x74\x28\x29\x2c\x6d\x3d\x5b\x5d\x2c\x6e\x3d\x30\x2c\x6f\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x6e\x3c\x6f\x3b\x6e\x2b\x2b\x29\x69\x66\x28\x66\x3d\x61\x5b\x6e\x5d\x2c\x66\x7c\x7c\x30\x3d\x3d\x3d\x66\x29\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x66\x29\x29\x72\x2e\x6d\x65\x72\x67\x65\x28\x6d\x2c\x66\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x5b\x66\x5d\x3a\x66\x29\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x6f\x61\x2e\x74\x65\x73\x74\x28\x66\x29\x29\x7b\x67\x3d\x67\x7c\x7c\x6c\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x29\x2c\x68\x3d\x28\x6a\x61\x2e\x65\x78\x65\x63\x28\x66\x29\x7c\x7c\x5b\x22\x22\x2c\x22\x22\x5d\x29\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x69\x3d\x6c\x61\x5b\x68\x5d\x7c\x7c\x6c\x61\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2c\x67\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x69\x5b\x31\x5d\x2b\x72\x2e\x68\x74\x6d\x6c\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x66\x29\x2b\x69\x5b\x32\x5d\x2c\x6b\x3d\x69\x5b\x30\x5d\x3b\x77\x68\x69\x6c\x65\x28\x6b\x2d\x2d\x29\x67\x3d\x67\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x3b\x72\x2e\x6d\x65\x72\x67\x65\x28\x6d\x2c\x67\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x2c\x67\x3d\x6c\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x67\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x22\x7d\x65\x6c\x73\x65\x20\x6d\x2e\x70\x75\x73\x68\x28\x62\x2e\x63\x72\x65\x61\x74\x65\x54\x65\x78\x74\x4e\x6f\x64\x65\x28\x66\x29\x29\x3b\x6c\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x22\x2c\x6e\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x6d\x5b\x6e\x2b\x2b\x5d\x29\x69\x66\x28\x64\x26\x26\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x66\x2c\x64\x29\x3e\x2d\x31\x29\x65\x26\x26\x65\x2e\x70\x75\x73\x68\x28\x66\x29\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x6a\x3d\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x66\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x66\x29\x2c\x67\x3d\x6d\x61\x28\x6c\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x66\x29\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x2c\x6a\x26\x26\x6e\x61\x28\x67\x29\x2c\x63\x29\x7b\x6b\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x67\x5b\x6b\x2b\x2b\x5d\x29\x6b\x61\x2e\x74\x65\x73\x74\x28\x66\x2e\x74\x79\x70\x65\x7c\x7c\x22\x22\x29\x26\x26\x63\x2e\x70\x75\x73\x68\x28\x66\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6c\x7d\x21\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x44\x6f\x63\x75\x6d\x65\x6e\x74\x46\x72\x61\x67\x6d\x65\x6e\x74\x28\x29\x2c\x62\x3d\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x29\x2c\x63\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x3b\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x2c\x22\x72\x61\x64\x69\x6f\x22\x29\x2c\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x68\x65\x63\x6b\x65\x64\x22\x2c\x22\x63\x68\x65\x63\x6b\x65\x64\x22\x29\x2c\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x6e\x61\x6d\x65\x22\x2c\x22\x74\x22\x29\x2c\x62\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x63\x29\x2c\x6f\x2e\x63\x68\x65\x63\x6b\x43\x6c\x6f\x6e\x65\x3d\x62\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x2e\x63\x68\x65\x63\x6b\x65\x64\x2c\x62\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x74\x65\x78\x74\x61\x72\x65\x61\x3e\x78\x3c\x2f\x74\x65\x78\x74\x61\x72\x65\x61\x3e\x22\x2c\x6f\x2e\x6e\x6f\x43\x6c\x6f\x6e\x65\x43\x68\x65\x63\x6b\x65\x64\x3d\x21\x21\x62\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x6c\x61\x73\x74\x43\x68\x69\x6c\x64\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x7d\x28\x29\x3b\x76\x61\x72\x20\x71\x61\x3d\x64\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x72\x61\x3d\x2f\x5e\x6b\x65\x79\x2f\x2c\x73\x61\x3d\x2f\x5e\x28\x3f\x3a\x6d\x6f\x75\x73\x65\x7c\x70\x6f\x69\x6e\x74\x65\x72\x7c\x63\x6f\x6e\x74\x65\x78\x74\x6d\x65\x6e\x75\x7c\x64\x72\x61\x67\x7c\x64\x72\x6f\x70\x29\x7c\x63\x6c\x69\x63\x6b\x2f\x2c\x74\x61\x3d\x2f\x5e\x28\x5b\x5e\x2e\x5d\x2a\x29\x28\x3f\x3a\x5c\x2e\x28\x2e\x2b\x29\x7c\x29\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x75\x61\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x30\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x76\x61\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x61\x28\x29\x7b\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x61\x63\x74\x69\x76\x65\x45\x6c\x65\x6d\x65\x6e\x74\x7d\x63\x61\x74\x63\x68\x28\x61\x29\x7b\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x78\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x2c\x68\x3b\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x29\x7b\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x26\x26\x28\x64\x3d\x64\x7c\x7c\x63\x2c\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x3b\x66\x6f\x72\x28\x68\x20\x69\x6e\x20\x62\x29\x78\x61\x28\x61\x2c\x68\x2c\x63\x2c\x64\x2c\x62\x5b\x68\x5d\x2c\x66\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x69\x66\x28\x6e\x75\x6c\x6c\x3d\x3d\x64\x26\x26\x6e\x75\x6c\x6c\x3d\x3d\x65\x3f\x28\x65\x3d\x63\x2c\x64\x3d\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x3a\x6e\x75\x6c\x6c\x3d\x3d\x65\x26\x26\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x3f\x28\x65\x3d\x64\x2c\x64\x3d\x76\x6f\x69\x64\x20\x30\x29\x3a\x28\x65\x3d\x64\x2c\x64\x3d\x63\x2c\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x29\x2c\x65\x3d\x3d\x3d\x21\x31\x29\x65\x3d\x76\x61\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x21\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x3b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x66\x26\x26\x28\x67\x3d\x65\x2c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x28\x29\x2e\x6f\x66\x66\x28\x61\x29\x2c\x67\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x7d\x2c\x65\x2e\x67\x75\x69\x64\x3d\x67\x2e\x67\x75\x69\x64\x7c\x7c\x28\x67\x2e\x67\x75\x69\x64\x3d\x72\x2e\x67\x75\x69\x64\x2b\x2b\x29\x29\x2c\x61\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x61\x64\x64\x28\x74\x68\x69\x73\x2c\x62\x2c\x65\x2c\x64\x2c\x63\x29\x7d\x29\x7d\x72\x2e\x65\x76\x65\x6e\x74\x3d\x7b\x67\x6c\x6f\x62\x61\x6c\x3a\x7b\x7d\x2c\x61\x64\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x2c\x71\x3d\x56\x2e\x67\x65\x74\x28\x61\x29\x3b\x69\x66\x28\x71\x29\x7b\x63\x2e\x68\x61\x6e\x64\x6c\x65\x72\x26\x26\x28\x66\x3d\x63\x2c\x63\x3d\x66\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2c\x65\x3d\x66\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x29\x2c\x65\x26\x26\x72\x2e\x66\x69\x6e\x64\x2e\x6d\x61\x74\x63\x68\x65\x73\x53\x65\x6c\x65\x63\x74\x6f\x72\x28\x71\x61\x2c\x65\x29\x2c\x63\x2e\x67\x75\x69\x64\x7c\x7c\x28\x63\x2e\x67\x75\x69\x64\x3d\x72\x2e\x67\x75\x69\x64\x2b\x2b\x29\x2c\x28\x69\x3d\x71\x2e\x65\x76\x65\x6e\x74\x73\x29\x7c\x7c\x28\x69\x3d\x71\x2e\x65\x76\x65\x6e\x74\x73\x3d\x7b\x7d\x29\x2c\x28\x67\x3d\x71\x2e\x68\x61\x6e\x64\x6c\x65\x29\x7c\x7c\x28\x67\x3d\x71\x2e\x68\x61\x6e\x64\x6c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x72\x26\x26\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x21\x3d\x3d\x62\x2e\x74\x79\x70\x65\x3f\x72\x2e\x65\x76\x65\x6e\x74\x2e\x64\x69\x73\x70\x61\x74\x63\x68\x2e\x61\x70\x70\x6c\x79\x28\x61\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3a\x76\x6f\x69\x64\x20\x30\x7d\x29\x2c\x62\x3d\x28\x62\x7c\x7c\x22\x22\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x22\x22\x5d\x2c\x6a\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6a\x2d\x2d\x29\x68\x3d\x74\x61\x2e\x65\x78\x65\x63\x28\x62\x5b\x6a\x5d\x29\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x70\x3d\x68\x5b\x31\x5d\x2c\x6f\x3d\x28\x68\x5b\x32\x5d\x7c\x7c\x22\x22\x29\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x2e\x73\x6f\x72\x74\x28\x29\x2c\x6e\x26\x26\x28\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x6e\x5d\x7c\x7c\x7b\x7d\x2c\x6e\x3d\x28\x65\x3f\x6c\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x6c\x2e\x62\x69\x6e\x64\x54\x79\x70\x65\x29\x7c\x7c\x6e\x2c\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x6e\x5d\x7c\x7c\x7b\x7d\x2c\x6b\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x74\x79\x70\x65\x3a\x6e\x2c\x6f\x72\x69\x67\x54\x79\x70\x65\x3a\x70\x2c\x64\x61\x74\x61\x3a\x64\x2c\x68\x61\x6e\x64\x6c\x65\x72\x3a\x63\x2c\x67\x75\x69\x64\x3a\x63\x2e\x67\x75\x69\x64\x2c\x73\x65\x6c\x65\x63\x74\x6f\x72\x3a\x65\x2c\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x3a\x65\x26\x26\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x2e\x74\x65\x73\x74\x28\x65\x29\x2c\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x6f\x2e\x6a\x6f\x69\x6e\x28\x22\x2e\x22\x29\x7d\x2c\x66\x29\x2c\x28\x6d\x3d\x69\x5b\x6e\x5d\x29\x7c\x7c\x28\x6d\x3d\x69\x5b\x6e\x5d\x3d\x5b\x5d\x2c\x6d\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x3d\x30\x2c\x6c\x2e\x73\x65\x74\x75\x70\x26\x26\x6c\x2e\x73\x65\x74\x75\x70\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x64\x2c\x6f\x2c\x67\x29\x21\x3d\x3d\x21\x31\x7c\x7c\x61\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x26\x26\x61\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x6e\x2c\x67\x29\x29\x2c\x6c\x2e\x61\x64\x64\x26\x26\x28\x6c\x2e\x61\x64\x64\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6b\x29\x2c\x6b\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2e\x67\x75\x69\x64\x7c\x7c\x28\x6b\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2e\x67\x75\x69\x64\x3d\x63\x2e\x67\x75\x69\x64\x29\x29\x2c\x65\x3f\x6d\x2e\x73\x70\x6c\x69\x63\x65\x28\x6d\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x2b\x2b\x2c\x30\x2c\x6b\x29\x3a\x6d\x2e\x70\x75\x73\x68\x28\x6b\x29\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x67\x6c\x6f\x62\x61\x6c\x5b\x6e\x5d\x3d\x21\x30\x29\x7d\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x2c\x70\x2c\x71\x3d\x56\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x26\x26\x56\x2e\x67\x65\x74\x28\x61\x29\x3b\x69\x66\x28\x71\x26\x26\x28\x69\x3d\x71\x2e\x65\x76\x65\x6e\x74\x73\x29\x29\x7b\x62\x3d\x28\x62\x7c\x7c\x22\x22\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x22\x22\x5d\x2c\x6a\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x6a\x2d\x2d\x29\x69\x66\x28\x68\x3d\x74\x61\x2e\x65\x78\x65\x63\x28\x62\x5b\x6a\x5d\x29\x7c\x7c\x5b\x5d\x2c\x6e\x3d\x70\x3d\x68\x5b\x31\x5d\x2c\x6f\x3d\x28\x68\x5b\x32\x5d\x7c\x7c\x22\x22\x29\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x2e\x73\x6f\x72\x74\x28\x29\x2c\x6e\x29\x7b\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x6e\x5d\x7c\x7c\x7b\x7d\x2c\x6e\x3d\x28\x64\x3f\x6c\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x6c\x2e\x62\x69\x6e\x64\x54\x79\x70\x65\x29\x7c\x7c\x6e\x2c\x6d\x3d\x69\x5b\x6e\x5d\x7c\x7c\x5b\x5d\x2c\x68\x3d\x68\x5b\x32\x5d\x26\x26\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x28\x5e\x7c\x5c\x5c\x2e\x29\x22\x2b\x6f\x2e\x6a\x6f\x69\x6e\x28\x22\x5c\x5c\x2e\x28\x3f\x3a\x2e\x2a\x5c\x5c\x2e\x7c\x29\x22\x29\x2b\x22\x28\x5c\x5c\x2e\x7c\x24\x29\x22\x29\x2c\x67\x3d\x66\x3d\x6d\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x66\x2d\x2d\x29\x6b\x3d\x6d\x5b\x66\x5d\x2c\x21\x65\x26\x26\x70\x21\x3d\x3d\x6b\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x7c\x7c\x63\x26\x26\x63\x2e\x67\x75\x69\x64\x21\x3d\x3d\x6b\x2e\x67\x75\x69\x64\x7c\x7c\x68\x26\x26\x21\x68\x2e\x74\x65\x73\x74\x28\x6b\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x29\x7c\x7c\x64\x26\x26\x64\x21\x3d\x3d\x6b\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x26\x26\x28\x22\x2a\x2a\x22\x21\x3d\x3d\x64\x7c\x7c\x21\x6b\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x29\x7c\x7c\x28\x6d\x2e\x73\x70\x6c\x69\x63\x65\x28\x66\x2c\x31\x29\x2c\x6b\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x26\x26\x6d\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x2d\x2d\x2c\x6c\x2e\x72\x65\x6d\x6f\x76\x65\x26\x26\x6c\x2e\x72\x65\x6d\x6f\x76\x65\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6b\x29\x29\x3b\x67\x26\x26\x21\x6d\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x6c\x2e\x74\x65\x61\x72\x64\x6f\x77\x6e\x26\x26\x6c\x2e\x74\x65\x61\x72\x64\x6f\x77\x6e\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6f\x2c\x71\x2e\x68\x61\x6e\x64\x6c\x65\x29\x21\x3d\x3d\x21\x31\x7c\x7c\x72\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x28\x61\x2c\x6e\x2c\x71\x2e\x68\x61\x6e\x64\x6c\x65\x29\x2c\x64\x65\x6c\x65\x74\x65\x20\x69\x5b\x6e\x5d\x29\x7d\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x6e\x20\x69\x6e\x20\x69\x29\x72\x2e\x65\x76\x65\x6e\x74\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x6e\x2b\x62\x5b\x6a\x5d\x2c\x63\x2c\x64\x2c\x21\x30\x29\x3b\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x69\x29\x26\x26\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x22\x68\x61\x6e\x64\x6c\x65\x20\x65\x76\x65\x6e\x74\x73\x22\x29\x7d\x7d\x2c\x64\x69\x73\x70\x61\x74\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x66\x69\x78\x28\x61\x29\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x3d\x6e\x65\x77\x20\x41\x72\x72\x61\x79\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x2c\x6a\x3d\x28\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x65\x76\x65\x6e\x74\x73\x22\x29\x7c\x7c\x7b\x7d\x29\x5b\x62\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x5b\x5d\x2c\x6b\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x62\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x7b\x7d\x3b\x66\x6f\x72\x28\x69\x5b\x30\x5d\x3d\x62\x2c\x63\x3d\x31\x3b\x63\x3c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x2b\x2b\x29\x69\x5b\x63\x5d\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x5b\x63\x5d\x3b\x69\x66\x28\x62\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x61\x72\x67\x65\x74\x3d\x74\x68\x69\x73\x2c\x21\x6b\x2e\x70\x72\x65\x44\x69\x73\x70\x61\x74\x63\x68\x7c\x7c\x6b\x2e\x70\x72\x65\x44\x69\x73\x70\x61\x74\x63\x68\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x29\x21\x3d\x3d\x21\x31\x29\x7b\x68\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x68\x61\x6e\x64\x6c\x65\x72\x73\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x2c\x6a\x29\x2c\x63\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x28\x66\x3d\x68\x5b\x63\x2b\x2b\x5d\x29\x26\x26\x21\x62\x2e\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x28\x29\x29\x7b\x62\x2e\x63\x75\x72\x72\x65\x6e\x74\x54\x61\x72\x67\x65\x74\x3d\x66\x2e\x65\x6c\x65\x6d\x2c\x64\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x28\x67\x3d\x66\x2e\x68\x61\x6e\x64\x6c\x65\x72\x73\x5b\x64\x2b\x2b\x5d\x29\x26\x26\x21\x62\x2e\x69\x73\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x28\x29\x29\x62\x2e\x72\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x26\x26\x21\x62\x2e\x72\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x2e\x74\x65\x73\x74\x28\x67\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x29\x7c\x7c\x28\x62\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x3d\x67\x2c\x62\x2e\x64\x61\x74\x61\x3d\x67\x2e\x64\x61\x74\x61\x2c\x65\x3d\x28\x28\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x67\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x5d\x7c\x7c\x7b\x7d\x29\x2e\x68\x61\x6e\x64\x6c\x65\x7c\x7c\x67\x2e\x68\x61\x6e\x64\x6c\x65\x72\x29\x2e\x61\x70\x70\x6c\x79\x28\x66\x2e\x65\x6c\x65\x6d\x2c\x69\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x65\x26\x26\x28\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x65\x29\x3d\x3d\x3d\x21\x31\x26\x26\x28\x62\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x2c\x62\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x29\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6b\x2e\x70\x6f\x73\x74\x44\x69\x73\x70\x61\x74\x63\x68\x26\x26\x6b\x2e\x70\x6f\x73\x74\x44\x69\x73\x70\x61\x74\x63\x68\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x29\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x7d\x7d\x2c\x68\x61\x6e\x64\x6c\x65\x72\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x5b\x5d\x2c\x69\x3d\x62\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x43\x6f\x75\x6e\x74\x2c\x6a\x3d\x61\x2e\x74\x61\x72\x67\x65\x74\x3b\x69\x66\x28\x69\x26\x26\x6a\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x21\x28\x22\x63\x6c\x69\x63\x6b\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x26\x26\x61\x2e\x62\x75\x74\x74\x6f\x6e\x3e\x3d\x31\x29\x29\x66\x6f\x72\x28\x3b\x6a\x21\x3d\x3d\x74\x68\x69\x73\x3b\x6a\x3d\x6a\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x7c\x7c\x74\x68\x69\x73\x29\x69\x66\x28\x31\x3d\x3d\x3d\x6a\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x22\x63\x6c\x69\x63\x6b\x22\x21\x3d\x3d\x61\x2e\x74\x79\x70\x65\x7c\x7c\x6a\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x21\x3d\x3d\x21\x30\x29\x29\x7b\x66\x6f\x72\x28\x66\x3d\x5b\x5d\x2c\x67\x3d\x7b\x7d\x2c\x63\x3d\x30\x3b\x63\x3c\x69\x3b\x63\x2b\x2b\x29\x64\x3d\x62\x5b\x63\x5d\x2c\x65\x3d\x64\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x2b\x22\x20\x22\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x67\x5b\x65\x5d\x26\x26\x28\x67\x5b\x65\x5d\x3d\x64\x2e\x6e\x65\x65\x64\x73\x43\x6f\x6e\x74\x65\x78\x74\x3f\x72\x28\x65\x2c\x74\x68\x69\x73\x29\x2e\x69\x6e\x64\x65\x78\x28\x6a\x29\x3e\x2d\x31\x3a\x72\x2e\x66\x69\x6e\x64\x28\x65\x2c\x74\x68\x69\x73\x2c\x6e\x75\x6c\x6c\x2c\x5b\x6a\x5d\x29\x2e\x6c\x65\x6e\x67\x74\x68\x29\x2c\x67\x5b\x65\x5d\x26\x26\x66\x2e\x70\x75\x73\x68\x28\x64\x29\x3b\x66\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x7b\x65\x6c\x65\x6d\x3a\x6a\x2c\x68\x61\x6e\x64\x6c\x65\x72\x73\x3a\x66\x7d\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x6a\x3d\x74\x68\x69\x73\x2c\x69\x3c\x62\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x68\x2e\x70\x75\x73\x68\x28\x7b\x65\x6c\x65\x6d\x3a\x6a\x2c\x68\x61\x6e\x64\x6c\x65\x72\x73\x3a\x62\x2e\x73\x6c\x69\x63\x65\x28\x69\x29\x7d\x29\x2c\x68\x7d\x2c\x61\x64\x64\x50\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x4f\x62\x6a\x65\x63\x74\x2e\x64\x65\x66\x69\x6e\x65\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x72\x2e\x45\x76\x65\x6e\x74\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2c\x61\x2c\x7b\x65\x6e\x75\x6d\x65\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x67\x65\x74\x3a\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x29\x7d\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x5b\x61\x5d\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x4f\x62\x6a\x65\x63\x74\x2e\x64\x65\x66\x69\x6e\x65\x50\x72\x6f\x70\x65\x72\x74\x79\x28\x74\x68\x69\x73\x2c\x61\x2c\x7b\x65\x6e\x75\x6d\x65\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x62\x6c\x65\x3a\x21\x30\x2c\x77\x72\x69\x74\x61\x62\x6c\x65\x3a\x21\x30\x2c\x76\x61\x6c\x75\x65\x3a\x62\x7d\x29\x7d\x7d\x29\x7d\x2c\x66\x69\x78\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x5b\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3f\x61\x3a\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x28\x61\x29\x7d\x2c\x73\x70\x65\x63\x69\x61\x6c\x3a\x7b\x6c\x6f\x61\x64\x3a\x7b\x6e\x6f\x42\x75\x62\x62\x6c\x65\x3a\x21\x30\x7d\x2c\x66\x6f\x63\x75\x73\x3a\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x21\x3d\x3d\x77\x61\x28\x29\x26\x26\x74\x68\x69\x73\x2e\x66\x6f\x63\x75\x73\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x66\x6f\x63\x75\x73\x28\x29\x2c\x21\x31\x7d\x2c\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x22\x66\x6f\x63\x75\x73\x69\x6e\x22\x7d\x2c\x62\x6c\x75\x72\x3a\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x3d\x3d\x3d\x77\x61\x28\x29\x26\x26\x74\x68\x69\x73\x2e\x62\x6c\x75\x72\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x62\x6c\x75\x72\x28\x29\x2c\x21\x31\x7d\x2c\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x22\x66\x6f\x63\x75\x73\x6f\x75\x74\x22\x7d\x2c\x63\x6c\x69\x63\x6b\x3a\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x26\x26\x74\x68\x69\x73\x2e\x63\x6c\x69\x63\x6b\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x74\x68\x69\x73\x2c\x22\x69\x6e\x70\x75\x74\x22\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x63\x6c\x69\x63\x6b\x28\x29\x2c\x21\x31\x7d\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2e\x74\x61\x72\x67\x65\x74\x2c\x22\x61\x22\x29\x7d\x7d\x2c\x62\x65\x66\x6f\x72\x65\x75\x6e\x6c\x6f\x61\x64\x3a\x7b\x70\x6f\x73\x74\x44\x69\x73\x70\x61\x74\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x61\x2e\x72\x65\x73\x75\x6c\x74\x26\x26\x61\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x26\x26\x28\x61\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x2e\x72\x65\x74\x75\x72\x6e\x56\x61\x6c\x75\x65\x3d\x61\x2e\x72\x65\x73\x75\x6c\x74\x29\x7d\x7d\x7d\x7d\x2c\x72\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x61\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x26\x26\x61\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x62\x2c\x63\x29\x7d\x2c\x72\x2e\x45\x76\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x6f\x66\x20\x72\x2e\x45\x76\x65\x6e\x74\x3f\x28\x61\x26\x26\x61\x2e\x74\x79\x70\x65\x3f\x28\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3d\x61\x2c\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x3d\x61\x2e\x74\x79\x70\x65\x2c\x74\x68\x69\x73\x2e\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x3d\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x7c\x7c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x26\x26\x61\x2e\x72\x65\x74\x75\x72\x6e\x56\x61\x6c\x75\x65\x3d\x3d\x3d\x21\x31\x3f\x75\x61\x3a\x76\x61\x2c\x74\x68\x69\x73\x2e\x74\x61\x72\x67\x65\x74\x3d\x61\x2e\x74\x61\x72\x67\x65\x74\x26\x26\x33\x3d\x3d\x3d\x61\x2e\x74\x61\x72\x67\x65\x74\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x61\x2e\x74\x61\x72\x67\x65\x74\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3a\x61\x2e\x74\x61\x72\x67\x65\x74\x2c\x74\x68\x69\x73\x2e\x63\x75\x72\x72\x65\x6e\x74\x54\x61\x72\x67\x65\x74\x3d\x61\x2e\x63\x75\x72\x72\x65\x6e\x74\x54\x61\x72\x67\x65\x74\x2c\x74\x68\x69\x73\x2e\x72\x65\x6c\x61\x74\x65\x64\x54\x61\x72\x67\x65\x74\x3d\x61\x2e\x72\x65\x6c\x61\x74\x65\x64\x54\x61\x72\x67\x65\x74\x29\x3a\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x3d\x61\x2c\x62\x26\x26\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x74\x68\x69\x73\x2c\x62\x29\x2c\x74\x68\x69\x73\x2e\x74\x69\x6d\x65\x53\x74\x61\x6d\x70\x3d\x61\x26\x26\x61\x2e\x74\x69\x6d\x65\x53\x74\x61\x6d\x70\x7c\x7c\x72\x2e\x6e\x6f\x77\x28\x29\x2c\x76\x6f\x69\x64\x28\x74\x68\x69\x73\x5b\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x21\x30\x29\x29\x3a\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x28\x61\x2c\x62\x29\x7d\x2c\x72\x2e\x45\x76\x65\x6e\x74\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x3a\x72\x2e\x45\x76\x65\x6e\x74\x2c\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x3a\x76\x61\x2c\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3a\x76\x61\x2c\x69\x73\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3a\x76\x61\x2c\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x3a\x21\x31\x2c\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3b\x74\x68\x69\x73\x2e\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x3d\x75\x61\x2c\x61\x26\x26\x21\x74\x68\x69\x73\x2e\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x26\x26\x61\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x7d\x2c\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3b\x74\x68\x69\x73\x2e\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3d\x75\x61\x2c\x61\x26\x26\x21\x74\x68\x69\x73\x2e\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x26\x26\x61\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x7d\x2c\x73\x74\x6f\x70\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x72\x69\x67\x69\x6e\x61\x6c\x45\x76\x65\x6e\x74\x3b\x74\x68\x69\x73\x2e\x69\x73\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x3d\x75\x61\x2c\x61\x26\x26\x21\x74\x68\x69\x73\x2e\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x26\x26\x61\x2e\x73\x74\x6f\x70\x49\x6d\x6d\x65\x64\x69\x61\x74\x65\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x2c\x74\x68\x69\x73\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x7d\x7d\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x61\x6c\x74\x4b\x65\x79\x3a\x21\x30\x2c\x62\x75\x62\x62\x6c\x65\x73\x3a\x21\x30\x2c\x63\x61\x6e\x63\x65\x6c\x61\x62\x6c\x65\x3a\x21\x30\x2c\x63\x68\x61\x6e\x67\x65\x64\x54\x6f\x75\x63\x68\x65\x73\x3a\x21\x30\x2c\x63\x74\x72\x6c\x4b\x65\x79\x3a\x21\x30\x2c\x64\x65\x74\x61\x69\x6c\x3a\x21\x30\x2c\x65\x76\x65\x6e\x74\x50\x68\x61\x73\x65\x3a\x21\x30\x2c\x6d\x65\x74\x61\x4b\x65\x79\x3a\x21\x30\x2c\x70\x61\x67\x65\x58\x3a\x21\x30\x2c\x70\x61\x67\x65\x59\x3a\x21\x30\x2c\x73\x68\x69\x66\x74\x4b\x65\x79\x3a\x21\x30\x2c\x76\x69\x65\x77\x3a\x21\x30\x2c\x22\x63\x68\x61\x72\x22\x3a\x21\x30\x2c\x63\x68\x61\x72\x43\x6f\x64\x65\x3a\x21\x30\x2c\x6b\x65\x79\x3a\x21\x30\x2c\x6b\x65\x79\x43\x6f\x64\x65\x3a\x21\x30\x2c\x62\x75\x74\x74\x6f\x6e\x3a\x21\x30\x2c\x62\x75\x74\x74\x6f\x6e\x73\x3a\x21\x30\x2c\x63\x6c\x69\x65\x6e\x74\x58\x3a\x21\x30\x2c\x63\x6c\x69\x65\x6e\x74\x59\x3a\x21\x30\x2c\x6f\x66\x66\x73\x65\x74\x58\x3a\x21\x30\x2c\x6f\x66\x66\x73\x65\x74\x59\x3a\x21\x30\x2c\x70\x6f\x69\x6e\x74\x65\x72\x49\x64\x3a\x21\x30\x2c\x70\x6f\x69\x6e\x74\x65\x72\x54\x79\x70\x65\x3a\x21\x30\x2c\x73\x63\x72\x65\x65\x6e\x58\x3a\x21\x30\x2c\x73\x63\x72\x65\x65\x6e\x59\x3a\x21\x30\x2c\x74\x61\x72\x67\x65\x74\x54\x6f\x75\x63\x68\x65\x73\x3a\x21\x30\x2c\x74\x6f\x45\x6c\x65\x6d\x65\x6e\x74\x3a\x21\x30\x2c\x74\x6f\x75\x63\x68\x65\x73\x3a\x21\x30\x2c\x77\x68\x69\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x62\x75\x74\x74\x6f\x6e\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x77\x68\x69\x63\x68\x26\x26\x72\x61\x2e\x74\x65\x73\x74\x28\x61\x2e\x74\x79\x70\x65\x29\x3f\x6e\x75\x6c\x6c\x21\x3d\x61\x2e\x63\x68\x61\x72\x43\x6f\x64\x65\x3f\x61\x2e\x63\x68\x61\x72\x43\x6f\x64\x65\x3a\x61\x2e\x6b\x65\x79\x43\x6f\x64\x65\x3a\x21\x61\x2e\x77\x68\x69\x63\x68\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x26\x26\x73\x61\x2e\x74\x65\x73\x74\x28\x61\x2e\x74\x79\x70\x65\x29\x3f\x31\x26\x62\x3f\x31\x3a\x32\x26\x62\x3f\x33\x3a\x34\x26\x62\x3f\x32\x3a\x30\x3a\x61\x2e\x77\x68\x69\x63\x68\x7d\x7d\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x61\x64\x64\x50\x72\x6f\x70\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x6d\x6f\x75\x73\x65\x65\x6e\x74\x65\x72\x3a\x22\x6d\x6f\x75\x73\x65\x6f\x76\x65\x72\x22\x2c\x6d\x6f\x75\x73\x65\x6c\x65\x61\x76\x65\x3a\x22\x6d\x6f\x75\x73\x65\x6f\x75\x74\x22\x2c\x70\x6f\x69\x6e\x74\x65\x72\x65\x6e\x74\x65\x72\x3a\x22\x70\x6f\x69\x6e\x74\x65\x72\x6f\x76\x65\x72\x22\x2c\x70\x6f\x69\x6e\x74\x65\x72\x6c\x65\x61\x76\x65\x3a\x22\x70\x6f\x69\x6e\x74\x65\x72\x6f\x75\x74\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x61\x5d\x3d\x7b\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x3a\x62\x2c\x62\x69\x6e\x64\x54\x79\x70\x65\x3a\x62\x2c\x68\x61\x6e\x64\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x74\x68\x69\x73\x2c\x65\x3d\x61\x2e\x72\x65\x6c\x61\x74\x65\x64\x54\x61\x72\x67\x65\x74\x2c\x66\x3d\x61\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x26\x26\x28\x65\x3d\x3d\x3d\x64\x7c\x7c\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x64\x2c\x65\x29\x29\x7c\x7c\x28\x61\x2e\x74\x79\x70\x65\x3d\x66\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x2c\x63\x3d\x66\x2e\x68\x61\x6e\x64\x6c\x65\x72\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x2c\x61\x2e\x74\x79\x70\x65\x3d\x62\x29\x2c\x63\x7d\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x78\x61\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7d\x2c\x6f\x6e\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x78\x61\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x31\x29\x7d\x2c\x6f\x66\x66\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x3b\x69\x66\x28\x61\x26\x26\x61\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x26\x26\x61\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3d\x61\x2e\x68\x61\x6e\x64\x6c\x65\x4f\x62\x6a\x2c\x72\x28\x61\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x61\x72\x67\x65\x74\x29\x2e\x6f\x66\x66\x28\x64\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3f\x64\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x2b\x22\x2e\x22\x2b\x64\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x64\x2e\x6f\x72\x69\x67\x54\x79\x70\x65\x2c\x64\x2e\x73\x65\x6c\x65\x63\x74\x6f\x72\x2c\x64\x2e\x68\x61\x6e\x64\x6c\x65\x72\x29\x2c\x74\x68\x69\x73\x3b\x69\x66\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x7b\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x61\x29\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x65\x2c\x62\x2c\x61\x5b\x65\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x72\x65\x74\x75\x72\x6e\x20\x62\x21\x3d\x3d\x21\x31\x26\x26\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x7c\x7c\x28\x63\x3d\x62\x2c\x62\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x63\x3d\x3d\x3d\x21\x31\x26\x26\x28\x63\x3d\x76\x61\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x72\x65\x6d\x6f\x76\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x63\x2c\x62\x29\x7d\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x79\x61\x3d\x2f\x3c\x28\x3f\x21\x61\x72\x65\x61\x7c\x62\x72\x7c\x63\x6f\x6c\x7c\x65\x6d\x62\x65\x64\x7c\x68\x72\x7c\x69\x6d\x67\x7c\x69\x6e\x70\x75\x74\x7c\x6c\x69\x6e\x6b\x7c\x6d\x65\x74\x61\x7c\x70\x61\x72\x61\x6d\x29\x28\x28\x5b\x61\x2d\x7a\x5d\x5b\x5e\x5c\x2f\x5c\x30\x3e\x5c\x78\x32\x30\x5c\x74\x5c\x72\x5c\x6e\x5c\x66\x5d\x2a\x29\x5b\x5e\x3e\x5d\x2a\x29\x5c\x2f\x3e\x2f\x67\x69\x2c\x7a\x61\x3d\x2f\x3c\x73\x63\x72\x69\x70\x74\x7c\x3c\x73\x74\x79\x6c\x65\x7c\x3c\x6c\x69\x6e\x6b\x2f\x69\x2c\x41\x61\x3d\x2f\x63\x68\x65\x63\x6b\x65\x64\x5c\x73\x2a\x28\x3f\x3a\x5b\x5e\x3d\x5d\x7c\x3d\x5c\x73\x2a\x2e\x63\x68\x65\x63\x6b\x65\x64\x2e\x29\x2f\x69\x2c\x42\x61\x3d\x2f\x5e\x74\x72\x75\x65\x5c\x2f\x28\x2e\x2a\x29\x2f\x2c\x43\x61\x3d\x2f\x5e\x5c\x73\x2a\x3c\x21\x28\x3f\x3a\x5c\x5b\x43\x44\x41\x54\x41\x5c\x5b\x7c\x2d\x2d\x29\x7c\x28\x3f\x3a\x5c\x5d\x5c\x5d\x7c\x2d\x2d\x29\x3e\x5c\x73\x2a\x24\x2f\x67\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x44\x61\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2c\x22\x74\x61\x62\x6c\x65\x22\x29\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x31\x31\x21\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x62\x3a\x62\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x22\x74\x72\x22\x29\x3f\x61\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x22\x74\x62\x6f\x64\x79\x22\x29\x5b\x30\x5d\x7c\x7c\x61\x3a\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x45\x61\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x74\x79\x70\x65\x3d\x28\x6e\x75\x6c\x6c\x21\x3d\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x29\x29\x2b\x22\x2f\x22\x2b\x61\x2e\x74\x79\x70\x65\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x46\x61\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x42\x61\x2e\x65\x78\x65\x63\x28\x61\x2e\x74\x79\x70\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x61\x2e\x74\x79\x70\x65\x3d\x62\x5b\x31\x5d\x3a\x61\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x29\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x47\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x3b\x69\x66\x28\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x69\x66\x28\x56\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x26\x26\x28\x66\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x29\x2c\x67\x3d\x56\x2e\x73\x65\x74\x28\x62\x2c\x66\x29\x2c\x6a\x3d\x66\x2e\x65\x76\x65\x6e\x74\x73\x29\x29\x7b\x64\x65\x6c\x65\x74\x65\x20\x67\x2e\x68\x61\x6e\x64\x6c\x65\x2c\x67\x2e\x65\x76\x65\x6e\x74\x73\x3d\x7b\x7d\x3b\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x6a\x29\x66\x6f\x72\x28\x63\x3d\x30\x2c\x64\x3d\x6a\x5b\x65\x5d\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x72\x2e\x65\x76\x65\x6e\x74\x2e\x61\x64\x64\x28\x62\x2c\x65\x2c\x6a\x5b\x65\x5d\x5b\x63\x5d\x29\x7d\x57\x2e\x68\x61\x73\x44\x61\x74\x61\x28\x61\x29\x26\x26\x28\x68\x3d\x57\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x29\x2c\x69\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x68\x29\x2c\x57\x2e\x73\x65\x74\x28\x62\x2c\x69\x29\x29\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x48\x61\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x22\x69\x6e\x70\x75\x74\x22\x3d\x3d\x3d\x63\x26\x26\x69\x61\x2e\x74\x65\x73\x74\x28\x61\x2e\x74\x79\x70\x65\x29\x3f\x62\x2e\x63\x68\x65\x63\x6b\x65\x64\x3d\x61\x2e\x63\x68\x65\x63\x6b\x65\x64\x3a\x22\x69\x6e\x70\x75\x74\x22\x21\x3d\x3d\x63\x26\x26\x22\x74\x65\x78\x74\x61\x72\x65\x61\x22\x21\x3d\x3d\x63\x7c\x7c\x28\x62\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x3d\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x61\x6c\x75\x65\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x49\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x62\x3d\x67\x2e\x61\x70\x70\x6c\x79\x28\x5b\x5d\x2c\x62\x29\x3b\x76\x61\x72\x20\x65\x2c\x66\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x3d\x30\x2c\x6d\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x6e\x3d\x6d\x2d\x31\x2c\x71\x3d\x62\x5b\x30\x5d\x2c\x73\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x71\x29\x3b\x69\x66\x28\x73\x7c\x7c\x6d\x3e\x31\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x71\x26\x26\x21\x6f\x2e\x63\x68\x65\x63\x6b\x43\x6c\x6f\x6e\x65\x26\x26\x41\x61\x2e\x74\x65\x73\x74\x28\x71\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x61\x2e\x65\x71\x28\x65\x29\x3b\x73\x26\x26\x28\x62\x5b\x30\x5d\x3d\x71\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x65\x2c\x66\x2e\x68\x74\x6d\x6c\x28\x29\x29\x29\x2c\x49\x61\x28\x66\x2c\x62\x2c\x63\x2c\x64\x29\x7d\x29\x3b\x69\x66\x28\x6d\x26\x26\x28\x65\x3d\x70\x61\x28\x62\x2c\x61\x5b\x30\x5d\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x21\x31\x2c\x61\x2c\x64\x29\x2c\x66\x3d\x65\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x2c\x31\x3d\x3d\x3d\x65\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x65\x3d\x66\x29\x2c\x66\x7c\x7c\x64\x29\x29\x7b\x66\x6f\x72\x28\x68\x3d\x72\x2e\x6d\x61\x70\x28\x6d\x61\x28\x65\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x2c\x45\x61\x29\x2c\x69\x3d\x68\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x6c\x3c\x6d\x3b\x6c\x2b\x2b\x29\x6a\x3d\x65\x2c\x6c\x21\x3d\x3d\x6e\x26\x26\x28\x6a\x3d\x72\x2e\x63\x6c\x6f\x6e\x65\x28\x6a\x2c\x21\x30\x2c\x21\x30\x29\x2c\x69\x26\x26\x72\x2e\x6d\x65\x72\x67\x65\x28\x68\x2c\x6d\x61\x28\x6a\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x29\x29\x2c\x63\x2e\x63\x61\x6c\x6c\x28\x61\x5b\x6c\x5d\x2c\x6a\x2c\x6c\x29\x3b\x69\x66\x28\x69\x29\x66\x6f\x72\x28\x6b\x3d\x68\x5b\x68\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x5d\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x72\x2e\x6d\x61\x70\x28\x68\x2c\x46\x61\x29\x2c\x6c\x3d\x30\x3b\x6c\x3c\x69\x3b\x6c\x2b\x2b\x29\x6a\x3d\x68\x5b\x6c\x5d\x2c\x6b\x61\x2e\x74\x65\x73\x74\x28\x6a\x2e\x74\x79\x70\x65\x7c\x7c\x22\x22\x29\x26\x26\x21\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x6a\x2c\x22\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x22\x29\x26\x26\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x6b\x2c\x6a\x29\x26\x26\x28\x6a\x2e\x73\x72\x63\x3f\x72\x2e\x5f\x65\x76\x61\x6c\x55\x72\x6c\x26\x26\x72\x2e\x5f\x65\x76\x61\x6c\x55\x72\x6c\x28\x6a\x2e\x73\x72\x63\x29\x3a\x70\x28\x6a\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x43\x61\x2c\x22\x22\x29\x2c\x6b\x29\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4a\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x2c\x65\x3d\x62\x3f\x72\x2e\x66\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x29\x3a\x61\x2c\x66\x3d\x30\x3b\x6e\x75\x6c\x6c\x21\x3d\x28\x64\x3d\x65\x5b\x66\x5d\x29\x3b\x66\x2b\x2b\x29\x63\x7c\x7c\x31\x21\x3d\x3d\x64\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x64\x29\x29\x2c\x64\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x28\x63\x26\x26\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x64\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x64\x29\x26\x26\x6e\x61\x28\x6d\x61\x28\x64\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x29\x2c\x64\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x64\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x74\x6d\x6c\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x79\x61\x2c\x22\x3c\x24\x31\x3e\x3c\x2f\x24\x32\x3e\x22\x29\x7d\x2c\x63\x6c\x6f\x6e\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x61\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2c\x69\x3d\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x61\x29\x3b\x69\x66\x28\x21\x28\x6f\x2e\x6e\x6f\x43\x6c\x6f\x6e\x65\x43\x68\x65\x63\x6b\x65\x64\x7c\x7c\x31\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x31\x31\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x28\x61\x29\x29\x29\x66\x6f\x72\x28\x67\x3d\x6d\x61\x28\x68\x29\x2c\x66\x3d\x6d\x61\x28\x61\x29\x2c\x64\x3d\x30\x2c\x65\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x48\x61\x28\x66\x5b\x64\x5d\x2c\x67\x5b\x64\x5d\x29\x3b\x69\x66\x28\x62\x29\x69\x66\x28\x63\x29\x66\x6f\x72\x28\x66\x3d\x66\x7c\x7c\x6d\x61\x28\x61\x29\x2c\x67\x3d\x67\x7c\x7c\x6d\x61\x28\x68\x29\x2c\x64\x3d\x30\x2c\x65\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x47\x61\x28\x66\x5b\x64\x5d\x2c\x67\x5b\x64\x5d\x29\x3b\x65\x6c\x73\x65\x20\x47\x61\x28\x61\x2c\x68\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x3d\x6d\x61\x28\x68\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x2c\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x26\x26\x6e\x61\x28\x67\x2c\x21\x69\x26\x26\x6d\x61\x28\x61\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x29\x2c\x68\x7d\x2c\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x2c\x66\x3d\x30\x3b\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x63\x3d\x61\x5b\x66\x5d\x29\x3b\x66\x2b\x2b\x29\x69\x66\x28\x54\x28\x63\x29\x29\x7b\x69\x66\x28\x62\x3d\x63\x5b\x56\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x29\x7b\x69\x66\x28\x62\x2e\x65\x76\x65\x6e\x74\x73\x29\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x62\x2e\x65\x76\x65\x6e\x74\x73\x29\x65\x5b\x64\x5d\x3f\x72\x2e\x65\x76\x65\x6e\x74\x2e\x72\x65\x6d\x6f\x76\x65\x28\x63\x2c\x64\x29\x3a\x72\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x28\x63\x2c\x64\x2c\x62\x2e\x68\x61\x6e\x64\x6c\x65\x29\x3b\x63\x5b\x56\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x76\x6f\x69\x64\x20\x30\x7d\x63\x5b\x57\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x26\x26\x28\x63\x5b\x57\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3d\x76\x6f\x69\x64\x20\x30\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x64\x65\x74\x61\x63\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x61\x28\x74\x68\x69\x73\x2c\x61\x2c\x21\x30\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x4a\x61\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x2c\x74\x65\x78\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x3f\x72\x2e\x74\x65\x78\x74\x28\x74\x68\x69\x73\x29\x3a\x74\x68\x69\x73\x2e\x65\x6d\x70\x74\x79\x28\x29\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x31\x21\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x31\x31\x21\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x39\x21\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x28\x74\x68\x69\x73\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x61\x29\x7d\x29\x7d\x2c\x6e\x75\x6c\x6c\x2c\x61\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x2c\x61\x70\x70\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x31\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x39\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x76\x61\x72\x20\x62\x3d\x44\x61\x28\x74\x68\x69\x73\x2c\x61\x29\x3b\x62\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x61\x29\x7d\x7d\x29\x7d\x2c\x70\x72\x65\x70\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x31\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x39\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x7b\x76\x61\x72\x20\x62\x3d\x44\x61\x28\x74\x68\x69\x73\x2c\x61\x29\x3b\x62\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x61\x2c\x62\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x7d\x7d\x29\x7d\x2c\x62\x65\x66\x6f\x72\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x61\x2c\x74\x68\x69\x73\x29\x7d\x29\x7d\x2c\x61\x66\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x61\x2c\x74\x68\x69\x73\x2e\x6e\x65\x78\x74\x53\x69\x62\x6c\x69\x6e\x67\x29\x7d\x29\x7d\x2c\x65\x6d\x70\x74\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x61\x2c\x62\x3d\x30\x3b\x6e\x75\x6c\x6c\x21\x3d\x28\x61\x3d\x74\x68\x69\x73\x5b\x62\x5d\x29\x3b\x62\x2b\x2b\x29\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x61\x2c\x21\x31\x29\x29\x2c\x61\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x63\x6c\x6f\x6e\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x61\x2c\x62\x3d\x6e\x75\x6c\x6c\x3d\x3d\x62\x3f\x61\x3a\x62\x2c\x74\x68\x69\x73\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x63\x6c\x6f\x6e\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x7d\x29\x7d\x2c\x68\x74\x6d\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x74\x68\x69\x73\x5b\x30\x5d\x7c\x7c\x7b\x7d\x2c\x63\x3d\x30\x2c\x64\x3d\x74\x68\x69\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x69\x66\x28\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x26\x26\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x21\x7a\x61\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x21\x6c\x61\x5b\x28\x6a\x61\x2e\x65\x78\x65\x63\x28\x61\x29\x7c\x7c\x5b\x22\x22\x2c\x22\x22\x5d\x29\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x29\x7b\x61\x3d\x72\x2e\x68\x74\x6d\x6c\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x61\x29\x3b\x74\x72\x79\x7b\x66\x6f\x72\x28\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x62\x3d\x74\x68\x69\x73\x5b\x63\x5d\x7c\x7c\x7b\x7d\x2c\x31\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x62\x2c\x21\x31\x29\x29\x2c\x62\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x61\x29\x3b\x62\x3d\x30\x7d\x63\x61\x74\x63\x68\x28\x65\x29\x7b\x7d\x7d\x62\x26\x26\x74\x68\x69\x73\x2e\x65\x6d\x70\x74\x79\x28\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x61\x29\x7d\x2c\x6e\x75\x6c\x6c\x2c\x61\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x2c\x72\x65\x70\x6c\x61\x63\x65\x57\x69\x74\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x5b\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x49\x61\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x74\x68\x69\x73\x2c\x61\x29\x3c\x30\x26\x26\x28\x72\x2e\x63\x6c\x65\x61\x6e\x44\x61\x74\x61\x28\x6d\x61\x28\x74\x68\x69\x73\x29\x29\x2c\x63\x26\x26\x63\x2e\x72\x65\x70\x6c\x61\x63\x65\x43\x68\x69\x6c\x64\x28\x62\x2c\x74\x68\x69\x73\x29\x29\x7d\x2c\x61\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x61\x70\x70\x65\x6e\x64\x54\x6f\x3a\x22\x61\x70\x70\x65\x6e\x64\x22\x2c\x70\x72\x65\x70\x65\x6e\x64\x54\x6f\x3a\x22\x70\x72\x65\x70\x65\x6e\x64\x22\x2c\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x3a\x22\x62\x65\x66\x6f\x72\x65\x22\x2c\x69\x6e\x73\x65\x72\x74\x41\x66\x74\x65\x72\x3a\x22\x61\x66\x74\x65\x72\x22\x2c\x72\x65\x70\x6c\x61\x63\x65\x41\x6c\x6c\x3a\x22\x72\x65\x70\x6c\x61\x63\x65\x57\x69\x74\x68\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x72\x28\x61\x29\x2c\x66\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x2d\x31\x2c\x67\x3d\x30\x3b\x67\x3c\x3d\x66\x3b\x67\x2b\x2b\x29\x63\x3d\x67\x3d\x3d\x3d\x66\x3f\x74\x68\x69\x73\x3a\x74\x68\x69\x73\x2e\x63\x6c\x6f\x6e\x65\x28\x21\x30\x29\x2c\x72\x28\x65\x5b\x67\x5d\x29\x5b\x62\x5d\x28\x63\x29\x2c\x68\x2e\x61\x70\x70\x6c\x79\x28\x64\x2c\x63\x2e\x67\x65\x74\x28\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x75\x73\x68\x53\x74\x61\x63\x6b\x28\x64\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x4b\x61\x3d\x2f\x5e\x6d\x61\x72\x67\x69\x6e\x2f\x2c\x4c\x61\x3d\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x5e\x28\x22\x2b\x5f\x2b\x22\x29\x28\x3f\x21\x70\x78\x29\x5b\x61\x2d\x7a\x25\x5d\x2b\x24\x22\x2c\x22\x69\x22\x29\x2c\x4d\x61\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x62\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x63\x2e\x6f\x70\x65\x6e\x65\x72\x7c\x7c\x28\x63\x3d\x61\x29\x2c\x63\x2e\x67\x65\x74\x43\x6f\x6d\x70\x75\x74\x65\x64\x53\x74\x79\x6c\x65\x28\x62\x29\x7d\x3b\x21\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x28\x29\x7b\x69\x66\x28\x69\x29\x7b\x69\x2e\x73\x74\x79\x6c\x65\x2e\x63\x73\x73\x54\x65\x78\x74\x3d\x22\x62\x6f\x78\x2d\x73\x69\x7a\x69\x6e\x67\x3a\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x3b\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x72\x65\x6c\x61\x74\x69\x76\x65\x3b\x64\x69\x73\x70\x6c\x61\x79\x3a\x62\x6c\x6f\x63\x6b\x3b\x6d\x61\x72\x67\x69\x6e\x3a\x61\x75\x74\x6f\x3b\x62\x6f\x72\x64\x65\x72\x3a\x31\x70\x78\x3b\x70\x61\x64\x64\x69\x6e\x67\x3a\x31\x70\x78\x3b\x74\x6f\x70\x3a\x31\x25\x3b\x77\x69\x64\x74\x68\x3a\x35\x30\x25\x22\x2c\x69\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x22\x2c\x71\x61\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x68\x29\x3b\x76\x61\x72\x20\x62\x3d\x61\x2e\x67\x65\x74\x43\x6f\x6d\x70\x75\x74\x65\x64\x53\x74\x79\x6c\x65\x28\x69\x29\x3b\x63\x3d\x22\x31\x25\x22\x21\x3d\x3d\x62\x2e\x74\x6f\x70\x2c\x67\x3d\x22\x32\x70\x78\x22\x3d\x3d\x3d\x62\x2e\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x2c\x65\x3d\x22\x34\x70\x78\x22\x3d\x3d\x3d\x62\x2e\x77\x69\x64\x74\x68\x2c\x69\x2e\x73\x74\x79\x6c\x65\x2e\x6d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x3d\x22\x35\x30\x25\x22\x2c\x66\x3d\x22\x34\x70\x78\x22\x3d\x3d\x3d\x62\x2e\x6d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x2c\x71\x61\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x68\x29\x2c\x69\x3d\x6e\x75\x6c\x6c\x7d\x7d\x76\x61\x72\x20\x63\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x2c\x69\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x3b\x69\x2e\x73\x74\x79\x6c\x65\x26\x26\x28\x69\x2e\x73\x74\x79\x6c\x65\x2e\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x43\x6c\x69\x70\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x2d\x62\x6f\x78\x22\x2c\x69\x2e\x63\x6c\x6f\x6e\x65\x4e\x6f\x64\x65\x28\x21\x30\x29\x2e\x73\x74\x79\x6c\x65\x2e\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x43\x6c\x69\x70\x3d\x22\x22\x2c\x6f\x2e\x63\x6c\x65\x61\x72\x43\x6c\x6f\x6e\x65\x53\x74\x79\x6c\x65\x3d\x22\x63\x6f\x6e\x74\x65\x6e\x74\x2d\x62\x6f\x78\x22\x3d\x3d\x3d\x69\x2e\x73\x74\x79\x6c\x65\x2e\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x43\x6c\x69\x70\x2c\x68\x2e\x73\x74\x79\x6c\x65\x2e\x63\x73\x73\x54\x65\x78\x74\x3d\x22\x62\x6f\x72\x64\x65\x72\x3a\x30\x3b\x77\x69\x64\x74\x68\x3a\x38\x70\x78\x3b\x68\x65\x69\x67\x68\x74\x3a\x30\x3b\x74\x6f\x70\x3a\x30\x3b\x6c\x65\x66\x74\x3a\x2d\x39\x39\x39\x39\x70\x78\x3b\x70\x61\x64\x64\x69\x6e\x67\x3a\x30\x3b\x6d\x61\x72\x67\x69\x6e\x2d\x74\x6f\x70\x3a\x31\x70\x78\x3b\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x61\x62\x73\x6f\x6c\x75\x74\x65\x22\x2c\x68\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x69\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x6f\x2c\x7b\x70\x69\x78\x65\x6c\x50\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x63\x7d\x2c\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x52\x65\x6c\x69\x61\x62\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x65\x7d\x2c\x70\x69\x78\x65\x6c\x4d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x66\x7d\x2c\x72\x65\x6c\x69\x61\x62\x6c\x65\x4d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x28\x29\x2c\x67\x7d\x7d\x29\x29\x7d\x28\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4e\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x61\x2e\x73\x74\x79\x6c\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x63\x7c\x7c\x4d\x61\x28\x61\x29\x2c\x63\x26\x26\x28\x67\x3d\x63\x2e\x67\x65\x74\x50\x72\x6f\x70\x65\x72\x74\x79\x56\x61\x6c\x75\x65\x28\x62\x29\x7c\x7c\x63\x5b\x62\x5d\x2c\x22\x22\x21\x3d\x3d\x67\x7c\x7c\x72\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x73\x28\x61\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x61\x29\x7c\x7c\x28\x67\x3d\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x62\x29\x29\x2c\x21\x6f\x2e\x70\x69\x78\x65\x6c\x4d\x61\x72\x67\x69\x6e\x52\x69\x67\x68\x74\x28\x29\x26\x26\x4c\x61\x2e\x74\x65\x73\x74\x28\x67\x29\x26\x26\x4b\x61\x2e\x74\x65\x73\x74\x28\x62\x29\x26\x26\x28\x64\x3d\x68\x2e\x77\x69\x64\x74\x68\x2c\x65\x3d\x68\x2e\x6d\x69\x6e\x57\x69\x64\x74\x68\x2c\x66\x3d\x68\x2e\x6d\x61\x78\x57\x69\x64\x74\x68\x2c\x68\x2e\x6d\x69\x6e\x57\x69\x64\x74\x68\x3d\x68\x2e\x6d\x61\x78\x57\x69\x64\x74\x68\x3d\x68\x2e\x77\x69\x64\x74\x68\x3d\x67\x2c\x67\x3d\x63\x2e\x77\x69\x64\x74\x68\x2c\x68\x2e\x77\x69\x64\x74\x68\x3d\x64\x2c\x68\x2e\x6d\x69\x6e\x57\x69\x64\x74\x68\x3d\x65\x2c\x68\x2e\x6d\x61\x78\x57\x69\x64\x74\x68\x3d\x66\x29\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x67\x3f\x67\x2b\x22\x22\x3a\x67\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4f\x61\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x28\x29\x3f\x76\x6f\x69\x64\x20\x64\x65\x6c\x65\x74\x65\x20\x74\x68\x69\x73\x2e\x67\x65\x74\x3a\x28\x74\x68\x69\x73\x2e\x67\x65\x74\x3d\x62\x29\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x7d\x7d\x7d\x76\x61\x72\x20\x50\x61\x3d\x2f\x5e\x28\x6e\x6f\x6e\x65\x7c\x74\x61\x62\x6c\x65\x28\x3f\x21\x2d\x63\x5b\x65\x61\x5d\x29\x2e\x2b\x29\x2f\x2c\x51\x61\x3d\x7b\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x22\x61\x62\x73\x6f\x6c\x75\x74\x65\x22\x2c\x76\x69\x73\x69\x62\x69\x6c\x69\x74\x79\x3a\x22\x68\x69\x64\x64\x65\x6e\x22\x2c\x64\x69\x73\x70\x6c\x61\x79\x3a\x22\x62\x6c\x6f\x63\x6b\x22\x7d\x2c\x52\x61\x3d\x7b\x6c\x65\x74\x74\x65\x72\x53\x70\x61\x63\x69\x6e\x67\x3a\x22\x30\x22\x2c\x66\x6f\x6e\x74\x57\x65\x69\x67\x68\x74\x3a\x22\x34\x30\x30\x22\x7d\x2c\x53\x61\x3d\x5b\x22\x57\x65\x62\x6b\x69\x74\x22\x2c\x22\x4d\x6f\x7a\x22\x2c\x22\x6d\x73\x22\x5d\x2c\x54\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x64\x69\x76\x22\x29\x2e\x73\x74\x79\x6c\x65\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x55\x61\x28\x61\x29\x7b\x69\x66\x28\x61\x20\x69\x6e\x20\x54\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x3b\x76\x61\x72\x20\x62\x3d\x61\x5b\x30\x5d\x2e\x74\x6f\x55\x70\x70\x65\x72\x43\x61\x73\x65\x28\x29\x2b\x61\x2e\x73\x6c\x69\x63\x65\x28\x31\x29\x2c\x63\x3d\x53\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x63\x2d\x2d\x29\x69\x66\x28\x61\x3d\x53\x61\x5b\x63\x5d\x2b\x62\x2c\x61\x20\x69\x6e\x20\x54\x61\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x56\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x61\x61\x2e\x65\x78\x65\x63\x28\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x3f\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x30\x2c\x64\x5b\x32\x5d\x2d\x28\x63\x7c\x7c\x30\x29\x29\x2b\x28\x64\x5b\x33\x5d\x7c\x7c\x22\x70\x78\x22\x29\x3a\x62\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x57\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x2c\x67\x3d\x30\x3b\x66\x6f\x72\x28\x66\x3d\x63\x3d\x3d\x3d\x28\x64\x3f\x22\x62\x6f\x72\x64\x65\x72\x22\x3a\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x29\x3f\x34\x3a\x22\x77\x69\x64\x74\x68\x22\x3d\x3d\x3d\x62\x3f\x31\x3a\x30\x3b\x66\x3c\x34\x3b\x66\x2b\x3d\x32\x29\x22\x6d\x61\x72\x67\x69\x6e\x22\x3d\x3d\x3d\x63\x26\x26\x28\x67\x2b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x63\x2b\x62\x61\x5b\x66\x5d\x2c\x21\x30\x2c\x65\x29\x29\x2c\x64\x3f\x28\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3d\x3d\x3d\x63\x26\x26\x28\x67\x2d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x2b\x62\x61\x5b\x66\x5d\x2c\x21\x30\x2c\x65\x29\x29\x2c\x22\x6d\x61\x72\x67\x69\x6e\x22\x21\x3d\x3d\x63\x26\x26\x28\x67\x2d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x72\x64\x65\x72\x22\x2b\x62\x61\x5b\x66\x5d\x2b\x22\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x2c\x65\x29\x29\x29\x3a\x28\x67\x2b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x2b\x62\x61\x5b\x66\x5d\x2c\x21\x30\x2c\x65\x29\x2c\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x21\x3d\x3d\x63\x26\x26\x28\x67\x2b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x72\x64\x65\x72\x22\x2b\x62\x61\x5b\x66\x5d\x2b\x22\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x2c\x65\x29\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x58\x61\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x3d\x21\x30\x2c\x66\x3d\x4d\x61\x28\x61\x29\x2c\x67\x3d\x22\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x22\x2c\x21\x31\x2c\x66\x29\x3b\x69\x66\x28\x61\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x64\x3d\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x5b\x62\x5d\x29\x2c\x64\x3c\x3d\x30\x7c\x7c\x6e\x75\x6c\x6c\x3d\x3d\x64\x29\x7b\x69\x66\x28\x64\x3d\x4e\x61\x28\x61\x2c\x62\x2c\x66\x29\x2c\x28\x64\x3c\x30\x7c\x7c\x6e\x75\x6c\x6c\x3d\x3d\x64\x29\x26\x26\x28\x64\x3d\x61\x2e\x73\x74\x79\x6c\x65\x5b\x62\x5d\x29\x2c\x4c\x61\x2e\x74\x65\x73\x74\x28\x64\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3b\x65\x3d\x67\x26\x26\x28\x6f\x2e\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x52\x65\x6c\x69\x61\x62\x6c\x65\x28\x29\x7c\x7c\x64\x3d\x3d\x3d\x61\x2e\x73\x74\x79\x6c\x65\x5b\x62\x5d\x29\x2c\x64\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x64\x29\x7c\x7c\x30\x7d\x72\x65\x74\x75\x72\x6e\x20\x64\x2b\x57\x61\x28\x61\x2c\x62\x2c\x63\x7c\x7c\x28\x67\x3f\x22\x62\x6f\x72\x64\x65\x72\x22\x3a\x22\x63\x6f\x6e\x74\x65\x6e\x74\x22\x29\x2c\x65\x2c\x66\x29\x2b\x22\x70\x78\x22\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x63\x73\x73\x48\x6f\x6f\x6b\x73\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x4e\x61\x28\x61\x2c\x22\x6f\x70\x61\x63\x69\x74\x79\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x22\x3d\x3d\x3d\x63\x3f\x22\x31\x22\x3a\x63\x7d\x7d\x7d\x7d\x2c\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x3a\x7b\x61\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x49\x74\x65\x72\x61\x74\x69\x6f\x6e\x43\x6f\x75\x6e\x74\x3a\x21\x30\x2c\x63\x6f\x6c\x75\x6d\x6e\x43\x6f\x75\x6e\x74\x3a\x21\x30\x2c\x66\x69\x6c\x6c\x4f\x70\x61\x63\x69\x74\x79\x3a\x21\x30\x2c\x66\x6c\x65\x78\x47\x72\x6f\x77\x3a\x21\x30\x2c\x66\x6c\x65\x78\x53\x68\x72\x69\x6e\x6b\x3a\x21\x30\x2c\x66\x6f\x6e\x74\x57\x65\x69\x67\x68\x74\x3a\x21\x30\x2c\x6c\x69\x6e\x65\x48\x65\x69\x67\x68\x74\x3a\x21\x30\x2c\x6f\x70\x61\x63\x69\x74\x79\x3a\x21\x30\x2c\x6f\x72\x64\x65\x72\x3a\x21\x30\x2c\x6f\x72\x70\x68\x61\x6e\x73\x3a\x21\x30\x2c\x77\x69\x64\x6f\x77\x73\x3a\x21\x30\x2c\x7a\x49\x6e\x64\x65\x78\x3a\x21\x30\x2c\x7a\x6f\x6f\x6d\x3a\x21\x30\x7d\x2c\x63\x73\x73\x50\x72\x6f\x70\x73\x3a\x7b\x22\x66\x6c\x6f\x61\x74\x22\x3a\x22\x63\x73\x73\x46\x6c\x6f\x61\x74\x22\x7d\x2c\x73\x74\x79\x6c\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x69\x66\x28\x61\x26\x26\x33\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x38\x21\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x73\x74\x79\x6c\x65\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x2c\x69\x3d\x61\x2e\x73\x74\x79\x6c\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x3d\x55\x61\x28\x68\x29\x7c\x7c\x68\x29\x2c\x67\x3d\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x7c\x7c\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x68\x5d\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x63\x3f\x67\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x67\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x65\x3d\x67\x2e\x67\x65\x74\x28\x61\x2c\x21\x31\x2c\x64\x29\x29\x3f\x65\x3a\x69\x5b\x62\x5d\x3a\x28\x66\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x66\x26\x26\x28\x65\x3d\x61\x61\x2e\x65\x78\x65\x63\x28\x63\x29\x29\x26\x26\x65\x5b\x31\x5d\x26\x26\x28\x63\x3d\x65\x61\x28\x61\x2c\x62\x2c\x65\x29\x2c\x66\x3d\x22\x6e\x75\x6d\x62\x65\x72\x22\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x63\x26\x26\x63\x3d\x3d\x3d\x63\x26\x26\x28\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x3d\x66\x26\x26\x28\x63\x2b\x3d\x65\x26\x26\x65\x5b\x33\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x68\x5d\x3f\x22\x22\x3a\x22\x70\x78\x22\x29\x29\x2c\x6f\x2e\x63\x6c\x65\x61\x72\x43\x6c\x6f\x6e\x65\x53\x74\x79\x6c\x65\x7c\x7c\x22\x22\x21\x3d\x3d\x63\x7c\x7c\x30\x21\x3d\x3d\x62\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x22\x29\x7c\x7c\x28\x69\x5b\x62\x5d\x3d\x22\x69\x6e\x68\x65\x72\x69\x74\x22\x29\x2c\x67\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x67\x26\x26\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x28\x63\x3d\x67\x2e\x73\x65\x74\x28\x61\x2c\x63\x2c\x64\x29\x29\x7c\x7c\x28\x69\x5b\x62\x5d\x3d\x63\x29\x29\x2c\x76\x6f\x69\x64\x20\x30\x29\x7d\x7d\x2c\x63\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x7c\x7c\x28\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x68\x5d\x3d\x55\x61\x28\x68\x29\x7c\x7c\x68\x29\x2c\x67\x3d\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x7c\x7c\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x68\x5d\x2c\x67\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x67\x26\x26\x28\x65\x3d\x67\x2e\x67\x65\x74\x28\x61\x2c\x21\x30\x2c\x63\x29\x29\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x65\x26\x26\x28\x65\x3d\x4e\x61\x28\x61\x2c\x62\x2c\x64\x29\x29\x2c\x22\x6e\x6f\x72\x6d\x61\x6c\x22\x3d\x3d\x3d\x65\x26\x26\x62\x20\x69\x6e\x20\x52\x61\x26\x26\x28\x65\x3d\x52\x61\x5b\x62\x5d\x29\x2c\x22\x22\x3d\x3d\x3d\x63\x7c\x7c\x63\x3f\x28\x66\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x65\x29\x2c\x63\x3d\x3d\x3d\x21\x30\x7c\x7c\x69\x73\x46\x69\x6e\x69\x74\x65\x28\x66\x29\x3f\x66\x7c\x7c\x30\x3a\x65\x29\x3a\x65\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x68\x65\x69\x67\x68\x74\x22\x2c\x22\x77\x69\x64\x74\x68\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x3d\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x69\x66\x28\x63\x29\x72\x65\x74\x75\x72\x6e\x21\x50\x61\x2e\x74\x65\x73\x74\x28\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x29\x7c\x7c\x61\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2e\x77\x69\x64\x74\x68\x3f\x58\x61\x28\x61\x2c\x62\x2c\x64\x29\x3a\x64\x61\x28\x61\x2c\x51\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x58\x61\x28\x61\x2c\x62\x2c\x64\x29\x7d\x29\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x3d\x64\x26\x26\x4d\x61\x28\x61\x29\x2c\x67\x3d\x64\x26\x26\x57\x61\x28\x61\x2c\x62\x2c\x64\x2c\x22\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x62\x6f\x78\x53\x69\x7a\x69\x6e\x67\x22\x2c\x21\x31\x2c\x66\x29\x2c\x66\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x26\x26\x28\x65\x3d\x61\x61\x2e\x65\x78\x65\x63\x28\x63\x29\x29\x26\x26\x22\x70\x78\x22\x21\x3d\x3d\x28\x65\x5b\x33\x5d\x7c\x7c\x22\x70\x78\x22\x29\x26\x26\x28\x61\x2e\x73\x74\x79\x6c\x65\x5b\x62\x5d\x3d\x63\x2c\x63\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x29\x29\x2c\x56\x61\x28\x61\x2c\x63\x2c\x67\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x2e\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x3d\x4f\x61\x28\x6f\x2e\x72\x65\x6c\x69\x61\x62\x6c\x65\x4d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x62\x29\x72\x65\x74\x75\x72\x6e\x28\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x4e\x61\x28\x61\x2c\x22\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x22\x29\x29\x7c\x7c\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2e\x6c\x65\x66\x74\x2d\x64\x61\x28\x61\x2c\x7b\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x3a\x30\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2e\x6c\x65\x66\x74\x7d\x29\x29\x2b\x22\x70\x78\x22\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x6d\x61\x72\x67\x69\x6e\x3a\x22\x22\x2c\x70\x61\x64\x64\x69\x6e\x67\x3a\x22\x22\x2c\x62\x6f\x72\x64\x65\x72\x3a\x22\x57\x69\x64\x74\x68\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x61\x2b\x62\x5d\x3d\x7b\x65\x78\x70\x61\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x3d\x30\x2c\x65\x3d\x7b\x7d\x2c\x66\x3d\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x3f\x63\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x3a\x5b\x63\x5d\x3b\x64\x3c\x34\x3b\x64\x2b\x2b\x29\x65\x5b\x61\x2b\x62\x61\x5b\x64\x5d\x2b\x62\x5d\x3d\x66\x5b\x64\x5d\x7c\x7c\x66\x5b\x64\x2d\x32\x5d\x7c\x7c\x66\x5b\x30\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x7d\x7d\x2c\x4b\x61\x2e\x74\x65\x73\x74\x28\x61\x29\x7c\x7c\x28\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x61\x2b\x62\x5d\x2e\x73\x65\x74\x3d\x56\x61\x29\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x63\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x7b\x7d\x2c\x67\x3d\x30\x3b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x29\x7b\x66\x6f\x72\x28\x64\x3d\x4d\x61\x28\x61\x29\x2c\x65\x3d\x62\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x67\x3c\x65\x3b\x67\x2b\x2b\x29\x66\x5b\x62\x5b\x67\x5d\x5d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x5b\x67\x5d\x2c\x21\x31\x2c\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x66\x7d\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x62\x2c\x63\x29\x3a\x72\x2e\x63\x73\x73\x28\x61\x2c\x62\x29\x7d\x2c\x61\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x29\x7d\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x59\x61\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x69\x6e\x69\x74\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x29\x7d\x72\x2e\x54\x77\x65\x65\x6e\x3d\x59\x61\x2c\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x7b\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x6f\x72\x3a\x59\x61\x2c\x69\x6e\x69\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x29\x7b\x74\x68\x69\x73\x2e\x65\x6c\x65\x6d\x3d\x61\x2c\x74\x68\x69\x73\x2e\x70\x72\x6f\x70\x3d\x63\x2c\x74\x68\x69\x73\x2e\x65\x61\x73\x69\x6e\x67\x3d\x65\x7c\x7c\x72\x2e\x65\x61\x73\x69\x6e\x67\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x3d\x62\x2c\x74\x68\x69\x73\x2e\x73\x74\x61\x72\x74\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x77\x3d\x74\x68\x69\x73\x2e\x63\x75\x72\x28\x29\x2c\x74\x68\x69\x73\x2e\x65\x6e\x64\x3d\x64\x2c\x74\x68\x69\x73\x2e\x75\x6e\x69\x74\x3d\x66\x7c\x7c\x28\x72\x2e\x63\x73\x73\x4e\x75\x6d\x62\x65\x72\x5b\x63\x5d\x3f\x22\x22\x3a\x22\x70\x78\x22\x29\x7d\x2c\x63\x75\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x70\x72\x6f\x70\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x26\x26\x61\x2e\x67\x65\x74\x3f\x61\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x3a\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x7d\x2c\x72\x75\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x70\x72\x6f\x70\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3f\x74\x68\x69\x73\x2e\x70\x6f\x73\x3d\x62\x3d\x72\x2e\x65\x61\x73\x69\x6e\x67\x5b\x74\x68\x69\x73\x2e\x65\x61\x73\x69\x6e\x67\x5d\x28\x61\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x2a\x61\x2c\x30\x2c\x31\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x29\x3a\x74\x68\x69\x73\x2e\x70\x6f\x73\x3d\x62\x3d\x61\x2c\x74\x68\x69\x73\x2e\x6e\x6f\x77\x3d\x28\x74\x68\x69\x73\x2e\x65\x6e\x64\x2d\x74\x68\x69\x73\x2e\x73\x74\x61\x72\x74\x29\x2a\x62\x2b\x74\x68\x69\x73\x2e\x73\x74\x61\x72\x74\x2c\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x73\x74\x65\x70\x26\x26\x74\x68\x69\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2e\x73\x74\x65\x70\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2e\x65\x6c\x65\x6d\x2c\x74\x68\x69\x73\x2e\x6e\x6f\x77\x2c\x74\x68\x69\x73\x29\x2c\x63\x26\x26\x63\x2e\x73\x65\x74\x3f\x63\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x29\x3a\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x29\x2c\x74\x68\x69\x73\x7d\x7d\x2c\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x69\x6e\x69\x74\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x3d\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2c\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x3d\x7b\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x72\x65\x74\x75\x72\x6e\x20\x31\x21\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x6e\x75\x6c\x6c\x21\x3d\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x26\x26\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x73\x74\x79\x6c\x65\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3f\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3a\x28\x62\x3d\x72\x2e\x63\x73\x73\x28\x61\x2e\x65\x6c\x65\x6d\x2c\x61\x2e\x70\x72\x6f\x70\x2c\x22\x22\x29\x2c\x62\x26\x26\x22\x61\x75\x74\x6f\x22\x21\x3d\x3d\x62\x3f\x62\x3a\x30\x29\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x66\x78\x2e\x73\x74\x65\x70\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3f\x72\x2e\x66\x78\x2e\x73\x74\x65\x70\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x28\x61\x29\x3a\x31\x21\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x6e\x75\x6c\x6c\x3d\x3d\x61\x2e\x65\x6c\x65\x6d\x2e\x73\x74\x79\x6c\x65\x5b\x72\x2e\x63\x73\x73\x50\x72\x6f\x70\x73\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x5d\x26\x26\x21\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3f\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3d\x61\x2e\x6e\x6f\x77\x3a\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2e\x65\x6c\x65\x6d\x2c\x61\x2e\x70\x72\x6f\x70\x2c\x61\x2e\x6e\x6f\x77\x2b\x61\x2e\x75\x6e\x69\x74\x29\x7d\x7d\x7d\x2c\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x3d\x59\x61\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x73\x63\x72\x6f\x6c\x6c\x4c\x65\x66\x74\x3d\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x2e\x65\x6c\x65\x6d\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x65\x6c\x65\x6d\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x28\x61\x2e\x65\x6c\x65\x6d\x5b\x61\x2e\x70\x72\x6f\x70\x5d\x3d\x61\x2e\x6e\x6f\x77\x29\x7d\x7d\x2c\x72\x2e\x65\x61\x73\x69\x6e\x67\x3d\x7b\x6c\x69\x6e\x65\x61\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x2c\x73\x77\x69\x6e\x67\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x2e\x35\x2d\x4d\x61\x74\x68\x2e\x63\x6f\x73\x28\x61\x2a\x4d\x61\x74\x68\x2e\x50\x49\x29\x2f\x32\x7d\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x22\x73\x77\x69\x6e\x67\x22\x7d\x2c\x72\x2e\x66\x78\x3d\x59\x61\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x69\x6e\x69\x74\x2c\x72\x2e\x66\x78\x2e\x73\x74\x65\x70\x3d\x7b\x7d\x3b\x76\x61\x72\x20\x5a\x61\x2c\x24\x61\x2c\x5f\x61\x3d\x2f\x5e\x28\x3f\x3a\x74\x6f\x67\x67\x6c\x65\x7c\x73\x68\x6f\x77\x7c\x68\x69\x64\x65\x29\x24\x2f\x2c\x61\x62\x3d\x2f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x24\x2f\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x62\x28\x29\x7b\x24\x61\x26\x26\x28\x61\x2e\x72\x65\x71\x75\x65\x73\x74\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x28\x62\x62\x29\x2c\x72\x2e\x66\x78\x2e\x74\x69\x63\x6b\x28\x29\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x62\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x5a\x61\x3d\x76\x6f\x69\x64\x20\x30\x7d\x29\x2c\x5a\x61\x3d\x72\x2e\x6e\x6f\x77\x28\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x64\x62\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x7b\x68\x65\x69\x67\x68\x74\x3a\x61\x7d\x3b\x66\x6f\x72\x28\x62\x3d\x62\x3f\x31\x3a\x30\x3b\x64\x3c\x34\x3b\x64\x2b\x3d\x32\x2d\x62\x29\x63\x3d\x62\x61\x5b\x64\x5d\x2c\x65\x5b\x22\x6d\x61\x72\x67\x69\x6e\x22\x2b\x63\x5d\x3d\x65\x5b\x22\x70\x61\x64\x64\x69\x6e\x67\x22\x2b\x63\x5d\x3d\x61\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x26\x26\x28\x65\x2e\x6f\x70\x61\x63\x69\x74\x79\x3d\x65\x2e\x77\x69\x64\x74\x68\x3d\x61\x29\x2c\x65\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x65\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x66\x6f\x72\x28\x76\x61\x72\x20\x64\x2c\x65\x3d\x28\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x62\x5d\x7c\x7c\x5b\x5d\x29\x2e\x63\x6f\x6e\x63\x61\x74\x28\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x22\x2a\x22\x5d\x29\x2c\x66\x3d\x30\x2c\x67\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x69\x66\x28\x64\x3d\x65\x5b\x66\x5d\x2e\x63\x61\x6c\x6c\x28\x63\x2c\x62\x2c\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x3d\x22\x77\x69\x64\x74\x68\x22\x69\x6e\x20\x62\x7c\x7c\x22\x68\x65\x69\x67\x68\x74\x22\x69\x6e\x20\x62\x2c\x6d\x3d\x74\x68\x69\x73\x2c\x6e\x3d\x7b\x7d\x2c\x6f\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2c\x70\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x63\x61\x28\x61\x29\x2c\x71\x3d\x56\x2e\x67\x65\x74\x28\x61\x2c\x22\x66\x78\x73\x68\x6f\x77\x22\x29\x3b\x63\x2e\x71\x75\x65\x75\x65\x7c\x7c\x28\x67\x3d\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x61\x2c\x22\x66\x78\x22\x29\x2c\x6e\x75\x6c\x6c\x3d\x3d\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x26\x26\x28\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x3d\x30\x2c\x68\x3d\x67\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x2c\x67\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x7c\x7c\x68\x28\x29\x7d\x29\x2c\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x2b\x2b\x2c\x6d\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6d\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x67\x2e\x75\x6e\x71\x75\x65\x75\x65\x64\x2d\x2d\x2c\x72\x2e\x71\x75\x65\x75\x65\x28\x61\x2c\x22\x66\x78\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x67\x2e\x65\x6d\x70\x74\x79\x2e\x66\x69\x72\x65\x28\x29\x7d\x29\x7d\x29\x29\x3b\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x62\x29\x69\x66\x28\x65\x3d\x62\x5b\x64\x5d\x2c\x5f\x61\x2e\x74\x65\x73\x74\x28\x65\x29\x29\x7b\x69\x66\x28\x64\x65\x6c\x65\x74\x65\x20\x62\x5b\x64\x5d\x2c\x66\x3d\x66\x7c\x7c\x22\x74\x6f\x67\x67\x6c\x65\x22\x3d\x3d\x3d\x65\x2c\x65\x3d\x3d\x3d\x28\x70\x3f\x22\x68\x69\x64\x65\x22\x3a\x22\x73\x68\x6f\x77\x22\x29\x29\x7b\x69\x66\x28\x22\x73\x68\x6f\x77\x22\x21\x3d\x3d\x65\x7c\x7c\x21\x71\x7c\x7c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x71\x5b\x64\x5d\x29\x63\x6f\x6e\x74\x69\x6e\x75\x65\x3b\x70\x3d\x21\x30\x7d\x6e\x5b\x64\x5d\x3d\x71\x26\x26\x71\x5b\x64\x5d\x7c\x7c\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x64\x29\x7d\x69\x66\x28\x69\x3d\x21\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x62\x29\x2c\x69\x7c\x7c\x21\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x6e\x29\x29\x7b\x6c\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3d\x5b\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x58\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x59\x5d\x2c\x6a\x3d\x71\x26\x26\x71\x2e\x64\x69\x73\x70\x6c\x61\x79\x2c\x6e\x75\x6c\x6c\x3d\x3d\x6a\x26\x26\x28\x6a\x3d\x56\x2e\x67\x65\x74\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x29\x2c\x6b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x2c\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x6b\x26\x26\x28\x6a\x3f\x6b\x3d\x6a\x3a\x28\x68\x61\x28\x5b\x61\x5d\x2c\x21\x30\x29\x2c\x6a\x3d\x61\x2e\x73\x74\x79\x6c\x65\x2e\x64\x69\x73\x70\x6c\x61\x79\x7c\x7c\x6a\x2c\x6b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x64\x69\x73\x70\x6c\x61\x79\x22\x29\x2c\x68\x61\x28\x5b\x61\x5d\x29\x29\x29\x2c\x28\x22\x69\x6e\x6c\x69\x6e\x65\x22\x3d\x3d\x3d\x6b\x7c\x7c\x22\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x22\x3d\x3d\x3d\x6b\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x6a\x29\x26\x26\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x66\x6c\x6f\x61\x74\x22\x29\x26\x26\x28\x69\x7c\x7c\x28\x6d\x2e\x64\x6f\x6e\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6f\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x6a\x7d\x29\x2c\x6e\x75\x6c\x6c\x3d\x3d\x6a\x26\x26\x28\x6b\x3d\x6f\x2e\x64\x69\x73\x70\x6c\x61\x79\x2c\x6a\x3d\x22\x6e\x6f\x6e\x65\x22\x3d\x3d\x3d\x6b\x3f\x22\x22\x3a\x6b\x29\x29\x2c\x6f\x2e\x64\x69\x73\x70\x6c\x61\x79\x3d\x22\x69\x6e\x6c\x69\x6e\x65\x2d\x62\x6c\x6f\x63\x6b\x22\x29\x29\x2c\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x26\x26\x28\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3d\x22\x68\x69\x64\x64\x65\x6e\x22\x2c\x6d\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3d\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x5b\x30\x5d\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x58\x3d\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x5b\x31\x5d\x2c\x6f\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x59\x3d\x63\x2e\x6f\x76\x65\x72\x66\x6c\x6f\x77\x5b\x32\x5d\x7d\x29\x29\x2c\x69\x3d\x21\x31\x3b\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x6e\x29\x69\x7c\x7c\x28\x71\x3f\x22\x68\x69\x64\x64\x65\x6e\x22\x69\x6e\x20\x71\x26\x26\x28\x70\x3d\x71\x2e\x68\x69\x64\x64\x65\x6e\x29\x3a\x71\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x61\x2c\x22\x66\x78\x73\x68\x6f\x77\x22\x2c\x7b\x64\x69\x73\x70\x6c\x61\x79\x3a\x6a\x7d\x29\x2c\x66\x26\x26\x28\x71\x2e\x68\x69\x64\x64\x65\x6e\x3d\x21\x70\x29\x2c\x70\x26\x26\x68\x61\x28\x5b\x61\x5d\x2c\x21\x30\x29\x2c\x6d\x2e\x64\x6f\x6e\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x70\x7c\x7c\x68\x61\x28\x5b\x61\x5d\x29\x2c\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x61\x2c\x22\x66\x78\x73\x68\x6f\x77\x22\x29\x3b\x66\x6f\x72\x28\x64\x20\x69\x6e\x20\x6e\x29\x72\x2e\x73\x74\x79\x6c\x65\x28\x61\x2c\x64\x2c\x6e\x5b\x64\x5d\x29\x7d\x29\x29\x2c\x69\x3d\x65\x62\x28\x70\x3f\x71\x5b\x64\x5d\x3a\x30\x2c\x64\x2c\x6d\x29\x2c\x64\x20\x69\x6e\x20\x71\x7c\x7c\x28\x71\x5b\x64\x5d\x3d\x69\x2e\x73\x74\x61\x72\x74\x2c\x70\x26\x26\x28\x69\x2e\x65\x6e\x64\x3d\x69\x2e\x73\x74\x61\x72\x74\x2c\x69\x2e\x73\x74\x61\x72\x74\x3d\x30\x29\x29\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x62\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x3b\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x61\x29\x69\x66\x28\x64\x3d\x72\x2e\x63\x61\x6d\x65\x6c\x43\x61\x73\x65\x28\x63\x29\x2c\x65\x3d\x62\x5b\x64\x5d\x2c\x66\x3d\x61\x5b\x63\x5d\x2c\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x66\x29\x26\x26\x28\x65\x3d\x66\x5b\x31\x5d\x2c\x66\x3d\x61\x5b\x63\x5d\x3d\x66\x5b\x30\x5d\x29\x2c\x63\x21\x3d\x3d\x64\x26\x26\x28\x61\x5b\x64\x5d\x3d\x66\x2c\x64\x65\x6c\x65\x74\x65\x20\x61\x5b\x63\x5d\x29\x2c\x67\x3d\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x64\x5d\x2c\x67\x26\x26\x22\x65\x78\x70\x61\x6e\x64\x22\x69\x6e\x20\x67\x29\x7b\x66\x3d\x67\x2e\x65\x78\x70\x61\x6e\x64\x28\x66\x29\x2c\x64\x65\x6c\x65\x74\x65\x20\x61\x5b\x64\x5d\x3b\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x66\x29\x63\x20\x69\x6e\x20\x61\x7c\x7c\x28\x61\x5b\x63\x5d\x3d\x66\x5b\x63\x5d\x2c\x62\x5b\x63\x5d\x3d\x65\x29\x7d\x65\x6c\x73\x65\x20\x62\x5b\x64\x5d\x3d\x65\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x30\x2c\x67\x3d\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x2c\x68\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x64\x65\x6c\x65\x74\x65\x20\x69\x2e\x65\x6c\x65\x6d\x7d\x29\x2c\x69\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x65\x29\x72\x65\x74\x75\x72\x6e\x21\x31\x3b\x66\x6f\x72\x28\x76\x61\x72\x20\x62\x3d\x5a\x61\x7c\x7c\x63\x62\x28\x29\x2c\x63\x3d\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x30\x2c\x6a\x2e\x73\x74\x61\x72\x74\x54\x69\x6d\x65\x2b\x6a\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x2d\x62\x29\x2c\x64\x3d\x63\x2f\x6a\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x7c\x7c\x30\x2c\x66\x3d\x31\x2d\x64\x2c\x67\x3d\x30\x2c\x69\x3d\x6a\x2e\x74\x77\x65\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x67\x3c\x69\x3b\x67\x2b\x2b\x29\x6a\x2e\x74\x77\x65\x65\x6e\x73\x5b\x67\x5d\x2e\x72\x75\x6e\x28\x66\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x68\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x66\x2c\x63\x5d\x29\x2c\x66\x3c\x31\x26\x26\x69\x3f\x63\x3a\x28\x68\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x5d\x29\x2c\x21\x31\x29\x7d\x2c\x6a\x3d\x68\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x7b\x65\x6c\x65\x6d\x3a\x61\x2c\x70\x72\x6f\x70\x73\x3a\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x62\x29\x2c\x6f\x70\x74\x73\x3a\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x21\x30\x2c\x7b\x73\x70\x65\x63\x69\x61\x6c\x45\x61\x73\x69\x6e\x67\x3a\x7b\x7d\x2c\x65\x61\x73\x69\x6e\x67\x3a\x72\x2e\x65\x61\x73\x69\x6e\x67\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x7d\x2c\x63\x29\x2c\x6f\x72\x69\x67\x69\x6e\x61\x6c\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x62\x2c\x6f\x72\x69\x67\x69\x6e\x61\x6c\x4f\x70\x74\x69\x6f\x6e\x73\x3a\x63\x2c\x73\x74\x61\x72\x74\x54\x69\x6d\x65\x3a\x5a\x61\x7c\x7c\x63\x62\x28\x29\x2c\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x63\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x2c\x74\x77\x65\x65\x6e\x73\x3a\x5b\x5d\x2c\x63\x72\x65\x61\x74\x65\x54\x77\x65\x65\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x72\x2e\x54\x77\x65\x65\x6e\x28\x61\x2c\x6a\x2e\x6f\x70\x74\x73\x2c\x62\x2c\x63\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x70\x65\x63\x69\x61\x6c\x45\x61\x73\x69\x6e\x67\x5b\x62\x5d\x7c\x7c\x6a\x2e\x6f\x70\x74\x73\x2e\x65\x61\x73\x69\x6e\x67\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6a\x2e\x74\x77\x65\x65\x6e\x73\x2e\x70\x75\x73\x68\x28\x64\x29\x2c\x64\x7d\x2c\x73\x74\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x30\x2c\x64\x3d\x62\x3f\x6a\x2e\x74\x77\x65\x65\x6e\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x30\x3b\x69\x66\x28\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x3b\x66\x6f\x72\x28\x65\x3d\x21\x30\x3b\x63\x3c\x64\x3b\x63\x2b\x2b\x29\x6a\x2e\x74\x77\x65\x65\x6e\x73\x5b\x63\x5d\x2e\x72\x75\x6e\x28\x31\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x28\x68\x2e\x6e\x6f\x74\x69\x66\x79\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x31\x2c\x30\x5d\x29\x2c\x68\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x62\x5d\x29\x29\x3a\x68\x2e\x72\x65\x6a\x65\x63\x74\x57\x69\x74\x68\x28\x61\x2c\x5b\x6a\x2c\x62\x5d\x29\x2c\x74\x68\x69\x73\x7d\x7d\x29\x2c\x6b\x3d\x6a\x2e\x70\x72\x6f\x70\x73\x3b\x66\x6f\x72\x28\x67\x62\x28\x6b\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x70\x65\x63\x69\x61\x6c\x45\x61\x73\x69\x6e\x67\x29\x3b\x66\x3c\x67\x3b\x66\x2b\x2b\x29\x69\x66\x28\x64\x3d\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x5b\x66\x5d\x2e\x63\x61\x6c\x6c\x28\x6a\x2c\x61\x2c\x6b\x2c\x6a\x2e\x6f\x70\x74\x73\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x2e\x73\x74\x6f\x70\x29\x26\x26\x28\x72\x2e\x5f\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x28\x6a\x2e\x65\x6c\x65\x6d\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x71\x75\x65\x75\x65\x29\x2e\x73\x74\x6f\x70\x3d\x72\x2e\x70\x72\x6f\x78\x79\x28\x64\x2e\x73\x74\x6f\x70\x2c\x64\x29\x29\x2c\x64\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6d\x61\x70\x28\x6b\x2c\x65\x62\x2c\x6a\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x74\x61\x72\x74\x29\x26\x26\x6a\x2e\x6f\x70\x74\x73\x2e\x73\x74\x61\x72\x74\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6a\x29\x2c\x72\x2e\x66\x78\x2e\x74\x69\x6d\x65\x72\x28\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x69\x2c\x7b\x65\x6c\x65\x6d\x3a\x61\x2c\x61\x6e\x69\x6d\x3a\x6a\x2c\x71\x75\x65\x75\x65\x3a\x6a\x2e\x6f\x70\x74\x73\x2e\x71\x75\x65\x75\x65\x7d\x29\x29\x2c\x6a\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x29\x2e\x64\x6f\x6e\x65\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x64\x6f\x6e\x65\x2c\x6a\x2e\x6f\x70\x74\x73\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x29\x2e\x66\x61\x69\x6c\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x66\x61\x69\x6c\x29\x2e\x61\x6c\x77\x61\x79\x73\x28\x6a\x2e\x6f\x70\x74\x73\x2e\x61\x6c\x77\x61\x79\x73\x29\x7d\x72\x2e\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x68\x62\x2c\x7b\x74\x77\x65\x65\x6e\x65\x72\x73\x3a\x7b\x22\x2a\x22\x3a\x5b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x68\x69\x73\x2e\x63\x72\x65\x61\x74\x65\x54\x77\x65\x65\x6e\x28\x61\x2c\x62\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x61\x28\x63\x2e\x65\x6c\x65\x6d\x2c\x61\x2c\x61\x61\x2e\x65\x78\x65\x63\x28\x62\x29\x2c\x63\x29\x2c\x63\x7d\x5d\x7d\x2c\x74\x77\x65\x65\x6e\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x28\x62\x3d\x61\x2c\x61\x3d\x5b\x22\x2a\x22\x5d\x29\x3a\x61\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x3b\x66\x6f\x72\x28\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x61\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x64\x3c\x65\x3b\x64\x2b\x2b\x29\x63\x3d\x61\x5b\x64\x5d\x2c\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x63\x5d\x3d\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x63\x5d\x7c\x7c\x5b\x5d\x2c\x68\x62\x2e\x74\x77\x65\x65\x6e\x65\x72\x73\x5b\x63\x5d\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x62\x29\x7d\x2c\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x3a\x5b\x66\x62\x5d\x2c\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x62\x3f\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x61\x29\x3a\x68\x62\x2e\x70\x72\x65\x66\x69\x6c\x74\x65\x72\x73\x2e\x70\x75\x73\x68\x28\x61\x29\x7d\x7d\x29\x2c\x72\x2e\x73\x70\x65\x65\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x65\x3d\x61\x26\x26\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x61\x29\x3a\x7b\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3a\x63\x7c\x7c\x21\x63\x26\x26\x62\x7c\x7c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x26\x26\x61\x2c\x64\x75\x72\x61\x74\x69\x6f\x6e\x3a\x61\x2c\x65\x61\x73\x69\x6e\x67\x3a\x63\x26\x26\x62\x7c\x7c\x62\x26\x26\x21\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x26\x26\x62\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x66\x78\x2e\x6f\x66\x66\x7c\x7c\x64\x2e\x68\x69\x64\x64\x65\x6e\x3f\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3d\x30\x3a\x22\x6e\x75\x6d\x62\x65\x72\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x26\x26\x28\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x20\x69\x6e\x20\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x3f\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3d\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x5b\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x5d\x3a\x65\x2e\x64\x75\x72\x61\x74\x69\x6f\x6e\x3d\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x65\x2e\x71\x75\x65\x75\x65\x26\x26\x65\x2e\x71\x75\x65\x75\x65\x21\x3d\x3d\x21\x30\x7c\x7c\x28\x65\x2e\x71\x75\x65\x75\x65\x3d\x22\x66\x78\x22\x29\x2c\x65\x2e\x6f\x6c\x64\x3d\x65\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x2c\x65\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2e\x6f\x6c\x64\x29\x26\x26\x65\x2e\x6f\x6c\x64\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x2c\x65\x2e\x71\x75\x65\x75\x65\x26\x26\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x65\x2e\x71\x75\x65\x75\x65\x29\x7d\x2c\x65\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x66\x61\x64\x65\x54\x6f\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x66\x69\x6c\x74\x65\x72\x28\x63\x61\x29\x2e\x63\x73\x73\x28\x22\x6f\x70\x61\x63\x69\x74\x79\x22\x2c\x30\x29\x2e\x73\x68\x6f\x77\x28\x29\x2e\x65\x6e\x64\x28\x29\x2e\x61\x6e\x69\x6d\x61\x74\x65\x28\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x62\x7d\x2c\x61\x2c\x63\x2c\x64\x29\x7d\x2c\x61\x6e\x69\x6d\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x72\x2e\x69\x73\x45\x6d\x70\x74\x79\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x2c\x66\x3d\x72\x2e\x73\x70\x65\x65\x64\x28\x62\x2c\x63\x2c\x64\x29\x2c\x67\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x3d\x68\x62\x28\x74\x68\x69\x73\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x61\x29\x2c\x66\x29\x3b\x28\x65\x7c\x7c\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x66\x69\x6e\x69\x73\x68\x22\x29\x29\x26\x26\x62\x2e\x73\x74\x6f\x70\x28\x21\x30\x29\x7d\x3b\x72\x65\x74\x75\x72\x6e\x20\x67\x2e\x66\x69\x6e\x69\x73\x68\x3d\x67\x2c\x65\x7c\x7c\x66\x2e\x71\x75\x65\x75\x65\x3d\x3d\x3d\x21\x31\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x67\x29\x3a\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x66\x2e\x71\x75\x65\x75\x65\x2c\x67\x29\x7d\x2c\x73\x74\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x73\x74\x6f\x70\x3b\x64\x65\x6c\x65\x74\x65\x20\x61\x2e\x73\x74\x6f\x70\x2c\x62\x28\x63\x29\x7d\x3b\x72\x65\x74\x75\x72\x6e\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x61\x2c\x61\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x62\x26\x26\x61\x21\x3d\x3d\x21\x31\x26\x26\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x61\x7c\x7c\x22\x66\x78\x22\x2c\x5b\x5d\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x3d\x21\x30\x2c\x65\x3d\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x61\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x2c\x66\x3d\x72\x2e\x74\x69\x6d\x65\x72\x73\x2c\x67\x3d\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x3b\x69\x66\x28\x65\x29\x67\x5b\x65\x5d\x26\x26\x67\x5b\x65\x5d\x2e\x73\x74\x6f\x70\x26\x26\x64\x28\x67\x5b\x65\x5d\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x67\x29\x67\x5b\x65\x5d\x26\x26\x67\x5b\x65\x5d\x2e\x73\x74\x6f\x70\x26\x26\x61\x62\x2e\x74\x65\x73\x74\x28\x65\x29\x26\x26\x64\x28\x67\x5b\x65\x5d\x29\x3b\x66\x6f\x72\x28\x65\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x65\x2d\x2d\x3b\x29\x66\x5b\x65\x5d\x2e\x65\x6c\x65\x6d\x21\x3d\x3d\x74\x68\x69\x73\x7c\x7c\x6e\x75\x6c\x6c\x21\x3d\x61\x26\x26\x66\x5b\x65\x5d\x2e\x71\x75\x65\x75\x65\x21\x3d\x3d\x61\x7c\x7c\x28\x66\x5b\x65\x5d\x2e\x61\x6e\x69\x6d\x2e\x73\x74\x6f\x70\x28\x63\x29\x2c\x62\x3d\x21\x31\x2c\x66\x2e\x73\x70\x6c\x69\x63\x65\x28\x65\x2c\x31\x29\x29\x3b\x21\x62\x26\x26\x63\x7c\x7c\x72\x2e\x64\x65\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x2c\x66\x69\x6e\x69\x73\x68\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x21\x3d\x3d\x21\x31\x26\x26\x28\x61\x3d\x61\x7c\x7c\x22\x66\x78\x22\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3d\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x29\x2c\x64\x3d\x63\x5b\x61\x2b\x22\x71\x75\x65\x75\x65\x22\x5d\x2c\x65\x3d\x63\x5b\x61\x2b\x22\x71\x75\x65\x75\x65\x48\x6f\x6f\x6b\x73\x22\x5d\x2c\x66\x3d\x72\x2e\x74\x69\x6d\x65\x72\x73\x2c\x67\x3d\x64\x3f\x64\x2e\x6c\x65\x6e\x67\x74\x68\x3a\x30\x3b\x66\x6f\x72\x28\x63\x2e\x66\x69\x6e\x69\x73\x68\x3d\x21\x30\x2c\x72\x2e\x71\x75\x65\x75\x65\x28\x74\x68\x69\x73\x2c\x61\x2c\x5b\x5d\x29\x2c\x65\x26\x26\x65\x2e\x73\x74\x6f\x70\x26\x26\x65\x2e\x73\x74\x6f\x70\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x21\x30\x29\x2c\x62\x3d\x66\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x62\x2d\x2d\x3b\x29\x66\x5b\x62\x5d\x2e\x65\x6c\x65\x6d\x3d\x3d\x3d\x74\x68\x69\x73\x26\x26\x66\x5b\x62\x5d\x2e\x71\x75\x65\x75\x65\x3d\x3d\x3d\x61\x26\x26\x28\x66\x5b\x62\x5d\x2e\x61\x6e\x69\x6d\x2e\x73\x74\x6f\x70\x28\x21\x30\x29\x2c\x66\x2e\x73\x70\x6c\x69\x63\x65\x28\x62\x2c\x31\x29\x29\x3b\x66\x6f\x72\x28\x62\x3d\x30\x3b\x62\x3c\x67\x3b\x62\x2b\x2b\x29\x64\x5b\x62\x5d\x26\x26\x64\x5b\x62\x5d\x2e\x66\x69\x6e\x69\x73\x68\x26\x26\x64\x5b\x62\x5d\x2e\x66\x69\x6e\x69\x73\x68\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x29\x3b\x64\x65\x6c\x65\x74\x65\x20\x63\x2e\x66\x69\x6e\x69\x73\x68\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x74\x6f\x67\x67\x6c\x65\x22\x2c\x22\x73\x68\x6f\x77\x22\x2c\x22\x68\x69\x64\x65\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x2e\x66\x6e\x5b\x62\x5d\x3b\x72\x2e\x66\x6e\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x7c\x7c\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3f\x63\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x29\x3a\x74\x68\x69\x73\x2e\x61\x6e\x69\x6d\x61\x74\x65\x28\x64\x62\x28\x62\x2c\x21\x30\x29\x2c\x61\x2c\x64\x2c\x65\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x73\x6c\x69\x64\x65\x44\x6f\x77\x6e\x3a\x64\x62\x28\x22\x73\x68\x6f\x77\x22\x29\x2c\x73\x6c\x69\x64\x65\x55\x70\x3a\x64\x62\x28\x22\x68\x69\x64\x65\x22\x29\x2c\x73\x6c\x69\x64\x65\x54\x6f\x67\x67\x6c\x65\x3a\x64\x62\x28\x22\x74\x6f\x67\x67\x6c\x65\x22\x29\x2c\x66\x61\x64\x65\x49\x6e\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x22\x73\x68\x6f\x77\x22\x7d\x2c\x66\x61\x64\x65\x4f\x75\x74\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x22\x68\x69\x64\x65\x22\x7d\x2c\x66\x61\x64\x65\x54\x6f\x67\x67\x6c\x65\x3a\x7b\x6f\x70\x61\x63\x69\x74\x79\x3a\x22\x74\x6f\x67\x67\x6c\x65\x22\x7d\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x61\x6e\x69\x6d\x61\x74\x65\x28\x62\x2c\x61\x2c\x63\x2c\x64\x29\x7d\x7d\x29\x2c\x72\x2e\x74\x69\x6d\x65\x72\x73\x3d\x5b\x5d\x2c\x72\x2e\x66\x78\x2e\x74\x69\x63\x6b\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x2c\x62\x3d\x30\x2c\x63\x3d\x72\x2e\x74\x69\x6d\x65\x72\x73\x3b\x66\x6f\x72\x28\x5a\x61\x3d\x72\x2e\x6e\x6f\x77\x28\x29\x3b\x62\x3c\x63\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x62\x2b\x2b\x29\x61\x3d\x63\x5b\x62\x5d\x2c\x61\x28\x29\x7c\x7c\x63\x5b\x62\x5d\x21\x3d\x3d\x61\x7c\x7c\x63\x2e\x73\x70\x6c\x69\x63\x65\x28\x62\x2d\x2d\x2c\x31\x29\x3b\x63\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x72\x2e\x66\x78\x2e\x73\x74\x6f\x70\x28\x29\x2c\x5a\x61\x3d\x76\x6f\x69\x64\x20\x30\x7d\x2c\x72\x2e\x66\x78\x2e\x74\x69\x6d\x65\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x74\x69\x6d\x65\x72\x73\x2e\x70\x75\x73\x68\x28\x61\x29\x2c\x61\x28\x29\x3f\x72\x2e\x66\x78\x2e\x73\x74\x61\x72\x74\x28\x29\x3a\x72\x2e\x74\x69\x6d\x65\x72\x73\x2e\x70\x6f\x70\x28\x29\x7d\x2c\x72\x2e\x66\x78\x2e\x69\x6e\x74\x65\x72\x76\x61\x6c\x3d\x31\x33\x2c\x72\x2e\x66\x78\x2e\x73\x74\x61\x72\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x24\x61\x7c\x7c\x28\x24\x61\x3d\x61\x2e\x72\x65\x71\x75\x65\x73\x74\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x3f\x61\x2e\x72\x65\x71\x75\x65\x73\x74\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x28\x62\x62\x29\x3a\x61\x2e\x73\x65\x74\x49\x6e\x74\x65\x72\x76\x61\x6c\x28\x72\x2e\x66\x78\x2e\x74\x69\x63\x6b\x2c\x72\x2e\x66\x78\x2e\x69\x6e\x74\x65\x72\x76\x61\x6c\x29\x29\x7d\x2c\x72\x2e\x66\x78\x2e\x73\x74\x6f\x70\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x61\x2e\x63\x61\x6e\x63\x65\x6c\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x3f\x61\x2e\x63\x61\x6e\x63\x65\x6c\x41\x6e\x69\x6d\x61\x74\x69\x6f\x6e\x46\x72\x61\x6d\x65\x28\x24\x61\x29\x3a\x61\x2e\x63\x6c\x65\x61\x72\x49\x6e\x74\x65\x72\x76\x61\x6c\x28\x24\x61\x29\x2c\x24\x61\x3d\x6e\x75\x6c\x6c\x7d\x2c\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x3d\x7b\x73\x6c\x6f\x77\x3a\x36\x30\x30\x2c\x66\x61\x73\x74\x3a\x32\x30\x30\x2c\x5f\x64\x65\x66\x61\x75\x6c\x74\x3a\x34\x30\x30\x7d\x2c\x72\x2e\x66\x6e\x2e\x64\x65\x6c\x61\x79\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x66\x78\x3f\x72\x2e\x66\x78\x2e\x73\x70\x65\x65\x64\x73\x5b\x62\x5d\x7c\x7c\x62\x3a\x62\x2c\x63\x3d\x63\x7c\x7c\x22\x66\x78\x22\x2c\x74\x68\x69\x73\x2e\x71\x75\x65\x75\x65\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x2c\x62\x29\x3b\x64\x2e\x73\x74\x6f\x70\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x61\x2e\x63\x6c\x65\x61\x72\x54\x69\x6d\x65\x6f\x75\x74\x28\x65\x29\x7d\x7d\x29\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x2c\x62\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x73\x65\x6c\x65\x63\x74\x22\x29\x2c\x63\x3d\x62\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x6f\x70\x74\x69\x6f\x6e\x22\x29\x29\x3b\x61\x2e\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x2c\x6f\x2e\x63\x68\x65\x63\x6b\x4f\x6e\x3d\x22\x22\x21\x3d\x3d\x61\x2e\x76\x61\x6c\x75\x65\x2c\x6f\x2e\x6f\x70\x74\x53\x65\x6c\x65\x63\x74\x65\x64\x3d\x63\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x2c\x61\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x69\x6e\x70\x75\x74\x22\x29\x2c\x61\x2e\x76\x61\x6c\x75\x65\x3d\x22\x74\x22\x2c\x61\x2e\x74\x79\x70\x65\x3d\x22\x72\x61\x64\x69\x6f\x22\x2c\x6f\x2e\x72\x61\x64\x69\x6f\x56\x61\x6c\x75\x65\x3d\x22\x74\x22\x3d\x3d\x3d\x61\x2e\x76\x61\x6c\x75\x65\x7d\x28\x29\x3b\x76\x61\x72\x20\x69\x62\x2c\x6a\x62\x3d\x72\x2e\x65\x78\x70\x72\x2e\x61\x74\x74\x72\x48\x61\x6e\x64\x6c\x65\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x72\x2e\x61\x74\x74\x72\x2c\x61\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x28\x74\x68\x69\x73\x2c\x61\x29\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3b\x69\x66\x28\x33\x21\x3d\x3d\x66\x26\x26\x38\x21\x3d\x3d\x66\x26\x26\x32\x21\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x22\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x3f\x72\x2e\x70\x72\x6f\x70\x28\x61\x2c\x62\x2c\x63\x29\x3a\x28\x31\x3d\x3d\x3d\x66\x26\x26\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x28\x61\x29\x7c\x7c\x28\x65\x3d\x72\x2e\x61\x74\x74\x72\x48\x6f\x6f\x6b\x73\x5b\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7c\x7c\x28\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x62\x6f\x6f\x6c\x2e\x74\x65\x73\x74\x28\x62\x29\x3f\x69\x62\x3a\x76\x6f\x69\x64\x20\x30\x29\x29\x2c\x0a\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x6e\x75\x6c\x6c\x3d\x3d\x3d\x63\x3f\x76\x6f\x69\x64\x20\x72\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x28\x61\x2c\x62\x29\x3a\x65\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x73\x65\x74\x28\x61\x2c\x63\x2c\x62\x29\x29\x3f\x64\x3a\x28\x61\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x62\x2c\x63\x2b\x22\x22\x29\x2c\x63\x29\x3a\x65\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x29\x3f\x64\x3a\x28\x64\x3d\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x28\x61\x2c\x62\x29\x2c\x6e\x75\x6c\x6c\x3d\x3d\x64\x3f\x76\x6f\x69\x64\x20\x30\x3a\x64\x29\x29\x7d\x2c\x61\x74\x74\x72\x48\x6f\x6f\x6b\x73\x3a\x7b\x74\x79\x70\x65\x3a\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x21\x6f\x2e\x72\x61\x64\x69\x6f\x56\x61\x6c\x75\x65\x26\x26\x22\x72\x61\x64\x69\x6f\x22\x3d\x3d\x3d\x62\x26\x26\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x2c\x22\x69\x6e\x70\x75\x74\x22\x29\x29\x7b\x76\x61\x72\x20\x63\x3d\x61\x2e\x76\x61\x6c\x75\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x74\x79\x70\x65\x22\x2c\x62\x29\x2c\x63\x26\x26\x28\x61\x2e\x76\x61\x6c\x75\x65\x3d\x63\x29\x2c\x62\x7d\x7d\x7d\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x30\x2c\x65\x3d\x62\x26\x26\x62\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x3b\x69\x66\x28\x65\x26\x26\x31\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x29\x77\x68\x69\x6c\x65\x28\x63\x3d\x65\x5b\x64\x2b\x2b\x5d\x29\x61\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x63\x29\x7d\x7d\x29\x2c\x69\x62\x3d\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x3d\x3d\x21\x31\x3f\x72\x2e\x72\x65\x6d\x6f\x76\x65\x41\x74\x74\x72\x28\x61\x2c\x63\x29\x3a\x61\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x63\x2c\x63\x29\x2c\x63\x7d\x7d\x2c\x72\x2e\x65\x61\x63\x68\x28\x72\x2e\x65\x78\x70\x72\x2e\x6d\x61\x74\x63\x68\x2e\x62\x6f\x6f\x6c\x2e\x73\x6f\x75\x72\x63\x65\x2e\x6d\x61\x74\x63\x68\x28\x2f\x5c\x77\x2b\x2f\x67\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x6a\x62\x5b\x62\x5d\x7c\x7c\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x3b\x6a\x62\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x7c\x7c\x28\x66\x3d\x6a\x62\x5b\x67\x5d\x2c\x6a\x62\x5b\x67\x5d\x3d\x65\x2c\x65\x3d\x6e\x75\x6c\x6c\x21\x3d\x63\x28\x61\x2c\x62\x2c\x64\x29\x3f\x67\x3a\x6e\x75\x6c\x6c\x2c\x6a\x62\x5b\x67\x5d\x3d\x66\x29\x2c\x65\x7d\x7d\x29\x3b\x76\x61\x72\x20\x6b\x62\x3d\x2f\x5e\x28\x3f\x3a\x69\x6e\x70\x75\x74\x7c\x73\x65\x6c\x65\x63\x74\x7c\x74\x65\x78\x74\x61\x72\x65\x61\x7c\x62\x75\x74\x74\x6f\x6e\x29\x24\x2f\x69\x2c\x6c\x62\x3d\x2f\x5e\x28\x3f\x3a\x61\x7c\x61\x72\x65\x61\x29\x24\x2f\x69\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x70\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x72\x2e\x70\x72\x6f\x70\x2c\x61\x2c\x62\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x31\x29\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x50\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x64\x65\x6c\x65\x74\x65\x20\x74\x68\x69\x73\x5b\x72\x2e\x70\x72\x6f\x70\x46\x69\x78\x5b\x61\x5d\x7c\x7c\x61\x5d\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x70\x72\x6f\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3b\x69\x66\x28\x33\x21\x3d\x3d\x66\x26\x26\x38\x21\x3d\x3d\x66\x26\x26\x32\x21\x3d\x3d\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x66\x26\x26\x72\x2e\x69\x73\x58\x4d\x4c\x44\x6f\x63\x28\x61\x29\x7c\x7c\x28\x62\x3d\x72\x2e\x70\x72\x6f\x70\x46\x69\x78\x5b\x62\x5d\x7c\x7c\x62\x2c\x65\x3d\x72\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x63\x3f\x65\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x73\x65\x74\x28\x61\x2c\x63\x2c\x62\x29\x29\x3f\x64\x3a\x61\x5b\x62\x5d\x3d\x63\x3a\x65\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x65\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x3d\x28\x64\x3d\x65\x2e\x67\x65\x74\x28\x61\x2c\x62\x29\x29\x3f\x64\x3a\x61\x5b\x62\x5d\x7d\x2c\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x3a\x7b\x74\x61\x62\x49\x6e\x64\x65\x78\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x28\x61\x2c\x22\x74\x61\x62\x69\x6e\x64\x65\x78\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x70\x61\x72\x73\x65\x49\x6e\x74\x28\x62\x2c\x31\x30\x29\x3a\x6b\x62\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x7c\x7c\x6c\x62\x2e\x74\x65\x73\x74\x28\x61\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x26\x26\x61\x2e\x68\x72\x65\x66\x3f\x30\x3a\x2d\x31\x7d\x7d\x7d\x2c\x70\x72\x6f\x70\x46\x69\x78\x3a\x7b\x22\x66\x6f\x72\x22\x3a\x22\x68\x74\x6d\x6c\x46\x6f\x72\x22\x2c\x22\x63\x6c\x61\x73\x73\x22\x3a\x22\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x22\x7d\x7d\x29\x2c\x6f\x2e\x6f\x70\x74\x53\x65\x6c\x65\x63\x74\x65\x64\x7c\x7c\x28\x72\x2e\x70\x72\x6f\x70\x48\x6f\x6f\x6b\x73\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x6e\x75\x6c\x6c\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x3b\x62\x26\x26\x28\x62\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x74\x61\x62\x49\x6e\x64\x65\x78\x22\x2c\x22\x72\x65\x61\x64\x4f\x6e\x6c\x79\x22\x2c\x22\x6d\x61\x78\x4c\x65\x6e\x67\x74\x68\x22\x2c\x22\x63\x65\x6c\x6c\x53\x70\x61\x63\x69\x6e\x67\x22\x2c\x22\x63\x65\x6c\x6c\x50\x61\x64\x64\x69\x6e\x67\x22\x2c\x22\x72\x6f\x77\x53\x70\x61\x6e\x22\x2c\x22\x63\x6f\x6c\x53\x70\x61\x6e\x22\x2c\x22\x75\x73\x65\x4d\x61\x70\x22\x2c\x22\x66\x72\x61\x6d\x65\x42\x6f\x72\x64\x65\x72\x22\x2c\x22\x63\x6f\x6e\x74\x65\x6e\x74\x45\x64\x69\x74\x61\x62\x6c\x65\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x70\x72\x6f\x70\x46\x69\x78\x5b\x74\x68\x69\x73\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x74\x68\x69\x73\x7d\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6d\x62\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x6a\x6f\x69\x6e\x28\x22\x20\x22\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x62\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x26\x26\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x29\x7c\x7c\x22\x22\x7d\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x64\x64\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x3d\x30\x3b\x69\x66\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x2c\x6e\x62\x28\x74\x68\x69\x73\x29\x29\x29\x7d\x29\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x61\x29\x7b\x62\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x74\x68\x69\x73\x5b\x69\x2b\x2b\x5d\x29\x69\x66\x28\x65\x3d\x6e\x62\x28\x63\x29\x2c\x64\x3d\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x22\x20\x22\x2b\x6d\x62\x28\x65\x29\x2b\x22\x20\x22\x29\x7b\x67\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x62\x5b\x67\x2b\x2b\x5d\x29\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x20\x22\x2b\x66\x2b\x22\x20\x22\x29\x3c\x30\x26\x26\x28\x64\x2b\x3d\x66\x2b\x22\x20\x22\x29\x3b\x68\x3d\x6d\x62\x28\x64\x29\x2c\x65\x21\x3d\x3d\x68\x26\x26\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x68\x29\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x3d\x30\x3b\x69\x66\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x2c\x6e\x62\x28\x74\x68\x69\x73\x29\x29\x29\x7d\x29\x3b\x69\x66\x28\x21\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x61\x74\x74\x72\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x22\x22\x29\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x26\x26\x61\x29\x7b\x62\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x74\x68\x69\x73\x5b\x69\x2b\x2b\x5d\x29\x69\x66\x28\x65\x3d\x6e\x62\x28\x63\x29\x2c\x64\x3d\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x22\x20\x22\x2b\x6d\x62\x28\x65\x29\x2b\x22\x20\x22\x29\x7b\x67\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x66\x3d\x62\x5b\x67\x2b\x2b\x5d\x29\x77\x68\x69\x6c\x65\x28\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x20\x22\x2b\x66\x2b\x22\x20\x22\x29\x3e\x2d\x31\x29\x64\x3d\x64\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x22\x20\x22\x2b\x66\x2b\x22\x20\x22\x2c\x22\x20\x22\x29\x3b\x68\x3d\x6d\x62\x28\x64\x29\x2c\x65\x21\x3d\x3d\x68\x26\x26\x63\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x68\x29\x7d\x7d\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x74\x6f\x67\x67\x6c\x65\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x3b\x72\x65\x74\x75\x72\x6e\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x63\x3f\x62\x3f\x74\x68\x69\x73\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x61\x29\x3a\x74\x68\x69\x73\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x61\x29\x3a\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x74\x6f\x67\x67\x6c\x65\x43\x6c\x61\x73\x73\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x63\x2c\x6e\x62\x28\x74\x68\x69\x73\x29\x2c\x62\x29\x2c\x62\x29\x7d\x29\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x2c\x64\x2c\x65\x2c\x66\x3b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x3d\x63\x29\x7b\x64\x3d\x30\x2c\x65\x3d\x72\x28\x74\x68\x69\x73\x29\x2c\x66\x3d\x61\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x77\x68\x69\x6c\x65\x28\x62\x3d\x66\x5b\x64\x2b\x2b\x5d\x29\x65\x2e\x68\x61\x73\x43\x6c\x61\x73\x73\x28\x62\x29\x3f\x65\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x62\x29\x3a\x65\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x62\x29\x7d\x65\x6c\x73\x65\x20\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x61\x26\x26\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x21\x3d\x3d\x63\x7c\x7c\x28\x62\x3d\x6e\x62\x28\x74\x68\x69\x73\x29\x2c\x62\x26\x26\x56\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x5f\x5f\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x5f\x5f\x22\x2c\x62\x29\x2c\x74\x68\x69\x73\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x26\x26\x74\x68\x69\x73\x2e\x73\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x63\x6c\x61\x73\x73\x22\x2c\x62\x7c\x7c\x61\x3d\x3d\x3d\x21\x31\x3f\x22\x22\x3a\x56\x2e\x67\x65\x74\x28\x74\x68\x69\x73\x2c\x22\x5f\x5f\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x5f\x5f\x22\x29\x7c\x7c\x22\x22\x29\x29\x7d\x29\x7d\x2c\x68\x61\x73\x43\x6c\x61\x73\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x3d\x30\x3b\x62\x3d\x22\x20\x22\x2b\x61\x2b\x22\x20\x22\x3b\x77\x68\x69\x6c\x65\x28\x63\x3d\x74\x68\x69\x73\x5b\x64\x2b\x2b\x5d\x29\x69\x66\x28\x31\x3d\x3d\x3d\x63\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x22\x20\x22\x2b\x6d\x62\x28\x6e\x62\x28\x63\x29\x29\x2b\x22\x20\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x62\x29\x3e\x2d\x31\x29\x72\x65\x74\x75\x72\x6e\x21\x30\x3b\x72\x65\x74\x75\x72\x6e\x21\x31\x7d\x7d\x29\x3b\x76\x61\x72\x20\x6f\x62\x3d\x2f\x5c\x72\x2f\x67\x3b\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x76\x61\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x3d\x74\x68\x69\x73\x5b\x30\x5d\x3b\x7b\x69\x66\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x64\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x2c\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x76\x61\x72\x20\x65\x3b\x31\x3d\x3d\x3d\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x28\x65\x3d\x64\x3f\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x63\x2c\x72\x28\x74\x68\x69\x73\x29\x2e\x76\x61\x6c\x28\x29\x29\x3a\x61\x2c\x6e\x75\x6c\x6c\x3d\x3d\x65\x3f\x65\x3d\x22\x22\x3a\x22\x6e\x75\x6d\x62\x65\x72\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x3f\x65\x2b\x3d\x22\x22\x3a\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x65\x29\x26\x26\x28\x65\x3d\x72\x2e\x6d\x61\x70\x28\x65\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x61\x3f\x22\x22\x3a\x61\x2b\x22\x22\x7d\x29\x29\x2c\x62\x3d\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x2c\x62\x26\x26\x22\x73\x65\x74\x22\x69\x6e\x20\x62\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x2e\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x65\x2c\x22\x76\x61\x6c\x75\x65\x22\x29\x7c\x7c\x28\x74\x68\x69\x73\x2e\x76\x61\x6c\x75\x65\x3d\x65\x29\x29\x7d\x29\x3b\x69\x66\x28\x65\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3d\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x65\x2e\x74\x79\x70\x65\x5d\x7c\x7c\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x65\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x2c\x62\x26\x26\x22\x67\x65\x74\x22\x69\x6e\x20\x62\x26\x26\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x28\x63\x3d\x62\x2e\x67\x65\x74\x28\x65\x2c\x22\x76\x61\x6c\x75\x65\x22\x29\x29\x3f\x63\x3a\x28\x63\x3d\x65\x2e\x76\x61\x6c\x75\x65\x2c\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x63\x3f\x63\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x6f\x62\x2c\x22\x22\x29\x3a\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x22\x22\x3a\x63\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x3a\x7b\x6f\x70\x74\x69\x6f\x6e\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x66\x69\x6e\x64\x2e\x61\x74\x74\x72\x28\x61\x2c\x22\x76\x61\x6c\x75\x65\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x21\x3d\x62\x3f\x62\x3a\x6d\x62\x28\x72\x2e\x74\x65\x78\x74\x28\x61\x29\x29\x7d\x7d\x2c\x73\x65\x6c\x65\x63\x74\x3a\x7b\x67\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x3d\x61\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2c\x66\x3d\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x2c\x67\x3d\x22\x73\x65\x6c\x65\x63\x74\x2d\x6f\x6e\x65\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x2c\x68\x3d\x67\x3f\x6e\x75\x6c\x6c\x3a\x5b\x5d\x2c\x69\x3d\x67\x3f\x66\x2b\x31\x3a\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x66\x6f\x72\x28\x64\x3d\x66\x3c\x30\x3f\x69\x3a\x67\x3f\x66\x3a\x30\x3b\x64\x3c\x69\x3b\x64\x2b\x2b\x29\x69\x66\x28\x63\x3d\x65\x5b\x64\x5d\x2c\x28\x63\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x7c\x7c\x64\x3d\x3d\x3d\x66\x29\x26\x26\x21\x63\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x26\x26\x28\x21\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2e\x64\x69\x73\x61\x62\x6c\x65\x64\x7c\x7c\x21\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x63\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x2c\x22\x6f\x70\x74\x67\x72\x6f\x75\x70\x22\x29\x29\x29\x7b\x69\x66\x28\x62\x3d\x72\x28\x63\x29\x2e\x76\x61\x6c\x28\x29\x2c\x67\x29\x72\x65\x74\x75\x72\x6e\x20\x62\x3b\x68\x2e\x70\x75\x73\x68\x28\x62\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x68\x7d\x2c\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3d\x61\x2e\x6f\x70\x74\x69\x6f\x6e\x73\x2c\x66\x3d\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x62\x29\x2c\x67\x3d\x65\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x77\x68\x69\x6c\x65\x28\x67\x2d\x2d\x29\x64\x3d\x65\x5b\x67\x5d\x2c\x28\x64\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x3d\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x2e\x6f\x70\x74\x69\x6f\x6e\x2e\x67\x65\x74\x28\x64\x29\x2c\x66\x29\x3e\x2d\x31\x29\x26\x26\x28\x63\x3d\x21\x30\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x63\x7c\x7c\x28\x61\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x49\x6e\x64\x65\x78\x3d\x2d\x31\x29\x2c\x66\x7d\x7d\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x72\x61\x64\x69\x6f\x22\x2c\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x5d\x3d\x7b\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x63\x68\x65\x63\x6b\x65\x64\x3d\x72\x2e\x69\x6e\x41\x72\x72\x61\x79\x28\x72\x28\x61\x29\x2e\x76\x61\x6c\x28\x29\x2c\x62\x29\x3e\x2d\x31\x7d\x7d\x2c\x6f\x2e\x63\x68\x65\x63\x6b\x4f\x6e\x7c\x7c\x28\x72\x2e\x76\x61\x6c\x48\x6f\x6f\x6b\x73\x5b\x74\x68\x69\x73\x5d\x2e\x67\x65\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x3d\x61\x2e\x67\x65\x74\x41\x74\x74\x72\x69\x62\x75\x74\x65\x28\x22\x76\x61\x6c\x75\x65\x22\x29\x3f\x22\x6f\x6e\x22\x3a\x61\x2e\x76\x61\x6c\x75\x65\x7d\x29\x7d\x29\x3b\x76\x61\x72\x20\x70\x62\x3d\x2f\x5e\x28\x3f\x3a\x66\x6f\x63\x75\x73\x69\x6e\x66\x6f\x63\x75\x73\x7c\x66\x6f\x63\x75\x73\x6f\x75\x74\x62\x6c\x75\x72\x29\x24\x2f\x3b\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x72\x2e\x65\x76\x65\x6e\x74\x2c\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6d\x2c\x6e\x2c\x6f\x3d\x5b\x65\x7c\x7c\x64\x5d\x2c\x70\x3d\x6c\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x22\x74\x79\x70\x65\x22\x29\x3f\x62\x2e\x74\x79\x70\x65\x3a\x62\x2c\x71\x3d\x6c\x2e\x63\x61\x6c\x6c\x28\x62\x2c\x22\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x22\x29\x3f\x62\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x3a\x5b\x5d\x3b\x69\x66\x28\x68\x3d\x69\x3d\x65\x3d\x65\x7c\x7c\x64\x2c\x33\x21\x3d\x3d\x65\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x38\x21\x3d\x3d\x65\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x21\x70\x62\x2e\x74\x65\x73\x74\x28\x70\x2b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x29\x26\x26\x28\x70\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x2e\x22\x29\x3e\x2d\x31\x26\x26\x28\x71\x3d\x70\x2e\x73\x70\x6c\x69\x74\x28\x22\x2e\x22\x29\x2c\x70\x3d\x71\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x71\x2e\x73\x6f\x72\x74\x28\x29\x29\x2c\x6b\x3d\x70\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x3a\x22\x29\x3c\x30\x26\x26\x22\x6f\x6e\x22\x2b\x70\x2c\x62\x3d\x62\x5b\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x5d\x3f\x62\x3a\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x28\x70\x2c\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x62\x29\x2c\x62\x2e\x69\x73\x54\x72\x69\x67\x67\x65\x72\x3d\x66\x3f\x32\x3a\x33\x2c\x62\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3d\x71\x2e\x6a\x6f\x69\x6e\x28\x22\x2e\x22\x29\x2c\x62\x2e\x72\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3d\x62\x2e\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3f\x6e\x65\x77\x20\x52\x65\x67\x45\x78\x70\x28\x22\x28\x5e\x7c\x5c\x5c\x2e\x29\x22\x2b\x71\x2e\x6a\x6f\x69\x6e\x28\x22\x5c\x5c\x2e\x28\x3f\x3a\x2e\x2a\x5c\x5c\x2e\x7c\x29\x22\x29\x2b\x22\x28\x5c\x5c\x2e\x7c\x24\x29\x22\x29\x3a\x6e\x75\x6c\x6c\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x76\x6f\x69\x64\x20\x30\x2c\x62\x2e\x74\x61\x72\x67\x65\x74\x7c\x7c\x28\x62\x2e\x74\x61\x72\x67\x65\x74\x3d\x65\x29\x2c\x63\x3d\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x5b\x62\x5d\x3a\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x63\x2c\x5b\x62\x5d\x29\x2c\x6e\x3d\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x70\x5d\x7c\x7c\x7b\x7d\x2c\x66\x7c\x7c\x21\x6e\x2e\x74\x72\x69\x67\x67\x65\x72\x7c\x7c\x6e\x2e\x74\x72\x69\x67\x67\x65\x72\x2e\x61\x70\x70\x6c\x79\x28\x65\x2c\x63\x29\x21\x3d\x3d\x21\x31\x29\x29\x7b\x69\x66\x28\x21\x66\x26\x26\x21\x6e\x2e\x6e\x6f\x42\x75\x62\x62\x6c\x65\x26\x26\x21\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x65\x29\x29\x7b\x66\x6f\x72\x28\x6a\x3d\x6e\x2e\x64\x65\x6c\x65\x67\x61\x74\x65\x54\x79\x70\x65\x7c\x7c\x70\x2c\x70\x62\x2e\x74\x65\x73\x74\x28\x6a\x2b\x70\x29\x7c\x7c\x28\x68\x3d\x68\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x3b\x68\x3b\x68\x3d\x68\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x29\x6f\x2e\x70\x75\x73\x68\x28\x68\x29\x2c\x69\x3d\x68\x3b\x69\x3d\x3d\x3d\x28\x65\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x64\x29\x26\x26\x6f\x2e\x70\x75\x73\x68\x28\x69\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x7c\x7c\x69\x2e\x70\x61\x72\x65\x6e\x74\x57\x69\x6e\x64\x6f\x77\x7c\x7c\x61\x29\x7d\x67\x3d\x30\x3b\x77\x68\x69\x6c\x65\x28\x28\x68\x3d\x6f\x5b\x67\x2b\x2b\x5d\x29\x26\x26\x21\x62\x2e\x69\x73\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x53\x74\x6f\x70\x70\x65\x64\x28\x29\x29\x62\x2e\x74\x79\x70\x65\x3d\x67\x3e\x31\x3f\x6a\x3a\x6e\x2e\x62\x69\x6e\x64\x54\x79\x70\x65\x7c\x7c\x70\x2c\x6d\x3d\x28\x56\x2e\x67\x65\x74\x28\x68\x2c\x22\x65\x76\x65\x6e\x74\x73\x22\x29\x7c\x7c\x7b\x7d\x29\x5b\x62\x2e\x74\x79\x70\x65\x5d\x26\x26\x56\x2e\x67\x65\x74\x28\x68\x2c\x22\x68\x61\x6e\x64\x6c\x65\x22\x29\x2c\x6d\x26\x26\x6d\x2e\x61\x70\x70\x6c\x79\x28\x68\x2c\x63\x29\x2c\x6d\x3d\x6b\x26\x26\x68\x5b\x6b\x5d\x2c\x6d\x26\x26\x6d\x2e\x61\x70\x70\x6c\x79\x26\x26\x54\x28\x68\x29\x26\x26\x28\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x6d\x2e\x61\x70\x70\x6c\x79\x28\x68\x2c\x63\x29\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x3d\x3d\x3d\x21\x31\x26\x26\x62\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x74\x79\x70\x65\x3d\x70\x2c\x66\x7c\x7c\x62\x2e\x69\x73\x44\x65\x66\x61\x75\x6c\x74\x50\x72\x65\x76\x65\x6e\x74\x65\x64\x28\x29\x7c\x7c\x6e\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x26\x26\x6e\x2e\x5f\x64\x65\x66\x61\x75\x6c\x74\x2e\x61\x70\x70\x6c\x79\x28\x6f\x2e\x70\x6f\x70\x28\x29\x2c\x63\x29\x21\x3d\x3d\x21\x31\x7c\x7c\x21\x54\x28\x65\x29\x7c\x7c\x6b\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x5b\x70\x5d\x29\x26\x26\x21\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x65\x29\x26\x26\x28\x69\x3d\x65\x5b\x6b\x5d\x2c\x69\x26\x26\x28\x65\x5b\x6b\x5d\x3d\x6e\x75\x6c\x6c\x29\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x3d\x70\x2c\x65\x5b\x70\x5d\x28\x29\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x65\x64\x3d\x76\x6f\x69\x64\x20\x30\x2c\x69\x26\x26\x28\x65\x5b\x6b\x5d\x3d\x69\x29\x29\x2c\x62\x2e\x72\x65\x73\x75\x6c\x74\x7d\x7d\x2c\x73\x69\x6d\x75\x6c\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x3d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x6e\x65\x77\x20\x72\x2e\x45\x76\x65\x6e\x74\x2c\x63\x2c\x7b\x74\x79\x70\x65\x3a\x61\x2c\x69\x73\x53\x69\x6d\x75\x6c\x61\x74\x65\x64\x3a\x21\x30\x7d\x29\x3b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x64\x2c\x6e\x75\x6c\x6c\x2c\x62\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x74\x72\x69\x67\x67\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x61\x2c\x62\x2c\x74\x68\x69\x73\x29\x7d\x29\x7d\x2c\x74\x72\x69\x67\x67\x65\x72\x48\x61\x6e\x64\x6c\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x74\x68\x69\x73\x5b\x30\x5d\x3b\x69\x66\x28\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x61\x2c\x62\x2c\x63\x2c\x21\x30\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x22\x62\x6c\x75\x72\x20\x66\x6f\x63\x75\x73\x20\x66\x6f\x63\x75\x73\x69\x6e\x20\x66\x6f\x63\x75\x73\x6f\x75\x74\x20\x72\x65\x73\x69\x7a\x65\x20\x73\x63\x72\x6f\x6c\x6c\x20\x63\x6c\x69\x63\x6b\x20\x64\x62\x6c\x63\x6c\x69\x63\x6b\x20\x6d\x6f\x75\x73\x65\x64\x6f\x77\x6e\x20\x6d\x6f\x75\x73\x65\x75\x70\x20\x6d\x6f\x75\x73\x65\x6d\x6f\x76\x65\x20\x6d\x6f\x75\x73\x65\x6f\x76\x65\x72\x20\x6d\x6f\x75\x73\x65\x6f\x75\x74\x20\x6d\x6f\x75\x73\x65\x65\x6e\x74\x65\x72\x20\x6d\x6f\x75\x73\x65\x6c\x65\x61\x76\x65\x20\x63\x68\x61\x6e\x67\x65\x20\x73\x65\x6c\x65\x63\x74\x20\x73\x75\x62\x6d\x69\x74\x20\x6b\x65\x79\x64\x6f\x77\x6e\x20\x6b\x65\x79\x70\x72\x65\x73\x73\x20\x6b\x65\x79\x75\x70\x20\x63\x6f\x6e\x74\x65\x78\x74\x6d\x65\x6e\x75\x22\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x3f\x74\x68\x69\x73\x2e\x6f\x6e\x28\x62\x2c\x6e\x75\x6c\x6c\x2c\x61\x2c\x63\x29\x3a\x74\x68\x69\x73\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x62\x29\x7d\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x68\x6f\x76\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6d\x6f\x75\x73\x65\x65\x6e\x74\x65\x72\x28\x61\x29\x2e\x6d\x6f\x75\x73\x65\x6c\x65\x61\x76\x65\x28\x62\x7c\x7c\x61\x29\x7d\x7d\x29\x2c\x6f\x2e\x66\x6f\x63\x75\x73\x69\x6e\x3d\x22\x6f\x6e\x66\x6f\x63\x75\x73\x69\x6e\x22\x69\x6e\x20\x61\x2c\x6f\x2e\x66\x6f\x63\x75\x73\x69\x6e\x7c\x7c\x72\x2e\x65\x61\x63\x68\x28\x7b\x66\x6f\x63\x75\x73\x3a\x22\x66\x6f\x63\x75\x73\x69\x6e\x22\x2c\x62\x6c\x75\x72\x3a\x22\x66\x6f\x63\x75\x73\x6f\x75\x74\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x69\x6d\x75\x6c\x61\x74\x65\x28\x62\x2c\x61\x2e\x74\x61\x72\x67\x65\x74\x2c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x66\x69\x78\x28\x61\x29\x29\x7d\x3b\x72\x2e\x65\x76\x65\x6e\x74\x2e\x73\x70\x65\x63\x69\x61\x6c\x5b\x62\x5d\x3d\x7b\x73\x65\x74\x75\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x64\x3d\x74\x68\x69\x73\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x74\x68\x69\x73\x2c\x65\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x29\x3b\x65\x7c\x7c\x64\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x61\x2c\x63\x2c\x21\x30\x29\x2c\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x2c\x28\x65\x7c\x7c\x30\x29\x2b\x31\x29\x7d\x2c\x74\x65\x61\x72\x64\x6f\x77\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x64\x3d\x74\x68\x69\x73\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x7c\x7c\x74\x68\x69\x73\x2c\x65\x3d\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x29\x2d\x31\x3b\x65\x3f\x56\x2e\x61\x63\x63\x65\x73\x73\x28\x64\x2c\x62\x2c\x65\x29\x3a\x28\x64\x2e\x72\x65\x6d\x6f\x76\x65\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x61\x2c\x63\x2c\x21\x30\x29\x2c\x56\x2e\x72\x65\x6d\x6f\x76\x65\x28\x64\x2c\x62\x29\x29\x7d\x7d\x7d\x29\x3b\x76\x61\x72\x20\x71\x62\x3d\x61\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2c\x72\x62\x3d\x72\x2e\x6e\x6f\x77\x28\x29\x2c\x73\x62\x3d\x2f\x5c\x3f\x2f\x3b\x72\x2e\x70\x61\x72\x73\x65\x58\x4d\x4c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x3b\x69\x66\x28\x21\x62\x7c\x7c\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x29\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3b\x74\x72\x79\x7b\x63\x3d\x28\x6e\x65\x77\x20\x61\x2e\x44\x4f\x4d\x50\x61\x72\x73\x65\x72\x29\x2e\x70\x61\x72\x73\x65\x46\x72\x6f\x6d\x53\x74\x72\x69\x6e\x67\x28\x62\x2c\x22\x74\x65\x78\x74\x2f\x78\x6d\x6c\x22\x29\x7d\x63\x61\x74\x63\x68\x28\x64\x29\x7b\x63\x3d\x76\x6f\x69\x64\x20\x30\x7d\x72\x65\x74\x75\x72\x6e\x20\x63\x26\x26\x21\x63\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x73\x42\x79\x54\x61\x67\x4e\x61\x6d\x65\x28\x22\x70\x61\x72\x73\x65\x72\x65\x72\x72\x6f\x72\x22\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7c\x7c\x72\x2e\x65\x72\x72\x6f\x72\x28\x22\x49\x6e\x76\x61\x6c\x69\x64\x20\x58\x4d\x4c\x3a\x20\x22\x2b\x62\x29\x2c\x63\x7d\x3b\x76\x61\x72\x20\x74\x62\x3d\x2f\x5c\x5b\x5c\x5d\x24\x2f\x2c\x75\x62\x3d\x2f\x5c\x72\x3f\x5c\x6e\x2f\x67\x2c\x76\x62\x3d\x2f\x5e\x28\x3f\x3a\x73\x75\x62\x6d\x69\x74\x7c\x62\x75\x74\x74\x6f\x6e\x7c\x69\x6d\x61\x67\x65\x7c\x72\x65\x73\x65\x74\x7c\x66\x69\x6c\x65\x29\x24\x2f\x69\x2c\x77\x62\x3d\x2f\x5e\x28\x3f\x3a\x69\x6e\x70\x75\x74\x7c\x73\x65\x6c\x65\x63\x74\x7c\x74\x65\x78\x74\x61\x72\x65\x61\x7c\x6b\x65\x79\x67\x65\x6e\x29\x2f\x69\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x78\x62\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x62\x29\x29\x72\x2e\x65\x61\x63\x68\x28\x62\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x65\x29\x7b\x63\x7c\x7c\x74\x62\x2e\x74\x65\x73\x74\x28\x61\x29\x3f\x64\x28\x61\x2c\x65\x29\x3a\x78\x62\x28\x61\x2b\x22\x5b\x22\x2b\x28\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x26\x26\x6e\x75\x6c\x6c\x21\x3d\x65\x3f\x62\x3a\x22\x22\x29\x2b\x22\x5d\x22\x2c\x65\x2c\x63\x2c\x64\x29\x7d\x29\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x63\x7c\x7c\x22\x6f\x62\x6a\x65\x63\x74\x22\x21\x3d\x3d\x72\x2e\x74\x79\x70\x65\x28\x62\x29\x29\x64\x28\x61\x2c\x62\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x62\x29\x78\x62\x28\x61\x2b\x22\x5b\x22\x2b\x65\x2b\x22\x5d\x22\x2c\x62\x5b\x65\x5d\x2c\x63\x2c\x64\x29\x7d\x72\x2e\x70\x61\x72\x61\x6d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3d\x5b\x5d\x2c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x62\x28\x29\x3a\x62\x3b\x64\x5b\x64\x2e\x6c\x65\x6e\x67\x74\x68\x5d\x3d\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x43\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x28\x61\x29\x2b\x22\x3d\x22\x2b\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x43\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x28\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x22\x22\x3a\x63\x29\x7d\x3b\x69\x66\x28\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x61\x29\x7c\x7c\x61\x2e\x6a\x71\x75\x65\x72\x79\x26\x26\x21\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x29\x72\x2e\x65\x61\x63\x68\x28\x61\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x65\x28\x74\x68\x69\x73\x2e\x6e\x61\x6d\x65\x2c\x74\x68\x69\x73\x2e\x76\x61\x6c\x75\x65\x29\x7d\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x61\x29\x78\x62\x28\x63\x2c\x61\x5b\x63\x5d\x2c\x62\x2c\x65\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x2e\x6a\x6f\x69\x6e\x28\x22\x26\x22\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x73\x65\x72\x69\x61\x6c\x69\x7a\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x70\x61\x72\x61\x6d\x28\x74\x68\x69\x73\x2e\x73\x65\x72\x69\x61\x6c\x69\x7a\x65\x41\x72\x72\x61\x79\x28\x29\x29\x7d\x2c\x73\x65\x72\x69\x61\x6c\x69\x7a\x65\x41\x72\x72\x61\x79\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x72\x2e\x70\x72\x6f\x70\x28\x74\x68\x69\x73\x2c\x22\x65\x6c\x65\x6d\x65\x6e\x74\x73\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x3f\x72\x2e\x6d\x61\x6b\x65\x41\x72\x72\x61\x79\x28\x61\x29\x3a\x74\x68\x69\x73\x7d\x29\x2e\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x74\x79\x70\x65\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6e\x61\x6d\x65\x26\x26\x21\x72\x28\x74\x68\x69\x73\x29\x2e\x69\x73\x28\x22\x3a\x64\x69\x73\x61\x62\x6c\x65\x64\x22\x29\x26\x26\x77\x62\x2e\x74\x65\x73\x74\x28\x74\x68\x69\x73\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x29\x26\x26\x21\x76\x62\x2e\x74\x65\x73\x74\x28\x61\x29\x26\x26\x28\x74\x68\x69\x73\x2e\x63\x68\x65\x63\x6b\x65\x64\x7c\x7c\x21\x69\x61\x2e\x74\x65\x73\x74\x28\x61\x29\x29\x7d\x29\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x72\x28\x74\x68\x69\x73\x29\x2e\x76\x61\x6c\x28\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x63\x3f\x6e\x75\x6c\x6c\x3a\x72\x2e\x69\x73\x41\x72\x72\x61\x79\x28\x63\x29\x3f\x72\x2e\x6d\x61\x70\x28\x63\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x7b\x6e\x61\x6d\x65\x3a\x62\x2e\x6e\x61\x6d\x65\x2c\x76\x61\x6c\x75\x65\x3a\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x75\x62\x2c\x22\x5c\x72\x5c\x6e\x22\x29\x7d\x7d\x29\x3a\x7b\x6e\x61\x6d\x65\x3a\x62\x2e\x6e\x61\x6d\x65\x2c\x76\x61\x6c\x75\x65\x3a\x63\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x75\x62\x2c\x22\x5c\x72\x5c\x6e\x22\x29\x7d\x7d\x29\x2e\x67\x65\x74\x28\x29\x7d\x7d\x29\x3b\x76\x61\x72\x20\x79\x62\x3d\x2f\x25\x32\x30\x2f\x67\x2c\x7a\x62\x3d\x2f\x23\x2e\x2a\x24\x2f\x2c\x41\x62\x3d\x2f\x28\x5b\x3f\x26\x5d\x29\x5f\x3d\x5b\x5e\x26\x5d\x2a\x2f\x2c\x42\x62\x3d\x2f\x5e\x28\x2e\x2a\x3f\x29\x3a\x5b\x20\x5c\x74\x5d\x2a\x28\x5b\x5e\x5c\x72\x5c\x6e\x5d\x2a\x29\x24\x2f\x67\x6d\x2c\x43\x62\x3d\x2f\x5e\x28\x3f\x3a\x61\x62\x6f\x75\x74\x7c\x61\x70\x70\x7c\x61\x70\x70\x2d\x73\x74\x6f\x72\x61\x67\x65\x7c\x2e\x2b\x2d\x65\x78\x74\x65\x6e\x73\x69\x6f\x6e\x7c\x66\x69\x6c\x65\x7c\x72\x65\x73\x7c\x77\x69\x64\x67\x65\x74\x29\x3a\x24\x2f\x2c\x44\x62\x3d\x2f\x5e\x28\x3f\x3a\x47\x45\x54\x7c\x48\x45\x41\x44\x29\x24\x2f\x2c\x45\x62\x3d\x2f\x5e\x5c\x2f\x5c\x2f\x2f\x2c\x46\x62\x3d\x7b\x7d\x2c\x47\x62\x3d\x7b\x7d\x2c\x48\x62\x3d\x22\x2a\x2f\x22\x2e\x63\x6f\x6e\x63\x61\x74\x28\x22\x2a\x22\x29\x2c\x49\x62\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x61\x22\x29\x3b\x49\x62\x2e\x68\x72\x65\x66\x3d\x71\x62\x2e\x68\x72\x65\x66\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4a\x62\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x22\x2a\x22\x29\x3b\x76\x61\x72\x20\x64\x2c\x65\x3d\x30\x2c\x66\x3d\x62\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x5d\x3b\x69\x66\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x29\x77\x68\x69\x6c\x65\x28\x64\x3d\x66\x5b\x65\x2b\x2b\x5d\x29\x22\x2b\x22\x3d\x3d\x3d\x64\x5b\x30\x5d\x3f\x28\x64\x3d\x64\x2e\x73\x6c\x69\x63\x65\x28\x31\x29\x7c\x7c\x22\x2a\x22\x2c\x28\x61\x5b\x64\x5d\x3d\x61\x5b\x64\x5d\x7c\x7c\x5b\x5d\x29\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x63\x29\x29\x3a\x28\x61\x5b\x64\x5d\x3d\x61\x5b\x64\x5d\x7c\x7c\x5b\x5d\x29\x2e\x70\x75\x73\x68\x28\x63\x29\x7d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4b\x62\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x3d\x7b\x7d\x2c\x66\x3d\x61\x3d\x3d\x3d\x47\x62\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x67\x28\x68\x29\x7b\x76\x61\x72\x20\x69\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x5b\x68\x5d\x3d\x21\x30\x2c\x72\x2e\x65\x61\x63\x68\x28\x61\x5b\x68\x5d\x7c\x7c\x5b\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x68\x29\x7b\x76\x61\x72\x20\x6a\x3d\x68\x28\x62\x2c\x63\x2c\x64\x29\x3b\x72\x65\x74\x75\x72\x6e\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x6a\x7c\x7c\x66\x7c\x7c\x65\x5b\x6a\x5d\x3f\x66\x3f\x21\x28\x69\x3d\x6a\x29\x3a\x76\x6f\x69\x64\x20\x30\x3a\x28\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x6a\x29\x2c\x67\x28\x6a\x29\x2c\x21\x31\x29\x7d\x29\x2c\x69\x7d\x72\x65\x74\x75\x72\x6e\x20\x67\x28\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x29\x7c\x7c\x21\x65\x5b\x22\x2a\x22\x5d\x26\x26\x67\x28\x22\x2a\x22\x29\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4c\x62\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x2c\x65\x3d\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2e\x66\x6c\x61\x74\x4f\x70\x74\x69\x6f\x6e\x73\x7c\x7c\x7b\x7d\x3b\x66\x6f\x72\x28\x63\x20\x69\x6e\x20\x62\x29\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x62\x5b\x63\x5d\x26\x26\x28\x28\x65\x5b\x63\x5d\x3f\x61\x3a\x64\x7c\x7c\x28\x64\x3d\x7b\x7d\x29\x29\x5b\x63\x5d\x3d\x62\x5b\x63\x5d\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x64\x26\x26\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x21\x30\x2c\x61\x2c\x64\x29\x2c\x61\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4d\x62\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x61\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x2c\x69\x3d\x61\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x3b\x77\x68\x69\x6c\x65\x28\x22\x2a\x22\x3d\x3d\x3d\x69\x5b\x30\x5d\x29\x69\x2e\x73\x68\x69\x66\x74\x28\x29\x2c\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x64\x26\x26\x28\x64\x3d\x61\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x7c\x7c\x62\x2e\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x22\x29\x29\x3b\x69\x66\x28\x64\x29\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x68\x29\x69\x66\x28\x68\x5b\x65\x5d\x26\x26\x68\x5b\x65\x5d\x2e\x74\x65\x73\x74\x28\x64\x29\x29\x7b\x69\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x65\x29\x3b\x62\x72\x65\x61\x6b\x7d\x69\x66\x28\x69\x5b\x30\x5d\x69\x6e\x20\x63\x29\x66\x3d\x69\x5b\x30\x5d\x3b\x65\x6c\x73\x65\x7b\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x63\x29\x7b\x69\x66\x28\x21\x69\x5b\x30\x5d\x7c\x7c\x61\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x5b\x65\x2b\x22\x20\x22\x2b\x69\x5b\x30\x5d\x5d\x29\x7b\x66\x3d\x65\x3b\x62\x72\x65\x61\x6b\x7d\x67\x7c\x7c\x28\x67\x3d\x65\x29\x7d\x66\x3d\x66\x7c\x7c\x67\x7d\x69\x66\x28\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x66\x21\x3d\x3d\x69\x5b\x30\x5d\x26\x26\x69\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x66\x29\x2c\x63\x5b\x66\x5d\x7d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x4e\x62\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x3d\x7b\x7d\x2c\x6b\x3d\x61\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x2e\x73\x6c\x69\x63\x65\x28\x29\x3b\x69\x66\x28\x6b\x5b\x31\x5d\x29\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x61\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x29\x6a\x5b\x67\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x61\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x5b\x67\x5d\x3b\x66\x3d\x6b\x2e\x73\x68\x69\x66\x74\x28\x29\x3b\x77\x68\x69\x6c\x65\x28\x66\x29\x69\x66\x28\x61\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x46\x69\x65\x6c\x64\x73\x5b\x66\x5d\x26\x26\x28\x63\x5b\x61\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x46\x69\x65\x6c\x64\x73\x5b\x66\x5d\x5d\x3d\x62\x29\x2c\x21\x69\x26\x26\x64\x26\x26\x61\x2e\x64\x61\x74\x61\x46\x69\x6c\x74\x65\x72\x26\x26\x28\x62\x3d\x61\x2e\x64\x61\x74\x61\x46\x69\x6c\x74\x65\x72\x28\x62\x2c\x61\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x29\x29\x2c\x69\x3d\x66\x2c\x66\x3d\x6b\x2e\x73\x68\x69\x66\x74\x28\x29\x29\x69\x66\x28\x22\x2a\x22\x3d\x3d\x3d\x66\x29\x66\x3d\x69\x3b\x65\x6c\x73\x65\x20\x69\x66\x28\x22\x2a\x22\x21\x3d\x3d\x69\x26\x26\x69\x21\x3d\x3d\x66\x29\x7b\x69\x66\x28\x67\x3d\x6a\x5b\x69\x2b\x22\x20\x22\x2b\x66\x5d\x7c\x7c\x6a\x5b\x22\x2a\x20\x22\x2b\x66\x5d\x2c\x21\x67\x29\x66\x6f\x72\x28\x65\x20\x69\x6e\x20\x6a\x29\x69\x66\x28\x68\x3d\x65\x2e\x73\x70\x6c\x69\x74\x28\x22\x20\x22\x29\x2c\x68\x5b\x31\x5d\x3d\x3d\x3d\x66\x26\x26\x28\x67\x3d\x6a\x5b\x69\x2b\x22\x20\x22\x2b\x68\x5b\x30\x5d\x5d\x7c\x7c\x6a\x5b\x22\x2a\x20\x22\x2b\x68\x5b\x30\x5d\x5d\x29\x29\x7b\x67\x3d\x3d\x3d\x21\x30\x3f\x67\x3d\x6a\x5b\x65\x5d\x3a\x6a\x5b\x65\x5d\x21\x3d\x3d\x21\x30\x26\x26\x28\x66\x3d\x68\x5b\x30\x5d\x2c\x6b\x2e\x75\x6e\x73\x68\x69\x66\x74\x28\x68\x5b\x31\x5d\x29\x29\x3b\x62\x72\x65\x61\x6b\x7d\x69\x66\x28\x67\x21\x3d\x3d\x21\x30\x29\x69\x66\x28\x67\x26\x26\x61\x5b\x22\x74\x68\x72\x6f\x77\x73\x22\x5d\x29\x62\x3d\x67\x28\x62\x29\x3b\x65\x6c\x73\x65\x20\x74\x72\x79\x7b\x62\x3d\x67\x28\x62\x29\x7d\x63\x61\x74\x63\x68\x28\x6c\x29\x7b\x72\x65\x74\x75\x72\x6e\x7b\x73\x74\x61\x74\x65\x3a\x22\x70\x61\x72\x73\x65\x72\x65\x72\x72\x6f\x72\x22\x2c\x65\x72\x72\x6f\x72\x3a\x67\x3f\x6c\x3a\x22\x4e\x6f\x20\x63\x6f\x6e\x76\x65\x72\x73\x69\x6f\x6e\x20\x66\x72\x6f\x6d\x20\x22\x2b\x69\x2b\x22\x20\x74\x6f\x20\x22\x2b\x66\x7d\x7d\x7d\x72\x65\x74\x75\x72\x6e\x7b\x73\x74\x61\x74\x65\x3a\x22\x73\x75\x63\x63\x65\x73\x73\x22\x2c\x64\x61\x74\x61\x3a\x62\x7d\x7d\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x61\x63\x74\x69\x76\x65\x3a\x30\x2c\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x3a\x7b\x7d\x2c\x65\x74\x61\x67\x3a\x7b\x7d\x2c\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x3a\x7b\x75\x72\x6c\x3a\x71\x62\x2e\x68\x72\x65\x66\x2c\x74\x79\x70\x65\x3a\x22\x47\x45\x54\x22\x2c\x69\x73\x4c\x6f\x63\x61\x6c\x3a\x43\x62\x2e\x74\x65\x73\x74\x28\x71\x62\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x29\x2c\x67\x6c\x6f\x62\x61\x6c\x3a\x21\x30\x2c\x70\x72\x6f\x63\x65\x73\x73\x44\x61\x74\x61\x3a\x21\x30\x2c\x61\x73\x79\x6e\x63\x3a\x21\x30\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x3a\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x77\x77\x77\x2d\x66\x6f\x72\x6d\x2d\x75\x72\x6c\x65\x6e\x63\x6f\x64\x65\x64\x3b\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x55\x54\x46\x2d\x38\x22\x2c\x61\x63\x63\x65\x70\x74\x73\x3a\x7b\x22\x2a\x22\x3a\x48\x62\x2c\x74\x65\x78\x74\x3a\x22\x74\x65\x78\x74\x2f\x70\x6c\x61\x69\x6e\x22\x2c\x68\x74\x6d\x6c\x3a\x22\x74\x65\x78\x74\x2f\x68\x74\x6d\x6c\x22\x2c\x78\x6d\x6c\x3a\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x6d\x6c\x2c\x20\x74\x65\x78\x74\x2f\x78\x6d\x6c\x22\x2c\x6a\x73\x6f\x6e\x3a\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x2c\x20\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x22\x7d\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x7b\x78\x6d\x6c\x3a\x2f\x5c\x62\x78\x6d\x6c\x5c\x62\x2f\x2c\x68\x74\x6d\x6c\x3a\x2f\x5c\x62\x68\x74\x6d\x6c\x2f\x2c\x6a\x73\x6f\x6e\x3a\x2f\x5c\x62\x6a\x73\x6f\x6e\x5c\x62\x2f\x7d\x2c\x72\x65\x73\x70\x6f\x6e\x73\x65\x46\x69\x65\x6c\x64\x73\x3a\x7b\x78\x6d\x6c\x3a\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x58\x4d\x4c\x22\x2c\x74\x65\x78\x74\x3a\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x22\x2c\x6a\x73\x6f\x6e\x3a\x22\x72\x65\x73\x70\x6f\x6e\x73\x65\x4a\x53\x4f\x4e\x22\x7d\x2c\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x3a\x7b\x22\x2a\x20\x74\x65\x78\x74\x22\x3a\x53\x74\x72\x69\x6e\x67\x2c\x22\x74\x65\x78\x74\x20\x68\x74\x6d\x6c\x22\x3a\x21\x30\x2c\x22\x74\x65\x78\x74\x20\x6a\x73\x6f\x6e\x22\x3a\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x2c\x22\x74\x65\x78\x74\x20\x78\x6d\x6c\x22\x3a\x72\x2e\x70\x61\x72\x73\x65\x58\x4d\x4c\x7d\x2c\x66\x6c\x61\x74\x4f\x70\x74\x69\x6f\x6e\x73\x3a\x7b\x75\x72\x6c\x3a\x21\x30\x2c\x63\x6f\x6e\x74\x65\x78\x74\x3a\x21\x30\x7d\x7d\x2c\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x62\x3f\x4c\x62\x28\x4c\x62\x28\x61\x2c\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x29\x2c\x62\x29\x3a\x4c\x62\x28\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2c\x61\x29\x7d\x2c\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x3a\x4a\x62\x28\x46\x62\x29\x2c\x61\x6a\x61\x78\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x3a\x4a\x62\x28\x47\x62\x29\x2c\x61\x6a\x61\x78\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x29\x7b\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x63\x3d\x63\x7c\x7c\x7b\x7d\x3b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x2c\x6c\x2c\x6d\x2c\x6e\x2c\x6f\x3d\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x28\x7b\x7d\x2c\x63\x29\x2c\x70\x3d\x6f\x2e\x63\x6f\x6e\x74\x65\x78\x74\x7c\x7c\x6f\x2c\x71\x3d\x6f\x2e\x63\x6f\x6e\x74\x65\x78\x74\x26\x26\x28\x70\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x7c\x7c\x70\x2e\x6a\x71\x75\x65\x72\x79\x29\x3f\x72\x28\x70\x29\x3a\x72\x2e\x65\x76\x65\x6e\x74\x2c\x73\x3d\x72\x2e\x44\x65\x66\x65\x72\x72\x65\x64\x28\x29\x2c\x74\x3d\x72\x2e\x43\x61\x6c\x6c\x62\x61\x63\x6b\x73\x28\x22\x6f\x6e\x63\x65\x20\x6d\x65\x6d\x6f\x72\x79\x22\x29\x2c\x75\x3d\x6f\x2e\x73\x74\x61\x74\x75\x73\x43\x6f\x64\x65\x7c\x7c\x7b\x7d\x2c\x76\x3d\x7b\x7d\x2c\x77\x3d\x7b\x7d\x2c\x78\x3d\x22\x63\x61\x6e\x63\x65\x6c\x65\x64\x22\x2c\x79\x3d\x7b\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x3a\x30\x2c\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x69\x66\x28\x6b\x29\x7b\x69\x66\x28\x21\x68\x29\x7b\x68\x3d\x7b\x7d\x3b\x77\x68\x69\x6c\x65\x28\x62\x3d\x42\x62\x2e\x65\x78\x65\x63\x28\x67\x29\x29\x68\x5b\x62\x5b\x31\x5d\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x62\x5b\x32\x5d\x7d\x62\x3d\x68\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7d\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x62\x3f\x6e\x75\x6c\x6c\x3a\x62\x7d\x2c\x67\x65\x74\x41\x6c\x6c\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x73\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6b\x3f\x67\x3a\x6e\x75\x6c\x6c\x7d\x2c\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x6b\x26\x26\x28\x61\x3d\x77\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x3d\x77\x5b\x61\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x5d\x7c\x7c\x61\x2c\x76\x5b\x61\x5d\x3d\x62\x29\x2c\x74\x68\x69\x73\x7d\x2c\x6f\x76\x65\x72\x72\x69\x64\x65\x4d\x69\x6d\x65\x54\x79\x70\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x75\x6c\x6c\x3d\x3d\x6b\x26\x26\x28\x6f\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x3d\x61\x29\x2c\x74\x68\x69\x73\x7d\x2c\x73\x74\x61\x74\x75\x73\x43\x6f\x64\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x69\x66\x28\x61\x29\x69\x66\x28\x6b\x29\x79\x2e\x61\x6c\x77\x61\x79\x73\x28\x61\x5b\x79\x2e\x73\x74\x61\x74\x75\x73\x5d\x29\x3b\x65\x6c\x73\x65\x20\x66\x6f\x72\x28\x62\x20\x69\x6e\x20\x61\x29\x75\x5b\x62\x5d\x3d\x5b\x75\x5b\x62\x5d\x2c\x61\x5b\x62\x5d\x5d\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x7d\x2c\x61\x62\x6f\x72\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x61\x7c\x7c\x78\x3b\x72\x65\x74\x75\x72\x6e\x20\x65\x26\x26\x65\x2e\x61\x62\x6f\x72\x74\x28\x62\x29\x2c\x41\x28\x30\x2c\x62\x29\x2c\x74\x68\x69\x73\x7d\x7d\x3b\x69\x66\x28\x73\x2e\x70\x72\x6f\x6d\x69\x73\x65\x28\x79\x29\x2c\x6f\x2e\x75\x72\x6c\x3d\x28\x28\x62\x7c\x7c\x6f\x2e\x75\x72\x6c\x7c\x7c\x71\x62\x2e\x68\x72\x65\x66\x29\x2b\x22\x22\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x45\x62\x2c\x71\x62\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2b\x22\x2f\x2f\x22\x29\x2c\x6f\x2e\x74\x79\x70\x65\x3d\x63\x2e\x6d\x65\x74\x68\x6f\x64\x7c\x7c\x63\x2e\x74\x79\x70\x65\x7c\x7c\x6f\x2e\x6d\x65\x74\x68\x6f\x64\x7c\x7c\x6f\x2e\x74\x79\x70\x65\x2c\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x3d\x28\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x7c\x7c\x22\x2a\x22\x29\x2e\x74\x6f\x4c\x6f\x77\x65\x72\x43\x61\x73\x65\x28\x29\x2e\x6d\x61\x74\x63\x68\x28\x4b\x29\x7c\x7c\x5b\x22\x22\x5d\x2c\x6e\x75\x6c\x6c\x3d\x3d\x6f\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x29\x7b\x6a\x3d\x64\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x61\x22\x29\x3b\x74\x72\x79\x7b\x6a\x2e\x68\x72\x65\x66\x3d\x6f\x2e\x75\x72\x6c\x2c\x6a\x2e\x68\x72\x65\x66\x3d\x6a\x2e\x68\x72\x65\x66\x2c\x6f\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x3d\x49\x62\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2b\x22\x2f\x2f\x22\x2b\x49\x62\x2e\x68\x6f\x73\x74\x21\x3d\x6a\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x2b\x22\x2f\x2f\x22\x2b\x6a\x2e\x68\x6f\x73\x74\x7d\x63\x61\x74\x63\x68\x28\x7a\x29\x7b\x6f\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x3d\x21\x30\x7d\x7d\x69\x66\x28\x6f\x2e\x64\x61\x74\x61\x26\x26\x6f\x2e\x70\x72\x6f\x63\x65\x73\x73\x44\x61\x74\x61\x26\x26\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x6f\x2e\x64\x61\x74\x61\x26\x26\x28\x6f\x2e\x64\x61\x74\x61\x3d\x72\x2e\x70\x61\x72\x61\x6d\x28\x6f\x2e\x64\x61\x74\x61\x2c\x6f\x2e\x74\x72\x61\x64\x69\x74\x69\x6f\x6e\x61\x6c\x29\x29\x2c\x4b\x62\x28\x46\x62\x2c\x6f\x2c\x63\x2c\x79\x29\x2c\x6b\x29\x72\x65\x74\x75\x72\x6e\x20\x79\x3b\x6c\x3d\x72\x2e\x65\x76\x65\x6e\x74\x26\x26\x6f\x2e\x67\x6c\x6f\x62\x61\x6c\x2c\x6c\x26\x26\x30\x3d\x3d\x3d\x72\x2e\x61\x63\x74\x69\x76\x65\x2b\x2b\x26\x26\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x53\x74\x61\x72\x74\x22\x29\x2c\x6f\x2e\x74\x79\x70\x65\x3d\x6f\x2e\x74\x79\x70\x65\x2e\x74\x6f\x55\x70\x70\x65\x72\x43\x61\x73\x65\x28\x29\x2c\x6f\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x3d\x21\x44\x62\x2e\x74\x65\x73\x74\x28\x6f\x2e\x74\x79\x70\x65\x29\x2c\x66\x3d\x6f\x2e\x75\x72\x6c\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x7a\x62\x2c\x22\x22\x29\x2c\x6f\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x3f\x6f\x2e\x64\x61\x74\x61\x26\x26\x6f\x2e\x70\x72\x6f\x63\x65\x73\x73\x44\x61\x74\x61\x26\x26\x30\x3d\x3d\x3d\x28\x6f\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x7c\x7c\x22\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x77\x77\x77\x2d\x66\x6f\x72\x6d\x2d\x75\x72\x6c\x65\x6e\x63\x6f\x64\x65\x64\x22\x29\x26\x26\x28\x6f\x2e\x64\x61\x74\x61\x3d\x6f\x2e\x64\x61\x74\x61\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x79\x62\x2c\x22\x2b\x22\x29\x29\x3a\x28\x6e\x3d\x6f\x2e\x75\x72\x6c\x2e\x73\x6c\x69\x63\x65\x28\x66\x2e\x6c\x65\x6e\x67\x74\x68\x29\x2c\x6f\x2e\x64\x61\x74\x61\x26\x26\x28\x66\x2b\x3d\x28\x73\x62\x2e\x74\x65\x73\x74\x28\x66\x29\x3f\x22\x26\x22\x3a\x22\x3f\x22\x29\x2b\x6f\x2e\x64\x61\x74\x61\x2c\x64\x65\x6c\x65\x74\x65\x20\x6f\x2e\x64\x61\x74\x61\x29\x2c\x6f\x2e\x63\x61\x63\x68\x65\x3d\x3d\x3d\x21\x31\x26\x26\x28\x66\x3d\x66\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x41\x62\x2c\x22\x24\x31\x22\x29\x2c\x6e\x3d\x28\x73\x62\x2e\x74\x65\x73\x74\x28\x66\x29\x3f\x22\x26\x22\x3a\x22\x3f\x22\x29\x2b\x22\x5f\x3d\x22\x2b\x72\x62\x2b\x2b\x20\x2b\x6e\x29\x2c\x6f\x2e\x75\x72\x6c\x3d\x66\x2b\x6e\x29\x2c\x6f\x2e\x69\x66\x4d\x6f\x64\x69\x66\x69\x65\x64\x26\x26\x28\x72\x2e\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x5b\x66\x5d\x26\x26\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x49\x66\x2d\x4d\x6f\x64\x69\x66\x69\x65\x64\x2d\x53\x69\x6e\x63\x65\x22\x2c\x72\x2e\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x5b\x66\x5d\x29\x2c\x72\x2e\x65\x74\x61\x67\x5b\x66\x5d\x26\x26\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x49\x66\x2d\x4e\x6f\x6e\x65\x2d\x4d\x61\x74\x63\x68\x22\x2c\x72\x2e\x65\x74\x61\x67\x5b\x66\x5d\x29\x29\x2c\x28\x6f\x2e\x64\x61\x74\x61\x26\x26\x6f\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x26\x26\x6f\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x21\x3d\x3d\x21\x31\x7c\x7c\x63\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x29\x26\x26\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x22\x2c\x6f\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x29\x2c\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x22\x41\x63\x63\x65\x70\x74\x22\x2c\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x26\x26\x6f\x2e\x61\x63\x63\x65\x70\x74\x73\x5b\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x5d\x3f\x6f\x2e\x61\x63\x63\x65\x70\x74\x73\x5b\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x5d\x2b\x28\x22\x2a\x22\x21\x3d\x3d\x6f\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x3f\x22\x2c\x20\x22\x2b\x48\x62\x2b\x22\x3b\x20\x71\x3d\x30\x2e\x30\x31\x22\x3a\x22\x22\x29\x3a\x6f\x2e\x61\x63\x63\x65\x70\x74\x73\x5b\x22\x2a\x22\x5d\x29\x3b\x66\x6f\x72\x28\x6d\x20\x69\x6e\x20\x6f\x2e\x68\x65\x61\x64\x65\x72\x73\x29\x79\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x6d\x2c\x6f\x2e\x68\x65\x61\x64\x65\x72\x73\x5b\x6d\x5d\x29\x3b\x69\x66\x28\x6f\x2e\x62\x65\x66\x6f\x72\x65\x53\x65\x6e\x64\x26\x26\x28\x6f\x2e\x62\x65\x66\x6f\x72\x65\x53\x65\x6e\x64\x2e\x63\x61\x6c\x6c\x28\x70\x2c\x79\x2c\x6f\x29\x3d\x3d\x3d\x21\x31\x7c\x7c\x6b\x29\x29\x72\x65\x74\x75\x72\x6e\x20\x79\x2e\x61\x62\x6f\x72\x74\x28\x29\x3b\x69\x66\x28\x78\x3d\x22\x61\x62\x6f\x72\x74\x22\x2c\x74\x2e\x61\x64\x64\x28\x6f\x2e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x29\x2c\x79\x2e\x64\x6f\x6e\x65\x28\x6f\x2e\x73\x75\x63\x63\x65\x73\x73\x29\x2c\x79\x2e\x66\x61\x69\x6c\x28\x6f\x2e\x65\x72\x72\x6f\x72\x29\x2c\x65\x3d\x4b\x62\x28\x47\x62\x2c\x6f\x2c\x63\x2c\x79\x29\x29\x7b\x69\x66\x28\x79\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x3d\x31\x2c\x6c\x26\x26\x71\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x53\x65\x6e\x64\x22\x2c\x5b\x79\x2c\x6f\x5d\x29\x2c\x6b\x29\x72\x65\x74\x75\x72\x6e\x20\x79\x3b\x6f\x2e\x61\x73\x79\x6e\x63\x26\x26\x6f\x2e\x74\x69\x6d\x65\x6f\x75\x74\x3e\x30\x26\x26\x28\x69\x3d\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x79\x2e\x61\x62\x6f\x72\x74\x28\x22\x74\x69\x6d\x65\x6f\x75\x74\x22\x29\x7d\x2c\x6f\x2e\x74\x69\x6d\x65\x6f\x75\x74\x29\x29\x3b\x74\x72\x79\x7b\x6b\x3d\x21\x31\x2c\x65\x2e\x73\x65\x6e\x64\x28\x76\x2c\x41\x29\x7d\x63\x61\x74\x63\x68\x28\x7a\x29\x7b\x69\x66\x28\x6b\x29\x74\x68\x72\x6f\x77\x20\x7a\x3b\x41\x28\x2d\x31\x2c\x7a\x29\x7d\x7d\x65\x6c\x73\x65\x20\x41\x28\x2d\x31\x2c\x22\x4e\x6f\x20\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x22\x29\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x41\x28\x62\x2c\x63\x2c\x64\x2c\x68\x29\x7b\x76\x61\x72\x20\x6a\x2c\x6d\x2c\x6e\x2c\x76\x2c\x77\x2c\x78\x3d\x63\x3b\x6b\x7c\x7c\x28\x6b\x3d\x21\x30\x2c\x69\x26\x26\x61\x2e\x63\x6c\x65\x61\x72\x54\x69\x6d\x65\x6f\x75\x74\x28\x69\x29\x2c\x65\x3d\x76\x6f\x69\x64\x20\x30\x2c\x67\x3d\x68\x7c\x7c\x22\x22\x2c\x79\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x3d\x62\x3e\x30\x3f\x34\x3a\x30\x2c\x6a\x3d\x62\x3e\x3d\x32\x30\x30\x26\x26\x62\x3c\x33\x30\x30\x7c\x7c\x33\x30\x34\x3d\x3d\x3d\x62\x2c\x64\x26\x26\x28\x76\x3d\x4d\x62\x28\x6f\x2c\x79\x2c\x64\x29\x29\x2c\x76\x3d\x4e\x62\x28\x6f\x2c\x76\x2c\x79\x2c\x6a\x29\x2c\x6a\x3f\x28\x6f\x2e\x69\x66\x4d\x6f\x64\x69\x66\x69\x65\x64\x26\x26\x28\x77\x3d\x79\x2e\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x28\x22\x4c\x61\x73\x74\x2d\x4d\x6f\x64\x69\x66\x69\x65\x64\x22\x29\x2c\x77\x26\x26\x28\x72\x2e\x6c\x61\x73\x74\x4d\x6f\x64\x69\x66\x69\x65\x64\x5b\x66\x5d\x3d\x77\x29\x2c\x77\x3d\x79\x2e\x67\x65\x74\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x28\x22\x65\x74\x61\x67\x22\x29\x2c\x77\x26\x26\x28\x72\x2e\x65\x74\x61\x67\x5b\x66\x5d\x3d\x77\x29\x29\x2c\x32\x30\x34\x3d\x3d\x3d\x62\x7c\x7c\x22\x48\x45\x41\x44\x22\x3d\x3d\x3d\x6f\x2e\x74\x79\x70\x65\x3f\x78\x3d\x22\x6e\x6f\x63\x6f\x6e\x74\x65\x6e\x74\x22\x3a\x33\x30\x34\x3d\x3d\x3d\x62\x3f\x78\x3d\x22\x6e\x6f\x74\x6d\x6f\x64\x69\x66\x69\x65\x64\x22\x3a\x28\x78\x3d\x76\x2e\x73\x74\x61\x74\x65\x2c\x6d\x3d\x76\x2e\x64\x61\x74\x61\x2c\x6e\x3d\x76\x2e\x65\x72\x72\x6f\x72\x2c\x6a\x3d\x21\x6e\x29\x29\x3a\x28\x6e\x3d\x78\x2c\x21\x62\x26\x26\x78\x7c\x7c\x28\x78\x3d\x22\x65\x72\x72\x6f\x72\x22\x2c\x62\x3c\x30\x26\x26\x28\x62\x3d\x30\x29\x29\x29\x2c\x79\x2e\x73\x74\x61\x74\x75\x73\x3d\x62\x2c\x79\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x3d\x28\x63\x7c\x7c\x78\x29\x2b\x22\x22\x2c\x6a\x3f\x73\x2e\x72\x65\x73\x6f\x6c\x76\x65\x57\x69\x74\x68\x28\x70\x2c\x5b\x6d\x2c\x78\x2c\x79\x5d\x29\x3a\x73\x2e\x72\x65\x6a\x65\x63\x74\x57\x69\x74\x68\x28\x70\x2c\x5b\x79\x2c\x78\x2c\x6e\x5d\x29\x2c\x79\x2e\x73\x74\x61\x74\x75\x73\x43\x6f\x64\x65\x28\x75\x29\x2c\x75\x3d\x76\x6f\x69\x64\x20\x30\x2c\x6c\x26\x26\x71\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x6a\x3f\x22\x61\x6a\x61\x78\x53\x75\x63\x63\x65\x73\x73\x22\x3a\x22\x61\x6a\x61\x78\x45\x72\x72\x6f\x72\x22\x2c\x5b\x79\x2c\x6f\x2c\x6a\x3f\x6d\x3a\x6e\x5d\x29\x2c\x74\x2e\x66\x69\x72\x65\x57\x69\x74\x68\x28\x70\x2c\x5b\x79\x2c\x78\x5d\x29\x2c\x6c\x26\x26\x28\x71\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x43\x6f\x6d\x70\x6c\x65\x74\x65\x22\x2c\x5b\x79\x2c\x6f\x5d\x29\x2c\x2d\x2d\x72\x2e\x61\x63\x74\x69\x76\x65\x7c\x7c\x72\x2e\x65\x76\x65\x6e\x74\x2e\x74\x72\x69\x67\x67\x65\x72\x28\x22\x61\x6a\x61\x78\x53\x74\x6f\x70\x22\x29\x29\x29\x7d\x72\x65\x74\x75\x72\x6e\x20\x79\x7d\x2c\x67\x65\x74\x4a\x53\x4f\x4e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x65\x74\x28\x61\x2c\x62\x2c\x63\x2c\x22\x6a\x73\x6f\x6e\x22\x29\x7d\x2c\x67\x65\x74\x53\x63\x72\x69\x70\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x65\x74\x28\x61\x2c\x76\x6f\x69\x64\x20\x30\x2c\x62\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x67\x65\x74\x22\x2c\x22\x70\x6f\x73\x74\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x2c\x64\x2c\x65\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x26\x26\x28\x65\x3d\x65\x7c\x7c\x64\x2c\x64\x3d\x63\x2c\x63\x3d\x76\x6f\x69\x64\x20\x30\x29\x2c\x72\x2e\x61\x6a\x61\x78\x28\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x75\x72\x6c\x3a\x61\x2c\x74\x79\x70\x65\x3a\x62\x2c\x64\x61\x74\x61\x54\x79\x70\x65\x3a\x65\x2c\x64\x61\x74\x61\x3a\x63\x2c\x73\x75\x63\x63\x65\x73\x73\x3a\x64\x7d\x2c\x72\x2e\x69\x73\x50\x6c\x61\x69\x6e\x4f\x62\x6a\x65\x63\x74\x28\x61\x29\x26\x26\x61\x29\x29\x7d\x7d\x29\x2c\x72\x2e\x5f\x65\x76\x61\x6c\x55\x72\x6c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x61\x6a\x61\x78\x28\x7b\x75\x72\x6c\x3a\x61\x2c\x74\x79\x70\x65\x3a\x22\x47\x45\x54\x22\x2c\x64\x61\x74\x61\x54\x79\x70\x65\x3a\x22\x73\x63\x72\x69\x70\x74\x22\x2c\x63\x61\x63\x68\x65\x3a\x21\x30\x2c\x61\x73\x79\x6e\x63\x3a\x21\x31\x2c\x67\x6c\x6f\x62\x61\x6c\x3a\x21\x31\x2c\x22\x74\x68\x72\x6f\x77\x73\x22\x3a\x21\x30\x7d\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x77\x72\x61\x70\x41\x6c\x6c\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x5b\x30\x5d\x26\x26\x28\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x26\x26\x28\x61\x3d\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x5b\x30\x5d\x29\x29\x2c\x62\x3d\x72\x28\x61\x2c\x74\x68\x69\x73\x5b\x30\x5d\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x29\x2e\x65\x71\x28\x30\x29\x2e\x63\x6c\x6f\x6e\x65\x28\x21\x30\x29\x2c\x74\x68\x69\x73\x5b\x30\x5d\x2e\x70\x61\x72\x65\x6e\x74\x4e\x6f\x64\x65\x26\x26\x62\x2e\x69\x6e\x73\x65\x72\x74\x42\x65\x66\x6f\x72\x65\x28\x74\x68\x69\x73\x5b\x30\x5d\x29\x2c\x62\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x3b\x77\x68\x69\x6c\x65\x28\x61\x2e\x66\x69\x72\x73\x74\x45\x6c\x65\x6d\x65\x6e\x74\x43\x68\x69\x6c\x64\x29\x61\x3d\x61\x2e\x66\x69\x72\x73\x74\x45\x6c\x65\x6d\x65\x6e\x74\x43\x68\x69\x6c\x64\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7d\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x74\x68\x69\x73\x29\x29\x2c\x74\x68\x69\x73\x7d\x2c\x77\x72\x61\x70\x49\x6e\x6e\x65\x72\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3f\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x77\x72\x61\x70\x49\x6e\x6e\x65\x72\x28\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x62\x29\x29\x7d\x29\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x28\x74\x68\x69\x73\x29\x2c\x63\x3d\x62\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x28\x29\x3b\x63\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x63\x2e\x77\x72\x61\x70\x41\x6c\x6c\x28\x61\x29\x3a\x62\x2e\x61\x70\x70\x65\x6e\x64\x28\x61\x29\x7d\x29\x7d\x2c\x77\x72\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x61\x72\x20\x62\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x77\x72\x61\x70\x41\x6c\x6c\x28\x62\x3f\x61\x2e\x63\x61\x6c\x6c\x28\x74\x68\x69\x73\x2c\x63\x29\x3a\x61\x29\x7d\x29\x7d\x2c\x75\x6e\x77\x72\x61\x70\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x70\x61\x72\x65\x6e\x74\x28\x61\x29\x2e\x6e\x6f\x74\x28\x22\x62\x6f\x64\x79\x22\x29\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x28\x74\x68\x69\x73\x29\x2e\x72\x65\x70\x6c\x61\x63\x65\x57\x69\x74\x68\x28\x74\x68\x69\x73\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x7d\x29\x2c\x74\x68\x69\x73\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x68\x69\x64\x64\x65\x6e\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x76\x69\x73\x69\x62\x6c\x65\x28\x61\x29\x7d\x2c\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x76\x69\x73\x69\x62\x6c\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x21\x21\x28\x61\x2e\x6f\x66\x66\x73\x65\x74\x57\x69\x64\x74\x68\x7c\x7c\x61\x2e\x6f\x66\x66\x73\x65\x74\x48\x65\x69\x67\x68\x74\x7c\x7c\x61\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x2c\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2e\x78\x68\x72\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x74\x72\x79\x7b\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x61\x2e\x58\x4d\x4c\x48\x74\x74\x70\x52\x65\x71\x75\x65\x73\x74\x7d\x63\x61\x74\x63\x68\x28\x62\x29\x7b\x7d\x7d\x3b\x76\x61\x72\x20\x4f\x62\x3d\x7b\x30\x3a\x32\x30\x30\x2c\x31\x32\x32\x33\x3a\x32\x30\x34\x7d\x2c\x50\x62\x3d\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x74\x69\x6e\x67\x73\x2e\x78\x68\x72\x28\x29\x3b\x6f\x2e\x63\x6f\x72\x73\x3d\x21\x21\x50\x62\x26\x26\x22\x77\x69\x74\x68\x43\x72\x65\x64\x65\x6e\x74\x69\x61\x6c\x73\x22\x69\x6e\x20\x50\x62\x2c\x6f\x2e\x61\x6a\x61\x78\x3d\x50\x62\x3d\x21\x21\x50\x62\x2c\x72\x2e\x61\x6a\x61\x78\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x76\x61\x72\x20\x63\x2c\x64\x3b\x69\x66\x28\x6f\x2e\x63\x6f\x72\x73\x7c\x7c\x50\x62\x26\x26\x21\x62\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x29\x72\x65\x74\x75\x72\x6e\x7b\x73\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x2c\x68\x3d\x62\x2e\x78\x68\x72\x28\x29\x3b\x69\x66\x28\x68\x2e\x6f\x70\x65\x6e\x28\x62\x2e\x74\x79\x70\x65\x2c\x62\x2e\x75\x72\x6c\x2c\x62\x2e\x61\x73\x79\x6e\x63\x2c\x62\x2e\x75\x73\x65\x72\x6e\x61\x6d\x65\x2c\x62\x2e\x70\x61\x73\x73\x77\x6f\x72\x64\x29\x2c\x62\x2e\x78\x68\x72\x46\x69\x65\x6c\x64\x73\x29\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x62\x2e\x78\x68\x72\x46\x69\x65\x6c\x64\x73\x29\x68\x5b\x67\x5d\x3d\x62\x2e\x78\x68\x72\x46\x69\x65\x6c\x64\x73\x5b\x67\x5d\x3b\x62\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x26\x26\x68\x2e\x6f\x76\x65\x72\x72\x69\x64\x65\x4d\x69\x6d\x65\x54\x79\x70\x65\x26\x26\x68\x2e\x6f\x76\x65\x72\x72\x69\x64\x65\x4d\x69\x6d\x65\x54\x79\x70\x65\x28\x62\x2e\x6d\x69\x6d\x65\x54\x79\x70\x65\x29\x2c\x62\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x7c\x7c\x65\x5b\x22\x58\x2d\x52\x65\x71\x75\x65\x73\x74\x65\x64\x2d\x57\x69\x74\x68\x22\x5d\x7c\x7c\x28\x65\x5b\x22\x58\x2d\x52\x65\x71\x75\x65\x73\x74\x65\x64\x2d\x57\x69\x74\x68\x22\x5d\x3d\x22\x58\x4d\x4c\x48\x74\x74\x70\x52\x65\x71\x75\x65\x73\x74\x22\x29\x3b\x66\x6f\x72\x28\x67\x20\x69\x6e\x20\x65\x29\x68\x2e\x73\x65\x74\x52\x65\x71\x75\x65\x73\x74\x48\x65\x61\x64\x65\x72\x28\x67\x2c\x65\x5b\x67\x5d\x29\x3b\x63\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x28\x63\x3d\x64\x3d\x68\x2e\x6f\x6e\x6c\x6f\x61\x64\x3d\x68\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x3d\x68\x2e\x6f\x6e\x61\x62\x6f\x72\x74\x3d\x68\x2e\x6f\x6e\x72\x65\x61\x64\x79\x73\x74\x61\x74\x65\x63\x68\x61\x6e\x67\x65\x3d\x6e\x75\x6c\x6c\x2c\x22\x61\x62\x6f\x72\x74\x22\x3d\x3d\x3d\x61\x3f\x68\x2e\x61\x62\x6f\x72\x74\x28\x29\x3a\x22\x65\x72\x72\x6f\x72\x22\x3d\x3d\x3d\x61\x3f\x22\x6e\x75\x6d\x62\x65\x72\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x68\x2e\x73\x74\x61\x74\x75\x73\x3f\x66\x28\x30\x2c\x22\x65\x72\x72\x6f\x72\x22\x29\x3a\x66\x28\x68\x2e\x73\x74\x61\x74\x75\x73\x2c\x68\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x29\x3a\x66\x28\x4f\x62\x5b\x68\x2e\x73\x74\x61\x74\x75\x73\x5d\x7c\x7c\x68\x2e\x73\x74\x61\x74\x75\x73\x2c\x68\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x2c\x22\x74\x65\x78\x74\x22\x21\x3d\x3d\x28\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x79\x70\x65\x7c\x7c\x22\x74\x65\x78\x74\x22\x29\x7c\x7c\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x3f\x7b\x62\x69\x6e\x61\x72\x79\x3a\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x7d\x3a\x7b\x74\x65\x78\x74\x3a\x68\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x7d\x2c\x68\x2e\x67\x65\x74\x41\x6c\x6c\x52\x65\x73\x70\x6f\x6e\x73\x65\x48\x65\x61\x64\x65\x72\x73\x28\x29\x29\x29\x7d\x7d\x2c\x68\x2e\x6f\x6e\x6c\x6f\x61\x64\x3d\x63\x28\x29\x2c\x64\x3d\x68\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x3d\x63\x28\x22\x65\x72\x72\x6f\x72\x22\x29\x2c\x76\x6f\x69\x64\x20\x30\x21\x3d\x3d\x68\x2e\x6f\x6e\x61\x62\x6f\x72\x74\x3f\x68\x2e\x6f\x6e\x61\x62\x6f\x72\x74\x3d\x64\x3a\x68\x2e\x6f\x6e\x72\x65\x61\x64\x79\x73\x74\x61\x74\x65\x63\x68\x61\x6e\x67\x65\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x34\x3d\x3d\x3d\x68\x2e\x72\x65\x61\x64\x79\x53\x74\x61\x74\x65\x26\x26\x61\x2e\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x64\x28\x29\x7d\x29\x7d\x2c\x63\x3d\x63\x28\x22\x61\x62\x6f\x72\x74\x22\x29\x3b\x74\x72\x79\x7b\x68\x2e\x73\x65\x6e\x64\x28\x62\x2e\x68\x61\x73\x43\x6f\x6e\x74\x65\x6e\x74\x26\x26\x62\x2e\x64\x61\x74\x61\x7c\x7c\x6e\x75\x6c\x6c\x29\x7d\x63\x61\x74\x63\x68\x28\x69\x29\x7b\x69\x66\x28\x63\x29\x74\x68\x72\x6f\x77\x20\x69\x7d\x7d\x2c\x61\x62\x6f\x72\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x63\x28\x29\x7d\x7d\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x61\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x26\x26\x28\x61\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x73\x2e\x73\x63\x72\x69\x70\x74\x3d\x21\x31\x29\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x28\x7b\x61\x63\x63\x65\x70\x74\x73\x3a\x7b\x73\x63\x72\x69\x70\x74\x3a\x22\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x2c\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x2c\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x65\x63\x6d\x61\x73\x63\x72\x69\x70\x74\x2c\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x65\x63\x6d\x61\x73\x63\x72\x69\x70\x74\x22\x7d\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x73\x3a\x7b\x73\x63\x72\x69\x70\x74\x3a\x2f\x5c\x62\x28\x3f\x3a\x6a\x61\x76\x61\x7c\x65\x63\x6d\x61\x29\x73\x63\x72\x69\x70\x74\x5c\x62\x2f\x7d\x2c\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x3a\x7b\x22\x74\x65\x78\x74\x20\x73\x63\x72\x69\x70\x74\x22\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x6c\x6f\x62\x61\x6c\x45\x76\x61\x6c\x28\x61\x29\x2c\x61\x7d\x7d\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x22\x73\x63\x72\x69\x70\x74\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x2e\x63\x61\x63\x68\x65\x26\x26\x28\x61\x2e\x63\x61\x63\x68\x65\x3d\x21\x31\x29\x2c\x61\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x26\x26\x28\x61\x2e\x74\x79\x70\x65\x3d\x22\x47\x45\x54\x22\x29\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x54\x72\x61\x6e\x73\x70\x6f\x72\x74\x28\x22\x73\x63\x72\x69\x70\x74\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x61\x2e\x63\x72\x6f\x73\x73\x44\x6f\x6d\x61\x69\x6e\x29\x7b\x76\x61\x72\x20\x62\x2c\x63\x3b\x72\x65\x74\x75\x72\x6e\x7b\x73\x65\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2c\x66\x29\x7b\x62\x3d\x72\x28\x22\x3c\x73\x63\x72\x69\x70\x74\x3e\x22\x29\x2e\x70\x72\x6f\x70\x28\x7b\x63\x68\x61\x72\x73\x65\x74\x3a\x61\x2e\x73\x63\x72\x69\x70\x74\x43\x68\x61\x72\x73\x65\x74\x2c\x73\x72\x63\x3a\x61\x2e\x75\x72\x6c\x7d\x29\x2e\x6f\x6e\x28\x22\x6c\x6f\x61\x64\x20\x65\x72\x72\x6f\x72\x22\x2c\x63\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x62\x2e\x72\x65\x6d\x6f\x76\x65\x28\x29\x2c\x63\x3d\x6e\x75\x6c\x6c\x2c\x61\x26\x26\x66\x28\x22\x65\x72\x72\x6f\x72\x22\x3d\x3d\x3d\x61\x2e\x74\x79\x70\x65\x3f\x34\x30\x34\x3a\x32\x30\x30\x2c\x61\x2e\x74\x79\x70\x65\x29\x7d\x29\x2c\x64\x2e\x68\x65\x61\x64\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x5b\x30\x5d\x29\x7d\x2c\x61\x62\x6f\x72\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x26\x26\x63\x28\x29\x7d\x7d\x7d\x7d\x29\x3b\x76\x61\x72\x20\x51\x62\x3d\x5b\x5d\x2c\x52\x62\x3d\x2f\x28\x3d\x29\x5c\x3f\x28\x3f\x3d\x26\x7c\x24\x29\x7c\x5c\x3f\x5c\x3f\x2f\x3b\x72\x2e\x61\x6a\x61\x78\x53\x65\x74\x75\x70\x28\x7b\x6a\x73\x6f\x6e\x70\x3a\x22\x63\x61\x6c\x6c\x62\x61\x63\x6b\x22\x2c\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x51\x62\x2e\x70\x6f\x70\x28\x29\x7c\x7c\x72\x2e\x65\x78\x70\x61\x6e\x64\x6f\x2b\x22\x5f\x22\x2b\x72\x62\x2b\x2b\x3b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x5b\x61\x5d\x3d\x21\x30\x2c\x61\x7d\x7d\x29\x2c\x72\x2e\x61\x6a\x61\x78\x50\x72\x65\x66\x69\x6c\x74\x65\x72\x28\x22\x6a\x73\x6f\x6e\x20\x6a\x73\x6f\x6e\x70\x22\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x64\x29\x7b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x2c\x68\x3d\x62\x2e\x6a\x73\x6f\x6e\x70\x21\x3d\x3d\x21\x31\x26\x26\x28\x52\x62\x2e\x74\x65\x73\x74\x28\x62\x2e\x75\x72\x6c\x29\x3f\x22\x75\x72\x6c\x22\x3a\x22\x73\x74\x72\x69\x6e\x67\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x2e\x64\x61\x74\x61\x26\x26\x30\x3d\x3d\x3d\x28\x62\x2e\x63\x6f\x6e\x74\x65\x6e\x74\x54\x79\x70\x65\x7c\x7c\x22\x22\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x78\x2d\x77\x77\x77\x2d\x66\x6f\x72\x6d\x2d\x75\x72\x6c\x65\x6e\x63\x6f\x64\x65\x64\x22\x29\x26\x26\x52\x62\x2e\x74\x65\x73\x74\x28\x62\x2e\x64\x61\x74\x61\x29\x26\x26\x22\x64\x61\x74\x61\x22\x29\x3b\x69\x66\x28\x68\x7c\x7c\x22\x6a\x73\x6f\x6e\x70\x22\x3d\x3d\x3d\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x29\x72\x65\x74\x75\x72\x6e\x20\x65\x3d\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x3d\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x29\x3f\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x28\x29\x3a\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x2c\x68\x3f\x62\x5b\x68\x5d\x3d\x62\x5b\x68\x5d\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x52\x62\x2c\x22\x24\x31\x22\x2b\x65\x29\x3a\x62\x2e\x6a\x73\x6f\x6e\x70\x21\x3d\x3d\x21\x31\x26\x26\x28\x62\x2e\x75\x72\x6c\x2b\x3d\x28\x73\x62\x2e\x74\x65\x73\x74\x28\x62\x2e\x75\x72\x6c\x29\x3f\x22\x26\x22\x3a\x22\x3f\x22\x29\x2b\x62\x2e\x6a\x73\x6f\x6e\x70\x2b\x22\x3d\x22\x2b\x65\x29\x2c\x62\x2e\x63\x6f\x6e\x76\x65\x72\x74\x65\x72\x73\x5b\x22\x73\x63\x72\x69\x70\x74\x20\x6a\x73\x6f\x6e\x22\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x67\x7c\x7c\x72\x2e\x65\x72\x72\x6f\x72\x28\x65\x2b\x22\x20\x77\x61\x73\x20\x6e\x6f\x74\x20\x63\x61\x6c\x6c\x65\x64\x22\x29\x2c\x67\x5b\x30\x5d\x7d\x2c\x62\x2e\x64\x61\x74\x61\x54\x79\x70\x65\x73\x5b\x30\x5d\x3d\x22\x6a\x73\x6f\x6e\x22\x2c\x66\x3d\x61\x5b\x65\x5d\x2c\x61\x5b\x65\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x7d\x2c\x64\x2e\x61\x6c\x77\x61\x79\x73\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x66\x3f\x72\x28\x61\x29\x2e\x72\x65\x6d\x6f\x76\x65\x50\x72\x6f\x70\x28\x65\x29\x3a\x61\x5b\x65\x5d\x3d\x66\x2c\x62\x5b\x65\x5d\x26\x26\x28\x62\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x3d\x63\x2e\x6a\x73\x6f\x6e\x70\x43\x61\x6c\x6c\x62\x61\x63\x6b\x2c\x51\x62\x2e\x70\x75\x73\x68\x28\x65\x29\x29\x2c\x67\x26\x26\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x66\x29\x26\x26\x66\x28\x67\x5b\x30\x5d\x29\x2c\x67\x3d\x66\x3d\x76\x6f\x69\x64\x20\x30\x7d\x29\x2c\x22\x73\x63\x72\x69\x70\x74\x22\x7d\x29\x2c\x6f\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x64\x2e\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x28\x22\x22\x29\x2e\x62\x6f\x64\x79\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x69\x6e\x6e\x65\x72\x48\x54\x4d\x4c\x3d\x22\x3c\x66\x6f\x72\x6d\x3e\x3c\x2f\x66\x6f\x72\x6d\x3e\x3c\x66\x6f\x72\x6d\x3e\x3c\x2f\x66\x6f\x72\x6d\x3e\x22\x2c\x32\x3d\x3d\x3d\x61\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x28\x29\x2c\x72\x2e\x70\x61\x72\x73\x65\x48\x54\x4d\x4c\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x69\x66\x28\x22\x73\x74\x72\x69\x6e\x67\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x61\x29\x72\x65\x74\x75\x72\x6e\x5b\x5d\x3b\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x63\x3d\x62\x2c\x62\x3d\x21\x31\x29\x3b\x76\x61\x72\x20\x65\x2c\x66\x2c\x67\x3b\x72\x65\x74\x75\x72\x6e\x20\x62\x7c\x7c\x28\x6f\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x3f\x28\x62\x3d\x64\x2e\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x61\x74\x69\x6f\x6e\x2e\x63\x72\x65\x61\x74\x65\x48\x54\x4d\x4c\x44\x6f\x63\x75\x6d\x65\x6e\x74\x28\x22\x22\x29\x2c\x65\x3d\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x62\x61\x73\x65\x22\x29\x2c\x65\x2e\x68\x72\x65\x66\x3d\x64\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x72\x65\x66\x2c\x62\x2e\x68\x65\x61\x64\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x65\x29\x29\x3a\x62\x3d\x64\x29\x2c\x66\x3d\x42\x2e\x65\x78\x65\x63\x28\x61\x29\x2c\x67\x3d\x21\x63\x26\x26\x5b\x5d\x2c\x66\x3f\x5b\x62\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x66\x5b\x31\x5d\x29\x5d\x3a\x28\x66\x3d\x70\x61\x28\x5b\x61\x5d\x2c\x62\x2c\x67\x29\x2c\x67\x26\x26\x67\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x72\x28\x67\x29\x2e\x72\x65\x6d\x6f\x76\x65\x28\x29\x2c\x72\x2e\x6d\x65\x72\x67\x65\x28\x5b\x5d\x2c\x66\x2e\x63\x68\x69\x6c\x64\x4e\x6f\x64\x65\x73\x29\x29\x7d\x2c\x72\x2e\x66\x6e\x2e\x6c\x6f\x61\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x3d\x74\x68\x69\x73\x2c\x68\x3d\x61\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x20\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x68\x3e\x2d\x31\x26\x26\x28\x64\x3d\x6d\x62\x28\x61\x2e\x73\x6c\x69\x63\x65\x28\x68\x29\x29\x2c\x61\x3d\x61\x2e\x73\x6c\x69\x63\x65\x28\x30\x2c\x68\x29\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x3f\x28\x63\x3d\x62\x2c\x62\x3d\x76\x6f\x69\x64\x20\x30\x29\x3a\x62\x26\x26\x22\x6f\x62\x6a\x65\x63\x74\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x62\x26\x26\x28\x65\x3d\x22\x50\x4f\x53\x54\x22\x29\x2c\x67\x2e\x6c\x65\x6e\x67\x74\x68\x3e\x30\x26\x26\x72\x2e\x61\x6a\x61\x78\x28\x7b\x75\x72\x6c\x3a\x61\x2c\x74\x79\x70\x65\x3a\x65\x7c\x7c\x22\x47\x45\x54\x22\x2c\x64\x61\x74\x61\x54\x79\x70\x65\x3a\x22\x68\x74\x6d\x6c\x22\x2c\x64\x61\x74\x61\x3a\x62\x7d\x29\x2e\x64\x6f\x6e\x65\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x66\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2c\x67\x2e\x68\x74\x6d\x6c\x28\x64\x3f\x72\x28\x22\x3c\x64\x69\x76\x3e\x22\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x72\x2e\x70\x61\x72\x73\x65\x48\x54\x4d\x4c\x28\x61\x29\x29\x2e\x66\x69\x6e\x64\x28\x64\x29\x3a\x61\x29\x7d\x29\x2e\x61\x6c\x77\x61\x79\x73\x28\x63\x26\x26\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x67\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x63\x2e\x61\x70\x70\x6c\x79\x28\x74\x68\x69\x73\x2c\x66\x7c\x7c\x5b\x61\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x2c\x62\x2c\x61\x5d\x29\x7d\x29\x7d\x29\x2c\x74\x68\x69\x73\x7d\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x61\x6a\x61\x78\x53\x74\x61\x72\x74\x22\x2c\x22\x61\x6a\x61\x78\x53\x74\x6f\x70\x22\x2c\x22\x61\x6a\x61\x78\x43\x6f\x6d\x70\x6c\x65\x74\x65\x22\x2c\x22\x61\x6a\x61\x78\x45\x72\x72\x6f\x72\x22\x2c\x22\x61\x6a\x61\x78\x53\x75\x63\x63\x65\x73\x73\x22\x2c\x22\x61\x6a\x61\x78\x53\x65\x6e\x64\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x66\x6e\x5b\x62\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x6e\x28\x62\x2c\x61\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x78\x70\x72\x2e\x70\x73\x65\x75\x64\x6f\x73\x2e\x61\x6e\x69\x6d\x61\x74\x65\x64\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x67\x72\x65\x70\x28\x72\x2e\x74\x69\x6d\x65\x72\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x3d\x3d\x3d\x62\x2e\x65\x6c\x65\x6d\x7d\x29\x2e\x6c\x65\x6e\x67\x74\x68\x7d\x3b\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x53\x62\x28\x61\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x61\x29\x3f\x61\x3a\x39\x3d\x3d\x3d\x61\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x26\x26\x61\x2e\x64\x65\x66\x61\x75\x6c\x74\x56\x69\x65\x77\x7d\x72\x2e\x6f\x66\x66\x73\x65\x74\x3d\x7b\x73\x65\x74\x4f\x66\x66\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x76\x61\x72\x20\x64\x2c\x65\x2c\x66\x2c\x67\x2c\x68\x2c\x69\x2c\x6a\x2c\x6b\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x6f\x73\x69\x74\x69\x6f\x6e\x22\x29\x2c\x6c\x3d\x72\x28\x61\x29\x2c\x6d\x3d\x7b\x7d\x3b\x22\x73\x74\x61\x74\x69\x63\x22\x3d\x3d\x3d\x6b\x26\x26\x28\x61\x2e\x73\x74\x79\x6c\x65\x2e\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3d\x22\x72\x65\x6c\x61\x74\x69\x76\x65\x22\x29\x2c\x68\x3d\x6c\x2e\x6f\x66\x66\x73\x65\x74\x28\x29\x2c\x66\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x74\x6f\x70\x22\x29\x2c\x69\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x6c\x65\x66\x74\x22\x29\x2c\x6a\x3d\x28\x22\x61\x62\x73\x6f\x6c\x75\x74\x65\x22\x3d\x3d\x3d\x6b\x7c\x7c\x22\x66\x69\x78\x65\x64\x22\x3d\x3d\x3d\x6b\x29\x26\x26\x28\x66\x2b\x69\x29\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x61\x75\x74\x6f\x22\x29\x3e\x2d\x31\x2c\x6a\x3f\x28\x64\x3d\x6c\x2e\x70\x6f\x73\x69\x74\x69\x6f\x6e\x28\x29\x2c\x67\x3d\x64\x2e\x74\x6f\x70\x2c\x65\x3d\x64\x2e\x6c\x65\x66\x74\x29\x3a\x28\x67\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x66\x29\x7c\x7c\x30\x2c\x65\x3d\x70\x61\x72\x73\x65\x46\x6c\x6f\x61\x74\x28\x69\x29\x7c\x7c\x30\x29\x2c\x72\x2e\x69\x73\x46\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x26\x26\x28\x62\x3d\x62\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x63\x2c\x72\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x7d\x2c\x68\x29\x29\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x62\x2e\x74\x6f\x70\x26\x26\x28\x6d\x2e\x74\x6f\x70\x3d\x62\x2e\x74\x6f\x70\x2d\x68\x2e\x74\x6f\x70\x2b\x67\x29\x2c\x6e\x75\x6c\x6c\x21\x3d\x62\x2e\x6c\x65\x66\x74\x26\x26\x28\x6d\x2e\x6c\x65\x66\x74\x3d\x62\x2e\x6c\x65\x66\x74\x2d\x68\x2e\x6c\x65\x66\x74\x2b\x65\x29\x2c\x22\x75\x73\x69\x6e\x67\x22\x69\x6e\x20\x62\x3f\x62\x2e\x75\x73\x69\x6e\x67\x2e\x63\x61\x6c\x6c\x28\x61\x2c\x6d\x29\x3a\x6c\x2e\x63\x73\x73\x28\x6d\x29\x7d\x7d\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x6f\x66\x66\x73\x65\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x29\x7b\x69\x66\x28\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x61\x3f\x74\x68\x69\x73\x3a\x74\x68\x69\x73\x2e\x65\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x2e\x6f\x66\x66\x73\x65\x74\x2e\x73\x65\x74\x4f\x66\x66\x73\x65\x74\x28\x74\x68\x69\x73\x2c\x61\x2c\x62\x29\x7d\x29\x3b\x76\x61\x72\x20\x62\x2c\x63\x2c\x64\x2c\x65\x2c\x66\x3d\x74\x68\x69\x73\x5b\x30\x5d\x3b\x69\x66\x28\x66\x29\x72\x65\x74\x75\x72\x6e\x20\x66\x2e\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x73\x28\x29\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x28\x64\x3d\x66\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x2c\x64\x2e\x77\x69\x64\x74\x68\x7c\x7c\x64\x2e\x68\x65\x69\x67\x68\x74\x3f\x28\x65\x3d\x66\x2e\x6f\x77\x6e\x65\x72\x44\x6f\x63\x75\x6d\x65\x6e\x74\x2c\x63\x3d\x53\x62\x28\x65\x29\x2c\x62\x3d\x65\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x7b\x74\x6f\x70\x3a\x64\x2e\x74\x6f\x70\x2b\x63\x2e\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x2d\x62\x2e\x63\x6c\x69\x65\x6e\x74\x54\x6f\x70\x2c\x6c\x65\x66\x74\x3a\x64\x2e\x6c\x65\x66\x74\x2b\x63\x2e\x70\x61\x67\x65\x58\x4f\x66\x66\x73\x65\x74\x2d\x62\x2e\x63\x6c\x69\x65\x6e\x74\x4c\x65\x66\x74\x7d\x29\x3a\x64\x29\x3a\x7b\x74\x6f\x70\x3a\x30\x2c\x6c\x65\x66\x74\x3a\x30\x7d\x7d\x2c\x70\x6f\x73\x69\x74\x69\x6f\x6e\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x69\x66\x28\x74\x68\x69\x73\x5b\x30\x5d\x29\x7b\x76\x61\x72\x20\x61\x2c\x62\x2c\x63\x3d\x74\x68\x69\x73\x5b\x30\x5d\x2c\x64\x3d\x7b\x74\x6f\x70\x3a\x30\x2c\x6c\x65\x66\x74\x3a\x30\x7d\x3b\x72\x65\x74\x75\x72\x6e\x22\x66\x69\x78\x65\x64\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x63\x2c\x22\x70\x6f\x73\x69\x74\x69\x6f\x6e\x22\x29\x3f\x62\x3d\x63\x2e\x67\x65\x74\x42\x6f\x75\x6e\x64\x69\x6e\x67\x43\x6c\x69\x65\x6e\x74\x52\x65\x63\x74\x28\x29\x3a\x28\x61\x3d\x74\x68\x69\x73\x2e\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x28\x29\x2c\x62\x3d\x74\x68\x69\x73\x2e\x6f\x66\x66\x73\x65\x74\x28\x29\x2c\x72\x2e\x6e\x6f\x64\x65\x4e\x61\x6d\x65\x28\x61\x5b\x30\x5d\x2c\x22\x68\x74\x6d\x6c\x22\x29\x7c\x7c\x28\x64\x3d\x61\x2e\x6f\x66\x66\x73\x65\x74\x28\x29\x29\x2c\x64\x3d\x7b\x74\x6f\x70\x3a\x64\x2e\x74\x6f\x70\x2b\x72\x2e\x63\x73\x73\x28\x61\x5b\x30\x5d\x2c\x22\x62\x6f\x72\x64\x65\x72\x54\x6f\x70\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x29\x2c\x6c\x65\x66\x74\x3a\x64\x2e\x6c\x65\x66\x74\x2b\x72\x2e\x63\x73\x73\x28\x61\x5b\x30\x5d\x2c\x22\x62\x6f\x72\x64\x65\x72\x4c\x65\x66\x74\x57\x69\x64\x74\x68\x22\x2c\x21\x30\x29\x7d\x29\x2c\x7b\x74\x6f\x70\x3a\x62\x2e\x74\x6f\x70\x2d\x64\x2e\x74\x6f\x70\x2d\x72\x2e\x63\x73\x73\x28\x63\x2c\x22\x6d\x61\x72\x67\x69\x6e\x54\x6f\x70\x22\x2c\x21\x30\x29\x2c\x6c\x65\x66\x74\x3a\x62\x2e\x6c\x65\x66\x74\x2d\x64\x2e\x6c\x65\x66\x74\x2d\x72\x2e\x63\x73\x73\x28\x63\x2c\x22\x6d\x61\x72\x67\x69\x6e\x4c\x65\x66\x74\x22\x2c\x21\x30\x29\x7d\x7d\x7d\x2c\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6d\x61\x70\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x76\x61\x72\x20\x61\x3d\x74\x68\x69\x73\x2e\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x3b\x77\x68\x69\x6c\x65\x28\x61\x26\x26\x22\x73\x74\x61\x74\x69\x63\x22\x3d\x3d\x3d\x72\x2e\x63\x73\x73\x28\x61\x2c\x22\x70\x6f\x73\x69\x74\x69\x6f\x6e\x22\x29\x29\x61\x3d\x61\x2e\x6f\x66\x66\x73\x65\x74\x50\x61\x72\x65\x6e\x74\x3b\x72\x65\x74\x75\x72\x6e\x20\x61\x7c\x7c\x71\x61\x7d\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x73\x63\x72\x6f\x6c\x6c\x4c\x65\x66\x74\x3a\x22\x70\x61\x67\x65\x58\x4f\x66\x66\x73\x65\x74\x22\x2c\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x3a\x22\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x76\x61\x72\x20\x63\x3d\x22\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x22\x3d\x3d\x3d\x62\x3b\x72\x2e\x66\x6e\x5b\x61\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x64\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3d\x53\x62\x28\x61\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x65\x3f\x66\x3f\x66\x5b\x62\x5d\x3a\x61\x5b\x64\x5d\x3a\x76\x6f\x69\x64\x28\x66\x3f\x66\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x28\x63\x3f\x66\x2e\x70\x61\x67\x65\x58\x4f\x66\x66\x73\x65\x74\x3a\x65\x2c\x63\x3f\x65\x3a\x66\x2e\x70\x61\x67\x65\x59\x4f\x66\x66\x73\x65\x74\x29\x3a\x61\x5b\x64\x5d\x3d\x65\x29\x7d\x2c\x61\x2c\x64\x2c\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x29\x7d\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x5b\x22\x74\x6f\x70\x22\x2c\x22\x6c\x65\x66\x74\x22\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x63\x73\x73\x48\x6f\x6f\x6b\x73\x5b\x62\x5d\x3d\x4f\x61\x28\x6f\x2e\x70\x69\x78\x65\x6c\x50\x6f\x73\x69\x74\x69\x6f\x6e\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x63\x29\x7b\x69\x66\x28\x63\x29\x72\x65\x74\x75\x72\x6e\x20\x63\x3d\x4e\x61\x28\x61\x2c\x62\x29\x2c\x4c\x61\x2e\x74\x65\x73\x74\x28\x63\x29\x3f\x72\x28\x61\x29\x2e\x70\x6f\x73\x69\x74\x69\x6f\x6e\x28\x29\x5b\x62\x5d\x2b\x22\x70\x78\x22\x3a\x63\x7d\x29\x7d\x29\x2c\x72\x2e\x65\x61\x63\x68\x28\x7b\x48\x65\x69\x67\x68\x74\x3a\x22\x68\x65\x69\x67\x68\x74\x22\x2c\x57\x69\x64\x74\x68\x3a\x22\x77\x69\x64\x74\x68\x22\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x2e\x65\x61\x63\x68\x28\x7b\x70\x61\x64\x64\x69\x6e\x67\x3a\x22\x69\x6e\x6e\x65\x72\x22\x2b\x61\x2c\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x62\x2c\x22\x22\x3a\x22\x6f\x75\x74\x65\x72\x22\x2b\x61\x7d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x63\x2c\x64\x29\x7b\x72\x2e\x66\x6e\x5b\x64\x5d\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x2c\x66\x29\x7b\x76\x61\x72\x20\x67\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x26\x26\x28\x63\x7c\x7c\x22\x62\x6f\x6f\x6c\x65\x61\x6e\x22\x21\x3d\x74\x79\x70\x65\x6f\x66\x20\x65\x29\x2c\x68\x3d\x63\x7c\x7c\x28\x65\x3d\x3d\x3d\x21\x30\x7c\x7c\x66\x3d\x3d\x3d\x21\x30\x3f\x22\x6d\x61\x72\x67\x69\x6e\x22\x3a\x22\x62\x6f\x72\x64\x65\x72\x22\x29\x3b\x72\x65\x74\x75\x72\x6e\x20\x53\x28\x74\x68\x69\x73\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x2c\x63\x2c\x65\x29\x7b\x76\x61\x72\x20\x66\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x69\x73\x57\x69\x6e\x64\x6f\x77\x28\x62\x29\x3f\x30\x3d\x3d\x3d\x64\x2e\x69\x6e\x64\x65\x78\x4f\x66\x28\x22\x6f\x75\x74\x65\x72\x22\x29\x3f\x62\x5b\x22\x69\x6e\x6e\x65\x72\x22\x2b\x61\x5d\x3a\x62\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x5b\x22\x63\x6c\x69\x65\x6e\x74\x22\x2b\x61\x5d\x3a\x39\x3d\x3d\x3d\x62\x2e\x6e\x6f\x64\x65\x54\x79\x70\x65\x3f\x28\x66\x3d\x62\x2e\x64\x6f\x63\x75\x6d\x65\x6e\x74\x45\x6c\x65\x6d\x65\x6e\x74\x2c\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x62\x2e\x62\x6f\x64\x79\x5b\x22\x73\x63\x72\x6f\x6c\x6c\x22\x2b\x61\x5d\x2c\x66\x5b\x22\x73\x63\x72\x6f\x6c\x6c\x22\x2b\x61\x5d\x2c\x62\x2e\x62\x6f\x64\x79\x5b\x22\x6f\x66\x66\x73\x65\x74\x22\x2b\x61\x5d\x2c\x66\x5b\x22\x6f\x66\x66\x73\x65\x74\x22\x2b\x61\x5d\x2c\x66\x5b\x22\x63\x6c\x69\x65\x6e\x74\x22\x2b\x61\x5d\x29\x29\x3a\x76\x6f\x69\x64\x20\x30\x3d\x3d\x3d\x65\x3f\x72\x2e\x63\x73\x73\x28\x62\x2c\x63\x2c\x68\x29\x3a\x72\x2e\x73\x74\x79\x6c\x65\x28\x62\x2c\x63\x2c\x65\x2c\x68\x29\x7d\x2c\x62\x2c\x67\x3f\x65\x3a\x76\x6f\x69\x64\x20\x30\x2c\x67\x29\x7d\x7d\x29\x7d\x29\x2c\x72\x2e\x66\x6e\x2e\x65\x78\x74\x65\x6e\x64\x28\x7b\x62\x69\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x6e\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x62\x2c\x63\x29\x7d\x2c\x75\x6e\x62\x69\x6e\x64\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x61\x2c\x6e\x75\x6c\x6c\x2c\x62\x29\x7d\x2c\x64\x65\x6c\x65\x67\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x2c\x64\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x74\x68\x69\x73\x2e\x6f\x6e\x28\x62\x2c\x61\x2c\x63\x2c\x64\x29\x7d\x2c\x75\x6e\x64\x65\x6c\x65\x67\x61\x74\x65\x3a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x61\x2c\x62\x2c\x63\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x31\x3d\x3d\x3d\x61\x72\x67\x75\x6d\x65\x6e\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3f\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x61\x2c\x22\x2a\x2a\x22\x29\x3a\x74\x68\x69\x73\x2e\x6f\x66\x66\x28\x62\x2c\x61\x7c\x7c\x22\x2a\x2a\x22\x2c\x63\x29\x7d\x7d\x29\x2c\x72\x2e\x70\x61\x72\x73\x65\x4a\x53\x4f\x4e\x3d\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x2c\x22\x66\x75\x6e\x63\x74\x69\x6f\x6e\x22\x3d\x3d\x74\x79\x70\x65\x6f\x66\x20\x64\x65\x66\x69\x6e\x65\x26\x26\x64\x65\x66\x69\x6e\x65\x2e\x61\x6d\x64\x26\x26\x64\x65\x66\x69\x6e\x65\x28\x22\x6a\x71\x75\x65\x72\x79\x22\x2c\x5b\x5d\x2c\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x72\x7d\x29\x3b\x76\x61\x72\x20\x54\x62\x3d\x61\x2e\x6a\x51\x75\x65\x72\x79\x2c\x55\x62\x3d\x61\x2e\x24\x3b\x72\x65\x74\x75\x72\x6e\x20\x72\x2e\x6e\x6f\x43\x6f\x6e\x66\x6c\x69\x63\x74\x3d\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x62\x29\x7b\x72\x65\x74\x75\x72\x6e\x20\x61\x2e\x24\x3d\x3d\x3d\x72\x26\x26\x28\x61\x2e\x24\x3d\x55\x62\x29\x2c\x62\x26\x26\x61\x2e\x6a\x51\x75\x65\x72\x79\x3d\x3d\x3d\x72\x26\x26\x28\x61\x2e\x6a\x51\x75\x65\x72\x79\x3d\x54\x62\x29\x2c\x72\x7d\x2c\x62\x7c\x7c\x28\x61\x2e\x6a\x51\x75\x65\x72\x79\x3d\x61\x2e\x24\x3d\x72\x29\x2c\x72\x7d\x29\x3b\x0a\x0a\x0a\x2f\x2f\x2f\x20\x64\x72\x61\x77\x20\x62\x61\x73\x69\x63\x20\x64\x6f\x63\x0a\x0a\x76\x61\x72\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x3b\x0a\x76\x61\x72\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x47\x72\x6f\x75\x70\x20\x3d\x20\x2d\x31\x3b\x0a\x76\x61\x72\x20\x77\x73\x6f\x63\x6b\x20\x3d\x20\x6e\x65\x77\x20\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x28\x28\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\x20\x22\x68\x74\x74\x70\x73\x3a\x22\x20\x3f\x20\x22\x77\x73\x73\x3a\x2f\x2f\x22\x20\x3a\x20\x22\x77\x73\x3a\x2f\x2f\x22\x29\x2b\x77\x69\x6e\x64\x6f\x77\x2e\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x6f\x73\x74\x2b\x22\x2f\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x22\x29\x3b\x0a\x0a\x24\x2e\x67\x65\x74\x4a\x53\x4f\x4e\x28\x20\x22\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2e\x6a\x73\x6f\x6e\x22\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x20\x3d\x20\x64\x61\x74\x61\x3b\x0a\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x73\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x2f\x2f\x20\x63\x72\x65\x61\x74\x65\x20\x62\x75\x74\x74\x6f\x6e\x20\x66\x6f\x72\x20\x65\x61\x63\x68\x20\x67\x72\x6f\x75\x70\x0a\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x64\x61\x74\x61\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x42\x75\x74\x74\x6f\x6e\x20\x3d\x20\x24\x28\x22\x3c\x6c\x69\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x22\x67\x22\x2b\x6b\x65\x79\x2c\x20\x74\x65\x78\x74\x3a\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x67\x42\x75\x74\x74\x6f\x6e\x22\x7d\x29\x2e\x63\x6c\x69\x63\x6b\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x20\x73\x68\x6f\x77\x47\x72\x6f\x75\x70\x28\x6b\x65\x79\x29\x3b\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x76\x61\x6c\x2e\x53\x74\x61\x72\x74\x20\x3d\x3d\x20\x22\x6d\x61\x6e\x75\x61\x6c\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x6f\x66\x20\x6d\x61\x6e\x75\x61\x6c\x20\x67\x72\x6f\x75\x70\x73\x20\x77\x61\x69\x74\x20\x75\x6e\x74\x69\x6c\x20\x73\x74\x61\x72\x74\x65\x64\x20\x68\x65\x72\x65\x0a\x20\x20\x20\x20\x20\x20\x67\x72\x6f\x75\x70\x42\x75\x74\x74\x6f\x6e\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x62\x75\x74\x74\x6f\x6e\x2f\x3e\x22\x2c\x20\x7b\x20\x74\x65\x78\x74\x3a\x20\x22\x53\x74\x61\x72\x74\x22\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x73\x74\x61\x72\x74\x22\x20\x7d\x29\x2e\x63\x6c\x69\x63\x6b\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x76\x65\x6e\x74\x2e\x73\x74\x6f\x70\x50\x72\x6f\x70\x61\x67\x61\x74\x69\x6f\x6e\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x24\x2e\x70\x6f\x73\x74\x28\x22\x73\x74\x61\x72\x74\x47\x72\x6f\x75\x70\x22\x2c\x20\x7b\x20\x67\x72\x6f\x75\x70\x3a\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x66\x61\x69\x6c\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x78\x68\x72\x29\x20\x7b\x20\x61\x6c\x65\x72\x74\x28\x22\x43\x61\x6e\x6e\x6f\x74\x20\x73\x74\x61\x72\x74\x20\x22\x20\x2b\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x20\x2b\x20\x22\x3a\x20\x22\x20\x2b\x20\x78\x68\x72\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x29\x3b\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x29\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x67\x72\x6f\x75\x70\x42\x75\x74\x74\x6f\x6e\x2e\x61\x70\x70\x65\x6e\x64\x28\x70\x72\x6f\x67\x72\x65\x73\x73\x42\x61\x72\x28\x29\x29\x3b\x0a\x20\x20\x20\x20\x67\x72\x6f\x75\x70\x42\x75\x74\x74\x6f\x6e\x2e\x61\x70\x70\x65\x6e\x64\x54\x6f\x28\x24\x28\x22\x23\x67\x72\x6f\x75\x70\x73\x22\x29\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x2f\x2f\x20\x61\x64\x64\x20\x44\x49\x56\x73\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x67\x72\x6f\x75\x70\x73\x2c\x20\x61\x6c\x6c\x20\x68\x69\x64\x64\x65\x6e\x20\x62\x79\x20\x64\x65\x66\x61\x75\x6c\x74\x0a\x20\x20\x24\x28\x22\x23\x63\x6c\x69\x65\x6e\x74\x73\x22\x29\x2e\x68\x74\x6d\x6c\x28\x22\x22\x29\x3b\x0a\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x20\x3d\x20\x6b\x65\x79\x3b\x20\x2f\x2f\x22\x63\x67\x72\x6f\x75\x70\x5f\x22\x20\x2b\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x20\x3d\x20\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x2c\x20\x74\x65\x78\x74\x3a\x20\x22\x22\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x47\x72\x6f\x75\x70\x22\x7d\x29\x20\x3b\x0a\x20\x20\x20\x20\x24\x28\x22\x23\x63\x6c\x69\x65\x6e\x74\x73\x22\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x67\x72\x6f\x75\x70\x44\x69\x76\x29\x3b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x5b\x6b\x65\x79\x5d\x2e\x48\x6f\x73\x74\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x2c\x20\x76\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x22\x23\x22\x2b\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x68\x6f\x73\x74\x42\x75\x74\x74\x6f\x6e\x28\x76\x29\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x2f\x2f\x20\x67\x65\x74\x2f\x73\x65\x74\x20\x69\x6e\x69\x74\x69\x61\x6c\x20\x73\x74\x61\x74\x65\x20\x66\x6f\x72\x20\x61\x6c\x6c\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x75\x70\x6f\x6e\x20\x70\x61\x67\x65\x20\x6c\x6f\x61\x64\x0a\x20\x20\x24\x2e\x67\x65\x74\x4a\x53\x4f\x4e\x28\x20\x22\x67\x65\x74\x43\x6c\x69\x65\x6e\x74\x53\x74\x61\x74\x69\x2e\x6a\x73\x6f\x6e\x22\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x64\x61\x74\x61\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x68\x6f\x73\x74\x2c\x20\x68\x6f\x73\x74\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x68\x6f\x73\x74\x29\x29\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x2e\x53\x74\x61\x74\x75\x73\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x41\x64\x64\x72\x65\x73\x73\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x43\x68\x61\x69\x6e\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x55\x6e\x63\x6f\x6e\x66\x69\x72\x6d\x65\x64\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x20\x20\x24\x2e\x67\x65\x74\x4a\x53\x4f\x4e\x28\x20\x22\x67\x65\x74\x55\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x43\x6c\x69\x65\x6e\x74\x73\x2e\x6a\x73\x6f\x6e\x22\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x64\x61\x74\x61\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x68\x6f\x73\x74\x2c\x20\x68\x6f\x73\x74\x64\x61\x74\x61\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x55\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x28\x68\x6f\x73\x74\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x7d\x29\x3b\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x6f\x73\x74\x42\x75\x74\x74\x6f\x6e\x28\x68\x6f\x73\x74\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x20\x3d\x20\x24\x28\x22\x3c\x6c\x69\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x68\x6f\x73\x74\x49\x64\x28\x68\x6f\x73\x74\x29\x2c\x20\x74\x65\x78\x74\x3a\x20\x68\x6f\x73\x74\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x42\x75\x74\x74\x6f\x6e\x22\x7d\x29\x20\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x61\x64\x64\x72\x65\x73\x73\x22\x20\x7d\x29\x29\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x68\x61\x69\x6e\x22\x20\x7d\x29\x29\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x61\x70\x70\x65\x6e\x64\x28\x70\x72\x6f\x67\x72\x65\x73\x73\x42\x61\x72\x28\x29\x29\x3b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x69\x74\x65\x6d\x3b\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x47\x72\x6f\x75\x70\x28\x6e\x29\x20\x7b\x0a\x20\x20\x73\x65\x6c\x65\x63\x74\x65\x64\x47\x72\x6f\x75\x70\x20\x3d\x20\x6e\x3b\x0a\x20\x20\x24\x28\x22\x23\x63\x6c\x69\x65\x6e\x74\x73\x20\x64\x69\x76\x2e\x63\x47\x72\x6f\x75\x70\x22\x29\x2e\x68\x69\x64\x65\x28\x29\x3b\x0a\x20\x20\x76\x61\x72\x20\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x20\x3d\x20\x6e\x3b\x20\x2f\x2f\x22\x63\x67\x72\x6f\x75\x70\x5f\x22\x20\x2b\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x5b\x6e\x5d\x2e\x4e\x61\x6d\x65\x3b\x0a\x20\x20\x24\x28\x22\x23\x22\x2b\x67\x72\x6f\x75\x70\x44\x69\x76\x49\x64\x29\x2e\x73\x68\x6f\x77\x28\x29\x3b\x0a\x7d\x0a\x0a\x77\x73\x6f\x63\x6b\x2e\x6f\x6e\x63\x6c\x6f\x73\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x2f\x2f\x61\x6c\x65\x72\x74\x28\x22\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x20\x63\x6c\x6f\x73\x65\x64\x20\x2d\x20\x73\x65\x72\x76\x65\x72\x20\x67\x6f\x6e\x65\x2e\x2e\x2e\x3f\x22\x29\x3b\x0a\x20\x20\x24\x28\x20\x22\x23\x77\x65\x62\x73\x6f\x63\x6b\x42\x72\x6f\x6b\x65\x6e\x22\x20\x29\x2e\x73\x68\x6f\x77\x28\x29\x3b\x0a\x7d\x3b\x0a\x0a\x77\x73\x6f\x63\x6b\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x61\x6c\x65\x72\x74\x28\x22\x43\x61\x6e\x6e\x6f\x74\x20\x6f\x70\x65\x6e\x20\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x22\x29\x3b\x0a\x7d\x3b\x0a\x0a\x77\x73\x6f\x63\x6b\x2e\x6f\x6e\x6d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x76\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x64\x61\x74\x61\x20\x3d\x20\x24\x2e\x70\x61\x72\x73\x65\x4a\x53\x4f\x4e\x28\x20\x65\x76\x65\x6e\x74\x2e\x64\x61\x74\x61\x20\x29\x3b\x0a\x20\x20\x69\x66\x20\x28\x64\x61\x74\x61\x2e\x53\x74\x61\x74\x75\x73\x20\x3d\x3d\x20\x22\x52\x45\x4c\x4f\x41\x44\x45\x44\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x2f\x2f\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x63\x68\x61\x6e\x67\x65\x64\x3a\x20\x72\x65\x64\x72\x61\x77\x20\x67\x72\x6f\x75\x70\x73\x20\x61\x6e\x64\x20\x63\x6c\x69\x65\x6e\x74\x73\x0a\x20\x20\x20\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x72\x65\x6c\x6f\x61\x64\x28\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x66\x20\x28\x64\x61\x74\x61\x2e\x53\x74\x61\x74\x75\x73\x20\x3d\x3d\x20\x22\x55\x4e\x41\x53\x53\x49\x47\x4e\x45\x44\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x73\x68\x6f\x77\x55\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x28\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x24\x28\x22\x23\x75\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x2e\x72\x65\x6d\x6f\x76\x65\x28\x29\x3b\x0a\x20\x20\x61\x64\x64\x48\x6f\x73\x74\x28\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x2e\x72\x65\x6d\x6f\x76\x65\x43\x6c\x61\x73\x73\x28\x22\x4e\x4f\x4e\x45\x20\x42\x55\x53\x59\x20\x57\x41\x49\x54\x20\x45\x52\x52\x4f\x52\x20\x44\x4f\x4e\x45\x20\x42\x41\x44\x53\x55\x4d\x22\x29\x2e\x61\x64\x64\x43\x6c\x61\x73\x73\x28\x64\x61\x74\x61\x2e\x53\x74\x61\x74\x75\x73\x29\x3b\x0a\x20\x20\x73\x68\x6f\x77\x41\x64\x64\x72\x65\x73\x73\x28\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x73\x68\x6f\x77\x43\x68\x61\x69\x6e\x28\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x73\x68\x6f\x77\x55\x6e\x63\x6f\x6e\x66\x69\x72\x6d\x65\x64\x28\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x73\x68\x6f\x77\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x64\x61\x74\x61\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x68\x6f\x73\x74\x49\x64\x20\x74\x75\x72\x6e\x73\x20\x61\x20\x68\x6f\x73\x74\x73\x20\x65\x6e\x74\x72\x79\x20\x28\x49\x50\x2c\x20\x4d\x41\x43\x2c\x20\x55\x55\x49\x44\x20\x6f\x72\x20\x68\x6f\x73\x74\x6e\x61\x6d\x65\x29\x20\x69\x6e\x74\x6f\x20\x61\x6e\x20\x65\x6c\x65\x6d\x65\x6e\x74\x20\x69\x64\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x68\x6f\x73\x74\x49\x64\x28\x68\x6f\x73\x74\x29\x20\x7b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x22\x68\x5f\x22\x20\x2b\x20\x68\x6f\x73\x74\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x2f\x5b\x5e\x41\x2d\x5a\x61\x2d\x7a\x30\x2d\x39\x5f\x2d\x5d\x2f\x67\x2c\x20\x22\x5f\x22\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x68\x6f\x77\x41\x64\x64\x72\x65\x73\x73\x20\x73\x68\x6f\x77\x73\x20\x74\x68\x65\x20\x6c\x65\x61\x72\x6e\x65\x64\x20\x49\x50\x20\x6f\x66\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x6e\x6f\x74\x20\x6c\x69\x73\x74\x65\x64\x20\x62\x79\x20\x49\x50\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x41\x64\x64\x72\x65\x73\x73\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x2e\x66\x69\x6e\x64\x28\x22\x2e\x61\x64\x64\x72\x65\x73\x73\x22\x29\x2e\x74\x65\x78\x74\x28\x64\x61\x74\x61\x2e\x41\x64\x64\x72\x65\x73\x73\x20\x21\x3d\x20\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x20\x3f\x20\x64\x61\x74\x61\x2e\x41\x64\x64\x72\x65\x73\x73\x20\x3a\x20\x22\x22\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x68\x6f\x77\x43\x68\x61\x69\x6e\x20\x73\x68\x6f\x77\x73\x20\x77\x68\x69\x63\x68\x20\x63\x68\x61\x69\x6e\x20\x61\x20\x63\x6c\x69\x65\x6e\x74\x20\x69\x73\x20\x69\x6e\x2c\x20\x66\x6f\x72\x20\x67\x72\x6f\x75\x70\x73\x20\x77\x69\x74\x68\x20\x73\x65\x76\x65\x72\x61\x6c\x20\x63\x68\x61\x69\x6e\x73\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x43\x68\x61\x69\x6e\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x2e\x66\x69\x6e\x64\x28\x22\x2e\x63\x68\x61\x69\x6e\x22\x29\x2e\x74\x65\x78\x74\x28\x64\x61\x74\x61\x2e\x43\x68\x61\x69\x6e\x20\x3f\x20\x22\x63\x68\x61\x69\x6e\x20\x22\x20\x2b\x20\x64\x61\x74\x61\x2e\x43\x68\x61\x69\x6e\x20\x3a\x20\x22\x22\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x73\x68\x6f\x77\x55\x6e\x63\x6f\x6e\x66\x69\x72\x6d\x65\x64\x20\x6c\x65\x74\x73\x20\x74\x68\x65\x20\x6f\x70\x65\x72\x61\x74\x6f\x72\x20\x63\x6f\x6e\x66\x69\x72\x6d\x20\x61\x20\x6e\x65\x77\x20\x61\x64\x64\x72\x65\x73\x73\x20\x63\x6c\x61\x69\x6d\x69\x6e\x67\x20\x61\x20\x63\x6c\x69\x65\x6e\x74\x20\x28\x54\x4c\x53\x29\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x55\x6e\x63\x6f\x6e\x66\x69\x72\x6d\x65\x64\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x20\x3d\x20\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x63\x6f\x6e\x66\x69\x72\x6d\x22\x29\x2e\x72\x65\x6d\x6f\x76\x65\x28\x29\x3b\x0a\x20\x20\x69\x66\x20\x28\x64\x61\x74\x61\x2e\x55\x6e\x63\x6f\x6e\x66\x69\x72\x6d\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x74\x65\x6d\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x62\x75\x74\x74\x6f\x6e\x2f\x3e\x22\x2c\x20\x7b\x20\x74\x65\x78\x74\x3a\x20\x22\x43\x6f\x6e\x66\x69\x72\x6d\x20\x22\x20\x2b\x20\x64\x61\x74\x61\x2e\x55\x6e\x63\x6f\x6e\x66\x69\x72\x6d\x65\x64\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x6f\x6e\x66\x69\x72\x6d\x22\x20\x7d\x29\x2e\x63\x6c\x69\x63\x6b\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x24\x2e\x70\x6f\x73\x74\x28\x22\x63\x6f\x6e\x66\x69\x72\x6d\x43\x6c\x69\x65\x6e\x74\x22\x2c\x20\x7b\x20\x68\x6f\x73\x74\x3a\x20\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x66\x61\x69\x6c\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x78\x68\x72\x29\x20\x7b\x20\x61\x6c\x65\x72\x74\x28\x22\x43\x61\x6e\x6e\x6f\x74\x20\x63\x6f\x6e\x66\x69\x72\x6d\x20\x22\x20\x2b\x20\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x20\x2b\x20\x22\x3a\x20\x22\x20\x2b\x20\x78\x68\x72\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x29\x3b\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x29\x3b\x0a\x20\x20\x7d\x0a\x7d\x0a\x0a\x2f\x2f\x2f\x20\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x63\x6c\x69\x65\x6e\x74\x73\x0a\x0a\x2f\x2f\x20\x73\x68\x6f\x77\x55\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x6c\x69\x73\x74\x73\x20\x61\x6e\x20\x75\x6e\x6b\x6e\x6f\x77\x6e\x20\x63\x6c\x69\x65\x6e\x74\x2c\x20\x6c\x65\x74\x74\x69\x6e\x67\x20\x74\x68\x65\x20\x6f\x70\x65\x72\x61\x74\x6f\x72\x20\x61\x73\x73\x69\x67\x6e\x20\x69\x74\x20\x74\x6f\x20\x61\x20\x67\x72\x6f\x75\x70\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x55\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x20\x3d\x20\x24\x28\x22\x23\x75\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x3b\x0a\x20\x20\x69\x66\x20\x28\x69\x74\x65\x6d\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x73\x65\x6c\x65\x63\x74\x20\x3d\x20\x24\x28\x22\x3c\x73\x65\x6c\x65\x63\x74\x2f\x3e\x22\x29\x3b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x6c\x65\x63\x74\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x6f\x70\x74\x69\x6f\x6e\x2f\x3e\x22\x2c\x20\x7b\x20\x76\x61\x6c\x75\x65\x3a\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x2c\x20\x74\x65\x78\x74\x3a\x20\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x20\x7d\x29\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x62\x75\x74\x74\x6f\x6e\x20\x3d\x20\x24\x28\x22\x3c\x62\x75\x74\x74\x6f\x6e\x2f\x3e\x22\x2c\x20\x7b\x20\x74\x65\x78\x74\x3a\x20\x22\x41\x73\x73\x69\x67\x6e\x22\x20\x7d\x29\x2e\x63\x6c\x69\x63\x6b\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x24\x2e\x70\x6f\x73\x74\x28\x22\x61\x73\x73\x69\x67\x6e\x43\x6c\x69\x65\x6e\x74\x22\x2c\x20\x7b\x20\x68\x6f\x73\x74\x3a\x20\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x2c\x20\x67\x72\x6f\x75\x70\x3a\x20\x73\x65\x6c\x65\x63\x74\x2e\x76\x61\x6c\x28\x29\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x66\x61\x69\x6c\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x78\x68\x72\x29\x20\x7b\x20\x61\x6c\x65\x72\x74\x28\x22\x43\x61\x6e\x6e\x6f\x74\x20\x61\x73\x73\x69\x67\x6e\x20\x22\x20\x2b\x20\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x20\x2b\x20\x22\x3a\x20\x22\x20\x2b\x20\x78\x68\x72\x2e\x72\x65\x73\x70\x6f\x6e\x73\x65\x54\x65\x78\x74\x29\x3b\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x69\x74\x65\x6d\x20\x3d\x20\x24\x28\x22\x3c\x6c\x69\x2f\x3e\x22\x2c\x20\x7b\x20\x69\x64\x3a\x20\x22\x75\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x2c\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x55\x4e\x41\x53\x53\x49\x47\x4e\x45\x44\x22\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x69\x74\x65\x6d\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x69\x64\x65\x6e\x74\x69\x74\x79\x22\x20\x7d\x29\x2c\x20\x73\x65\x6c\x65\x63\x74\x2c\x20\x62\x75\x74\x74\x6f\x6e\x29\x3b\x0a\x20\x20\x20\x20\x24\x28\x22\x23\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x22\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x69\x74\x65\x6d\x29\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x76\x61\x72\x20\x69\x64\x65\x6e\x74\x69\x74\x79\x20\x3d\x20\x5b\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x2c\x20\x64\x61\x74\x61\x2e\x41\x64\x64\x72\x65\x73\x73\x5d\x3b\x0a\x20\x20\x69\x66\x20\x28\x64\x61\x74\x61\x2e\x49\x64\x65\x6e\x74\x69\x74\x79\x20\x26\x26\x20\x64\x61\x74\x61\x2e\x49\x64\x65\x6e\x74\x69\x74\x79\x2e\x48\x6f\x73\x74\x6e\x61\x6d\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x64\x65\x6e\x74\x69\x74\x79\x2e\x70\x75\x73\x68\x28\x64\x61\x74\x61\x2e\x49\x64\x65\x6e\x74\x69\x74\x79\x2e\x48\x6f\x73\x74\x6e\x61\x6d\x65\x29\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x69\x64\x65\x6e\x74\x69\x74\x79\x22\x29\x2e\x74\x65\x78\x74\x28\x69\x64\x65\x6e\x74\x69\x74\x79\x2e\x6a\x6f\x69\x6e\x28\x22\x20\xc2\xb7\x20\x22\x29\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x61\x64\x64\x48\x6f\x73\x74\x20\x61\x64\x64\x73\x20\x61\x20\x63\x6c\x69\x65\x6e\x74\x20\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x61\x74\x20\x72\x75\x6e\x74\x69\x6d\x65\x20\x74\x6f\x20\x69\x74\x73\x20\x67\x72\x6f\x75\x70\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x61\x64\x64\x48\x6f\x73\x74\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x69\x66\x20\x28\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x2e\x6c\x65\x6e\x67\x74\x68\x20\x21\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x20\x3d\x3d\x20\x64\x61\x74\x61\x2e\x47\x72\x6f\x75\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x2e\x48\x6f\x73\x74\x73\x2e\x70\x75\x73\x68\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x22\x23\x22\x2b\x6b\x65\x79\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x68\x6f\x73\x74\x42\x75\x74\x74\x6f\x6e\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x2f\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x62\x61\x72\x73\x0a\x0a\x76\x61\x72\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x3d\x20\x7b\x7d\x3b\x20\x2f\x2f\x20\x6c\x61\x74\x65\x73\x74\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x20\x62\x79\x20\x68\x6f\x73\x74\x73\x20\x65\x6e\x74\x72\x79\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x42\x61\x72\x28\x29\x20\x7b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x20\x7d\x29\x2e\x61\x70\x70\x65\x6e\x64\x28\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x62\x61\x72\x22\x20\x7d\x29\x29\x0a\x20\x20\x20\x20\x2e\x61\x64\x64\x28\x24\x28\x22\x3c\x64\x69\x76\x2f\x3e\x22\x2c\x20\x7b\x20\x63\x6c\x61\x73\x73\x3a\x20\x22\x70\x72\x6f\x67\x72\x65\x73\x73\x49\x6e\x66\x6f\x22\x20\x7d\x29\x29\x3b\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x5b\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x5d\x20\x3d\x20\x64\x61\x74\x61\x2e\x50\x72\x6f\x67\x72\x65\x73\x73\x3b\x0a\x20\x20\x76\x61\x72\x20\x69\x74\x65\x6d\x20\x3d\x20\x24\x28\x22\x23\x22\x2b\x68\x6f\x73\x74\x49\x64\x28\x64\x61\x74\x61\x2e\x48\x6f\x73\x74\x29\x29\x3b\x0a\x20\x20\x72\x65\x6e\x64\x65\x72\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x69\x74\x65\x6d\x2c\x20\x64\x61\x74\x61\x2e\x50\x72\x6f\x67\x72\x65\x73\x73\x29\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x74\x6f\x67\x67\x6c\x65\x43\x6c\x61\x73\x73\x28\x22\x42\x4f\x54\x54\x4c\x45\x4e\x45\x43\x4b\x22\x2c\x20\x64\x61\x74\x61\x2e\x42\x6f\x74\x74\x6c\x65\x6e\x65\x63\x6b\x20\x3d\x3d\x3d\x20\x74\x72\x75\x65\x29\x3b\x0a\x20\x20\x2f\x2f\x20\x66\x61\x69\x6c\x65\x64\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x73\x68\x6f\x77\x20\x77\x68\x79\x2c\x20\x6f\x74\x68\x65\x72\x73\x20\x77\x68\x65\x72\x65\x20\x74\x68\x65\x79\x20\x73\x70\x65\x6e\x64\x20\x74\x68\x65\x69\x72\x20\x74\x69\x6d\x65\x0a\x20\x20\x69\x74\x65\x6d\x2e\x61\x74\x74\x72\x28\x22\x74\x69\x74\x6c\x65\x22\x2c\x20\x64\x61\x74\x61\x2e\x45\x72\x72\x6f\x72\x20\x3f\x20\x64\x61\x74\x61\x2e\x45\x72\x72\x6f\x72\x20\x3a\x20\x77\x61\x69\x74\x54\x69\x6d\x65\x73\x28\x64\x61\x74\x61\x2e\x50\x72\x6f\x67\x72\x65\x73\x73\x29\x29\x3b\x0a\x20\x20\x2f\x2f\x20\x67\x72\x6f\x75\x70\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x3a\x20\x61\x6c\x6c\x20\x62\x79\x74\x65\x73\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x76\x73\x2e\x20\x61\x6c\x6c\x20\x62\x79\x74\x65\x73\x20\x74\x6f\x20\x72\x65\x63\x65\x69\x76\x65\x3b\x20\x45\x54\x41\x20\x6f\x66\x20\x73\x6c\x6f\x77\x65\x73\x74\x20\x68\x6f\x73\x74\x0a\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x63\x6c\x69\x65\x6e\x74\x47\x72\x6f\x75\x70\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x65\x79\x2c\x20\x76\x61\x6c\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x76\x61\x6c\x2e\x4e\x61\x6d\x65\x20\x21\x3d\x20\x64\x61\x74\x61\x2e\x47\x72\x6f\x75\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x73\x75\x6d\x20\x3d\x20\x7b\x20\x53\x69\x7a\x65\x3a\x20\x30\x2c\x20\x52\x65\x63\x65\x69\x76\x65\x64\x3a\x20\x30\x2c\x20\x52\x61\x74\x65\x3a\x20\x30\x20\x7d\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x65\x74\x61\x20\x3d\x20\x30\x3b\x0a\x20\x20\x20\x20\x24\x2e\x65\x61\x63\x68\x28\x20\x76\x61\x6c\x2e\x48\x6f\x73\x74\x73\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x20\x6b\x2c\x20\x68\x6f\x73\x74\x20\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x70\x20\x3d\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x5b\x68\x6f\x73\x74\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x2e\x53\x69\x7a\x65\x20\x2b\x3d\x20\x70\x2e\x53\x69\x7a\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x2e\x52\x65\x63\x65\x69\x76\x65\x64\x20\x2b\x3d\x20\x70\x2e\x52\x65\x63\x65\x69\x76\x65\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x74\x61\x20\x3d\x20\x4d\x61\x74\x68\x2e\x6d\x61\x78\x28\x65\x74\x61\x2c\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x53\x65\x63\x6f\x6e\x64\x73\x28\x70\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x6e\x64\x65\x72\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x24\x28\x22\x23\x67\x22\x2b\x6b\x65\x79\x29\x2c\x20\x73\x75\x6d\x2e\x53\x69\x7a\x65\x20\x3e\x20\x30\x20\x3f\x20\x73\x75\x6d\x20\x3a\x20\x6e\x75\x6c\x6c\x2c\x20\x65\x74\x61\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x65\x6e\x64\x65\x72\x50\x72\x6f\x67\x72\x65\x73\x73\x28\x69\x74\x65\x6d\x2c\x20\x70\x2c\x20\x65\x74\x61\x29\x20\x7b\x0a\x20\x20\x69\x66\x20\x28\x21\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x29\x2e\x68\x69\x64\x65\x28\x29\x3b\x0a\x20\x20\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x49\x6e\x66\x6f\x22\x29\x2e\x74\x65\x78\x74\x28\x22\x22\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x76\x61\x72\x20\x70\x65\x72\x63\x65\x6e\x74\x20\x3d\x20\x70\x2e\x53\x69\x7a\x65\x20\x3e\x20\x30\x20\x3f\x20\x4d\x61\x74\x68\x2e\x66\x6c\x6f\x6f\x72\x28\x31\x30\x30\x20\x2a\x20\x70\x2e\x52\x65\x63\x65\x69\x76\x65\x64\x20\x2f\x20\x70\x2e\x53\x69\x7a\x65\x29\x20\x3a\x20\x30\x3b\x0a\x20\x20\x76\x61\x72\x20\x69\x6e\x66\x6f\x20\x3d\x20\x70\x65\x72\x63\x65\x6e\x74\x20\x2b\x20\x22\x25\x22\x3b\x0a\x20\x20\x69\x66\x20\x28\x70\x2e\x52\x61\x74\x65\x20\x3e\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x6e\x66\x6f\x20\x2b\x3d\x20\x22\x20\xc2\xb7\x20\x22\x20\x2b\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x70\x2e\x52\x61\x74\x65\x29\x20\x2b\x20\x22\x2f\x73\x22\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x66\x20\x28\x65\x74\x61\x20\x3d\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x65\x74\x61\x20\x3d\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x53\x65\x63\x6f\x6e\x64\x73\x28\x70\x29\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x66\x20\x28\x70\x2e\x52\x65\x63\x65\x69\x76\x65\x64\x20\x3c\x20\x70\x2e\x53\x69\x7a\x65\x20\x26\x26\x20\x65\x74\x61\x20\x3e\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x6e\x66\x6f\x20\x2b\x3d\x20\x22\x20\xc2\xb7\x20\x45\x54\x41\x20\x22\x20\x2b\x20\x66\x6f\x72\x6d\x61\x74\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x65\x74\x61\x29\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x22\x29\x2e\x73\x68\x6f\x77\x28\x29\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x62\x61\x72\x22\x29\x2e\x63\x73\x73\x28\x22\x77\x69\x64\x74\x68\x22\x2c\x20\x70\x65\x72\x63\x65\x6e\x74\x20\x2b\x20\x22\x25\x22\x29\x3b\x0a\x20\x20\x69\x74\x65\x6d\x2e\x66\x69\x6e\x64\x28\x22\x2e\x70\x72\x6f\x67\x72\x65\x73\x73\x49\x6e\x66\x6f\x22\x29\x2e\x74\x65\x78\x74\x28\x69\x6e\x66\x6f\x29\x3b\x0a\x7d\x0a\x0a\x2f\x2f\x20\x77\x61\x69\x74\x54\x69\x6d\x65\x73\x20\x64\x65\x73\x63\x72\x69\x62\x65\x73\x20\x77\x68\x65\x72\x65\x20\x61\x20\x68\x6f\x73\x74\x20\x73\x70\x65\x6e\x64\x73\x20\x69\x74\x73\x20\x74\x69\x6d\x65\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x77\x61\x69\x74\x54\x69\x6d\x65\x73\x28\x70\x29\x20\x7b\x0a\x20\x20\x69\x66\x20\x28\x21\x70\x20\x7c\x7c\x20\x70\x2e\x45\x6c\x61\x70\x73\x65\x64\x20\x3c\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x22\x22\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x76\x61\x72\x20\x73\x68\x61\x72\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x6d\x73\x29\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x4d\x61\x74\x68\x2e\x72\x6f\x75\x6e\x64\x28\x31\x30\x30\x20\x2a\x20\x6d\x73\x20\x2f\x20\x70\x2e\x45\x6c\x61\x70\x73\x65\x64\x29\x20\x2b\x20\x22\x25\x22\x3b\x20\x7d\x3b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x22\x77\x61\x69\x74\x69\x6e\x67\x20\x66\x6f\x72\x20\x64\x69\x73\x6b\x20\x22\x20\x2b\x20\x73\x68\x61\x72\x65\x28\x70\x2e\x44\x69\x73\x6b\x57\x61\x69\x74\x29\x20\x2b\x20\x22\x2c\x20\x75\x70\x73\x74\x72\x65\x61\x6d\x20\x22\x20\x2b\x20\x73\x68\x61\x72\x65\x28\x70\x2e\x55\x70\x73\x74\x72\x65\x61\x6d\x57\x61\x69\x74\x29\x20\x2b\x0a\x20\x20\x20\x20\x22\x2c\x20\x6e\x65\x69\x67\x68\x62\x6f\x72\x73\x20\x22\x20\x2b\x20\x73\x68\x61\x72\x65\x28\x70\x2e\x4e\x65\x69\x67\x68\x62\x6f\x72\x57\x61\x69\x74\x29\x3b\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x65\x6d\x61\x69\x6e\x69\x6e\x67\x53\x65\x63\x6f\x6e\x64\x73\x28\x70\x29\x20\x7b\x0a\x20\x20\x69\x66\x20\x28\x70\x2e\x52\x65\x63\x65\x69\x76\x65\x64\x20\x3e\x3d\x20\x70\x2e\x53\x69\x7a\x65\x20\x7c\x7c\x20\x70\x2e\x52\x61\x74\x65\x20\x3c\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x30\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x70\x2e\x53\x69\x7a\x65\x20\x2d\x20\x70\x2e\x52\x65\x63\x65\x69\x76\x65\x64\x29\x20\x2f\x20\x70\x2e\x52\x61\x74\x65\x3b\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x6e\x29\x20\x7b\x0a\x20\x20\x76\x61\x72\x20\x75\x6e\x69\x74\x73\x20\x3d\x20\x5b\x22\x42\x22\x2c\x20\x22\x4b\x42\x22\x2c\x20\x22\x4d\x42\x22\x2c\x20\x22\x47\x42\x22\x2c\x20\x22\x54\x42\x22\x5d\x3b\x0a\x20\x20\x76\x61\x72\x20\x69\x20\x3d\x20\x30\x3b\x0a\x20\x20\x77\x68\x69\x6c\x65\x20\x28\x6e\x20\x3e\x3d\x20\x31\x30\x30\x30\x20\x26\x26\x20\x69\x20\x3c\x20\x75\x6e\x69\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x2d\x20\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x6e\x20\x2f\x3d\x20\x31\x30\x30\x30\x3b\x0a\x20\x20\x20\x20\x69\x2b\x2b\x3b\x0a\x20\x20\x7d\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x2e\x74\x6f\x46\x69\x78\x65\x64\x28\x69\x20\x3e\x20\x30\x20\x3f\x20\x31\x20\x3a\x20\x30\x29\x20\x2b\x20\x22\x20\x22\x20\x2b\x20\x75\x6e\x69\x74\x73\x5b\x69\x5d\x3b\x0a\x7d\x0a\x0a\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x6d\x61\x74\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x73\x65\x63\x6f\x6e\x64\x73\x29\x20\x7b\x0a\x20\x20\x73\x65\x63\x6f\x6e\x64\x73\x20\x3d\x20\x4d\x61\x74\x68\x2e\x63\x65\x69\x6c\x28\x73\x65\x63\x6f\x6e\x64\x73\x29\x3b\x0a\x20\x20\x76\x61\x72\x20\x6d\x20\x3d\x20\x4d\x61\x74\x68\x2e\x66\x6c\x6f\x6f\x72\x28\x73\x65\x63\x6f\x6e\x64\x73\x20\x2f\x20\x36\x30\x29\x3b\x0a\x20\x20\x76\x61\x72\x20\x73\x20\x3d\x20\x73\x65\x63\x6f\x6e\x64\x73\x20\x25\x20\x36\x30\x3b\x0a\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6d\x20\x2b\x20\x22\x3a\x22\x20\x2b\x20\x28\x73\x20\x3c\x20\x31\x30\x20\x3f\x20\x22\x30\x22\x20\x3a\x20\x22\x22\x29\x20\x2b\x20\x73\x3b\x0a\x7d\x0a"
 
 func assetsAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -96,7 +96,7 @@ func assetsAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/app.js", size: 88490, mode: os.FileMode(420), modTime: time.Unix(1482247577, 0)}
+	info := bindataFileInfo{name: "assets/app.js", size: 94665, mode: os.FileMode(420), modTime: time.Unix(1792264488, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -121,7 +121,7 @@ func faviconPng() (*asset, error) {
 	return a, nil
 }
 
-var _indexHtml = "\x3c\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x65\x61\x64\x3e\x0a\x20\x20\x3c\x74\x69\x74\x6c\x65\x3e\x74\x68\x65\x50\x75\x73\x68\x65\x72\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x74\x79\x6c\x65\x73\x68\x65\x65\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x61\x73\x73\x65\x74\x73\x2f\x61\x70\x70\x2e\x63\x73\x73\x22\x3e\x0a\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x69\x63\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x66\x61\x76\x69\x63\x6f\x6e\x2e\x70\x6e\x67\x22\x20\x74\x79\x70\x65\x3d\x22\x69\x6d\x61\x67\x65\x2f\x70\x6e\x67\x22\x3e\x0a\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x0a\x3c\x62\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x2f\x61\x73\x73\x65\x74\x73\x2f\x61\x70\x70\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x77\x65\x62\x73\x6f\x63\x6b\x42\x72\x6f\x6b\x65\x6e\x22\x3e\x4f\x6f\x70\x73\x2c\x20\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x20\x68\x61\x73\x20\x67\x6f\x6e\x65\x2e\x20\x53\x65\x72\x76\x65\x72\x20\x62\x6f\x6f\x62\x6f\x6f\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x6e\x6f\x73\x63\x72\x69\x70\x74\x3e\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x6e\x6f\x6a\x73\x22\x3e\x50\x6c\x65\x61\x73\x65\x20\x65\x6e\x61\x62\x6c\x65\x20\x4a\x61\x76\x61\x53\x63\x72\x69\x70\x74\x20\x69\x6e\x20\x79\x6f\x75\x72\x20\x62\x72\x6f\x77\x73\x65\x72\x20\x74\x6f\x20\x65\x6e\x6a\x6f\x79\x21\x3c\x2f\x64\x69\x76\x3e\x3c\x2f\x6e\x6f\x73\x63\x72\x69\x70\x74\x3e\x0a\x0a\x20\x20\x3c\x68\x31\x3e\x74\x68\x65\x50\x75\x73\x68\x65\x72\x20\x3a\x3a\x20\x73\x74\x61\x74\x75\x73\x20\x6d\x6f\x6e\x69\x74\x6f\x72\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x0a\x20\x20\x3c\x68\x32\x3e\x43\x6c\x69\x65\x6e\x74\x20\x67\x72\x6f\x75\x70\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x67\x72\x6f\x75\x70\x73\x44\x69\x76\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x67\x72\x6f\x75\x70\x73\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x0a\x20\x20\x3c\x68\x32\x3e\x43\x6c\x69\x65\x6e\x74\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x63\x6c\x69\x65\x6e\x74\x73\x44\x69\x76\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x63\x6c\x69\x65\x6e\x74\x73\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x3c\x68\x33\x3e\x4c\x65\x67\x65\x6e\x64\x3c\x2f\x68\x33\x3e\x0a\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x6c\x65\x67\x65\x6e\x64\x22\x3e\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x4e\x4f\x4e\x45\x22\x3e\x6e\x6f\x20\x73\x74\x61\x74\x75\x73\x3c\x2f\x6c\x69\x3e\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x57\x41\x49\x54\x22\x3e\x77\x61\x69\x74\x69\x6e\x67\x3c\x2f\x6c\x69\x3e\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x42\x55\x53\x59\x22\x3e\x62\x75\x73\x79\x3c\x2f\x6c\x69\x3e\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x44\x4f\x4e\x45\x22\x3e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x64\x3c\x2f\x6c\x69\x3e\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x45\x52\x52\x4f\x52\x22\x3e\x65\x72\x72\x6f\x72\x3c\x2f\x6c\x69\x3e\x20\x20\x20\x20\x0a\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a\x0a"
+var _indexHtml = "\x3c\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x65\x61\x64\x3e\x0a\x20\x20\x3c\x74\x69\x74\x6c\x65\x3e\x74\x68\x65\x50\x75\x73\x68\x65\x72\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x74\x79\x6c\x65\x73\x68\x65\x65\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x61\x73\x73\x65\x74\x73\x2f\x61\x70\x70\x2e\x63\x73\x73\x22\x3e\x0a\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x69\x63\x6f\x6e\x22\x20\x68\x72\x65\x66\x3d\x22\x2f\x66\x61\x76\x69\x63\x6f\x6e\x2e\x70\x6e\x67\x22\x20\x74\x79\x70\x65\x3d\x22\x69\x6d\x61\x67\x65\x2f\x70\x6e\x67\x22\x3e\x0a\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x0a\x3c\x62\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x2f\x61\x73\x73\x65\x74\x73\x2f\x61\x70\x70\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x77\x65\x62\x73\x6f\x63\x6b\x42\x72\x6f\x6b\x65\x6e\x22\x3e\x4f\x6f\x70\x73\x2c\x20\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x20\x68\x61\x73\x20\x67\x6f\x6e\x65\x2e\x20\x53\x65\x72\x76\x65\x72\x20\x62\x6f\x6f\x62\x6f\x6f\x3f\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x6e\x6f\x73\x63\x72\x69\x70\x74\x3e\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x6e\x6f\x6a\x73\x22\x3e\x50\x6c\x65\x61\x73\x65\x20\x65\x6e\x61\x62\x6c\x65\x20\x4a\x61\x76\x61\x53\x63\x72\x69\x70\x74\x20\x69\x6e\x20\x79\x6f\x75\x72\x20\x62\x72\x6f\x77\x73\x65\x72\x20\x74\x6f\x20\x65\x6e\x6a\x6f\x79\x21\x3c\x2f\x64\x69\x76\x3e\x3c\x2f\x6e\x6f\x73\x63\x72\x69\x70\x74\x3e\x0a\x0a\x20\x20\x3c\x68\x31\x3e\x74\x68\x65\x50\x75\x73\x68\x65\x72\x20\x3a\x3a\x20\x73\x74\x61\x74\x75\x73\x20\x6d\x6f\x6e\x69\x74\x6f\x72\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x0a\x20\x20\x3c\x68\x32\x3e\x43\x6c\x69\x65\x6e\x74\x20\x67\x72\x6f\x75\x70\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x67\x72\x6f\x75\x70\x73\x44\x69\x76\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x67\x72\x6f\x75\x70\x73\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x0a\x20\x20\x3c\x68\x32\x3e\x43\x6c\x69\x65\x6e\x74\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x63\x6c\x69\x65\x6e\x74\x73\x44\x69\x76\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x63\x6c\x69\x65\x6e\x74\x73\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x3c\x68\x32\x3e\x55\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x20\x63\x6c\x69\x65\x6e\x74\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x44\x69\x76\x22\x3e\x0a\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x75\x6e\x61\x73\x73\x69\x67\x6e\x65\x64\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x3c\x68\x33\x3e\x4c\x65\x67\x65\x6e\x64\x3c\x2f\x68\x33\x3e\x0a\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x6c\x65\x67\x65\x6e\x64\x22\x3e\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x4e\x4f\x4e\x45\x22\x3e\x6e\x6f\x20\x73\x74\x61\x74\x75\x73\x3c\x2f\x6c\x69\x3e\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x57\x41\x49\x54\x22\x3e\x77\x61\x69\x74\x69\x6e\x67\x3c\x2f\x6c\x69\x3e\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x42\x55\x53\x59\x22\x3e\x62\x75\x73\x79\x3c\x2f\x6c\x69\x3e\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x44\x4f\x4e\x45\x22\x3e\x63\x6f\x6d\x70\x6c\x65\x74\x65\x64\x3c\x2f\x6c\x69\x3e\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x45\x52\x52\x4f\x52\x22\x3e\x65\x72\x72\x6f\x72\x3c\x2f\x6c\x69\x3e\x20\x20\x20\x20\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x42\x41\x44\x53\x55\x4d\x22\x3e\x62\x61\x64\x20\x63\x68\x65\x63\x6b\x73\x75\x6d\x3c\x2f\x6c\x69\x3e\x0a\x09\x09\x3c\x6c\x69\x20\x63\x6c\x61\x73\x73\x3d\x22\x63\x42\x75\x74\x74\x6f\x6e\x20\x42\x4f\x54\x54\x4c\x45\x4e\x45\x43\x4b\x22\x3e\x62\x6f\x74\x74\x6c\x65\x6e\x65\x63\x6b\x3c\x2f\x6c\x69\x3e\x0a\x20\x20\x3c\x2f\x75\x6c\x3e\x0a\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a\x0a"
 
 func indexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -136,7 +136,7 @@ func indexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "index.html", size: 852, mode: os.FileMode(420), modTime: time.Unix(1481728982, 0)}
+	info := bindataFileInfo{name: "index.html", size: 1045, mode: os.FileMode(420), modTime: time.Unix(1792261846, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	HEADER_OFFSET = "X-Pusher-Offset" // bytes of image stream a client has received
+	LINK_TIMEOUT  = 60 * time.Second  // max. time a neighbor may block a single write
+	CHAIN_BUFFER  = 1024              // writes a chain may lag behind the others
+)
+
+var errLinkTimeout = errors.New("neighbor stopped reading")
+
+// treeForwarder fans the image stream out to all neighbors of a host
+// (used by master and clients alike)
+type treeForwarder struct {
+	links  []*chainForwarder
+	offset int64 // bytes forwarded so far
+	size   int64 // total size of image stream
+	relink func(deadNeighbor string) []string
+	replay func(from, to int64) (io.ReadCloser, error)
+}
+
+func newTreeForwarder(neighbors []string, size int64, relink func(string) []string, replay func(int64, int64) (io.ReadCloser, error)) *treeForwarder {
+	t := &treeForwarder{size: size, relink: relink, replay: replay}
+	for _, neighbor := range neighbors {
+		f := t.newLink(neighbor)
+		f.connect(0)
+	}
+	return t
+}
+
+func (t *treeForwarder) newLink(neighbor string) *chainForwarder {
+	f := &chainForwarder{neighbor: neighbor, offset: t.offset, size: t.size, relink: t.relink, replay: t.replay}
+	t.links = append(t.links, f)
+	return f
+}
+
+func (t *treeForwarder) Write(p []byte) (int, error) {
+	// links adopted while writing have p replayed already, so skip them
+	for _, f := range t.links {
+		f.Write(p)
+		t.adopt(f)
+	}
+	t.offset += int64(len(p))
+	return len(p), nil
+}
+
+// adopt takes over the extra neighbors a link got assigned during recovery
+func (t *treeForwarder) adopt(f *chainForwarder) {
+	for len(f.adopted) > 0 {
+		neighbor := f.adopted[0]
+		f.adopted = f.adopted[1:]
+		link := t.newLink(neighbor)
+		link.offset = f.offset
+		link.attach(nil, nil)
+	}
+}
+
+// Close completes the stream on all links; it fails if any link gave up
+func (t *treeForwarder) Close() error {
+	failed := []string{}
+	for i := 0; i < len(t.links); i++ {
+		if err := t.links[i].Close(); err != nil {
+			failed = append(failed, err.Error())
+		}
+		t.adopt(t.links[i])
+	}
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// parallelForwarder feeds the image stream to several chains (used by
+// master). Each chain has its own buffer, so one chain stalling for a
+// moment does not hold up the others.
+type parallelForwarder struct {
+	chains []chan []byte
+	errs   chainErrors
+	done   sync.WaitGroup
+}
+
+// chainErrors holds the error of each chain master streamed to, nil for
+// chains that completed
+type chainErrors []error
+
+func (e chainErrors) Error() string {
+	failed := []string{}
+	for i, err := range e {
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("chain %d: %s", i+1, err))
+		}
+	}
+	return strings.Join(failed, "; ")
+}
+
+func newParallelForwarder(forwarders []io.WriteCloser) *parallelForwarder {
+	p := &parallelForwarder{errs: make(chainErrors, len(forwarders))}
+	for i, f := range forwarders {
+		chain := make(chan []byte, CHAIN_BUFFER)
+		p.chains = append(p.chains, chain)
+		p.done.Add(1)
+		go func(i int, f io.WriteCloser, chain chan []byte) {
+			defer p.done.Done()
+			var err error
+			for chunk := range chain {
+				// a failed chain still drains its buffer, not to block the others
+				if err == nil {
+					_, err = f.Write(chunk)
+				}
+			}
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			p.errs[i] = err
+		}(i, f, chain)
+	}
+	return p
+}
+
+func (p *parallelForwarder) Write(b []byte) (int, error) {
+	// chains share the copy, they only read it
+	chunk := append([]byte{}, b...)
+	for _, chain := range p.chains {
+		chain <- chunk
+	}
+	return len(b), nil
+}
+
+// Close completes the stream on all chains; if any chain failed, it
+// returns the chainErrors
+func (p *parallelForwarder) Close() error {
+	for _, chain := range p.chains {
+		close(chain)
+	}
+	p.done.Wait()
+	for _, err := range p.errs {
+		if err != nil {
+			return p.errs
+		}
+	}
+	return nil
+}
+
+// chainForwarder streams the image to a single neighbor. If the neighbor
+// breaks away, relink is asked for replacements and the data a replacement
+// is missing is taken from replay.
+type chainForwarder struct {
+	neighbor string   // IP:port the neighbor receives on
+	adopted  []string // additional replacements for a dead neighbor
+	offset   int64    // bytes forwarded so far
+	size     int64    // total size of image stream
+	relink   func(deadNeighbor string) []string
+	replay   func(from, to int64) (io.ReadCloser, error)
+	err      error // why forwarding was given up
+	pr       *io.PipeReader
+	pw       *io.PipeWriter
+	result   chan error
+}
+
+// connect sets up the PUT to current neighbor, starting at stream offset start
+func (f *chainForwarder) connect(start int64) {
+	pr, pw := io.Pipe()
+	result := make(chan error, 1)
+	f.pr, f.pw, f.result = pr, pw, result
+
+	url := fmt.Sprintf("%s://%s/receiveImage", scheme, f.neighbor)
+	request, _ := http.NewRequest("PUT", url, pr)
+	request.ContentLength = f.size - start
+	request.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, f.size-1, f.size))
+	go func() {
+		log.Printf("Connecting to neighbor %s (offset %d)", f.neighbor, start)
+		response, err := http.DefaultClient.Do(request)
+		if err == nil {
+			ioutil.ReadAll(response.Body)
+			response.Body.Close()
+			if response.StatusCode != http.StatusOK {
+				err = fmt.Errorf("neighbor responded with status %d", response.StatusCode)
+			}
+		}
+		if err != nil {
+			// unblock pending writes if request ended prematurely
+			pr.CloseWithError(err)
+		}
+		result <- err
+	}()
+}
+
+func (f *chainForwarder) Write(p []byte) (int, error) {
+	pending := p
+	for f.neighbor != "" {
+		err := f.write(pending)
+		if err == nil {
+			break
+		}
+		pending = p[f.recover(err, p):]
+	}
+	f.offset += int64(len(p))
+	return len(p), nil
+}
+
+// write passes p to current neighbor, failing if neighbor blocks too long
+func (f *chainForwarder) write(p []byte) error {
+	pr := f.pr
+	timer := time.AfterFunc(LINK_TIMEOUT, func() { pr.CloseWithError(errLinkTimeout) })
+	_, err := f.pw.Write(p)
+	timer.Stop()
+	return err
+}
+
+// recover replaces a broken neighbor and replays the data it is missing;
+// returns how many bytes of the pending write it has received already
+func (f *chainForwarder) recover(cause error, pending []byte) int {
+	log.Printf("Forwarding to %s failed: %s", f.neighbor, cause)
+	f.pw.CloseWithError(cause)
+	return f.attach(cause, pending)
+}
+
+// attach connects to f.neighbor at the offset it has reached, replaying the
+// data it is missing. If it fails, neighbor is replaced via relink first.
+// A neighbor may have received part of the pending write before the link
+// broke; attach returns the number of those bytes, to be skipped.
+func (f *chainForwarder) attach(cause error, pending []byte) int {
+	for f.neighbor != "" {
+		if cause != nil {
+			replacements := f.relink(f.neighbor)
+			if len(replacements) == 0 {
+				log.Printf("No live neighbor left for %s, not forwarding", f.neighbor)
+				f.err = fmt.Errorf("no live neighbor left for %s (%s)", f.neighbor, cause)
+				f.neighbor = ""
+				return 0
+			}
+			f.neighbor = replacements[0]
+			f.adopted = append(f.adopted, replacements[1:]...)
+		}
+		start, err := queryStreamOffset(f.neighbor)
+		if err != nil {
+			cause = err
+			continue
+		}
+		if end := f.offset + int64(len(pending)); start > end {
+			// relink would return the same, live neighbor
+			log.Printf("Neighbor %s is ahead of us (%d > %d), not forwarding", f.neighbor, start, end)
+			f.neighbor = ""
+			return 0
+		}
+		f.connect(start)
+		if start >= f.offset {
+			return int(start - f.offset)
+		}
+		log.Printf("Replaying bytes %d-%d to %s", start, f.offset-1, f.neighbor)
+		if cause = f.replayRange(start, f.offset); cause == nil {
+			return 0
+		}
+		log.Printf("Forwarding to %s failed: %s", f.neighbor, cause)
+		f.pw.CloseWithError(cause)
+	}
+	return 0
+}
+
+func (f *chainForwarder) replayRange(from, to int64) error {
+	reader, err := f.replay(from, to)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	buf := make([]byte, 1048576)
+	for from < to {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := f.write(buf[:n]); err != nil {
+				return err
+			}
+			from += int64(n)
+		}
+		if err == io.EOF && from < to {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close completes the stream and waits for the neighbor to confirm
+// reception; it fails if no neighbor was left to forward to
+func (f *chainForwarder) Close() error {
+	for f.neighbor != "" {
+		f.pw.Close()
+		err := <-f.result
+		if err == nil {
+			return nil
+		}
+		f.recover(err, nil)
+	}
+	return f.err
+}
+
+// queryStreamOffset asks the client receiving on address (IP:port) how many
+// bytes of the image stream it has received
+func queryStreamOffset(address string) (int64, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	response, err := client.Head(fmt.Sprintf("%s://%s/receiveImage", scheme, address))
+	if err != nil {
+		return 0, err
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s responded with status %d", address, response.StatusCode)
+	}
+	return strconv.ParseInt(response.Header.Get(HEADER_OFFSET), 10, 64)
+}
+
+// parseContentRange returns start offset and total size of a PUT request
+func parseContentRange(request *http.Request) (int64, int64, error) {
+	contentRange := request.Header.Get("Content-Range")
+	if contentRange == "" {
+		return 0, request.ContentLength, nil
+	}
+	var start, end, size int64
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &size); err != nil {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", contentRange)
+	}
+	if start < 0 || start > size || end >= size {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", contentRange)
+	}
+	return start, size, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		contentRange  string
+		contentLength int64
+		start, size   int64
+		fails         bool
+	}{
+		{"", 1000, 0, 1000, false},
+		{"bytes 0-999/1000", 1000, 0, 1000, false},
+		{"bytes 500-999/1000", 500, 500, 1000, false},
+		{"bytes 999-999/1000", 1, 999, 1000, false},
+		{"bytes 1000-999/0", 0, 0, 0, true},
+		{"bytes 0-0/0", 0, 0, 0, true},
+		{"bytes 0-1000/1000", 1001, 0, 0, true},
+		{"bytes 1001-1001/1000", 0, 0, 0, true},
+		{"bytes -1-999/1000", 1001, 0, 0, true},
+		{"bytes 0-999/*", 1000, 0, 0, true},
+		{"items 0-999/1000", 1000, 0, 0, true},
+		{"garbage", 1000, 0, 0, true},
+	}
+	for _, test := range tests {
+		request, _ := http.NewRequest("PUT", "http://localhost/receiveImage", nil)
+		request.ContentLength = test.contentLength
+		if test.contentRange != "" {
+			request.Header.Set("Content-Range", test.contentRange)
+		}
+		start, size, err := parseContentRange(request)
+		if test.fails {
+			if err == nil {
+				t.Errorf("%q: no error", test.contentRange)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %s", test.contentRange, err)
+		} else if start != test.start || size != test.size {
+			t.Errorf("%q: got start %d size %d, want %d %d", test.contentRange, start, size, test.start, test.size)
+		}
+	}
+}
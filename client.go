@@ -2,17 +2,32 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"compress/bzip2"
+	"compress/gzip"
+	"crypto/ecdsa"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/fatih/color"
+	"github.com/klauspost/compress/zstd"
+	"hash"
 	"io"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -20,6 +35,8 @@ var red = color.New(color.FgRed).SprintFunc()
 var blue = color.New(color.FgBlue).SprintFunc()
 var cTask ClientTask
 
+const PUT_ATTEMPTS = 10
+
 func runClient() {
 	if pusherIP == "" {
 		fmt.Printf("%s: -pusher flag required. Use -h for help.\n", red("ERROR"))
@@ -41,146 +58,397 @@ func runClient() {
 	// report_ready() -- defer by 2 seconds; start image reception first
 	go func() {
 		time.Sleep(2 * time.Second)
-		if cTask.ClientInfo.Neighbor == "" {
-			ssurl := fmt.Sprintf("http://%s:8080/startStream/%s", pusherIP, cTask.ClientInfo.Group)
-			log.Printf("Requesting startStream from master: %s", ssurl)
-			ssreq, err := http.Get(ssurl)
+		if len(cTask.ClientInfo.Neighbors) == 0 {
+			request := masterRequest("/startStream/" + cTask.ClientInfo.Group)
+			log.Printf("Requesting startStream from master: %s", request.URL)
+			ssreq, err := http.DefaultClient.Do(request)
 			if err != nil || ssreq.StatusCode != 200 {
-				log.Fatalf("FAILED to request startStream from %s (%d)", ssurl, ssreq.StatusCode)
+				log.Fatalf("FAILED to request startStream from %s (%d)", request.URL, ssreq.StatusCode)
 			}
 			ssreq.Body.Close()
 		}
 	}()
 
 	// run http listener for image reception
-	log.Printf("Waiting for PUT request ...")
 	http.HandleFunc("/receiveImage", receiveImageHandler)
-	err := http.ListenAndServe(":8080", nil)
+	http.HandleFunc("/probePeers", probePeersHandler) // master measures RTTs for chain ordering
+	if cTask.ClientInfo.Transport == TRANSPORT_MULTICAST {
+		go receiveMulticast()
+	} else {
+		log.Printf("Waiting for PUT request ...")
+	}
+	err := listenAndServe(fmt.Sprintf(":%d", cTask.ClientInfo.dataPort()), listenerTLS)
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
+// imageStream outlives single /receiveImage requests: if upstream breaks away,
+// the replacement upstream resumes at offset, feeding the same pipe
+type imageStream struct {
+	mutex    sync.Mutex     // one upstream at a time
+	offset   int64          // bytes received so far, accessed atomically
+	pw       *io.PipeWriter // feeds storeImage()
+	done     chan bool
+	upstream *http.ResponseController
+	upMutex  sync.Mutex // guards upstream
+}
+
+var stream imageStream
+
 func receiveImageHandler(w http.ResponseWriter, request *http.Request) {
-	log.Printf("/receiveImage starting (source: %s)", request.RemoteAddr)
-	outfile, err := os.Create(cTask.ImageInfo.Destination)
+	if request.Method == "HEAD" {
+		w.Header().Set(HEADER_OFFSET, strconv.FormatInt(atomic.LoadInt64(&stream.offset), 10))
+		return
+	}
+	start, size, err := parseContentRange(request)
 	if err != nil {
-		log.Fatalf("ERROR: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	defer outfile.Close()
+	log.Printf("/receiveImage starting (source: %s, offset: %d)", request.RemoteAddr, start)
 
-	if cTask.ClientInfo.Neighbor == "" {
-		// WRITE LOCALLY ONLY
-		log.Print("/receiveImage ... starting in local-write-only mode")
-		go reportClientStatus(STATUS_BUSY)
-		outfileWriter := bufio.NewWriter(outfile)
-		if cTask.ImageInfo.Compression == COMP_BZIP2 {
-			reader := bzip2.NewReader(request.Body)
-			outfileWriter.ReadFrom(reader)
-		} else {
-			reader := bufio.NewReader(request.Body)
-			reader.WriteTo(outfileWriter)
-		}
-		outfileWriter.Flush()
-		outfile.Sync()
-	} else {
-		// WRITE LOCALLY AND STREAM TO NEIGHBOR
-		// http://stackoverflow.com/questions/1821811/how-to-read-write-from-to-file
-		url := fmt.Sprintf("http://%s:8080/receiveImage", cTask.ClientInfo.Neighbor)
+	// a new upstream supersedes a stale one that may still block in Read()
+	stream.upMutex.Lock()
+	if stream.upstream != nil {
+		stream.upstream.SetReadDeadline(time.Now())
+	}
+	stream.upMutex.Unlock()
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+	upstream := http.NewResponseController(w)
+	stream.upMutex.Lock()
+	stream.upstream = upstream
+	stream.upMutex.Unlock()
+
+	offset := atomic.LoadInt64(&stream.offset)
+	if stream.pw == nil && start == 0 {
 		pr, pw := io.Pipe()
-		fwdRequest, err := http.NewRequest("PUT", url, pr)
-		fwdRequest.ContentLength = request.ContentLength
-		if err != nil {
-			log.Fatalf("CANNOT FORWARD: %s", err)
-		}
-		fwdClient := &http.Client{}
-
-		// create forward connection
-		go func() {
-			log.Print("/receiveImage ... connection to neighbor set up")
-			_, ferr := fwdClient.Do(fwdRequest)
-			if ferr != nil {
-				log.Fatalf("Forwarding error: %s", ferr)
-			}
-			pr.Close()
-		}()
-		log.Print("/receiveImage ... storing and forwarding now...")
-		go reportClientStatus(STATUS_BUSY)
+		stream.pw = pw
+		stream.done = make(chan bool)
+		go storeImage(pr, size)
+	} else if stream.pw == nil || start > offset {
+		w.Header().Set(HEADER_OFFSET, strconv.FormatInt(offset, 10))
+		http.Error(w, "Requested range not satisfiable", http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
 
-		// tee is a reader on request.body, copying to pipe-forward to neighbor
-		tee := io.TeeReader(request.Body, pw)
+	// skip data we already have, pass the rest on to storeImage()
+	if _, err = io.CopyN(ioutil.Discard, request.Body, offset-start); err == nil {
 		buf := make([]byte, 1048576)
-
-		// Switch BZIP2 or not
-		if cTask.ImageInfo.Compression == COMP_BZIP2 {
-			// BZIP2
-			log.Printf("/receiveImage ... starting in BZ2 forwarding mode (to: %s)", cTask.ClientInfo.Neighbor)
-			decompReader := bzip2.NewReader(tee)
-			// now store and forward...
-			for {
-				// read a chunk -- read on T (here, via decompReader) automatically writes to pipe
-				n, err := decompReader.Read(buf)
-				if err != nil && err != io.EOF {
-					panic(err)
-				}
-				if n == 0 {
+		for {
+			n, rerr := request.Body.Read(buf)
+			if n > 0 {
+				if _, err = stream.pw.Write(buf[:n]); err != nil {
 					break
 				}
-				// write a chunk to disk
-				if _, err := outfile.Write(buf[:n]); err != nil {
-					panic(err)
-				}
+				atomic.AddInt64(&stream.offset, int64(n))
 			}
-		} else {
-			// NON-BZIP2
-			log.Printf("/receiveImage ... starting in NONBZ2 forwarding mode (to: %s)", cTask.ClientInfo.Neighbor)
-			// now store and forward...
-			for {
-				// read a chunk -- read on T automatically writes to pipe
-				n, err := tee.Read(buf)
-				if err != nil && err != io.EOF {
-					panic(err)
-				}
-				if n == 0 {
-					break
-				}
-				// write a chunk to disk
-				if _, err := outfile.Write(buf[:n]); err != nil {
-					panic(err)
+			if rerr != nil {
+				if rerr != io.EOF {
+					err = rerr
 				}
+				break
 			}
 		}
+	}
+	if err != nil {
+		log.Printf("/receiveImage ... upstream lost at offset %d (%s), waiting for resume", atomic.LoadInt64(&stream.offset), err)
+		return
+	}
+	stream.pw.Close()
+	<-stream.done
+}
+
+// storeImage writes the image stream to disk, forwarding it to our neighbor
+func storeImage(source io.Reader, size int64) {
+	defer close(stream.done)
+	progress := newProgressReporter(size)
+	source = progress.timeUpstream(source)
+
+	// checksum is fed either the image file as stored on master or the
+	// decompressed data, depending on checksumOf setting of image
+	checksum := newImageChecksum(cTask.ImageInfo)
+	if checksum != nil && cTask.ImageInfo.ChecksumOf != CHECKSUM_DATA {
+		source = io.TeeReader(source, checksum)
+	}
+
+	// hosts assigned to our group at runtime may have become our neighbors
+	neighbors := getNeighbors()
+	if len(neighbors) == 0 {
+		log.Print("/receiveImage ... starting in local-write-only mode")
+	} else {
+		log.Printf("/receiveImage ... starting in %s forwarding mode (to: %s)", cTask.ImageInfo.Compression, strings.Join(neighbors, ", "))
+	}
+	forwarder := newTreeForwarder(neighbors, size, relinkNeighbor, replayImage)
+	go reportClientStatus(STATUS_BUSY, "")
+
+	// tee is a reader on image stream, copying to neighbor
+	tee := io.TeeReader(source, progress.timeNeighbors(forwarder))
 
-		// bzip2 or not...:
-		log.Print("/receiveImage ... closing filehandles")
-		outfile.Sync()
-		pw.Close()
+	// decompress if required -- reads on decompReader still pass through tee
+	decompReader, err := newDecompressReader(cTask.ImageInfo.Compression, tee)
+	if err != nil {
+		failClient(err)
+	}
+	defer decompReader.Close()
+	var reader io.Reader = decompReader
+	if checksum != nil && cTask.ImageInfo.ChecksumOf == CHECKSUM_DATA {
+		reader = io.TeeReader(reader, checksum)
+	}
+	// now store and forward...
+	if err := writeImage(cTask.ImageInfo.Type, progress.countWritten(reader), cTask.ImageInfo.Destination); err != nil {
+		failClient(err)
+	}
+	io.Copy(ioutil.Discard, reader)
+
+	// forward any trailing bytes the decompressor did not consume
+	io.Copy(ioutil.Discard, tee)
+
+	log.Print("/receiveImage ... closing filehandles")
+	if err := forwarder.Close(); err != nil {
+		log.Printf("Forwarding error: %s", err)
 	}
 
+	progress.stop()
 	log.Print("/receiveImage completed")
+	if checksum != nil {
+		if err := checksum.verify(); err != nil {
+			log.Printf("%s: %s", red("CHECKSUM MISMATCH"), err)
+			go reportClientStatus(STATUS_CHECKSUM_BAD, err.Error())
+			return
+		}
+		log.Print("/receiveImage ... checksum verified")
+	}
 	if cTask.ImageInfo.PostImage != "" {
 		log.Printf("Running postImage command: %s", cTask.ImageInfo.PostImage)
 		execScript(cTask.ImageInfo.PostImage)
 		log.Printf("postImage script completed")
 	}
-	go reportClientStatus(STATUS_DONE_OK)
+	go reportClientStatus(STATUS_DONE_OK, "")
 }
 
-func getTask() ClientTask {
-	log.Printf("Retrieving task from %s ...", pusherIP)
-	apiUrl := fmt.Sprintf("http://%s:8080/getClientTask", pusherIP)
-	response, err := http.Get(apiUrl)
+// writeImage stores the (decompressed) image data r to destination
+func writeImage(imageType string, r io.Reader, destination string) error {
+	switch imageType {
+	case IMG_TAR:
+		// TAR images are extracted into destination folder
+		return extractTar(r, destination)
+	case IMG_DISK:
+		return writeDisk(r, destination)
+	}
+	outfile, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+	defer outfile.Close()
+	if imageType == IMG_SPARSE {
+		err = writeSparse(r, outfile)
+	} else {
+		_, err = io.CopyBuffer(outfile, r, make([]byte, 1048576))
+	}
+	if err != nil {
+		return err
+	}
+	return outfile.Sync()
+}
+
+// masterAddr returns host:port of master
+func masterAddr() string {
+	return net.JoinHostPort(pusherIP, strconv.Itoa(pusherPort))
+}
+
+// masterRequest returns a GET request of path on master, identifying us by
+// the client ID we received with our task
+func masterRequest(path string) *http.Request {
+	request, _ := http.NewRequest("GET", fmt.Sprintf("%s://%s%s", scheme, masterAddr(), path), nil)
+	request.Header.Set(HEADER_CLIENT_ID, cTask.ClientID)
+	return request
+}
+
+// getNeighbors asks master for the addresses of the hosts we forward to;
+// hosts entries may name them by MAC etc., master knows their current IP
+func getNeighbors() []string {
+	response, err := http.DefaultClient.Do(masterRequest("/getNeighbors"))
 	if err != nil {
 		log.Fatalf("%s: Cannot contact master: %s", red("ERROR"), err)
 	}
-	if response.StatusCode == http.StatusNotFound {
-		log.Fatalf("%s: Client not found in any groups on master (404)", red("ERROR"))
-	} else if response.StatusCode != http.StatusOK {
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		log.Fatalf("%s: Bad response from server (%d)", red("ERROR"), response.StatusCode)
+	}
+	var neighbors []string
+	json.NewDecoder(response.Body).Decode(&neighbors)
+	return neighbors
+}
+
+// relinkNeighbor reports a broken neighbor to master, which returns replacements
+func relinkNeighbor(deadNeighbor string) []string {
+	request := masterRequest("/relinkNeighbor/" + deadNeighbor)
+	log.Printf("Requesting new neighbor from master: %s", request.URL)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		log.Fatalf("%s: Cannot contact master: %s", red("ERROR"), err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		log.Fatalf("%s: Bad response from server (%d)", red("ERROR"), response.StatusCode)
+	}
+	var replacements []string
+	json.NewDecoder(response.Body).Decode(&replacements)
+	return replacements
+}
+
+// replayImage retrieves a range of the image file from master
+func replayImage(from, to int64) (io.ReadCloser, error) {
+	request := masterRequest("/getImage/" + cTask.ClientInfo.Image)
+	request.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", from, to-1))
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusPartialContent {
+		response.Body.Close()
+		return nil, fmt.Errorf("bad response from master (%d)", response.StatusCode)
+	}
+	return response.Body, nil
+}
+
+// newDecompressReader wraps r according to the image's compression setting.
+// Decompression runs in its own goroutine(s), so it does not compete with
+// disk writes for a single core.
+func newDecompressReader(compression string, r io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case COMP_BZIP2:
+		return readAhead(bzip2.NewReader(r)), nil
+	case COMP_GZIP:
+		gzReader, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return readAhead(gzReader), nil
+	case COMP_XZ:
+		// xz decodes blocks concurrently, using all cores (if sizes are recorded)
+		return newXZReader(r), nil
+	case COMP_ZSTD:
+		// zstd decodes blocks concurrently, using all cores
+		zReader, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(runtime.NumCPU()))
+		if err != nil {
+			return nil, err
+		}
+		return zReader.IOReadCloser(), nil
+	case COMP_NONE:
+		return ioutil.NopCloser(r), nil
+	}
+	return nil, fmt.Errorf("unsupported compression %s", compression)
+}
+
+// readAhead decouples the (single-threaded) decoder r from its consumer
+func readAhead(r io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := io.Copy(pw, r)
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// imageChecksum hashes image data while it streams by
+type imageChecksum struct {
+	md5          hash.Hash
+	sha256       hash.Hash
+	expectMd5    string
+	expectSha256 string
+}
+
+// newImageChecksum returns nil if image defines neither md5 nor sha256
+func newImageChecksum(img Image) *imageChecksum {
+	if img.Md5 == "" && img.Sha256 == "" {
+		return nil
+	}
+	c := &imageChecksum{expectMd5: strings.ToLower(img.Md5), expectSha256: strings.ToLower(img.Sha256)}
+	if c.expectMd5 != "" {
+		c.md5 = md5.New()
+	}
+	if c.expectSha256 != "" {
+		c.sha256 = sha256.New()
+	}
+	return c
+}
+
+func (c *imageChecksum) Write(p []byte) (int, error) {
+	if c.md5 != nil {
+		c.md5.Write(p)
+	}
+	if c.sha256 != nil {
+		c.sha256.Write(p)
+	}
+	return len(p), nil
+}
+
+func (c *imageChecksum) verify() error {
+	if c.md5 != nil {
+		if sum := hex.EncodeToString(c.md5.Sum(nil)); sum != c.expectMd5 {
+			return fmt.Errorf("md5 is %s, expected %s", sum, c.expectMd5)
+		}
+	}
+	if c.sha256 != nil {
+		if sum := hex.EncodeToString(c.sha256.Sum(nil)); sum != c.expectSha256 {
+			return fmt.Errorf("sha256 is %s, expected %s", sum, c.expectSha256)
+		}
+	}
+	return nil
+}
+
+func getTask() ClientTask {
+	log.Printf("Retrieving task from %s ...", pusherIP)
+	if useTLS {
+		setupClientTLS()
+	}
+	apiUrl := fmt.Sprintf("%s://%s/getClientTask?%s", scheme, masterAddr(), identityQuery())
+	if clientListenPort != 0 {
+		// master tells our neighbors to stream to this port
+		apiUrl += fmt.Sprintf("&port=%d", clientListenPort)
+	}
+	var response *http.Response
+	var err error
+	var key *ecdsa.PrivateKey
+	var csr []byte
+	if useTLS {
+		// master issues our certificate along with the task
+		key, csr = newCertificateRequest()
+	}
+	for {
+		if useTLS {
+			response, err = http.Post(apiUrl, "application/x-pem-file", bytes.NewReader(csr))
+		} else {
+			response, err = http.Get(apiUrl)
+		}
+		if err != nil {
+			log.Fatalf("%s: Cannot contact master: %s", red("ERROR"), err)
+		}
+		if response.StatusCode == http.StatusNotFound {
+			// master lists us as unassigned until an operator assigns us to a group
+			log.Printf("Client not found in any groups on master (404), waiting for assignment ...")
+		} else if response.StatusCode == http.StatusForbidden {
+			// e.g. our address is new, until an operator confirms it
+			reason, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+			log.Printf("Master refused our task (403): %s, retrying ...", strings.TrimSpace(string(reason)))
+		} else {
+			break
+		}
+		response.Body.Close()
+		time.Sleep(ENROLL_POLL_INTERVAL)
+	}
+	if response.StatusCode != http.StatusOK {
 		log.Fatalf("%s: Bad response from server (%d)", red("ERROR"), response.StatusCode)
 	}
 	defer response.Body.Close()
 	var task ClientTask
 	json.NewDecoder(response.Body).Decode(&task)
+	if useTLS {
+		listenerTLS = clientServerTLS(task, key)
+	}
 	task.ImageInfo.PreImage = strings.Replace(task.ImageInfo.PreImage, "#MASTER#", pusherIP, -1)
 	task.ImageInfo.PostImage = strings.Replace(task.ImageInfo.PostImage, "#MASTER#", pusherIP, -1)
 	printTask(task)
@@ -204,59 +472,152 @@ func printTask(t ClientTask) {
 	fmt.Printf("Image type        : %s\n", t.ImageInfo.Type)
 	fmt.Printf("Image compression : %s\n", t.ImageInfo.Compression)
 	fmt.Printf("Image md5         : %s\n", t.ImageInfo.Md5)
+	fmt.Printf("Image sha256      : %s\n", t.ImageInfo.Sha256)
 	fmt.Printf("Image destination : %s\n", t.ImageInfo.Destination)
 	fmt.Printf("PreImage script   : %s\n", t.ImageInfo.PreImage)
 	fmt.Printf("PostImage script  : %s\n", t.ImageInfo.PostImage)
 
-	if t.ClientInfo.Neighbor != "" {
-		fmt.Printf("Streaming to      : %s\n", t.ClientInfo.Neighbor)
+	if t.ClientInfo.Transport == TRANSPORT_MULTICAST {
+		fmt.Printf("Multicast group   : %s\n", t.ClientInfo.MulticastAddr)
+	} else if len(t.ClientInfo.Neighbors) != 0 {
+		fmt.Printf("Streaming to      : %s\n", strings.Join(t.ClientInfo.Neighbors, ", "))
 	} else {
 		fmt.Println("Last client in chain, not forwarding stream")
 	}
 }
 
-func reportClientStatus(status string) {
-	ssurl := fmt.Sprintf("http://%s:8080/setClientStatus/%s", pusherIP, status)
-	log.Printf("Reporting client status: %s", ssurl)
-	ssreq, err := http.Get(ssurl)
+// reportClientStatus tells master our status; reason explains a failure
+func reportClientStatus(status string, reason string) {
+	path := "/setClientStatus/" + status
+	if reason != "" {
+		path += "?" + url.Values{"reason": {reason}}.Encode()
+	}
+	request := masterRequest(path)
+	log.Printf("Reporting client status: %s", request.URL)
+	ssreq, err := http.DefaultClient.Do(request)
 	if err != nil || ssreq.StatusCode != 200 {
 		log.Fatalf("FAILED to report status %s (%d)", status, ssreq.StatusCode)
 	}
 	ssreq.Body.Close()
 }
 
+// failClient reports err to master and exits
+func failClient(err error) {
+	log.Printf("%s: %s", red("ERROR"), err)
+	reportClientStatus(STATUS_ERROR, err.Error())
+	os.Exit(1)
+}
+
 func putImage() {
-	// upload new image to master from file/device
+	// upload new image to master from file/device; resumes interrupted uploads
+	if useTLS {
+		setupClientTLS()
+	}
 	basename := filepath.Base(imageToUpload)
-	url := fmt.Sprintf("http://%s:8080/saveImage/%s", pusherIP, basename)
+	if uploadSparse {
+		basename += ".sparse"
+	}
+	url := fmt.Sprintf("%s://%s/saveImage/%s", scheme, masterAddr(), basename)
 	fmt.Printf("PUT %s\n", url)
+	// refused uploads fail before the whole device is read
+	checkUploadAllowed(url)
 	f, err := os.Open(imageToUpload)
 	if err != nil {
 		log.Fatalf("Cannot open %s: %s", imageToUpload, err)
 	}
 	defer f.Close()
 
-	reader := bufio.NewReader(f)
-	request, err := http.NewRequest("PUT", url, reader)
+	// Stat() reports zero size for devices, so seek to end instead
+	size, err := f.Seek(0, io.SeekEnd)
 	if err != nil {
-		log.Fatalf("Cannot PUT -- server running?")
+		log.Fatal("Cannot determine size of file")
 	}
-	fileinfo, err := f.Stat()
-	if err != nil {
-		log.Fatal("Cannot stat() file")
+	var source io.ReadSeeker = f
+	if uploadSparse {
+		fmt.Printf("Scanning %s for zero blocks ...\n", imageToUpload)
+		sparse, err := newSparseReader(f, size)
+		if err != nil {
+			log.Fatalf("Cannot read %s: %s", imageToUpload, err)
+		}
+		fmt.Printf("Uploading %d extents, %d of %d bytes\n", len(sparse.extents), sparse.size, size)
+		source, size = sparse, sparse.size
 	}
-	request.ContentLength = fileinfo.Size()
 
-	client := &http.Client{}
-	response, ferr := client.Do(request)
-	if ferr != nil {
-		log.Fatalf("PUT FAILED: %s", err)
-	} else {
-		defer response.Body.Close()
-		if response.StatusCode == http.StatusOK {
+	for attempt := 1; ; attempt++ {
+		err := putImageFrom(source, url, size)
+		if err == nil {
 			fmt.Println("PUT completed successfully")
-		} else {
-			fmt.Printf("PUT failed with status %d (403 = File exists)\n", response.StatusCode)
+			return
 		}
+		if attempt == PUT_ATTEMPTS {
+			log.Fatalf("PUT FAILED: %s", err)
+		}
+		fmt.Printf("PUT interrupted (%s), resuming in 5 seconds...\n", err)
+		time.Sleep(5 * time.Second)
+	}
+}
+
+// putImageFrom uploads f, starting at the offset master already has
+func putImageFrom(f io.ReadSeeker, url string, size int64) error {
+	client := &http.Client{}
+	offset := int64(0)
+	request, _ := http.NewRequest("HEAD", url, nil)
+	request.Header.Set(HEADER_UPLOAD_TOKEN, uploadToken)
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	checkUploadRefused(response)
+	if response.StatusCode == http.StatusOK {
+		offset, _ = strconv.ParseInt(response.Header.Get(HEADER_OFFSET), 10, 64)
+	}
+	if offset > size {
+		offset = 0
+	}
+	if offset > 0 {
+		fmt.Printf("Resuming upload at offset %d\n", offset)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	request, err = http.NewRequest("PUT", url, bufio.NewReader(f))
+	if err != nil {
+		log.Fatalf("Cannot PUT -- server running?")
+	}
+	request.Header.Set(HEADER_UPLOAD_TOKEN, uploadToken)
+	request.ContentLength = size - offset
+	if size > 0 {
+		request.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, size-1, size))
+	}
+	response, err = client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	checkUploadRefused(response)
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// checkUploadAllowed exits if master refuses uploading to url
+func checkUploadAllowed(url string) {
+	request, _ := http.NewRequest("HEAD", url, nil)
+	request.Header.Set(HEADER_UPLOAD_TOKEN, uploadToken)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		log.Fatalf("%s: Cannot contact master: %s", red("ERROR"), err)
+	}
+	response.Body.Close()
+	checkUploadRefused(response)
+}
+
+// checkUploadRefused exits if master refused the upload; retrying won't help
+func checkUploadRefused(response *http.Response) {
+	if response.StatusCode == http.StatusForbidden || response.StatusCode == http.StatusRequestEntityTooLarge {
+		log.Fatalf("PUT refused by master (%s), see master log for reason", response.Status)
 	}
 }
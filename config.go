@@ -1,16 +1,18 @@
 package main
 
 import (
+	"fmt"
 	"github.com/hashicorp/hcl"
 	"io/ioutil"
-	"log"
 	"os"
+	"strings"
 )
 
 type Config struct {
-	// hosts_allow []string IPs
 	Images       []Image       `hcl:"image"`
 	Clientgroups []Clientgroup `hcl:"clientgroup"`
+	Upload       Upload        `hcl:"upload"` // policy for /saveImage, see upload.go
+	Hosts        []HostLabels  `hcl:"host"`   // locations of hosts, see ordering.go
 }
 
 type Image struct {
@@ -18,6 +20,8 @@ type Image struct {
 	Filename    string `hcl:"filename"`
 	Comment     string `hcl:"comment"`
 	Md5         string `hcl:"md5"`
+	Sha256      string `hcl:"sha256"`
+	ChecksumOf  string `hcl:"checksumOf"` // FILE (default) or DATA
 	Destination string `hcl:"destination"`
 	Type        string `hcl:"type"`
 	Compression string `hcl:"compression"`
@@ -27,49 +31,165 @@ type Image struct {
 }
 
 type Clientgroup struct {
-	Name  string   `hcl:",key"`
-	Image string   `hcl:"image"`
-	Hosts []string `hcl:"hosts"`
+	Name       string   `hcl:",key"`
+	Image      string   `hcl:"image"`
+	Hosts      []string `hcl:"hosts"`      // IP, MAC, DMI UUID or hostname of clients
+	Fanout     int      `hcl:"fanout"`     // max. neighbors each host forwards to; 1 (default) is a chain
+	ClientPort int      `hcl:"clientPort"` // data port clients receive the image on, defaults to DEFAULT_PORT
+	// transport "chain" (default) streams via HTTP PUT from host to host,
+	// "multicast" sends UDP datagrams to all hosts at once
+	Transport     string `hcl:"transport"`
+	MulticastAddr string `hcl:"multicastAddr"` // group:port, defaults to MCAST_DEFAULT_ADDR
+	MulticastRate int    `hcl:"multicastRate"` // Mbit/s, defaults to MCAST_DEFAULT_RATE
+	// start streaming after readyTimeout seconds with minReady hosts ready,
+	// dropping the others; see quorum.go
+	ReadyTimeout int `hcl:"readyTimeout"`
+	MinReady     int `hcl:"minReady"`
+	// start "auto" (default) streams once hosts are ready, "manual" when
+	// an operator starts the group; see start.go
+	Start string `hcl:"start"`
+	// ordering of the chain: "list" (default), "topology" or "measured"
+	Ordering string `hcl:"ordering"`
+	// split hosts into this many chains, fed in parallel by master
+	Chains int `hcl:"chains"`
 }
 
-func readConfig(filename string) {
+// readConfig reads and verifies the configuration in filename
+func readConfig(filename string) (Config, error) {
 	var result Config
 	fileContents, err := ioutil.ReadFile(filename)
 	if err != nil {
-		log.Fatal("Cannot read config file ", filename)
+		return result, fmt.Errorf("Cannot read config file %s", filename)
 	}
 	hclParseTree, err := hcl.ParseBytes(fileContents)
 	if err != nil {
-		log.Fatal("Config parser error: ", err)
+		return result, fmt.Errorf("Config parser error: %s", err)
 	}
 	if err := hcl.DecodeObject(&result, hclParseTree); err != nil {
-		log.Fatal("Error decoding config: ", err)
+		return result, fmt.Errorf("Error decoding config: %s", err)
 	}
-	masterConfig = result
-	verifyConfig()
+	return result, verifyConfig(result)
 }
 
-func verifyConfig() {
-	for _, grp := range masterConfig.Clientgroups {
-		img := getImageByKey(grp.Image)
+func verifyConfig(config Config) error {
+	for _, grp := range config.Clientgroups {
+		for i, host := range grp.Hosts {
+			grp.Hosts[i] = normalizeHost(host)
+		}
+	}
+	for _, allowed := range config.Upload.Allow {
+		if _, _, err := parseCIDR(allowed); err != nil {
+			return fmt.Errorf("Invalid upload source %s: %s", allowed, err)
+		}
+	}
+	if config.Upload.MaxSize < 0 {
+		return fmt.Errorf("Invalid upload maxSize %d", config.Upload.MaxSize)
+	}
+	for _, grp := range config.Clientgroups {
+		img := config.imageByKey(grp.Image)
 		if img.Name == "" {
-			log.Fatalf("Invalid image %s for client group %s", grp.Image, grp.Name)
+			return fmt.Errorf("Invalid image %s for client group %s", grp.Image, grp.Name)
 		}
-		if img.Compression != COMP_NONE && img.Compression != COMP_BZIP2 {
-			log.Fatalf("Invalid compression %s for image %s. Supported: '%s' and '%s'", img.Compression, grp.Image, COMP_NONE, COMP_BZIP2)
+		switch img.Compression {
+		case COMP_NONE, COMP_BZIP2, COMP_GZIP, COMP_ZSTD, COMP_XZ:
+		default:
+			return fmt.Errorf("Invalid compression %s for image %s. Supported: %s", img.Compression, grp.Image,
+				strings.Join([]string{COMP_NONE, COMP_BZIP2, COMP_GZIP, COMP_ZSTD, COMP_XZ}, ", "))
+		}
+		if img.ChecksumOf != "" && img.ChecksumOf != CHECKSUM_FILE && img.ChecksumOf != CHECKSUM_DATA {
+			return fmt.Errorf("Invalid checksumOf %s for image %s. Supported: '%s' and '%s'", img.ChecksumOf, grp.Image, CHECKSUM_FILE, CHECKSUM_DATA)
 		}
 		if _, err := os.Stat(imageStorage + "/" + img.Filename); os.IsNotExist(err) {
-			log.Fatalf("Image '%s' of group %s does not exist", img.Filename, grp.Name)
+			return fmt.Errorf("Image '%s' of group %s does not exist", img.Filename, grp.Name)
+		}
+		if grp.Transport != "" && grp.Transport != TRANSPORT_CHAIN && grp.Transport != TRANSPORT_MULTICAST {
+			return fmt.Errorf("Invalid transport %s for group %s. Supported: '%s' and '%s'", grp.Transport, grp.Name, TRANSPORT_CHAIN, TRANSPORT_MULTICAST)
+		}
+		if grp.ClientPort < 0 || grp.ClientPort > 65535 {
+			return fmt.Errorf("Invalid clientPort %d for group %s", grp.ClientPort, grp.Name)
+		}
+		if grp.Fanout < 0 {
+			return fmt.Errorf("Invalid fanout %d for group %s", grp.Fanout, grp.Name)
+		}
+		if grp.Chains < 0 {
+			return fmt.Errorf("Invalid chains %d for group %s", grp.Chains, grp.Name)
+		}
+		if grp.Chains > 1 && grp.Transport == TRANSPORT_MULTICAST {
+			return fmt.Errorf("chains of group %s do not apply to multicast", grp.Name)
+		}
+		if useTLS && grp.Transport == TRANSPORT_MULTICAST {
+			// datagrams are neither encrypted nor authenticated
+			return fmt.Errorf("Multicast of group %s is not supported with TLS", grp.Name)
 		}
 		if len(grp.Hosts) == 0 {
-			log.Fatalf("Group %s has zero hosts defined", grp.Name)
+			return fmt.Errorf("Group %s has zero hosts defined", grp.Name)
+		}
+		if grp.ReadyTimeout < 0 {
+			return fmt.Errorf("Invalid readyTimeout %d for group %s", grp.ReadyTimeout, grp.Name)
+		}
+		if grp.MinReady < 0 || grp.MinReady > len(grp.Hosts) {
+			return fmt.Errorf("Invalid minReady %d for group %s of %d hosts", grp.MinReady, grp.Name, len(grp.Hosts))
+		}
+		if grp.MinReady > 0 && grp.ReadyTimeout == 0 {
+			return fmt.Errorf("minReady of group %s requires readyTimeout", grp.Name)
+		}
+		if grp.Start != "" && grp.Start != START_AUTO && grp.Start != START_MANUAL {
+			return fmt.Errorf("Invalid start %s for group %s. Supported: '%s' and '%s'", grp.Start, grp.Name, START_AUTO, START_MANUAL)
+		}
+		switch grp.Ordering {
+		case "", ORDERING_LIST, ORDERING_TOPOLOGY, ORDERING_MEASURED:
+		default:
+			return fmt.Errorf("Invalid ordering %s for group %s. Supported: %s", grp.Ordering, grp.Name,
+				strings.Join([]string{ORDERING_LIST, ORDERING_TOPOLOGY, ORDERING_MEASURED}, ", "))
 		}
+		if grp.Start == START_MANUAL && grp.ReadyTimeout > 0 {
+			return fmt.Errorf("readyTimeout of group %s does not apply to start = \"%s\"", grp.Name, START_MANUAL)
+		}
+	}
+	return nil
+}
+
+// chainCount returns the number of chains to split hostCount hosts into
+func (cgroup Clientgroup) chainCount(hostCount int) int {
+	if cgroup.Chains > hostCount {
+		return hostCount
+	}
+	if cgroup.Chains < 1 {
+		return 1
+	}
+	return cgroup.Chains
+}
+
+func (cgroup Clientgroup) clientPort() int {
+	if cgroup.ClientPort == 0 {
+		return DEFAULT_PORT
 	}
+	return cgroup.ClientPort
+}
+
+// currentConfig returns the live configuration. Reloads and runtime
+// assignments replace masterConfig and its slices instead of changing them,
+// so the returned snapshot stays consistent; handlers take one and use it
+// for the rest of a request.
+func currentConfig() Config {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+	return masterConfig
+}
+
+func setConfig(config Config) {
+	configMutex.Lock()
+	masterConfig = config
+	configMutex.Unlock()
 }
 
 func getImageByKey(key string) Image {
+	return currentConfig().imageByKey(key)
+}
+
+func (config Config) imageByKey(key string) Image {
 	// isn't there an easier way to look it up...?
-	for _, img := range masterConfig.Images {
+	for _, img := range config.Images {
 		if img.Name == key {
 			return img
 		}
@@ -79,8 +199,12 @@ func getImageByKey(key string) Image {
 }
 
 func getClientgroupByKey(key string) Clientgroup {
+	return currentConfig().clientgroupByKey(key)
+}
+
+func (config Config) clientgroupByKey(key string) Clientgroup {
 	// isn't there an easier way to look it up...?
-	for _, grp := range masterConfig.Clientgroups {
+	for _, grp := range config.Clientgroups {
 		if grp.Name == key {
 			return grp
 		}
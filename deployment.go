@@ -0,0 +1,299 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A deployment is a run of streaming an image to a client group. Runs are
+// journaled to HISTORY_FILE (see state.go) and served by /api/deployments.
+const (
+	DEPLOYMENT_RUNNING = "RUNNING"
+	DEPLOYMENT_DONE    = "DONE"   // all hosts received image
+	DEPLOYMENT_FAILED  = "FAILED" // some hosts or master failed
+)
+
+type Deployment struct {
+	ID            int
+	Group         string
+	Image         string
+	Filename      string
+	Transport     string
+	Chains        []DeploymentChain `json:",omitempty"` // chain transport only
+	Status        string
+	Error         string `json:",omitempty"` // why master failed streaming
+	Started       time.Time
+	Finished      *time.Time `json:",omitempty"`
+	BytesStreamed int64      // by master, including retransmissions
+	Hosts         []DeploymentHost
+}
+
+// DeploymentChain is a chain of a deployment, with hosts in streaming order
+type DeploymentChain struct {
+	Hosts  []string
+	Status string
+	Error  string `json:",omitempty"` // why master failed streaming to chain
+}
+
+// DeploymentHost is the result of a deployment on one host
+type DeploymentHost struct {
+	Host     string
+	Address  string
+	Status   string
+	Error    string          `json:",omitempty"`
+	Chain    int             `json:",omitempty"` // number of chain, if more than one
+	Progress *ClientProgress `json:",omitempty"`
+}
+
+var deployments []Deployment              // history, oldest first
+var runningDeployments = map[string]int{} // group name -> index in deployments
+
+// deploymentStarted records the start of streaming image to cgroup, via
+// chains unless multicast; returns the ID of the deployment
+func deploymentStarted(cgroup Clientgroup, image Image, chains [][]string) int {
+	mutex.Lock()
+	id := 1
+	if len(deployments) != 0 {
+		id = deployments[len(deployments)-1].ID + 1
+	}
+	transport := cgroup.Transport
+	if transport == "" {
+		transport = TRANSPORT_CHAIN
+	}
+	d := Deployment{ID: id, Group: cgroup.Name, Image: image.Name, Filename: image.Filename,
+		Transport: transport, Status: DEPLOYMENT_RUNNING, Started: time.Now(),
+		Hosts: deploymentHosts(getClientgroupByKey(cgroup.Name))} // including dropped hosts
+	for _, chain := range chains {
+		d.Chains = append(d.Chains, DeploymentChain{Hosts: chain, Status: DEPLOYMENT_RUNNING})
+	}
+	var superseded *Deployment
+	if i, ok := runningDeployments[cgroup.Name]; ok {
+		deployments[i].abort(fmt.Sprintf("superseded by deployment %d", id))
+		record := deployments[i]
+		superseded = &record
+	}
+	runningDeployments[cgroup.Name] = len(deployments)
+	deployments = append(deployments, d)
+	mutex.Unlock()
+	if superseded != nil {
+		log.Printf("Deployment %d to group %s finished: %s", superseded.ID, superseded.Group, superseded.Status)
+		appendHistory(*superseded)
+	}
+	log.Printf("Deployment %d of %s to group %s started", d.ID, d.Image, d.Group)
+	appendHistory(d)
+	return d.ID
+}
+
+// deploymentStreamed records the bytes master streamed to cgroup with
+// deployment id, which may have finished already. If streaming failed, a
+// running deployment is finished as failed right away; if only some of its
+// chains failed, these are marked failed and the others are waited for.
+func deploymentStreamed(id int, cgroup Clientgroup, streamed int64, err error) {
+	mutex.Lock()
+	i := len(deployments) - 1
+	for i >= 0 && deployments[i].ID != id {
+		i--
+	}
+	if i < 0 {
+		mutex.Unlock()
+		return
+	}
+	deployments[i].BytesStreamed = streamed
+	if errs, ok := err.(chainErrors); ok && !deployments[i].failChains(errs) {
+		err = nil
+	}
+	running, ok := runningDeployments[cgroup.Name]
+	stillRunning := ok && running == i
+	record := deployments[i]
+	mutex.Unlock()
+	if !stillRunning {
+		appendHistory(record)
+	} else if err != nil {
+		deploymentFinished(cgroup, err.Error())
+	}
+}
+
+// deploymentFinished records the end of the running deployment of cgroup;
+// reason is empty unless master failed streaming
+func deploymentFinished(cgroup Clientgroup, reason string) {
+	mutex.Lock()
+	i, ok := runningDeployments[cgroup.Name]
+	if !ok {
+		mutex.Unlock()
+		return
+	}
+	delete(runningDeployments, cgroup.Name)
+	d := &deployments[i]
+	finished := time.Now()
+	d.Finished, d.Status, d.Error, d.Hosts = &finished, DEPLOYMENT_DONE, reason, deploymentHosts(d.group())
+	if reason != "" {
+		d.Status = DEPLOYMENT_FAILED
+	}
+	for _, h := range d.Hosts {
+		if h.Status != STATUS_DONE_OK {
+			d.Status = DEPLOYMENT_FAILED
+		}
+	}
+	d.updateChains()
+	for _, chain := range d.Chains {
+		if chain.Status == DEPLOYMENT_FAILED {
+			d.Status = DEPLOYMENT_FAILED
+		}
+	}
+	record := *d
+	mutex.Unlock()
+	log.Printf("Deployment %d to group %s finished: %s", record.ID, record.Group, record.Status)
+	appendHistory(record)
+}
+
+// abort finishes running deployment d as failed for reason, without
+// waiting for its hosts
+func (d *Deployment) abort(reason string) {
+	finished := time.Now()
+	d.Finished, d.Status, d.Error = &finished, DEPLOYMENT_FAILED, reason
+	for i := range d.Chains {
+		if d.Chains[i].Status == DEPLOYMENT_RUNNING {
+			d.Chains[i].Status = DEPLOYMENT_FAILED
+		}
+	}
+}
+
+// failChains marks the chains of d master failed streaming to; returns
+// whether all of them failed
+func (d *Deployment) failChains(errs chainErrors) bool {
+	all := true
+	for i := range d.Chains {
+		if i < len(errs) && errs[i] != nil {
+			d.Chains[i].Status, d.Chains[i].Error = DEPLOYMENT_FAILED, errs[i].Error()
+		} else {
+			all = false
+		}
+	}
+	return all
+}
+
+// deploymentHosts returns the current state of the hosts of cgroup. Call
+// with mutex held.
+func deploymentHosts(cgroup Clientgroup) []DeploymentHost {
+	hosts := []DeploymentHost{}
+	for _, hostname := range cgroup.Hosts {
+		if sitsOut(hostname) {
+			continue
+		}
+		c := clients[hostname]
+		hosts = append(hosts, DeploymentHost{Host: hostname, Address: c.Address, Status: c.Status,
+			Error: c.Error, Chain: c.Chain, Progress: c.Progress})
+	}
+	return hosts
+}
+
+// group returns the client group of d with the hosts it started with
+func (d Deployment) group() Clientgroup {
+	cgroup := Clientgroup{Name: d.Group}
+	for _, h := range d.Hosts {
+		cgroup.Hosts = append(cgroup.Hosts, h.Host)
+	}
+	return cgroup
+}
+
+// deployedGroup returns client group groupName with the hosts of its
+// running deployment; a reload may have changed them since it started
+func deployedGroup(groupName string) Clientgroup {
+	mutex.Lock()
+	defer mutex.Unlock()
+	cgroup := getClientgroupByKey(groupName)
+	if i, ok := runningDeployments[groupName]; ok {
+		cgroup.Name, cgroup.Hosts = groupName, deployments[i].group().Hosts
+	}
+	return cgroup
+}
+
+// updateChains sets the status of each chain from the status of its hosts
+func (d *Deployment) updateChains() {
+	status := map[string]string{}
+	for _, h := range d.Hosts {
+		status[h.Host] = h.Status
+	}
+	chains := []DeploymentChain{}
+	for _, chain := range d.Chains {
+		if chain.Error != "" {
+			// master failed streaming to it
+			chains = append(chains, chain)
+			continue
+		}
+		chain.Status = DEPLOYMENT_DONE
+		for _, hostname := range chain.Hosts {
+			switch status[hostname] {
+			case STATUS_DONE_OK:
+			case STATUS_ERROR, STATUS_CHECKSUM_BAD:
+				if chain.Status == DEPLOYMENT_DONE {
+					chain.Status = DEPLOYMENT_FAILED
+				}
+			default:
+				chain.Status = DEPLOYMENT_RUNNING
+			}
+		}
+		chains = append(chains, chain)
+	}
+	d.Chains = chains
+}
+
+// currentDeployment returns deployment i; hosts of a running deployment
+// are reported as they are now. Call with mutex held.
+func currentDeployment(i int) Deployment {
+	d := deployments[i]
+	if running, ok := runningDeployments[d.Group]; ok && running == i {
+		d.Hosts = deploymentHosts(d.group())
+		d.updateChains()
+	}
+	return d
+}
+
+func deploymentsHandler(w http.ResponseWriter, request *http.Request) {
+	// GET /api/deployments[?group=<group>] lists deployments, newest first;
+	// GET /api/deployments/<id> returns a single one
+	responseCode := 200
+	idString := strings.TrimPrefix(strings.TrimPrefix(request.URL.Path, "/api/deployments"), "/")
+	groupName := request.URL.Query().Get("group")
+	var result interface{}
+	mutex.Lock()
+	if idString == "" {
+		list := []Deployment{}
+		for i := range deployments {
+			if groupName == "" || deployments[i].Group == groupName {
+				list = append(list, currentDeployment(i))
+			}
+		}
+		sort.SliceStable(list, func(a, b int) bool { return list[a].ID > list[b].ID })
+		result = list
+	} else if id, err := strconv.Atoi(idString); err == nil {
+		for i := range deployments {
+			if deployments[i].ID == id {
+				result = currentDeployment(i)
+			}
+		}
+	}
+	mutex.Unlock()
+
+	if request.Method != "GET" && request.Method != "HEAD" {
+		responseCode = http.StatusMethodNotAllowed
+		http.Error(w, "GET required", responseCode)
+	} else if result == nil {
+		responseCode = 404
+		http.NotFound(w, request)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		myJSON, _ := json.Marshal(result)
+		w.Write(myJSON)
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
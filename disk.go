@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/hcl"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Disk images hold a whole disk: its partition table (as dumped by sfdisk)
+// and one payload per partition, each of its own type and compression:
+//
+//	"TPDISKIM" | manifest length | JSON manifest | payload 1 | payload 2 ...
+//
+// The manifest length is a big endian uint64. Disk images are created
+// from a manifest file using make-disk-image, which looks like:
+//
+//	partitionTable = "ws.sfdisk"   # sfdisk --dump /dev/sda > ws.sfdisk
+//	partition "1" {
+//	  filename    = "ws-root.tar.zst"
+//	  type        = "TAR"          # IMG | SPARSE | TAR
+//	  compression = "ZSTD"
+//	  filesystem  = "ext4"         # TAR only: run mkfs.ext4 prior to extraction
+//	}
+const (
+	DISK_MAGIC          = "TPDISKIM"
+	DISK_MAX_MANIFEST   = 1 << 20 // sanity limit for manifest size
+	DISK_DEVICE_TIMEOUT = 10 * time.Second
+)
+
+type diskManifest struct {
+	PartitionTable string          `hcl:"partitionTable"` // file name in manifest file, contents in image
+	Partitions     []diskPartition `hcl:"partition"`
+}
+
+type diskPartition struct {
+	Name        string `hcl:",key"` // partition number
+	Filename    string `hcl:"filename" json:"-"`
+	Type        string `hcl:"type"`
+	Compression string `hcl:"compression"`
+	Filesystem  string `hcl:"filesystem"`
+	Size        int64  // size of payload within disk image
+}
+
+// MASTER
+
+// makeDiskImage assembles a disk image from the manifest file; file names
+// in the manifest are relative to its folder
+func makeDiskImage(manifestFile string, outputFile string) {
+	fileContents, err := ioutil.ReadFile(manifestFile)
+	if err != nil {
+		log.Fatalf("Cannot read manifest %s: %s", manifestFile, err)
+	}
+	var manifest diskManifest
+	if err := hcl.Decode(&manifest, string(fileContents)); err != nil {
+		log.Fatalf("Error decoding manifest: %s", err)
+	}
+	if manifest.PartitionTable == "" || len(manifest.Partitions) == 0 {
+		log.Fatal("Manifest requires partitionTable and at least one partition")
+	}
+	baseDir := filepath.Dir(manifestFile)
+	table, err := ioutil.ReadFile(filepath.Join(baseDir, manifest.PartitionTable))
+	if err != nil {
+		log.Fatalf("Cannot read partition table: %s", err)
+	}
+	manifest.PartitionTable = string(table)
+	for i, p := range manifest.Partitions {
+		switch p.Type {
+		case IMG_DDIMG, IMG_SPARSE, IMG_TAR:
+		default:
+			log.Fatalf("Invalid type %s for partition %s. Supported: %s", p.Type, p.Name,
+				strings.Join([]string{IMG_DDIMG, IMG_SPARSE, IMG_TAR}, ", "))
+		}
+		switch p.Compression {
+		case "":
+			manifest.Partitions[i].Compression = COMP_NONE
+		case COMP_NONE, COMP_BZIP2, COMP_GZIP, COMP_ZSTD, COMP_XZ:
+		default:
+			log.Fatalf("Invalid compression %s for partition %s. Supported: %s", p.Compression, p.Name,
+				strings.Join([]string{COMP_NONE, COMP_BZIP2, COMP_GZIP, COMP_ZSTD, COMP_XZ}, ", "))
+		}
+		fileInfo, err := os.Stat(filepath.Join(baseDir, p.Filename))
+		if err != nil {
+			log.Fatalf("Cannot use payload of partition %s: %s", p.Name, err)
+		}
+		manifest.Partitions[i].Size = fileInfo.Size()
+	}
+
+	out, err := os.Create(outputFile)
+	if err != nil {
+		log.Fatalf("Cannot write to %s: %s", outputFile, err)
+	}
+	defer out.Close()
+	header, _ := encodeDiskHeader(manifest)
+	if _, err := out.Write(header); err != nil {
+		log.Fatalf("Cannot write to %s: %s", outputFile, err)
+	}
+	for _, p := range manifest.Partitions {
+		log.Printf("Adding partition %s: %s (%s, %s)", p.Name, p.Filename, p.Type, p.Compression)
+		payload, err := os.Open(filepath.Join(baseDir, p.Filename))
+		if err != nil {
+			log.Fatalf("Cannot open %s: %s", p.Filename, err)
+		}
+		_, err = io.Copy(out, payload)
+		payload.Close()
+		if err != nil {
+			log.Fatalf("Cannot write to %s: %s", outputFile, err)
+		}
+	}
+	if err := out.Sync(); err != nil {
+		log.Fatalf("Cannot write to %s: %s", outputFile, err)
+	}
+	log.Printf("Disk image %s created; use type = \"%s\" for it", outputFile, IMG_DISK)
+}
+
+func encodeDiskHeader(manifest diskManifest) ([]byte, error) {
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	var header bytes.Buffer
+	header.WriteString(DISK_MAGIC)
+	binary.Write(&header, binary.BigEndian, uint64(len(manifestJSON)))
+	header.Write(manifestJSON)
+	return header.Bytes(), nil
+}
+
+// CLIENT
+
+func readDiskHeader(r io.Reader) (diskManifest, error) {
+	var manifest diskManifest
+	magic := make([]byte, len(DISK_MAGIC))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return manifest, err
+	}
+	if string(magic) != DISK_MAGIC {
+		return manifest, errors.New("not a disk image")
+	}
+	var length uint64
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return manifest, err
+	}
+	if length > DISK_MAX_MANIFEST {
+		return manifest, fmt.Errorf("disk image manifest too large (%d bytes)", length)
+	}
+	err := json.NewDecoder(io.LimitReader(r, int64(length))).Decode(&manifest)
+	return manifest, err
+}
+
+// writeDisk partitions disk as described by the disk image r and
+// writes the payloads of r to the partitions
+func writeDisk(r io.Reader, disk string) error {
+	manifest, err := readDiskHeader(r)
+	if err != nil {
+		return err
+	}
+	log.Printf("Writing partition table to %s", disk)
+	sfdisk := exec.Command("sfdisk", disk)
+	sfdisk.Stdin = strings.NewReader(manifest.PartitionTable)
+	if output, err := sfdisk.CombinedOutput(); err != nil {
+		return fmt.Errorf("sfdisk failed: %s\n%s", err, output)
+	}
+
+	for _, p := range manifest.Partitions {
+		device := partitionDevice(disk, p.Name)
+		log.Printf("Writing partition %s: %s (%s, %s)", p.Name, device, p.Type, p.Compression)
+		if err := waitForDevice(device); err != nil {
+			return err
+		}
+		payload := io.LimitReader(r, p.Size)
+		decompReader, err := newDecompressReader(p.Compression, payload)
+		if err != nil {
+			return err
+		}
+		if p.Type == IMG_TAR {
+			err = extractTarPartition(decompReader, device, p.Filesystem)
+		} else {
+			err = writeImage(p.Type, decompReader, device)
+		}
+		if err != nil {
+			decompReader.Close()
+			return fmt.Errorf("partition %s: %s", p.Name, err)
+		}
+		// consume what is left of this partition's payload
+		io.Copy(ioutil.Discard, decompReader)
+		decompReader.Close()
+		io.Copy(ioutil.Discard, payload)
+	}
+	return nil
+}
+
+// partitionDevice returns the device of partition number on disk,
+// e.g. /dev/sda1 or /dev/nvme0n1p1
+func partitionDevice(disk string, number string) string {
+	if last := disk[len(disk)-1]; last >= '0' && last <= '9' {
+		return disk + "p" + number
+	}
+	return disk + number
+}
+
+// waitForDevice waits for the kernel to create device after re-partitioning
+func waitForDevice(device string) error {
+	deadline := time.Now().Add(DISK_DEVICE_TIMEOUT)
+	for {
+		_, err := os.Stat(device)
+		if err == nil || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// extractTarPartition creates a file system on device, if requested, and
+// extracts the tar stream r into it
+func extractTarPartition(r io.Reader, device string, filesystem string) error {
+	if filesystem != "" {
+		log.Printf("Creating %s file system on %s", filesystem, device)
+		if output, err := exec.Command("mkfs."+filesystem, device).CombinedOutput(); err != nil {
+			return fmt.Errorf("mkfs.%s failed: %s\n%s", filesystem, err, output)
+		}
+	}
+	mountpoint, err := ioutil.TempDir("", "thePusher")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(mountpoint)
+	if output, err := exec.Command("mount", device, mountpoint).CombinedOutput(); err != nil {
+		return fmt.Errorf("mount failed: %s\n%s", err, output)
+	}
+	err = extractTar(r, mountpoint)
+	if output, umountErr := exec.Command("umount", mountpoint).CombinedOutput(); umountErr != nil && err == nil {
+		err = fmt.Errorf("umount failed: %s\n%s", umountErr, output)
+	}
+	return err
+}
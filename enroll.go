@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Clients not listed in any group are kept in an unassigned pool and shown
+// in the web UI, where they can be assigned to a group. They keep asking for
+// their task until assigned.
+const ENROLL_POLL_INTERVAL = 10 * time.Second
+
+var unassignedClients = map[string]ClientInfo{}
+
+// assignedClients maps hosts assigned at runtime to their group; they are
+// kept in their group when the configuration is reloaded
+var assignedClients = map[string]string{}
+
+// enrollClient adds the unknown client sending request to the unassigned pool
+func enrollClient(request *http.Request, clientIP string) {
+	identity := requestIdentity(request)
+	host := identity.key(clientIP)
+	mutex.Lock()
+	c, known := unassignedClients[host]
+	changed := !known || c.Address != clientIP
+	c.Host, c.Address, c.Status, c.Identity = host, clientIP, STATUS_UNASSIGNED, &identity
+	unassignedClients[host] = c
+	mutex.Unlock()
+	if !known {
+		log.Printf("Unknown client %s (%s) waits for assignment to a group", host, clientIP)
+	}
+	if changed {
+		wsserver.sendAll(&c)
+	}
+}
+
+// assignClient moves host from the unassigned pool to the end of group
+// groupName; returns the new client entry
+func assignClient(host string, groupName string) (ClientInfo, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	pending, ok := unassignedClients[host]
+	if !ok {
+		return ClientInfo{}, fmt.Errorf("no unassigned client %s", host)
+	}
+	// config is replaced, not changed, see currentConfig
+	config := currentConfig()
+	config.Clientgroups = append([]Clientgroup{}, config.Clientgroups...)
+	for i := range config.Clientgroups {
+		group := &config.Clientgroups[i]
+		if group.Name != groupName {
+			continue
+		}
+		for _, hostname := range group.Hosts {
+			if clients[hostname].Status == STATUS_BUSY {
+				return ClientInfo{}, fmt.Errorf("group %s is receiving an image", groupName)
+			}
+		}
+		group.Hosts = append(append([]string{}, group.Hosts...), host)
+		setConfig(config)
+		delete(unassignedClients, host)
+		assignedClients[host] = groupName
+		// wire up the new host; its predecessor forwards to it from now on
+		for hostIndex, hostname := range group.Hosts {
+			entry := newClientEntry(*group, hostIndex)
+			if hostname != host {
+				c := clients[hostname]
+				c.Neighbors = entry.Neighbors
+				clients[hostname] = c
+				continue
+			}
+			entry.Address = pending.Address
+			clients[hostname] = entry
+		}
+		log.Printf("Assigned client %s (%s) to group %s", host, pending.Address, groupName)
+		return clients[host], nil
+	}
+	return ClientInfo{}, fmt.Errorf("no client group %s", groupName)
+}
+
+// reassignClients adds hosts assigned at runtime to their groups in config,
+// unless config lists them already or their group is gone. Groups of config
+// are copied first, config may be a snapshot of the live one.
+func reassignClients(config *Config) {
+	config.Clientgroups = append([]Clientgroup{}, config.Clientgroups...)
+	listed := map[string]bool{}
+	for _, group := range config.Clientgroups {
+		for _, hostname := range group.Hosts {
+			listed[hostname] = true
+		}
+	}
+	for host, groupName := range assignedClients {
+		for i := range config.Clientgroups {
+			group := &config.Clientgroups[i]
+			if group.Name == groupName && !listed[host] {
+				group.Hosts = append(append([]string{}, group.Hosts...), host)
+			}
+		}
+	}
+}
+
+// pruneAssignedClients forgets runtime assignments to groups config lacks
+func pruneAssignedClients(config Config) {
+	for host, groupName := range assignedClients {
+		if config.clientgroupByKey(groupName).Name == "" {
+			delete(assignedClients, host)
+		}
+	}
+}
+
+func assignClientHandler(w http.ResponseWriter, request *http.Request) {
+	// web UI assigns an unassigned client: POST host=<host>&group=<group>
+	responseCode := 200
+	if request.Method != "POST" {
+		responseCode = http.StatusMethodNotAllowed
+		http.Error(w, "POST required", responseCode)
+	} else if code := refuseOperator(w, request); code != 0 {
+		responseCode = code
+	} else if c, err := assignClient(request.FormValue("host"), request.FormValue("group")); err != nil {
+		responseCode = http.StatusConflict
+		http.Error(w, err.Error(), responseCode)
+		log.Printf("Cannot assign client: %s", err)
+	} else {
+		wsserver.sendAll(&c)
+		stateChanged()
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+func unassignedClientsHandler(w http.ResponseWriter, request *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	mutex.Lock()
+	myJSON, _ := json.Marshal(unassignedClients)
+	mutex.Unlock()
+	w.Write(myJSON)
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ext2/3/4 support for sparse capture: instead of looking for zero blocks,
+// the block bitmaps tell which blocks are allocated (much like partclone).
+// Unallocated blocks are skipped, so their content is not restored.
+const (
+	EXT_SUPERBLOCK_OFFSET = 1024
+	EXT_MAGIC             = 0xEF53
+	EXT_COMPAT_RESIZE     = 0x10
+	EXT_COMPAT_SPARSE2    = 0x200
+	EXT_INCOMPAT_META_BG  = 0x10
+	EXT_INCOMPAT_64BIT    = 0x80
+	EXT_RO_SPARSE_SUPER   = 0x1
+	EXT_RO_GDT_CSUM       = 0x10
+	EXT_RO_METADATA_CSUM  = 0x400
+	EXT_BG_BLOCK_UNINIT   = 0x2
+)
+
+var errNotExtFs = errors.New("no ext2/3/4 file system")
+
+type extSuperblock struct {
+	blockSize        int64
+	blocksCount      int64
+	firstDataBlock   int64
+	blocksPerGroup   int64
+	inodesPerGroup   int64
+	inodeSize        int64
+	descSize         int64
+	reservedGdt      int64
+	compat           uint32
+	incompat         uint32
+	roCompat         uint32
+	backupGroups     [2]int64
+	groupCount       int64
+	gdtBlocks        int64
+	inodeTableBlocks int64
+}
+
+type extGroupDesc struct {
+	blockBitmap int64
+	inodeBitmap int64
+	inodeTable  int64
+	flags       uint16
+}
+
+func readExtSuperblock(f io.ReaderAt) (*extSuperblock, error) {
+	raw := make([]byte, 1024)
+	if _, err := f.ReadAt(raw, EXT_SUPERBLOCK_OFFSET); err != nil {
+		return nil, errNotExtFs
+	}
+	le := binary.LittleEndian
+	if le.Uint16(raw[0x38:]) != EXT_MAGIC {
+		return nil, errNotExtFs
+	}
+	sb := &extSuperblock{
+		blockSize:      1024 << le.Uint32(raw[0x18:]),
+		blocksCount:    int64(le.Uint32(raw[0x4:])),
+		firstDataBlock: int64(le.Uint32(raw[0x14:])),
+		blocksPerGroup: int64(le.Uint32(raw[0x20:])),
+		inodesPerGroup: int64(le.Uint32(raw[0x28:])),
+		inodeSize:      128,
+		descSize:       32,
+		compat:         le.Uint32(raw[0x5C:]),
+		incompat:       le.Uint32(raw[0x60:]),
+		roCompat:       le.Uint32(raw[0x64:]),
+	}
+	if le.Uint32(raw[0x4C:]) >= 1 { // dynamic revision
+		sb.inodeSize = int64(le.Uint16(raw[0x58:]))
+	}
+	if sb.incompat&EXT_INCOMPAT_64BIT != 0 {
+		sb.blocksCount |= int64(le.Uint32(raw[0x150:])) << 32
+		sb.descSize = int64(le.Uint16(raw[0xFE:]))
+	}
+	if sb.compat&EXT_COMPAT_RESIZE != 0 {
+		sb.reservedGdt = int64(le.Uint16(raw[0xCE:]))
+	}
+	sb.backupGroups = [2]int64{int64(le.Uint32(raw[0x24C:])), int64(le.Uint32(raw[0x250:]))}
+
+	if sb.blockSize > 65536 || sb.blocksPerGroup == 0 || sb.blocksPerGroup > sb.blockSize*8 ||
+		sb.descSize < 32 || sb.inodeSize == 0 {
+		return nil, fmt.Errorf("unsupported ext file system parameters")
+	}
+	if sb.incompat&EXT_INCOMPAT_META_BG != 0 {
+		return nil, fmt.Errorf("ext file systems using meta_bg are not supported")
+	}
+	sb.groupCount = (sb.blocksCount - sb.firstDataBlock + sb.blocksPerGroup - 1) / sb.blocksPerGroup
+	sb.gdtBlocks = (sb.groupCount*sb.descSize + sb.blockSize - 1) / sb.blockSize
+	sb.inodeTableBlocks = (sb.inodesPerGroup*sb.inodeSize + sb.blockSize - 1) / sb.blockSize
+	return sb, nil
+}
+
+// hasSuperBackup tells whether group g starts with a copy of superblock and GDT
+func (sb *extSuperblock) hasSuperBackup(g int64) bool {
+	if g == 0 {
+		return true
+	}
+	if sb.compat&EXT_COMPAT_SPARSE2 != 0 {
+		return g == sb.backupGroups[0] || g == sb.backupGroups[1]
+	}
+	if sb.roCompat&EXT_RO_SPARSE_SUPER == 0 || g == 1 {
+		return true
+	}
+	for _, base := range []int64{3, 5, 7} {
+		n := base
+		for n < g {
+			n *= base
+		}
+		if n == g {
+			return true
+		}
+	}
+	return false
+}
+
+func readExtGroupDescs(f io.ReaderAt, sb *extSuperblock) ([]extGroupDesc, error) {
+	raw := make([]byte, sb.groupCount*sb.descSize)
+	if _, err := f.ReadAt(raw, (sb.firstDataBlock+1)*sb.blockSize); err != nil {
+		return nil, err
+	}
+	le := binary.LittleEndian
+	descs := make([]extGroupDesc, sb.groupCount)
+	for g := range descs {
+		d := raw[int64(g)*sb.descSize:]
+		descs[g] = extGroupDesc{
+			blockBitmap: int64(le.Uint32(d[0x0:])),
+			inodeBitmap: int64(le.Uint32(d[0x4:])),
+			inodeTable:  int64(le.Uint32(d[0x8:])),
+			flags:       le.Uint16(d[0x12:]),
+		}
+		if sb.descSize >= 64 {
+			descs[g].blockBitmap |= int64(le.Uint32(d[0x20:])) << 32
+			descs[g].inodeBitmap |= int64(le.Uint32(d[0x24:])) << 32
+			descs[g].inodeTable |= int64(le.Uint32(d[0x28:])) << 32
+		}
+	}
+	return descs, nil
+}
+
+// scanExtExtents returns the allocated blocks of the ext file system on f
+func scanExtExtents(f *os.File, size int64) ([]extent, error) {
+	sb, err := readExtSuperblock(f)
+	if err != nil {
+		return nil, err
+	}
+	if sb.blocksCount*sb.blockSize > size {
+		return nil, fmt.Errorf("ext file system exceeds device size")
+	}
+	descs, err := readExtGroupDescs(f, sb)
+	if err != nil {
+		return nil, err
+	}
+	uninitAllowed := sb.roCompat&(EXT_RO_GDT_CSUM|EXT_RO_METADATA_CSUM) != 0
+
+	extents := []extent{}
+	addBlocks := func(block, count int64) {
+		offset, length := block*sb.blockSize, count*sb.blockSize
+		if last := len(extents) - 1; last >= 0 && extents[last].Offset+extents[last].Length == offset {
+			extents[last].Length += length
+		} else {
+			extents = append(extents, extent{offset, length})
+		}
+	}
+	// boot block(s) in front of first group
+	addBlocks(0, sb.firstDataBlock)
+
+	bitmap := make([]byte, sb.blockSize)
+	for g := int64(0); g < sb.groupCount; g++ {
+		groupStart := sb.firstDataBlock + g*sb.blocksPerGroup
+		groupBlocks := sb.blocksPerGroup
+		if groupStart+groupBlocks > sb.blocksCount {
+			groupBlocks = sb.blocksCount - groupStart
+		}
+		if uninitAllowed && descs[g].flags&EXT_BG_BLOCK_UNINIT != 0 {
+			sb.initBlockBitmap(bitmap, g, groupStart, groupBlocks, descs)
+		} else if _, err := f.ReadAt(bitmap, descs[g].blockBitmap*sb.blockSize); err != nil {
+			return nil, err
+		}
+		for i := int64(0); i < groupBlocks; i++ {
+			if bitmap[i/8]&(1<<uint(i%8)) != 0 {
+				addBlocks(groupStart+i, 1)
+			}
+		}
+	}
+	return extents, nil
+}
+
+// initBlockBitmap computes the bitmap of a group whose bitmap is not
+// initialized yet: only superblock backup, GDT and metadata of any group
+// located in this group are in use
+func (sb *extSuperblock) initBlockBitmap(bitmap []byte, g int64, groupStart int64, groupBlocks int64, descs []extGroupDesc) {
+	for i := range bitmap {
+		bitmap[i] = 0
+	}
+	mark := func(block, count int64) {
+		from, to := block, block+count
+		if from < groupStart {
+			from = groupStart
+		}
+		if to > groupStart+groupBlocks {
+			to = groupStart + groupBlocks
+		}
+		for i := from - groupStart; i < to-groupStart; i++ {
+			bitmap[i/8] |= 1 << uint(i%8)
+		}
+	}
+	if sb.hasSuperBackup(g) {
+		mark(groupStart, 1+sb.gdtBlocks+sb.reservedGdt)
+	}
+	for _, d := range descs {
+		mark(d.blockBitmap, 1)
+		mark(d.inodeBitmap, 1)
+		mark(d.inodeTable, sb.inodeTableBlocks)
+	}
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// hosts entries of client groups may name a client by IP address, MAC
+// address (of any NIC), DMI system UUID or hostname. Clients send their
+// identity with /getClientTask; master resolves the entry from it and
+// learns the client's current IP address for chain wiring. Several clients
+// running on one machine share its identity; each is started with its own
+// --instance name and listed as <identity>/<instance>.
+//
+// Identities are visible on the wire and easily claimed by another machine.
+// A claim never moves a waiting or receiving host to another address; with
+// TLS, a host gets a certificate only at the address it had before, new
+// addresses must be confirmed by the operator (POST /confirmClient).
+
+// HEADER_CLIENT_ID carries the ID master issued with the task of a client;
+// clients send it with all later API requests, so master tells clients
+// apart even if they share an address.
+const HEADER_CLIENT_ID = "X-Pusher-Client"
+
+// clientIDs maps the client IDs issued by master to hosts entries
+var clientIDs = map[string]string{}
+
+// normalizeHost returns the canonical form of a hosts entry or identity
+func normalizeHost(entry string) string {
+	entry = strings.ToLower(strings.TrimSpace(entry))
+	if i := strings.LastIndex(entry, "/"); i >= 0 {
+		return normalizeHost(entry[:i]) + entry[i:]
+	}
+	if mac, err := net.ParseMAC(entry); err == nil && len(mac) == 6 {
+		return mac.String()
+	}
+	return entry
+}
+
+// entryIdentity returns a hosts entry without its instance name
+func entryIdentity(entry string) string {
+	return strings.SplitN(entry, "/", 2)[0]
+}
+
+// isIPEntry tells whether a hosts entry is an IP address (of an instance)
+func isIPEntry(entry string) bool {
+	return net.ParseIP(entryIdentity(entry)) != nil
+}
+
+// ClientIdentity is sent by clients with /getClientTask
+type ClientIdentity struct {
+	Macs     []string `json:",omitempty"`
+	UUID     string   `json:",omitempty"`
+	Hostname string   `json:",omitempty"`
+	Instance string   `json:",omitempty"` // tells clients on one machine apart
+}
+
+// MASTER
+
+func requestIdentity(request *http.Request) ClientIdentity {
+	query := request.URL.Query()
+	return ClientIdentity{Macs: query["mac"], UUID: query.Get("uuid"), Hostname: query.Get("hostname"),
+		Instance: query.Get("instance")}
+}
+
+// entry returns the hosts entry naming the client by identity
+func (identity ClientIdentity) entry(identityPart string) string {
+	if identity.Instance != "" {
+		identityPart += "/" + identity.Instance
+	}
+	return normalizeHost(identityPart)
+}
+
+// key returns the hosts entry naming an unknown client: its first MAC,
+// else its UUID, else clientIP
+func (identity ClientIdentity) key(clientIP string) string {
+	if len(identity.Macs) != 0 {
+		return identity.entry(identity.Macs[0])
+	}
+	if identity.UUID != "" {
+		return identity.entry(identity.UUID)
+	}
+	return identity.entry(clientIP)
+}
+
+// resolveClient returns the hosts entry matching the identity a client sent
+// with request: its IP address, MACs, system UUID or hostname, followed by
+// its instance name if it has one
+func resolveClient(request *http.Request, clientIP string) (string, bool) {
+	identity := requestIdentity(request)
+	candidates := []string{identity.UUID}
+	candidates = append(candidates, identity.Macs...)
+	if identity.Hostname != "" {
+		candidates = append(candidates, identity.Hostname, strings.Split(identity.Hostname, ".")[0])
+	}
+	candidates = append(candidates, clientIP)
+	for _, candidate := range candidates {
+		// IP entries only match the address the request comes from
+		if candidate == "" || (candidate != clientIP && isIPEntry(candidate)) {
+			continue
+		}
+		if _, ok := clients[identity.entry(candidate)]; ok {
+			return identity.entry(candidate), true
+		}
+	}
+	return "", false
+}
+
+// refuseIdentity tells why the client at clientIP may not take the task of
+// host, "" if it may. Call with mutex held.
+func refuseIdentity(host string, clientIP string) string {
+	c := clients[host]
+	if isIPEntry(host) || c.Address == clientIP {
+		return ""
+	}
+	if c.Address != "" && (c.Status == STATUS_READY_WAITING || c.Status == STATUS_BUSY) {
+		return fmt.Sprintf("%s is active at %s", host, c.Address)
+	}
+	if useTLS {
+		c.Unconfirmed = clientIP
+		clients[host] = c
+		return fmt.Sprintf("new address of %s, waiting for operator confirmation", host)
+	}
+	return ""
+}
+
+// learnAddress records the current IP of host; several clients may share
+// one (e.g. behind NAT). Call with mutex held.
+func learnAddress(host string, clientIP string) {
+	c := clients[host]
+	if c.Address != clientIP {
+		log.Printf("Client %s has address %s", host, clientIP)
+	}
+	c.Address, c.Unconfirmed = clientIP, ""
+	clients[host] = c
+}
+
+// confirmClient lets the client that claimed host from a new address use it
+func confirmClient(host string) (ClientInfo, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	c, ok := clients[host]
+	if !ok || c.Unconfirmed == "" {
+		return c, fmt.Errorf("no client %s waiting for confirmation", host)
+	}
+	if c.Status == STATUS_BUSY {
+		return c, fmt.Errorf("%s is receiving an image at %s", host, c.Address)
+	}
+	log.Printf("Operator confirmed address %s of client %s", c.Unconfirmed, host)
+	learnAddress(host, c.Unconfirmed)
+	return clients[host], nil
+}
+
+// issueClientID returns a new ID identifying host in requests following
+// its task; IDs issued to host before become invalid. Call with mutex held.
+func issueClientID(host string) string {
+	for id, idHost := range clientIDs {
+		if idHost == host {
+			delete(clientIDs, id)
+		}
+	}
+	raw := make([]byte, 16)
+	rand.Read(raw)
+	id := hex.EncodeToString(raw)
+	clientIDs[id] = host
+	return id
+}
+
+// requestClient returns the hosts entry of the client sending request, by
+// the client ID it got with its task. With TLS, it must also present its
+// certificate.
+func requestClient(request *http.Request) (string, bool) {
+	if clientAddress(request) == "" {
+		return "", false
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	host, ok := clientIDs[request.Header.Get(HEADER_CLIENT_ID)]
+	if _, listed := clients[host]; !ok || !listed {
+		return "", false
+	}
+	return host, true
+}
+
+// requestListenPort returns the port a client asked to receive the image on
+// with request, 0 if it uses the port of its group
+func requestListenPort(request *http.Request) int {
+	port, err := strconv.Atoi(request.URL.Query().Get("port"))
+	if err != nil || port < 1 || port > 65535 {
+		return 0
+	}
+	return port
+}
+
+// dataPort returns the port c receives the image on
+func (c ClientInfo) dataPort() int {
+	if c.ListenPort != 0 {
+		return c.ListenPort
+	}
+	return c.Port
+}
+
+// dataAddress returns IP and port c receives the image on, "" if unknown
+func (c ClientInfo) dataAddress() string {
+	if c.Address == "" {
+		return ""
+	}
+	return net.JoinHostPort(c.Address, strconv.Itoa(c.dataPort()))
+}
+
+// hostByAddress returns the one of hosts currently receiving on address
+func hostByAddress(hosts []string, address string) (string, bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	for _, host := range hosts {
+		if clients[host].dataAddress() == address {
+			return host, true
+		}
+	}
+	return "", false
+}
+
+// clientAddresses returns the addresses (IP:port) hosts receive the image on
+func clientAddresses(hosts []string) []string {
+	mutex.Lock()
+	defer mutex.Unlock()
+	addresses := []string{}
+	for _, host := range hosts {
+		if address := clients[host].dataAddress(); address != "" {
+			addresses = append(addresses, address)
+		} else {
+			log.Printf("Address of client %s is unknown, skipping it", host)
+		}
+	}
+	return addresses
+}
+
+func confirmClientHandler(w http.ResponseWriter, request *http.Request) {
+	// web UI confirms the new address of a client: POST host=<host>
+	responseCode := 200
+	if request.Method != "POST" {
+		responseCode = http.StatusMethodNotAllowed
+		http.Error(w, "POST required", responseCode)
+	} else if code := refuseOperator(w, request); code != 0 {
+		responseCode = code
+	} else if c, err := confirmClient(request.FormValue("host")); err != nil {
+		responseCode = http.StatusConflict
+		http.Error(w, err.Error(), responseCode)
+		log.Printf("Cannot confirm client: %s", err)
+	} else {
+		wsserver.sendAll(&c)
+		stateChanged()
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+func neighborsHandler(w http.ResponseWriter, request *http.Request) {
+	// reply with addresses of the hosts the calling client forwards to
+	responseCode := 200
+	host, ok := requestClient(request)
+	if ok {
+		mutex.Lock()
+		neighbors := clients[host].Neighbors
+		mutex.Unlock()
+		myJSON, _ := json.Marshal(clientAddresses(neighbors))
+		w.Write(myJSON)
+	} else {
+		responseCode = 404
+		http.NotFound(w, request)
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+// CLIENT
+
+// identityQuery returns the query string identifying this client to master
+func identityQuery() string {
+	query := url.Values{}
+	interfaces, _ := net.Interfaces()
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagLoopback == 0 && len(iface.HardwareAddr) == 6 {
+			query.Add("mac", iface.HardwareAddr.String())
+		}
+	}
+	if uuid := systemUUID(); uuid != "" {
+		query.Set("uuid", uuid)
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		query.Set("hostname", hostname)
+	}
+	if clientInstance != "" {
+		query.Set("instance", clientInstance)
+	}
+	return query.Encode()
+}
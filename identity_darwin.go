@@ -0,0 +1,19 @@
+package main
+
+import (
+	"os/exec"
+	"regexp"
+)
+
+// systemUUID returns the hardware UUID, "" if unknown
+func systemUUID() string {
+	out, err := exec.Command("ioreg", "-rd1", "-c", "IOPlatformExpertDevice").Output()
+	if err != nil {
+		return ""
+	}
+	match := regexp.MustCompile(`"IOPlatformUUID" = "([0-9A-Fa-f-]+)"`).FindSubmatch(out)
+	if match == nil {
+		return ""
+	}
+	return string(match[1])
+}
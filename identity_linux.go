@@ -0,0 +1,15 @@
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+)
+
+// systemUUID returns the DMI system UUID, "" if unknown
+func systemUUID() string {
+	uuid, err := ioutil.ReadFile("/sys/class/dmi/id/product_uuid")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(uuid))
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveClient(t *testing.T) {
+	mutex.Lock()
+	saved := clients
+	clients = map[string]ClientInfo{}
+	for _, host := range []string{"10.0.0.1", "10.0.0.2/b", "52:54:00:12:34:56", "52:54:00:12:34:56/a",
+		"52:54:00:12:34:56/b", "4c4c4544-0042-3510-8052-b4c04f4e3232", "pc7"} {
+		clients[host] = ClientInfo{Host: host}
+	}
+	mutex.Unlock()
+	defer func() {
+		mutex.Lock()
+		clients = saved
+		mutex.Unlock()
+	}()
+
+	tests := []struct {
+		name     string
+		query    string
+		clientIP string
+		want     string
+	}{
+		{"IP", "", "10.0.0.1", "10.0.0.1"},
+		{"MAC", "mac=aa:bb:cc:dd:ee:ff&mac=52-54-00-12-34-56", "10.0.0.9", "52:54:00:12:34:56"},
+		{"UUID before MAC", "uuid=4C4C4544-0042-3510-8052-B4C04F4E3232&mac=52:54:00:12:34:56", "10.0.0.9",
+			"4c4c4544-0042-3510-8052-b4c04f4e3232"},
+		{"short hostname", "hostname=PC7.example.org", "10.0.0.9", "pc7"},
+		{"IP claimed from elsewhere", "hostname=10.0.0.1", "10.0.0.9", ""},
+		{"instances on one machine", "mac=52:54:00:12:34:56&instance=a", "10.0.0.9", "52:54:00:12:34:56/a"},
+		{"other instance", "mac=52:54:00:12:34:56&instance=b", "10.0.0.9", "52:54:00:12:34:56/b"},
+		{"unlisted instance", "mac=52:54:00:12:34:56&instance=c", "10.0.0.9", ""},
+		{"instance of IP", "instance=b", "10.0.0.2", "10.0.0.2/b"},
+		{"unknown", "mac=aa:bb:cc:dd:ee:ff", "10.0.0.9", ""},
+	}
+	for _, test := range tests {
+		request := httptest.NewRequest("GET", "/getClientTask?"+test.query, nil)
+		mutex.Lock()
+		got, ok := resolveClient(request, test.clientIP)
+		mutex.Unlock()
+		if got != test.want || ok != (test.want != "") {
+			t.Errorf("%s: got %q %v, want %q", test.name, got, ok, test.want)
+		}
+	}
+}
+
+func TestNormalizeHost(t *testing.T) {
+	tests := map[string]string{
+		"52-54-00-12-34-56":   "52:54:00:12:34:56",
+		"52-54-00-12-34-56/A": "52:54:00:12:34:56/a",
+		" PC7 ":               "pc7",
+		"10.0.0.2/b":          "10.0.0.2/b",
+	}
+	for entry, want := range tests {
+		if got := normalizeHost(entry); got != want {
+			t.Errorf("%q: got %q, want %q", entry, got, want)
+		}
+	}
+}
@@ -105,6 +105,14 @@ if ! grep -qw superuser /proc/cmdline; then
   if grep -qw thePusher /proc/cmdline; then
 	  MASTER=$(sed -E 's/.*thePusher=([^ ]+).*/\1/' /proc/cmdline)
 	fi
+	PORT=8080
+	if grep -qw thePusherPort /proc/cmdline; then
+	  PORT=$(sed -E 's/.*thePusherPort=([^ ]+).*/\1/' /proc/cmdline)
+	fi
+	TLS=""
+	if grep -qw thePusherCA /proc/cmdline; then
+	  TLS="--tls --ca-fingerprint $(sed -E 's/.*thePusherCA=([^ ]+).*/\1/' /proc/cmdline)"
+	fi
 	if [ -z "$MASTER" ]; then
 	  if [ -z "$MASTER" ]; then
 	  	echo "FATAL ERROR: thePusher master neither hard-coded nor in /proc/cmdline"
@@ -114,10 +122,13 @@ if ! grep -qw superuser /proc/cmdline; then
 
   if grep -qw putImage /proc/cmdline; then
   	IMG2PUT=$(sed -E 's/.*putImage=([^ ]+).*/\1/' /proc/cmdline)
-  	sed -i "s@tty1.*@tty1::respawn:/sbin/startPusher put-image -p $MASTER -i $IMG2PUT@" /etc/inittab
+  	if grep -qw thePusherToken /proc/cmdline; then
+  	  IMG2PUT="$IMG2PUT -t $(sed -E 's/.*thePusherToken=([^ ]+).*/\1/' /proc/cmdline)"
+  	fi
+  	sed -i "s@tty1.*@tty1::respawn:/sbin/startPusher put-image -p $MASTER -P $PORT $TLS -i $IMG2PUT@" /etc/inittab
   	echo "thePusher: /etc/inittab set up for: put-image"
   else
-  	sed -i "s@tty1.*@tty1::respawn:/sbin/startPusher client -p $MASTER@" /etc/inittab
+  	sed -i "s@tty1.*@tty1::respawn:/sbin/startPusher client -p $MASTER -P $PORT $TLS@" /etc/inittab
   	echo "thePusher: /etc/inittab set up for: put-image"
   fi
 
@@ -13,8 +13,15 @@ const thePusherVersion = "0.0.2"
 var verbose bool
 var imageStorage string
 var pusherIP string
+var pusherPort int
+var clientInstance string
+var clientListenPort int
 var imageToUpload string
+var uploadSparse bool
+var uploadToken string
 var staticContentRoot string
+var diskManifestFile string
+var diskImageFile string
 
 func main() {
 
@@ -77,6 +84,16 @@ func main() {
 						Usage:       "(optional) path to folder to be served as /static",
 						Destination: &staticContentRoot,
 					},
+					&cli.BoolFlag{
+						Name:        "tls",
+						Usage:       "serve HTTPS using built-in CA, issue client certificates",
+						Destination: &useTLS,
+					},
+					&cli.StringFlag{
+						Name:        "operator-password-file",
+						Usage:       "(optional) file holding the password to change state from the web UI on other hosts",
+						Destination: &operatorPasswordFile,
+					},
 				},
 			},
 
@@ -116,6 +133,33 @@ func main() {
 						Usage:       "thePusher master IP address",
 						Destination: &pusherIP,
 					},
+					&cli.IntFlag{
+						Name:        "pusher-port",
+						Aliases:     []string{"P"},
+						Value:       DEFAULT_PORT,
+						Usage:       "thePusher master port",
+						Destination: &pusherPort,
+					},
+					&cli.BoolFlag{
+						Name:        "tls",
+						Usage:       "use TLS to talk to master and other clients",
+						Destination: &useTLS,
+					},
+					&cli.StringFlag{
+						Name:        "ca-fingerprint",
+						Usage:       "SHA-256 fingerprint of master's CA certificate, as logged by master",
+						Destination: &caFingerprint,
+					},
+					&cli.StringFlag{
+						Name:        "instance",
+						Usage:       "instance name telling several clients on one machine apart",
+						Destination: &clientInstance,
+					},
+					&cli.IntFlag{
+						Name:        "listen-port",
+						Usage:       "port to receive the image on, instead of the clientPort of the group",
+						Destination: &clientListenPort,
+					},
 				},
 			},
 
@@ -140,12 +184,66 @@ func main() {
 						Usage:       "thePusher master IP address",
 						Destination: &pusherIP,
 					},
+					&cli.IntFlag{
+						Name:        "pusher-port",
+						Aliases:     []string{"P"},
+						Value:       DEFAULT_PORT,
+						Usage:       "thePusher master port",
+						Destination: &pusherPort,
+					},
+					&cli.BoolFlag{
+						Name:        "tls",
+						Usage:       "use TLS to talk to master and other clients",
+						Destination: &useTLS,
+					},
+					&cli.StringFlag{
+						Name:        "ca-fingerprint",
+						Usage:       "SHA-256 fingerprint of master's CA certificate, as logged by master",
+						Destination: &caFingerprint,
+					},
 					&cli.StringFlag{
 						Name:        "image-file",
 						Aliases:     []string{"i"},
 						Usage:       "image file to upload",
 						Destination: &imageToUpload,
 					},
+					&cli.BoolFlag{
+						Name:        "sparse",
+						Usage:       "upload in SPARSE image format, skipping zero blocks",
+						Destination: &uploadSparse,
+					},
+					&cli.StringFlag{
+						Name:        "token",
+						Aliases:     []string{"t"},
+						Usage:       "upload token issued by master (see /uploadToken)",
+						Destination: &uploadToken,
+					},
+				},
+			},
+			{
+				Name:    "make-disk-image",
+				Aliases: []string{"d"},
+				Usage:   "create DISK image from partition table and partition payloads",
+				Action: func(c *cli.Context) error {
+					if diskManifestFile == "" || diskImageFile == "" {
+						log.Fatal("-manifest and -output required to create disk image")
+					}
+					makeDiskImage(diskManifestFile, diskImageFile)
+					return nil
+				},
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:        "manifest",
+						Aliases:     []string{"m"},
+						Usage:       "manifest file listing partition table and partitions",
+						Destination: &diskManifestFile,
+					},
+					&cli.StringFlag{
+						Name:        "output",
+						Aliases:     []string{"o"},
+						Usage:       "disk image file to create",
+						Destination: &diskImageFile,
+					},
 				},
 			},
 		},
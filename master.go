@@ -2,13 +2,16 @@ package main
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,30 +19,65 @@ import (
 )
 
 const (
-	IMG_DMG              = "DMG" // for mac
-	IMG_DDIMG            = "IMG" // for linux
-	IMG_TAR              = "TAR" // for any
+	IMG_DMG              = "DMG"    // for mac
+	IMG_DDIMG            = "IMG"    // for linux
+	IMG_TAR              = "TAR"    // for any
+	IMG_SPARSE           = "SPARSE" // for any, see sparse.go
+	IMG_DISK             = "DISK"   // for linux, see disk.go
 	COMP_GZIP            = "GZ"
 	COMP_BZIP2           = "BZ2"
+	COMP_ZSTD            = "ZSTD"
+	COMP_XZ              = "XZ"
 	COMP_NONE            = "NONE"
 	STATUS_NONE          = "NONE"
 	STATUS_READY_WAITING = "WAIT"
 	STATUS_BUSY          = "BUSY"
 	STATUS_DONE_OK       = "DONE"
 	STATUS_ERROR         = "ERROR"
+	STATUS_CHECKSUM_BAD  = "BADSUM"
+	STATUS_UNASSIGNED    = "UNASSIGNED" // unknown client waiting to be assigned to a group
+	STATUS_RELOADED      = "RELOADED"   // sent to web UI after configuration changed
+	CHECKSUM_FILE        = "FILE"       // checksum of image file as stored on master
+	CHECKSUM_DATA        = "DATA"       // checksum of decompressed image data
+	TRANSPORT_CHAIN      = "chain"
+	TRANSPORT_MULTICAST  = "multicast"
+	DEFAULT_PORT         = 8080 // of master and clients
 )
 
 type ClientTask struct {
 	ClientInfo ClientInfo `json:"client"`
 	ImageInfo  Image      `json:"image"`
+	// identifies the client in later requests, see requestClient
+	ClientID string `json:"clientID"`
+	// with TLS: client's certificate and CA, PEM encoded
+	Certificate   string `json:"certificate,omitempty"`
+	CACertificate string `json:"caCertificate,omitempty"`
 }
 
 type ClientInfo struct {
-	Address  string
-	Group    string
-	Image    string
-	Neighbor string
-	Status   string
+	Host      string // entry in hosts of client group: IP, MAC, UUID or hostname
+	Address   string // current IP address, "" until client asked for its task
+	Group     string
+	Image     string
+	Neighbors []string
+	Status    string
+	Port      int // data port of the client's group
+	// port the client chose to receive the image on (--listen-port), if any
+	ListenPort int `json:",omitempty"`
+	// multicast groups only
+	Transport     string `json:",omitempty"`
+	MulticastAddr string `json:",omitempty"`
+	// while receiving image
+	Progress   *ClientProgress `json:",omitempty"`
+	Bottleneck bool            `json:",omitempty"` // host limits throughput of its group
+	// why the client failed, as reported by it or noticed by master
+	Error string `json:",omitempty"`
+	// number of the chain the host is in, groups with chains > 1 only
+	Chain int `json:",omitempty"`
+	// unassigned clients only: identity sent with /getClientTask
+	Identity *ClientIdentity `json:",omitempty"`
+	// with TLS: new IP claiming the host's identity, until operator confirms
+	Unconfirmed string `json:",omitempty"`
 }
 
 type asset_info struct {
@@ -48,7 +86,9 @@ type asset_info struct {
 }
 
 var clients = map[string]ClientInfo{}
-var masterConfig Config
+var streamingGroups = map[string]bool{}
+var masterConfig Config      // read using currentConfig
+var configMutex sync.RWMutex // guards masterConfig itself, see currentConfig
 var mutex = &sync.Mutex{}
 
 var assetMap = map[string]asset_info{
@@ -65,6 +105,9 @@ var wsserver *Server
 
 func runWebserver() {
 	initClients()
+	loadOperatorPassword()
+	restoreState()
+	go stateSaver()
 	serverPort := "8080"
 	serverIP := "127.0.0.1"
 	var serverProto = "http"
@@ -82,6 +125,11 @@ func runWebserver() {
 	if _tmp[0] != "" {
 		serverIP = _tmp[0]
 	}
+	var tlsConfig *tls.Config
+	if useTLS {
+		tlsConfig = setupMasterTLS()
+		serverProto, scheme = "https", "https"
+	}
 	log.Printf("About to listen on %s ...", listenAddr)
 	serverURI = fmt.Sprintf("%s://%s:%d", serverProto, serverIP, serverPortInt)
 	log.Printf("Go to %s://%s:%d/", serverProto, serverIP, serverPortInt)
@@ -94,23 +142,41 @@ func runWebserver() {
 	// web UI
 	http.HandleFunc("/getClientGroups.json", clientGroupsHandler) // web UI retrieves all client groups
 	http.HandleFunc("/getClientStati.json", clientStatiHandler)
+	http.HandleFunc("/getUnassignedClients.json", unassignedClientsHandler)
+	http.HandleFunc("/assignClient", assignClientHandler)   // web UI assigns unknown clients to a group
+	http.HandleFunc("/reloadConfig", reloadConfigHandler)   // re-reads configuration, like SIGHUP
+	http.HandleFunc("/startGroup", startGroupHandler)       // operator starts a group with start = "manual"
+	http.HandleFunc("/confirmClient", confirmClientHandler) // operator confirms a client's new address
 	// status update websocket
 	wsserver = NewWebSocketServer("/websocket")
 	go wsserver.Listen()
+	go handleSIGHUP()
+
+	// deployment history: /api/deployments and /api/deployments/<id>
+	http.HandleFunc("/api/deployments", deploymentsHandler)
+	http.HandleFunc("/api/deployments/", deploymentsHandler)
 
 	// client api
-	http.HandleFunc("/getClientTask", clientTaskHandler)      // clients call it to receive their task
-	http.HandleFunc("/setClientStatus/", clientStatusHandler) // clients submit their status
-	http.HandleFunc("/startStream/", startStreamHandler)      // last client in chain calls us...
+	http.HandleFunc("/getClientTask", clientTaskHandler)          // clients call it to receive their task
+	http.HandleFunc("/setClientStatus/", clientStatusHandler)     // clients submit their status
+	http.HandleFunc("/setClientProgress/", clientProgressHandler) // clients submit bytes received/written
+	http.HandleFunc("/startStream/", startStreamHandler)          // last client in chain calls us...
+	http.HandleFunc("/getNeighbors", neighborsHandler)            // clients ask whom to forward to
+	http.HandleFunc("/relinkNeighbor/", relinkNeighborHandler)    // clients report a broken neighbor
+	http.HandleFunc("/getImage/", imageHandler)                   // clients replay image ranges to new neighbors
+	http.HandleFunc("/nackRange/", nackRangeHandler)              // multicast clients request retransmissions
+	// with TLS, clients renew their certificates while waiting or streaming
+	http.HandleFunc("/renewClientCertificate", renewClientCertificateHandler)
 	// retrieving new images (thePusher putImage)
-	http.HandleFunc("/saveImage/", saveImageHandler) // clients can PUT new images for later restore
+	http.HandleFunc("/saveImage/", saveImageHandler)     // clients can PUT new images for later restore
+	http.HandleFunc("/uploadToken/", uploadTokenHandler) // issues tokens for /saveImage, from master host only
 	// serve /static user-content
 	if staticContentRoot != "" {
 		files := http.FileServer(http.Dir(staticContentRoot))
 		http.Handle("/static/", http.StripPrefix("/static/", files))
 	}
 
-	err = http.ListenAndServe(listenAddr, nil)
+	err = listenAndServe(listenAddr, tlsConfig)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -118,43 +184,161 @@ func runWebserver() {
 
 func initClients() {
 	// sets global clients map for quick lookup...
-	readConfig(imageStorage + "/thePusher-config.hcl")
-	for _, group := range masterConfig.Clientgroups {
-		hostCount := len(group.Hosts)
+	config, err := readConfig(configFile())
+	if err != nil {
+		log.Fatal(err)
+	}
+	setConfig(config)
+	for _, group := range config.Clientgroups {
 		for hostIndex, hostname := range group.Hosts {
-			var clientEntry ClientInfo
-			clientEntry.Status = STATUS_NONE
-			clientEntry.Image = group.Image
-			clientEntry.Group = group.Name
-			clientEntry.Address = hostname
-			if hostIndex < hostCount-1 {
-				clientEntry.Neighbor = group.Hosts[hostIndex+1]
-			}
-			clients[hostname] = clientEntry
+			clients[hostname] = newClientEntry(group, hostIndex)
 		}
 	}
 	//log.Print(clients)
 }
 
+// newClientEntry returns the initial state of host number hostIndex of group
+func newClientEntry(group Clientgroup, hostIndex int) ClientInfo {
+	var clientEntry ClientInfo
+	hostname := group.Hosts[hostIndex]
+	clientEntry.Status = STATUS_NONE
+	clientEntry.Image = group.Image
+	clientEntry.Group = group.Name
+	clientEntry.Host = hostname
+	if isIPEntry(hostname) {
+		clientEntry.Address = entryIdentity(hostname)
+	}
+	clientEntry.Port = group.clientPort()
+	if group.Transport == TRANSPORT_MULTICAST {
+		clientEntry.Transport = TRANSPORT_MULTICAST
+		clientEntry.MulticastAddr = group.multicastAddr()
+	} else {
+		clientEntry.Neighbors = chainNeighbors(group, group.Hosts, hostIndex)
+	}
+	return clientEntry
+}
+
+// splitChains splits hosts into the chains of cgroup, in order
+func splitChains(cgroup Clientgroup, hosts []string) [][]string {
+	count := cgroup.chainCount(len(hosts))
+	chains := [][]string{}
+	for i := 0; i < count; i++ {
+		chains = append(chains, hosts[i*len(hosts)/count:(i+1)*len(hosts)/count])
+	}
+	return chains
+}
+
+// chainNeighbors returns the hosts that hosts[index] forwards the image to
+// within its chain
+func chainNeighbors(cgroup Clientgroup, hosts []string, index int) []string {
+	for _, chain := range splitChains(cgroup, hosts) {
+		if index < len(chain) {
+			return treeNeighbors(chain, index, cgroup.Fanout)
+		}
+		index -= len(chain)
+	}
+	return []string{}
+}
+
+// wireChains links the hosts of cgroup, all ready, into its chains;
+// returns the chains
+func wireChains(cgroup Clientgroup) [][]string {
+	chains := splitChains(cgroup, cgroup.Hosts)
+	mutex.Lock()
+	for chainIndex, chain := range chains {
+		for hostIndex, hostname := range chain {
+			c := clients[hostname]
+			c.Neighbors = treeNeighbors(chain, hostIndex, cgroup.Fanout)
+			c.Chain = 0
+			if len(chains) > 1 {
+				c.Chain = chainIndex + 1
+			}
+			clients[hostname] = c
+		}
+	}
+	mutex.Unlock()
+	return chains
+}
+
+// treeNeighbors returns the hosts that hosts[index] forwards the image to.
+// hosts form a tree in list order: first host is root, each host has up to
+// fanout children. fanout 1 (default) makes a linear chain.
+func treeNeighbors(hosts []string, index int, fanout int) []string {
+	if fanout < 1 {
+		fanout = 1
+	}
+	neighbors := []string{}
+	for child := index*fanout + 1; child <= index*fanout+fanout && child < len(hosts); child++ {
+		neighbors = append(neighbors, hosts[child])
+	}
+	return neighbors
+}
+
 func clientTaskHandler(w http.ResponseWriter, request *http.Request) {
 	var task ClientTask
 	responseCode := 200
 	clientIP, _, _ := net.SplitHostPort(request.RemoteAddr)
-	cinfo, ok := clients[clientIP]
+	mutex.Lock()
+	host, ok := resolveClient(request, clientIP)
+	refused := ""
+	if ok {
+		if refused = refuseIdentity(host, clientIP); refused == "" {
+			learnAddress(host, clientIP)
+			c := clients[host]
+			c.ListenPort = requestListenPort(request)
+			clients[host] = c
+		}
+	}
+	cinfo := clients[host]
+	mutex.Unlock()
+	if refused != "" {
+		log.Printf("REFUSED task of %s to %s: %s", host, clientIP, refused)
+		wsserver.sendAll(&cinfo)
+		http.Error(w, refused, http.StatusForbidden)
+		if verbose {
+			log.Printf("%s %3d %s %s", request.RemoteAddr, http.StatusForbidden, request.Method, request.URL.Path)
+		}
+		return
+	}
+	if ok && useTLS {
+		// clients POST a certificate request to get their certificate
+		csr, _ := ioutil.ReadAll(io.LimitReader(request.Body, 65536))
+		certificate, err := issueClientCertificate(csr, clientIP)
+		if err != nil {
+			responseCode = http.StatusBadRequest
+			http.Error(w, err.Error(), responseCode)
+			log.Printf("Cannot issue certificate for %s: %s", clientIP, err)
+			return
+		}
+		task.Certificate, task.CACertificate = certificate, string(masterCA.certPEM)
+	}
 	if ok {
 		task.ClientInfo = cinfo
 		task.ImageInfo = getImageByKey(cinfo.Image)
+		mutex.Lock()
+		task.ClientID = issueClientID(host)
+		mutex.Unlock()
 		myJSON, _ := json.Marshal(task)
 		w.Write(myJSON)
 
 		// lock!
 		mutex.Lock()
-		c := clients[clientIP]
+		c := clients[host]
 		c.Status = STATUS_READY_WAITING
-		clients[clientIP] = c
+		c.Progress = nil
+		c.Bottleneck = false
+		c.Error = ""
+		clients[host] = c
+		group := getClientgroupByKey(c.Group)
 		mutex.Unlock()
 		wsserver.sendAll(&c)
+		stateChanged()
+		if group.ReadyTimeout > 0 {
+			// the last host may never show up, readyTimeout runs from now
+			requestStream(group.Name)
+		}
 	} else {
+		enrollClient(request, clientIP)
 		responseCode = 404
 		http.NotFound(w, request)
 	}
@@ -165,29 +349,42 @@ func clientTaskHandler(w http.ResponseWriter, request *http.Request) {
 }
 
 func clientStatusHandler(w http.ResponseWriter, request *http.Request) {
-	// update a single client's status
+	// update a single client's status; failing clients may add ?reason=<error>
 	responseCode := 200
-	clientIP, _, _ := net.SplitHostPort(request.RemoteAddr)
-	_, ok := clients[clientIP]
+	host, ok := requestClient(request)
 	if ok {
 		var newStatus string
-		uriSegments := strings.Split(request.RequestURI, "/")
+		uriSegments := strings.Split(request.URL.Path, "/")
 		cStatus := uriSegments[2]
 		switch cStatus {
 		case STATUS_BUSY:
 			newStatus = STATUS_BUSY
 		case STATUS_DONE_OK:
 			newStatus = STATUS_DONE_OK
+		case STATUS_CHECKSUM_BAD:
+			newStatus = STATUS_CHECKSUM_BAD
 		default:
 			newStatus = STATUS_ERROR
 		}
 		// lock!
 		mutex.Lock()
-		c := clients[clientIP]
+		c := clients[host]
 		c.Status = newStatus
-		clients[clientIP] = c
+		c.Error = request.URL.Query().Get("reason")
+		if newStatus == STATUS_CHECKSUM_BAD && c.Error == "" {
+			c.Error = "checksum mismatch"
+		}
+		clients[host] = c
 		mutex.Unlock()
 		wsserver.sendAll(&c)
+		stateChanged()
+		if newStatus != STATUS_BUSY {
+			if group := deployedGroup(c.Group); groupFinished(group) {
+				logRunSummary(group)
+				deploymentFinished(group, "")
+			}
+			applyPendingClient(host)
+		}
 	} else {
 		responseCode = 404
 		http.NotFound(w, request)
@@ -200,68 +397,267 @@ func clientStatusHandler(w http.ResponseWriter, request *http.Request) {
 
 func startStreamHandler(w http.ResponseWriter, request *http.Request) {
 	uriSegments := strings.Split(request.RequestURI, "/")
-	groupName := uriSegments[2]
-	group := getClientgroupByKey(groupName)
-	image := getImageByKey(group.Image)
-	if group.Name != "" {
-		firstHost := group.Hosts[0]
-		log.Printf("Streaming %s (%s) to group %s (first: %s)", image.Name, image.Filename, groupName, firstHost)
-		go startStream(image.Filename, firstHost, group)
+	if _, ok := requestClient(request); !ok {
+		http.Error(w, "Unknown client", http.StatusForbidden)
+		return
+	}
+	if getClientgroupByKey(uriSegments[2]).Start != START_MANUAL {
+		requestStream(uriSegments[2])
 	}
 }
 
-func startStream(file string, target string, cgroup Clientgroup) {
-	url := fmt.Sprintf("http://%s:8080/receiveImage", target)
-	client := &http.Client{}
+// requestStream starts streaming to group groupName once its hosts are ready
+func requestStream(groupName string) {
+	mutex.Lock()
+	group := getClientgroupByKey(groupName)
+	// with fanout > 1 or multicast, every leaf requests the stream
+	alreadyStreaming := group.Name == "" || streamingGroups[groupName]
+	streamingGroups[groupName] = true
+	mutex.Unlock()
+	if alreadyStreaming {
+		return
+	}
+	go func() {
+		startStream(group)
+		mutex.Lock()
+		delete(streamingGroups, groupName)
+		mutex.Unlock()
+		applyPendingGroup(groupName)
+	}()
+}
 
+func startStream(cgroup Clientgroup) {
 	// as "last" client in chain isn't guaranteed to
 	// be the last client booted, this must WAIT HERE until
-	// all clients of group reported ready state
-	ready := false
-	cgroupHostCount := len(cgroup.Hosts)
-	for !ready {
-		waitingCount := 0
+	// all clients of group reported ready state -- or, see
+	// quorum.go, until readyTimeout passed with minReady hosts
+	waitStart := time.Now()
+	groupName := cgroup.Name
+	var ready []string
+	for {
+		// hosts may get assigned to the group while waiting
+		mutex.Lock()
+		cgroup = getClientgroupByKey(groupName)
+		if len(cgroup.Hosts) == 0 {
+			mutex.Unlock()
+			log.Printf("Group %s no longer exists or has no hosts, not streaming", groupName)
+			return
+		}
+		ready = readyHosts(cgroup)
 		for _, hostname := range cgroup.Hosts {
-			host := clients[hostname]
-			if host.Status == STATUS_NONE {
+			if host := clients[hostname]; host.Status == STATUS_NONE {
 				log.Printf("--  waiting for %s (still has status %s)\n", hostname, host.Status)
 			}
-			if host.Status == STATUS_READY_WAITING {
-				waitingCount = waitingCount + 1
-			}
 		}
-		if waitingCount == cgroupHostCount {
+		mutex.Unlock()
+		if len(ready) == len(cgroup.Hosts) {
 			log.Printf("Streaming to group %s finally starts now, all hosts ready!", cgroup.Name)
-			ready = true
-		} else {
-			log.Print("Not all hosts ready yet, still waiting...")
-			time.Sleep(5 * time.Second)
+			break
+		}
+		if quorumReached(cgroup, ready, waitStart) {
+			log.Printf("Streaming to group %s starts now, %d of %d hosts ready", cgroup.Name, len(ready), len(cgroup.Hosts))
+			break
 		}
+		log.Print("Not all hosts ready yet, still waiting...")
+		time.Sleep(5 * time.Second)
 	}
+	ready = orderHosts(cgroup, ready)
+	cgroup = dropStragglers(cgroup, ready, fmt.Sprintf("not ready within readyTimeout (%s)", cgroup.readyTimeout()))
+	streamToGroup(cgroup)
+}
 
-	filepath := fmt.Sprintf("%s/%s", imageStorage, file)
-	fileHandle, err := os.Open(filepath)
+// streamToGroup streams the group's image to its hosts, all ready
+func streamToGroup(cgroup Clientgroup) {
+	if len(cgroup.Hosts) == 0 {
+		log.Printf("No hosts left in group %s, not streaming", cgroup.Name)
+		return
+	}
+	image := getImageByKey(cgroup.Image)
+	var chains [][]string
+	heads := []string{cgroup.Hosts[0]}
+	if cgroup.Transport != TRANSPORT_MULTICAST {
+		chains = wireChains(cgroup)
+		heads = []string{}
+		for _, chain := range chains {
+			heads = append(heads, chain[0])
+		}
+	}
+	log.Printf("Streaming %s (%s) to group %s (first: %s)", image.Name, image.Filename, cgroup.Name, strings.Join(heads, ", "))
+	deploymentID := deploymentStarted(cgroup, image, chains)
+	imagepath := fmt.Sprintf("%s/%s", imageStorage, image.Filename)
+	var streamed int64
+	var err error
+	if cgroup.Transport == TRANSPORT_MULTICAST {
+		streamed, err = streamMulticast(imagepath, cgroup)
+	} else {
+		streamed, err = streamChain(imagepath, heads, cgroup)
+	}
+	deploymentStreamed(deploymentID, cgroup, streamed, err)
+}
+
+// streamChain streams the image file to heads, the first hosts of cgroup's
+// chains or trees; returns the bytes streamed
+func streamChain(imagepath string, heads []string, cgroup Clientgroup) (int64, error) {
+	file := filepath.Base(imagepath)
+	fileHandle, err := os.Open(imagepath)
 	if err != nil {
-		log.Fatalf("Cannot open %s: %s", file, err)
+		log.Printf("Cannot open %s: %s", file, err)
+		return 0, err
 	}
+	defer fileHandle.Close()
 	fileInfo, _ := fileHandle.Stat()
 	imageReader := bufio.NewReader(fileHandle)
-	request, err := http.NewRequest("PUT", url, imageReader)
 
-	// FIXME: should write progress so websocket can deliver and web UI can show it
-	request.ContentLength = fileInfo.Size()
-	response, err := client.Do(request)
-	if err != nil {
-		log.Fatal(err)
+	// progress is reported by clients, see clientProgressHandler
+	relink := func(deadNeighbor string) []string {
+		deadHost, ok := hostByAddress(cgroup.Hosts, deadNeighbor)
+		if !ok {
+			log.Printf("Cannot relink, %s is no host of group %s", deadNeighbor, cgroup.Name)
+			return []string{}
+		}
+		return clientAddresses(removeDeadNeighbor("", deadHost))
+	}
+	replay := func(from, to int64) (io.ReadCloser, error) {
+		return openImageRange(imagepath, from, to)
+	}
+	newForwarder := func(head string) *treeForwarder {
+		return newTreeForwarder(clientAddresses([]string{head}), fileInfo.Size(), relink, replay)
+	}
+	var forwarder io.WriteCloser
+	if len(heads) == 1 {
+		forwarder = newForwarder(heads[0])
 	} else {
-		defer response.Body.Close()
-		_, err := ioutil.ReadAll(response.Body)
-		if err != nil {
-			log.Fatal(err)
+		// a single read of the image feeds all chains
+		forwarders := []io.WriteCloser{}
+		for _, head := range heads {
+			forwarders = append(forwarders, newForwarder(head))
+		}
+		forwarder = newParallelForwarder(forwarders)
+	}
+	streamed, err := imageReader.WriteTo(forwarder)
+	if err != nil {
+		log.Printf("Streaming %s failed: %s", file, err)
+		return streamed, err
+	}
+	if err := forwarder.Close(); err != nil {
+		if len(heads) == 1 {
+			err = chainErrors{err}
 		}
+		log.Printf("Streaming %s failed: %s", file, err)
+		return streamed, err
 	}
-	fileHandle.Close()
 	log.Printf("Streaming %s completed", file)
+	return streamed, nil
+}
+
+func relinkNeighborHandler(w http.ResponseWriter, request *http.Request) {
+	// a client's neighbor broke away; reply with the neighbors replacing it
+	responseCode := 200
+	host, ok := requestClient(request)
+	uriSegments := strings.Split(request.RequestURI, "/")
+	mutex.Lock()
+	neighbors := clients[host].Neighbors
+	mutex.Unlock()
+	deadHost, deadOk := hostByAddress(neighbors, uriSegments[2])
+	if ok && deadOk {
+		myJSON, _ := json.Marshal(clientAddresses(removeDeadNeighbor(host, deadHost)))
+		w.Write(myJSON)
+	} else {
+		responseCode = 404
+		http.NotFound(w, request)
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+// removeDeadNeighbor drops deadNeighbor from its tree unless it still responds.
+// Its children (or theirs, if dead as well) get adopted by predecessor; they
+// are returned as the hosts predecessor must forward to instead. predecessor
+// is empty if master itself lost the first client.
+func removeDeadNeighbor(predecessor string, deadNeighbor string) []string {
+	replacements := liveNeighbors(deadNeighbor)
+	if predecessor != "" {
+		mutex.Lock()
+		c := clients[predecessor]
+		neighbors := []string{}
+		for _, neighbor := range c.Neighbors {
+			if neighbor != deadNeighbor {
+				neighbors = append(neighbors, neighbor)
+			}
+		}
+		c.Neighbors = append(neighbors, replacements...)
+		clients[predecessor] = c
+		mutex.Unlock()
+		log.Printf("Relinked %s to %s", predecessor, strings.Join(c.Neighbors, ", "))
+	} else {
+		log.Printf("Relinked master to %s", strings.Join(replacements, ", "))
+	}
+	return replacements
+}
+
+// liveNeighbors returns host if it still responds, otherwise marks it failed
+// and returns the live hosts of its subtree that it should have fed
+func liveNeighbors(host string) []string {
+	mutex.Lock()
+	address := clients[host].dataAddress()
+	mutex.Unlock()
+	if _, err := queryStreamOffset(address); err == nil {
+		return []string{host}
+	}
+	log.Printf("Removing unreachable client %s from chain", host)
+	mutex.Lock()
+	c := clients[host]
+	c.Status = STATUS_ERROR
+	c.Error = "unreachable, removed from chain"
+	clients[host] = c
+	mutex.Unlock()
+	wsserver.sendAll(&c)
+	stateChanged()
+	replacements := []string{}
+	for _, child := range c.Neighbors {
+		replacements = append(replacements, liveNeighbors(child)...)
+	}
+	return replacements
+}
+
+func imageHandler(w http.ResponseWriter, request *http.Request) {
+	// serve (ranges of) configured images, e.g. for replay to a new neighbor
+	if _, ok := requestClient(request); !ok {
+		http.Error(w, "Unknown client", http.StatusForbidden)
+		return
+	}
+	uriSegments := strings.Split(request.RequestURI, "/")
+	image := getImageByKey(uriSegments[2])
+	if image.Name == "" {
+		http.NotFound(w, request)
+		return
+	}
+	fileHandle, err := os.Open(fmt.Sprintf("%s/%s", imageStorage, image.Filename))
+	if err != nil {
+		http.NotFound(w, request)
+		return
+	}
+	defer fileHandle.Close()
+	fileInfo, _ := fileHandle.Stat()
+	http.ServeContent(w, request, image.Filename, fileInfo.ModTime(), fileHandle)
+}
+
+// openImageRange returns a reader on bytes from (incl.) to to (excl.) of an image file
+func openImageRange(imagepath string, from, to int64) (io.ReadCloser, error) {
+	fileHandle, err := os.Open(imagepath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fileHandle.Seek(from, io.SeekStart); err != nil {
+		fileHandle.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(fileHandle, to-from), fileHandle}, nil
 }
 
 // asset web server (for web-frontend)
@@ -295,37 +691,98 @@ func assetHttpHandler(w http.ResponseWriter, request *http.Request) {
 
 func clientGroupsHandler(w http.ResponseWriter, request *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	myJSON, _ := json.Marshal(masterConfig.Clientgroups)
+	myJSON, _ := json.Marshal(currentConfig().Clientgroups)
 	w.Write(myJSON)
 }
 
 func clientStatiHandler(w http.ResponseWriter, request *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+	// progress reports update clients all the time
+	mutex.Lock()
 	myJSON, _ := json.Marshal(clients)
+	mutex.Unlock()
 	w.Write(myJSON)
 }
 
 func saveImageHandler(w http.ResponseWriter, request *http.Request) {
-	// test: curl --upload-file my.img  http://localhost:8080/saveImage/my.img
-	log.Printf("/saveImage starting (source: %s)", request.RemoteAddr)
+	// test: curl -H "X-Pusher-Token: $(curl -s http://localhost:8080/uploadToken/my.img)" \
+	//         --upload-file my.img http://localhost:8080/saveImage/my.img
+	// uploads go to <filename>.part first and may be resumed using Content-Range;
+	// HEAD tells uploader how many bytes of a partial upload master already has
 	uriSegments := strings.Split(request.RequestURI, "/")
-	filename := uriSegments[2]
-	filepath := fmt.Sprintf("%s/%s", imageStorage, filename)
-	if _, err := os.Stat(filepath); err == nil {
+	filename := filepath.Base(uriSegments[2])
+	imagepath := fmt.Sprintf("%s/%s", imageStorage, filename)
+	partpath := imagepath + ".part"
+	start, size := int64(0), int64(-1)
+	if request.Method != "HEAD" {
+		var err error
+		if start, size, err = parseContentRange(request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	upload := currentConfig().Upload
+	if code, reason := refuseUpload(request, upload, filename, size); code != 0 {
+		log.Printf("REFUSED %s from %s: %s", filename, request.RemoteAddr, reason)
+		http.Error(w, reason, code)
+		return
+	}
+	if _, err := os.Stat(imagepath); err == nil {
 		log.Printf("REFUSED %s: File exists", filename)
 		http.Error(w, "Forbidden (File exists)", http.StatusForbidden)
 		return
 	}
-	// FIXME: add "clientsAllowedPut = ["a.b.c.d","e.f.g.h"]" and verify here [empty to forbid any]
-	outfile, err := os.Create(filepath)
+	partSize := int64(0)
+	if partInfo, err := os.Stat(partpath); err == nil {
+		partSize = partInfo.Size()
+	}
+	if request.Method == "HEAD" {
+		w.Header().Set(HEADER_OFFSET, strconv.FormatInt(partSize, 10))
+		return
+	}
+	if start > partSize {
+		log.Printf("REFUSED %s: Resume at %d, but only %d bytes present", filename, start, partSize)
+		w.Header().Set(HEADER_OFFSET, strconv.FormatInt(partSize, 10))
+		http.Error(w, "Requested range not satisfiable", http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	log.Printf("/saveImage starting (source: %s, offset: %d)", request.RemoteAddr, start)
+	outfile, err := os.OpenFile(partpath, os.O_WRONLY|os.O_CREATE, 0644)
 	if err != nil {
-		log.Fatalf("ERROR: %s", err)
+		log.Printf("ERROR: %s", err)
+		http.Error(w, "Cannot create image file", http.StatusInternalServerError)
+		return
 	}
 	defer outfile.Close()
+	outfile.Truncate(start)
+	outfile.Seek(start, io.SeekStart)
+	var body io.Reader = request.Body
+	maxSize := upload.maxBytes()
+	if maxSize > 0 {
+		// size may be unknown; read at most one byte beyond maxSize
+		body = io.LimitReader(body, maxSize-start+1)
+	}
 	outfileWriter := bufio.NewWriter(outfile)
-	reader := bufio.NewReader(request.Body)
-	reader.WriteTo(outfileWriter)
+	reader := bufio.NewReader(body)
+	written, err := reader.WriteTo(outfileWriter)
 	outfileWriter.Flush()
 	outfile.Sync()
+	if maxSize > 0 && start+written > maxSize {
+		os.Remove(partpath)
+		log.Printf("REFUSED %s from %s: exceeds maxSize of %d MB", filename, request.RemoteAddr, upload.MaxSize)
+		http.Error(w, "Upload exceeds maxSize", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil || (size >= 0 && start+written != size) {
+		log.Printf("/saveImage interrupted at offset %d, may be resumed", start+written)
+		http.Error(w, "Upload incomplete", http.StatusInternalServerError)
+		return
+	}
+	if err := os.Rename(partpath, imagepath); err != nil {
+		log.Printf("ERROR: %s", err)
+		http.Error(w, "Cannot rename image file", http.StatusInternalServerError)
+		return
+	}
+	consumeUploadToken(request)
 	log.Print("/saveImage completed")
 }
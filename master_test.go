@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTreeNeighbors(t *testing.T) {
+	hosts := []string{"a", "b", "c", "d", "e", "f", "g"}
+	tests := []struct {
+		index  int
+		fanout int
+		want   []string
+	}{
+		{0, 0, []string{"b"}},
+		{0, 1, []string{"b"}},
+		{5, 1, []string{"g"}},
+		{6, 1, []string{}},
+		{0, 2, []string{"b", "c"}},
+		{1, 2, []string{"d", "e"}},
+		{2, 2, []string{"f", "g"}},
+		{3, 2, []string{}},
+		{0, 3, []string{"b", "c", "d"}},
+		{1, 3, []string{"e", "f", "g"}},
+		{2, 3, []string{}},
+		{0, 10, []string{"b", "c", "d", "e", "f", "g"}},
+	}
+	for _, test := range tests {
+		got := treeNeighbors(hosts, test.index, test.fanout)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("host %d, fanout %d: got %v, want %v", test.index, test.fanout, got, test.want)
+		}
+	}
+}
+
+func TestSplitChains(t *testing.T) {
+	hosts := []string{"a", "b", "c", "d", "e", "f", "g"}
+	tests := []struct {
+		chains int
+		hosts  []string
+		want   [][]string
+	}{
+		{0, hosts, [][]string{hosts}},
+		{1, hosts, [][]string{hosts}},
+		{2, hosts, [][]string{{"a", "b", "c"}, {"d", "e", "f", "g"}}},
+		{3, hosts, [][]string{{"a", "b"}, {"c", "d"}, {"e", "f", "g"}}},
+		{7, hosts, [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}, {"f"}, {"g"}}},
+		{10, hosts[:3], [][]string{{"a"}, {"b"}, {"c"}}},
+		{2, hosts[:1], [][]string{{"a"}}},
+	}
+	for _, test := range tests {
+		got := splitChains(Clientgroup{Chains: test.chains}, test.hosts)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d chains of %v: got %v, want %v", test.chains, test.hosts, got, test.want)
+		}
+	}
+}
+
+func TestChainNeighbors(t *testing.T) {
+	// chains do not forward to each other; fanout applies within each chain
+	hosts := []string{"a", "b", "c", "d", "e", "f"}
+	cgroup := Clientgroup{Chains: 2, Fanout: 2}
+	want := [][]string{{"b", "c"}, {}, {}, {"e", "f"}, {}, {}}
+	for index := range hosts {
+		if got := chainNeighbors(cgroup, hosts, index); !reflect.DeepEqual(got, want[index]) {
+			t.Errorf("host %s: got %v, want %v", hosts[index], got, want[index])
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	MCAST_DEFAULT_ADDR = "239.255.80.80:8081"
+	MCAST_DEFAULT_RATE = 100      // Mbit/s
+	MCAST_HEADER       = 16       // offset + image size, both uint64
+	MCAST_PAYLOAD      = 1400     // fits into ethernet MTU, including headers
+	MCAST_WINDOW       = 64 << 20 // max. bytes a client buffers ahead of a gap
+	MCAST_NACK_DELAY   = 200 * time.Millisecond
+	MCAST_NACK_RANGES  = 64               // max. ranges per NACK request
+	MCAST_IDLE_TIMEOUT = 60 * time.Second // end session if nobody asks for repairs
+)
+
+// byteRange is a NACKed range of the image; to is exclusive, -1 means until end
+type byteRange struct {
+	from, to int64
+}
+
+// multicastSession collects repair requests of the clients of a group
+type multicastSession struct {
+	repairs chan byteRange
+}
+
+var multicastSessions = map[string]*multicastSession{} // by group name
+
+// MASTER
+
+// streamMulticast sends the image as sequenced datagrams to the group's
+// multicast address, serving repair requests until all clients finished;
+// returns the bytes sent, including repairs
+func streamMulticast(imagepath string, cgroup Clientgroup) (int64, error) {
+	fileHandle, err := os.Open(imagepath)
+	if err != nil {
+		log.Printf("Cannot open %s: %s", imagepath, err)
+		return 0, err
+	}
+	defer fileHandle.Close()
+	fileInfo, _ := fileHandle.Stat()
+	size := fileInfo.Size()
+
+	addr, err := net.ResolveUDPAddr("udp4", cgroup.multicastAddr())
+	if err != nil {
+		log.Printf("Invalid multicast address %s: %s", cgroup.multicastAddr(), err)
+		return 0, err
+	}
+	conn, err := net.DialUDP("udp4", nil, addr)
+	if err != nil {
+		log.Printf("Cannot send to %s: %s", addr, err)
+		return 0, err
+	}
+	defer conn.Close()
+
+	session := &multicastSession{repairs: make(chan byteRange, 1024)}
+	mutex.Lock()
+	multicastSessions[cgroup.Name] = session
+	mutex.Unlock()
+	defer func() {
+		mutex.Lock()
+		delete(multicastSessions, cgroup.Name)
+		mutex.Unlock()
+	}()
+
+	log.Printf("Multicasting %s to %s (%d Mbit/s)", imagepath, addr, cgroup.multicastRate())
+	datagram := make([]byte, MCAST_HEADER+MCAST_PAYLOAD)
+	pacer := newPacer(cgroup.multicastRate())
+	sent := int64(0)
+	send := func(offset int64) error {
+		n, err := fileHandle.ReadAt(datagram[MCAST_HEADER:], offset)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		binary.BigEndian.PutUint64(datagram[0:8], uint64(offset))
+		binary.BigEndian.PutUint64(datagram[8:16], uint64(size))
+		pacer.wait(MCAST_HEADER + n)
+		_, err = conn.Write(datagram[:MCAST_HEADER+n])
+		sent += int64(n)
+		return err
+	}
+	repair := func(r byteRange) {
+		if r.to < 0 || r.to > size {
+			r.to = size
+		}
+		for offset := r.from - r.from%MCAST_PAYLOAD; offset < r.to; offset += MCAST_PAYLOAD {
+			send(offset)
+		}
+	}
+
+	// first pass, repairs take precedence over new data
+	next := int64(0)
+	for next < size {
+		select {
+		case r := <-session.repairs:
+			repair(r)
+		default:
+			if err := send(next); err != nil {
+				log.Printf("Multicast failed: %s", err)
+				return sent, err
+			}
+			next += MCAST_PAYLOAD
+		}
+	}
+	log.Printf("Multicast of %s sent, serving repairs", imagepath)
+
+	// repair phase -- ends when all clients completed or went silent
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	lastRepair := time.Now()
+	for {
+		select {
+		case r := <-session.repairs:
+			repair(r)
+			lastRepair = time.Now()
+		case <-ticker.C:
+			if groupFinished(cgroup) {
+				log.Printf("Multicast of %s completed", imagepath)
+				return sent, nil
+			}
+			if time.Since(lastRepair) > MCAST_IDLE_TIMEOUT {
+				log.Printf("Multicast of %s ends, no repair requests for %s", imagepath, MCAST_IDLE_TIMEOUT)
+				return sent, nil
+			}
+			// announce image size to clients that missed all of it so far
+			send(size - 1 - (size-1)%MCAST_PAYLOAD)
+		}
+	}
+}
+
+// groupFinished tells whether no host of cgroup is receiving anymore;
+// hosts dropped from the run or removed by a reload don't count
+func groupFinished(cgroup Clientgroup) bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+	for _, hostname := range cgroup.Hosts {
+		c, ok := clients[hostname]
+		if droppedHosts[hostname] || !ok {
+			continue
+		}
+		switch c.Status {
+		case STATUS_DONE_OK, STATUS_ERROR, STATUS_CHECKSUM_BAD:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func nackRangeHandler(w http.ResponseWriter, request *http.Request) {
+	// clients request retransmission: /nackRange/<from>/<to>[/<from>/<to>...]
+	responseCode := 200
+	host, _ := requestClient(request)
+	uriSegments := strings.Split(request.RequestURI, "/")[2:]
+	ranges := []byteRange{}
+	var err error
+	if len(uriSegments)%2 != 0 {
+		err = fmt.Errorf("invalid request")
+	}
+	for i := 0; err == nil && i < len(uriSegments); i += 2 {
+		var r byteRange
+		if r.from, err = strconv.ParseInt(uriSegments[i], 10, 64); err == nil {
+			r.to, err = strconv.ParseInt(uriSegments[i+1], 10, 64)
+		}
+		ranges = append(ranges, r)
+	}
+	mutex.Lock()
+	session := multicastSessions[clients[host].Group]
+	mutex.Unlock()
+	if err != nil {
+		responseCode = http.StatusBadRequest
+		http.Error(w, err.Error(), responseCode)
+	} else if session == nil {
+		responseCode = 404
+		http.NotFound(w, request)
+	} else {
+		for _, r := range ranges {
+			select {
+			case session.repairs <- r:
+			default:
+				// queue full; client will ask again
+			}
+		}
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+func (cgroup Clientgroup) multicastAddr() string {
+	if cgroup.MulticastAddr == "" {
+		return MCAST_DEFAULT_ADDR
+	}
+	return cgroup.MulticastAddr
+}
+
+func (cgroup Clientgroup) multicastRate() int {
+	if cgroup.MulticastRate <= 0 {
+		return MCAST_DEFAULT_RATE
+	}
+	return cgroup.MulticastRate
+}
+
+// pacer limits the send rate to mbits Mbit/s
+type pacer struct {
+	start time.Time
+	sent  int64
+	mbits int
+}
+
+func newPacer(mbits int) *pacer {
+	return &pacer{start: time.Now(), mbits: mbits}
+}
+
+func (p *pacer) wait(bytes int) {
+	p.sent += int64(bytes)
+	due := p.start.Add(time.Duration(p.sent * 8 * int64(time.Microsecond) / int64(p.mbits)))
+	if delay := time.Until(due); delay > time.Millisecond {
+		time.Sleep(delay)
+	}
+}
+
+// CLIENT
+
+// receiveMulticast joins the group's multicast address and passes the
+// datagrams, reassembled in order, on to storeImage(). Gaps are NACKed.
+func receiveMulticast() {
+	addr, err := net.ResolveUDPAddr("udp4", cTask.ClientInfo.MulticastAddr)
+	if err != nil {
+		log.Fatalf("Invalid multicast address %s: %s", cTask.ClientInfo.MulticastAddr, err)
+	}
+	conn, err := net.ListenMulticastUDP("udp4", nil, addr)
+	if err != nil {
+		log.Fatalf("Cannot join multicast group %s: %s", addr, err)
+	}
+	defer conn.Close()
+	conn.SetReadBuffer(8 << 20)
+	log.Printf("Waiting for multicast on %s ...", addr)
+
+	size := int64(-1)
+	expected := int64(0)
+	pending := map[int64][]byte{} // datagrams received ahead of a gap
+	pendingBytes := 0
+	lastProgress := time.Now()
+	lastReceived := time.Now()
+	lastNack := time.Time{}
+	buf := make([]byte, 65536)
+	for size < 0 || expected < size {
+		conn.SetReadDeadline(time.Now().Add(MCAST_NACK_DELAY))
+		n, _, err := conn.ReadFromUDP(buf)
+		if err == nil && n >= MCAST_HEADER {
+			lastReceived = time.Now()
+			offset := int64(binary.BigEndian.Uint64(buf[0:8]))
+			if size < 0 {
+				size = int64(binary.BigEndian.Uint64(buf[8:16]))
+				log.Printf("/receiveImage ... receiving %d bytes via multicast", size)
+				pr, pw := io.Pipe()
+				stream.pw = pw
+				stream.done = make(chan bool)
+				go storeImage(pr, size)
+			}
+			payload := buf[MCAST_HEADER:n]
+			if offset == expected {
+				if data, ok := pending[offset]; ok {
+					delete(pending, offset)
+					pendingBytes -= len(data)
+				}
+				stream.pw.Write(payload)
+				expected += int64(len(payload))
+				lastProgress = time.Now()
+				for data, ok := pending[expected]; ok; data, ok = pending[expected] {
+					delete(pending, expected)
+					pendingBytes -= len(data)
+					stream.pw.Write(data)
+					expected += int64(len(data))
+				}
+				atomic.StoreInt64(&stream.offset, expected)
+			} else if offset > expected && pendingBytes < MCAST_WINDOW {
+				if _, ok := pending[offset]; !ok {
+					pending[offset] = append([]byte{}, payload...)
+					pendingBytes += len(payload)
+				}
+			}
+		}
+		// ask for the missing ranges if stuck; the range behind the last
+		// datagram received is only missing if master went quiet
+		if size >= 0 && expected < size && time.Since(lastProgress) > MCAST_NACK_DELAY && time.Since(lastNack) > MCAST_NACK_DELAY {
+			requestRepair(missingRanges(pending, expected, size, time.Since(lastReceived) > MCAST_NACK_DELAY))
+			lastNack = time.Now()
+		}
+	}
+	stream.pw.Close()
+	<-stream.done
+}
+
+// missingRanges lists the gaps between expected and the pending datagrams
+func missingRanges(pending map[int64][]byte, expected int64, size int64, withTail bool) []byteRange {
+	offsets := []int64{}
+	for offset := range pending {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	ranges := []byteRange{}
+	position := expected
+	for _, offset := range offsets {
+		if offset > position {
+			ranges = append(ranges, byteRange{position, offset})
+		}
+		position = offset + int64(len(pending[offset]))
+	}
+	if withTail && position < size {
+		ranges = append(ranges, byteRange{position, size})
+	}
+	if len(ranges) > MCAST_NACK_RANGES {
+		ranges = ranges[:MCAST_NACK_RANGES]
+	}
+	return ranges
+}
+
+func requestRepair(ranges []byteRange) {
+	if len(ranges) == 0 {
+		return
+	}
+	path := "/nackRange"
+	for _, r := range ranges {
+		path += fmt.Sprintf("/%d/%d", r.from, r.to)
+	}
+	response, err := http.DefaultClient.Do(masterRequest(path))
+	if err != nil {
+		log.Printf("Cannot request repair: %s", err)
+		return
+	}
+	response.Body.Close()
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMissingRanges(t *testing.T) {
+	datagram := make([]byte, 100)
+	pending := func(offsets ...int64) map[int64][]byte {
+		p := map[int64][]byte{}
+		for _, offset := range offsets {
+			p[offset] = datagram
+		}
+		return p
+	}
+	many := map[int64][]byte{}
+	for i := int64(0); i < 2*MCAST_NACK_RANGES; i++ {
+		many[200*i+100] = datagram
+	}
+	tests := []struct {
+		name     string
+		pending  map[int64][]byte
+		expected int64
+		withTail bool
+		want     []byteRange
+	}{
+		{"nothing pending", pending(), 0, false, []byteRange{}},
+		{"nothing pending, tail", pending(), 0, true, []byteRange{{0, 1000}}},
+		{"nothing pending, tail from expected", pending(), 300, true, []byteRange{{300, 1000}}},
+		{"gap before pending", pending(200, 300), 0, false, []byteRange{{0, 200}}},
+		{"gap before pending, tail", pending(200, 300), 0, true, []byteRange{{0, 200}, {400, 1000}}},
+		{"several gaps", pending(200, 500, 600, 900), 100, false, []byteRange{{100, 200}, {300, 500}, {700, 900}}},
+		{"pending up to end, tail", pending(500, 600, 700, 800, 900), 0, true, []byteRange{{0, 500}}},
+		{"limited ranges", many, 0, false, nil},
+	}
+	for _, test := range tests {
+		got := missingRanges(test.pending, test.expected, 1000, test.withTail)
+		if test.want == nil {
+			if len(got) != MCAST_NACK_RANGES || got[0] != (byteRange{0, 100}) {
+				t.Errorf("%s: got %d ranges starting with %v, want %d", test.name, len(got), got[0], MCAST_NACK_RANGES)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestMulticast streams an image from streamMulticast to receiveMulticast via
+// loopback multicast. A relay in between drops datagrams, so the image is
+// complete only if NACKed ranges are repaired.
+func TestMulticast(t *testing.T) {
+	group := probeMulticast(t)
+	dir, err := ioutil.TempDir("", "multicast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	image := make([]byte, 3*1048576+123)
+	for i := range image {
+		image[i] = byte(i * 7 % 251)
+	}
+	imagepath := filepath.Join(dir, "image")
+	if err := ioutil.WriteFile(imagepath, image, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// relay drops every 10th datagram and the last one (i.e. the tail),
+	// each only the first time it is sent
+	relay, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer relay.Close()
+	out, err := net.DialUDP("udp4", nil, group)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	dropped := map[uint64]bool{}
+	go func() {
+		buf := make([]byte, 65536)
+		for count := 0; ; count++ {
+			n, _, err := relay.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			offset := binary.BigEndian.Uint64(buf[0:8])
+			last := offset+MCAST_PAYLOAD >= uint64(len(image))
+			if (count%10 == 5 || last) && !dropped[offset] {
+				dropped[offset] = true
+				continue
+			}
+			out.Write(buf[:n])
+		}
+	}()
+
+	// master serves the NACKs; status and progress reports are just recorded
+	host := "127.0.0.1"
+	cgroup := Clientgroup{Name: "mcast", Hosts: []string{host}, MulticastAddr: relay.LocalAddr().String(), MulticastRate: 400}
+	mutex.Lock()
+	clients[host] = ClientInfo{Host: host, Address: host, Group: cgroup.Name, Status: STATUS_READY_WAITING}
+	clientIDs["multicast-test"] = host
+	mutex.Unlock()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/nackRange/", nackRangeHandler)
+	mux.HandleFunc("/getNeighbors", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("[]")) })
+	mux.HandleFunc("/setClientProgress/", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/setClientStatus/", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		c := clients[host]
+		c.Status = strings.Split(r.URL.Path, "/")[2]
+		clients[host] = c
+		mutex.Unlock()
+	})
+	master := httptest.NewServer(mux)
+	defer master.Close()
+	masterHost, masterPort, _ := net.SplitHostPort(strings.TrimPrefix(master.URL, "http://"))
+	pusherIP = masterHost
+	pusherPort, _ = strconv.Atoi(masterPort)
+
+	destination := filepath.Join(dir, "dest")
+	cTask = ClientTask{ClientID: "multicast-test",
+		ClientInfo: ClientInfo{Host: host, Group: cgroup.Name, Transport: TRANSPORT_MULTICAST, MulticastAddr: group.String()},
+		ImageInfo:  Image{Type: IMG_DDIMG, Compression: COMP_NONE, Destination: destination}}
+	received := make(chan bool)
+	go func() {
+		receiveMulticast()
+		close(received)
+	}()
+	time.Sleep(100 * time.Millisecond) // let client join the group
+
+	streamed := make(chan error)
+	go func() {
+		sent, err := streamMulticast(imagepath, cgroup)
+		if err == nil && sent <= int64(len(image)) {
+			t.Errorf("sent %d bytes, no repairs", sent)
+		}
+		streamed <- err
+	}()
+	select {
+	case <-received:
+	case <-time.After(30 * time.Second):
+		t.Fatal("image not received")
+	}
+	select {
+	case err := <-streamed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("master did not notice client finished")
+	}
+	if got, _ := ioutil.ReadFile(destination); !bytes.Equal(got, image) {
+		t.Errorf("received image differs (%d bytes, want %d)", len(got), len(image))
+	}
+}
+
+// probeMulticast returns a multicast group local datagrams are looped back
+// from; the test is skipped if there is none
+func probeMulticast(t *testing.T) *net.UDPAddr {
+	group := &net.UDPAddr{IP: net.IPv4(239, 255, 80, 99), Port: 18081}
+	conn, err := net.ListenMulticastUDP("udp4", nil, group)
+	if err != nil {
+		t.Skipf("cannot join multicast group: %s", err)
+	}
+	defer conn.Close()
+	sender, err := net.DialUDP("udp4", nil, group)
+	if err != nil {
+		t.Skipf("cannot send multicast: %s", err)
+	}
+	defer sender.Close()
+	sender.Write([]byte("probe"))
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, _, err := conn.ReadFromUDP(make([]byte, 16)); err != nil {
+		t.Skipf("multicast not looped back: %s", err)
+	}
+	return group
+}
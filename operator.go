@@ -0,0 +1,54 @@
+package main
+
+import (
+	"crypto/subtle"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Requests changing master's state (assigning clients, reloading the
+// configuration, starting groups) are accepted from master itself, like
+// /uploadToken. From other hosts, they require the operator password read
+// from --operator-password-file, using HTTP basic authentication with any
+// user name; browsers prompt for it.
+var operatorPasswordFile string
+var operatorPassword string
+
+// loadOperatorPassword reads the operator password, if a file is given
+func loadOperatorPassword() {
+	if operatorPasswordFile == "" {
+		log.Print("No operator password set, web UI can change state from master only")
+		return
+	}
+	password, err := ioutil.ReadFile(operatorPasswordFile)
+	if err != nil {
+		log.Fatalf("Cannot read operator password: %s", err)
+	}
+	if operatorPassword = strings.TrimSpace(string(password)); operatorPassword == "" {
+		log.Fatalf("Operator password file %s is empty", operatorPasswordFile)
+	}
+}
+
+// refuseOperator answers request if it may not change master's state; it
+// returns the HTTP status it answered with, or 0 if request is allowed
+func refuseOperator(w http.ResponseWriter, request *http.Request) int {
+	clientIP, _, _ := net.SplitHostPort(request.RemoteAddr)
+	if ip := net.ParseIP(clientIP); ip != nil && ip.IsLoopback() {
+		return 0
+	}
+	_, password, ok := request.BasicAuth()
+	if ok && operatorPassword != "" && subtle.ConstantTimeCompare([]byte(password), []byte(operatorPassword)) == 1 {
+		return 0
+	}
+	log.Printf("REFUSED %s %s from %s: operator password required", request.Method, request.URL.Path, clientIP)
+	if operatorPassword == "" {
+		http.Error(w, "Forbidden, only allowed on master itself", http.StatusForbidden)
+		return http.StatusForbidden
+	}
+	w.Header().Set("WWW-Authenticate", `Basic realm="thePusher"`)
+	http.Error(w, "Operator password required", http.StatusUnauthorized)
+	return http.StatusUnauthorized
+}
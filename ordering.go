@@ -0,0 +1,190 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ordering of a client group decides the order of its chain (or tree):
+// "list" (default) follows hosts, "topology" keeps hosts of a switch and
+// rack together, using labels of host blocks, so the stream enters and
+// leaves each switch once. "measured" lets ready clients probe each other's
+// round trip time and links each host to its nearest peer.
+const (
+	ORDERING_LIST     = "list"
+	ORDERING_TOPOLOGY = "topology"
+	ORDERING_MEASURED = "measured"
+	PROBE_COUNT       = 3               // requests per peer, the fastest one counts
+	PROBE_TIMEOUT     = 2 * time.Second // per request
+	PROBE_UNREACHABLE = time.Hour       // RTT assumed if probing failed
+)
+
+// HostLabels tell where a host is located in the network
+type HostLabels struct {
+	Name   string `hcl:",key"` // hosts entry: IP, MAC, DMI UUID or hostname
+	Switch string `hcl:"switch"`
+	Rack   string `hcl:"rack"`
+}
+
+// MASTER
+
+// orderHosts returns the ready hosts of cgroup in the order to stream to
+func orderHosts(cgroup Clientgroup, ready []string) []string {
+	if cgroup.Transport == TRANSPORT_MULTICAST {
+		return ready
+	}
+	switch cgroup.Ordering {
+	case ORDERING_TOPOLOGY:
+		labels := currentConfig().hostLabels()
+		ready = topologyOrder(ready, labels)
+	case ORDERING_MEASURED:
+		ready = measuredOrder(ready)
+	default:
+		return ready
+	}
+	log.Printf("Chain order of group %s (%s): %v", cgroup.Name, cgroup.Ordering, ready)
+	return ready
+}
+
+func (config Config) hostLabels() map[string]HostLabels {
+	labels := map[string]HostLabels{}
+	for _, host := range config.Hosts {
+		labels[normalizeHost(host.Name)] = host
+	}
+	return labels
+}
+
+// topologyOrder sorts hosts by switch, then by rack; switches and racks
+// come in the order of their first host in hosts
+func topologyOrder(hosts []string, labels map[string]HostLabels) []string {
+	switchRank, rackRank := map[string]int{}, map[[2]string]int{}
+	for _, host := range hosts {
+		l := labels[host]
+		if _, ok := switchRank[l.Switch]; !ok {
+			switchRank[l.Switch] = len(switchRank)
+		}
+		if _, ok := rackRank[[2]string{l.Switch, l.Rack}]; !ok {
+			rackRank[[2]string{l.Switch, l.Rack}] = len(rackRank)
+		}
+	}
+	ordered := append([]string{}, hosts...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := labels[ordered[i]], labels[ordered[j]]
+		if a.Switch != b.Switch {
+			return switchRank[a.Switch] < switchRank[b.Switch]
+		}
+		return rackRank[[2]string{a.Switch, a.Rack}] < rackRank[[2]string{b.Switch, b.Rack}]
+	})
+	return ordered
+}
+
+// measuredOrder starts the chain at the host nearest to master and appends
+// the unchained host nearest to the last one, as measured by the clients
+func measuredOrder(hosts []string) []string {
+	addresses := clientAddresses(hosts)
+	if len(addresses) != len(hosts) {
+		log.Print("Addresses of some clients unknown, keeping hosts order")
+		return hosts
+	}
+	rtt := make([]map[string]time.Duration, len(hosts)) // from host i to address
+	fromMaster := make([]time.Duration, len(hosts))
+	var wg sync.WaitGroup
+	for i := range hosts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			fromMaster[i] = probeRTT(addresses[i])
+			var err error
+			if rtt[i], err = requestProbe(addresses[i], addresses); err != nil {
+				log.Printf("Cannot probe peers of %s: %s", hosts[i], err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	distance := func(i, j int) time.Duration {
+		if d, ok := rtt[i][addresses[j]]; ok {
+			return d
+		}
+		return PROBE_UNREACHABLE
+	}
+	ordered := []string{}
+	chained := make([]bool, len(hosts))
+	last := -1
+	for len(ordered) < len(hosts) {
+		next := -1
+		for j := range hosts {
+			if chained[j] {
+				continue
+			}
+			if next == -1 || (last == -1 && fromMaster[j] < fromMaster[next]) ||
+				(last != -1 && distance(last, j) < distance(last, next)) {
+				next = j
+			}
+		}
+		chained[next] = true
+		ordered = append(ordered, hosts[next])
+		last = next
+	}
+	return ordered
+}
+
+// requestProbe asks the client at address to probe peers; returns their RTTs
+func requestProbe(address string, peers []string) (map[string]time.Duration, error) {
+	client := &http.Client{Timeout: time.Duration(len(peers)*(PROBE_COUNT+1)) * PROBE_TIMEOUT}
+	response, err := client.Get(fmt.Sprintf("%s://%s/probePeers?%s", scheme, address,
+		url.Values{"peer": peers}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", response.StatusCode)
+	}
+	var rtt map[string]time.Duration
+	err = json.NewDecoder(response.Body).Decode(&rtt)
+	return rtt, err
+}
+
+// probeRTT returns the round trip time of a request to the client at
+// address (IP:port), PROBE_UNREACHABLE if it does not respond
+func probeRTT(address string) time.Duration {
+	client := &http.Client{Timeout: PROBE_TIMEOUT}
+	best := PROBE_UNREACHABLE
+	// first request sets up the connection, the others reuse it
+	for i := 0; i <= PROBE_COUNT; i++ {
+		start := time.Now()
+		response, err := client.Head(fmt.Sprintf("%s://%s/receiveImage", scheme, address))
+		if err != nil {
+			return PROBE_UNREACHABLE
+		}
+		response.Body.Close()
+		if rtt := time.Since(start); i > 0 && rtt < best {
+			best = rtt
+		}
+	}
+	return best
+}
+
+// CLIENT
+
+func probePeersHandler(w http.ResponseWriter, request *http.Request) {
+	// master asks for our RTT to peers: GET /probePeers?peer=<ip:port>&peer=<ip:port>
+	rtt := map[string]time.Duration{}
+	for _, peer := range request.URL.Query()["peer"] {
+		if peer == cTask.ClientInfo.dataAddress() {
+			continue
+		}
+		if d := probeRTT(peer); d != PROBE_UNREACHABLE {
+			rtt[peer] = d
+		}
+	}
+	myJSON, _ := json.Marshal(rtt)
+	w.Write(myJSON)
+}
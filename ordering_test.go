@@ -0,0 +1,39 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTopologyOrder(t *testing.T) {
+	labels := Config{Hosts: []HostLabels{
+		{Name: "a1", Switch: "sw1", Rack: "r1"},
+		{Name: "a2", Switch: "sw1", Rack: "r2"},
+		{Name: "a3", Switch: "sw1", Rack: "r1"},
+		{Name: "b1", Switch: "sw2", Rack: "r1"}, // same rack name, other switch
+		{Name: "b2", Switch: "sw2"},
+		{Name: "52-54-00-00-00-01", Switch: "sw2", Rack: "r1"},
+	}}.hostLabels()
+	tests := []struct {
+		name  string
+		hosts []string
+		want  []string
+	}{
+		{"no hosts", []string{}, []string{}},
+		{"unlabeled hosts keep their order", []string{"x", "y", "z"}, []string{"x", "y", "z"}},
+		{"switches in order of first host", []string{"b1", "a1", "b2", "a3"}, []string{"b1", "b2", "a1", "a3"}},
+		{"racks kept together", []string{"a2", "a1", "a2", "a3"}, []string{"a2", "a2", "a1", "a3"}},
+		{"rack names are per switch", []string{"a1", "b2", "b1", "a2"}, []string{"a1", "a2", "b2", "b1"}},
+		{"MAC entries", []string{"b2", "a1", "52:54:00:00:00:01"}, []string{"b2", "52:54:00:00:00:01", "a1"}},
+		{"unlabeled hosts form a switch", []string{"x", "a1", "y", "a2"}, []string{"x", "y", "a1", "a2"}},
+	}
+	for _, test := range tests {
+		hosts := append([]string{}, test.hosts...)
+		if got := topologyOrder(hosts, labels); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+		if !reflect.DeepEqual(hosts, test.hosts) {
+			t.Errorf("%s: hosts modified to %v", test.name, hosts)
+		}
+	}
+}
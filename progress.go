@@ -0,0 +1,297 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	PROGRESS_INTERVAL   = 2 * time.Second // how often clients report progress
+	BOTTLENECK_MIN_BUSY = 0.5             // share of time a host must be busy to be flagged as bottleneck
+)
+
+// ClientProgress is reported by clients while receiving an image;
+// web UI computes percentage and ETA from it
+type ClientProgress struct {
+	Size     int64 // bytes of image stream
+	Received int64 // bytes of image stream received
+	Written  int64 // bytes written to destination (after decompression)
+	Rate     int64 // bytes of image stream received per second, recently
+	// milliseconds since reception started and spent blocked in
+	// reading upstream, writing to neighbors and writing to destination
+	Elapsed      int64
+	UpstreamWait int64
+	NeighborWait int64
+	DiskWait     int64
+}
+
+// busy returns the share of time the host was neither waiting for
+// upstream nor for its neighbors, i.e. writing, decompressing, ...
+func (p *ClientProgress) busy() float64 {
+	if p.Elapsed <= 0 {
+		return 0
+	}
+	idle := p.UpstreamWait + p.NeighborWait
+	if idle > p.Elapsed {
+		return 0
+	}
+	return float64(p.Elapsed-idle) / float64(p.Elapsed)
+}
+
+func (p *ClientProgress) share(wait int64) int {
+	if p.Elapsed <= 0 {
+		return 0
+	}
+	return int(100 * wait / p.Elapsed)
+}
+
+// MASTER
+
+func clientProgressHandler(w http.ResponseWriter, request *http.Request) {
+	// /setClientProgress/<size>/<received>/<written>/<rate>/<elapsed>/<upstreamWait>/<neighborWait>/<diskWait>
+	responseCode := 200
+	host, ok := requestClient(request)
+	uriSegments := strings.Split(request.RequestURI, "/")[2:]
+	values := make([]int64, 8)
+	var err error
+	if len(uriSegments) != len(values) {
+		err = fmt.Errorf("invalid request")
+	}
+	for i := 0; err == nil && i < len(values); i++ {
+		values[i], err = strconv.ParseInt(uriSegments[i], 10, 64)
+	}
+	mutex.Lock()
+	c := clients[host]
+	if ok && err == nil {
+		c.Progress = &ClientProgress{Size: values[0], Received: values[1], Written: values[2], Rate: values[3],
+			Elapsed: values[4], UpstreamWait: values[5], NeighborWait: values[6], DiskWait: values[7]}
+		clients[host] = c
+	}
+	mutex.Unlock()
+	if !ok {
+		responseCode = 404
+		http.NotFound(w, request)
+	} else if err != nil {
+		responseCode = http.StatusBadRequest
+		http.Error(w, err.Error(), responseCode)
+	} else {
+		wsserver.sendAll(&c)
+		updateBottleneck(getClientgroupByKey(c.Group))
+		stateChanged()
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+// groupBottlenecks returns the hosts limiting the throughput of each chain
+// of cgroup, by chain number: in a store-and-forward chain, hosts in front
+// of it wait for their neighbors, hosts behind it wait for upstream. Chains
+// without a host busy enough have none.
+func groupBottlenecks(cgroup Clientgroup) map[int]string {
+	bottlenecks, maxBusy := map[int]string{}, map[int]float64{}
+	for _, hostname := range cgroup.Hosts {
+		c := clients[hostname]
+		if _, ok := maxBusy[c.Chain]; !ok {
+			maxBusy[c.Chain] = BOTTLENECK_MIN_BUSY
+		}
+		if p := c.Progress; p != nil && p.busy() >= maxBusy[c.Chain] {
+			bottlenecks[c.Chain], maxBusy[c.Chain] = hostname, p.busy()
+		}
+	}
+	return bottlenecks
+}
+
+// updateBottleneck flags the bottlenecks of cgroup, notifying web UI on change
+func updateBottleneck(cgroup Clientgroup) {
+	changed := []ClientInfo{}
+	mutex.Lock()
+	bottlenecks := groupBottlenecks(cgroup)
+	for _, hostname := range cgroup.Hosts {
+		c := clients[hostname]
+		if isBottleneck := bottlenecks[c.Chain] == hostname; c.Bottleneck != isBottleneck {
+			c.Bottleneck = isBottleneck
+			clients[hostname] = c
+			changed = append(changed, c)
+		}
+	}
+	mutex.Unlock()
+	for i := range changed {
+		wsserver.sendAll(&changed[i])
+	}
+}
+
+// logRunSummary logs throughput and wait times of all hosts of cgroup
+func logRunSummary(cgroup Clientgroup) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	log.Printf("Summary for group %s:", cgroup.Name)
+	for _, hostname := range cgroup.Hosts {
+		if sitsOut(hostname) {
+			continue
+		}
+		c := clients[hostname]
+		p := c.Progress
+		if p == nil {
+			log.Printf("  %-15s %-6s no progress reported", hostname, c.Status)
+			continue
+		}
+		mbits := float64(0)
+		if p.Elapsed > 0 {
+			mbits = float64(p.Received) * 8 / 1000 / float64(p.Elapsed)
+		}
+		log.Printf("  %-15s %-6s %8.1f Mbit/s, busy %3d%%, waiting for disk %3d%%, upstream %3d%%, neighbors %3d%%",
+			hostname, c.Status, mbits, int(100*p.busy()), p.share(p.DiskWait), p.share(p.UpstreamWait), p.share(p.NeighborWait))
+	}
+	// one bottleneck per chain; chain 0 unless the group has several
+	bottlenecks := groupBottlenecks(cgroup)
+	chains, seen := []int{}, map[int]bool{}
+	for _, hostname := range cgroup.Hosts {
+		if chain := clients[hostname].Chain; !seen[chain] && !droppedHosts[hostname] {
+			seen[chain] = true
+			chains = append(chains, chain)
+		}
+	}
+	sort.Ints(chains)
+	for _, chain := range chains {
+		label := "Bottleneck"
+		if chain != 0 {
+			label = fmt.Sprintf("Bottleneck of chain %d", chain)
+		}
+		if bottleneck := bottlenecks[chain]; bottleneck != "" {
+			log.Printf("  %s: %s", label, bottleneck)
+		} else {
+			log.Printf("  %s: none of the clients (master or network)", label)
+		}
+	}
+}
+
+// CLIENT
+
+// progressReporter periodically reports reception progress to master
+type progressReporter struct {
+	size    int64
+	written int64   // accessed atomically
+	rate    float64 // smoothed bytes/s
+	start   time.Time
+	// nanoseconds blocked, accessed atomically
+	upstreamWait int64
+	neighborWait int64
+	diskWait     int64
+	stopCh       chan bool
+	doneCh       chan bool
+}
+
+func newProgressReporter(size int64) *progressReporter {
+	p := &progressReporter{size: size, start: time.Now(), stopCh: make(chan bool), doneCh: make(chan bool)}
+	go p.run()
+	return p
+}
+
+func (p *progressReporter) run() {
+	defer close(p.doneCh)
+	ticker := time.NewTicker(PROGRESS_INTERVAL)
+	defer ticker.Stop()
+	lastReceived, lastReport := int64(0), time.Now()
+	for {
+		select {
+		case <-p.stopCh:
+			p.report(atomic.LoadInt64(&stream.offset))
+			return
+		case now := <-ticker.C:
+			received := atomic.LoadInt64(&stream.offset)
+			current := float64(received-lastReceived) / now.Sub(lastReport).Seconds()
+			if p.rate == 0 {
+				p.rate = current
+			} else {
+				p.rate = 0.7*p.rate + 0.3*current
+			}
+			lastReceived, lastReport = received, now
+			p.report(received)
+		}
+	}
+}
+
+func (p *progressReporter) report(received int64) {
+	ms := int64(time.Millisecond)
+	request := masterRequest(fmt.Sprintf("/setClientProgress/%d/%d/%d/%d/%d/%d/%d/%d",
+		p.size, received, atomic.LoadInt64(&p.written), int64(p.rate),
+		int64(time.Since(p.start))/ms, atomic.LoadInt64(&p.upstreamWait)/ms,
+		atomic.LoadInt64(&p.neighborWait)/ms, atomic.LoadInt64(&p.diskWait)/ms))
+	client := &http.Client{Timeout: PROGRESS_INTERVAL}
+	response, err := client.Do(request)
+	if err != nil {
+		log.Printf("Cannot report progress: %s", err)
+		return
+	}
+	response.Body.Close()
+}
+
+// stop sends a final report and ends reporting
+func (p *progressReporter) stop() {
+	close(p.stopCh)
+	<-p.doneCh
+}
+
+// timeUpstream returns a reader on upstream r, measuring time blocked in reading
+func (p *progressReporter) timeUpstream(r io.Reader) io.Reader {
+	return &meteredReader{r: r, readWait: &p.upstreamWait}
+}
+
+// timeNeighbors returns a writer on w, measuring time blocked in writing to neighbors
+func (p *progressReporter) timeNeighbors(w io.Writer) io.Writer {
+	return &meteredWriter{w: w, writeWait: &p.neighborWait}
+}
+
+// countWritten returns a reader on r that counts the bytes read as written;
+// time between reads is spent by the consumer writing to destination
+func (p *progressReporter) countWritten(r io.Reader) io.Reader {
+	return &meteredReader{r: r, count: &p.written, consumerWait: &p.diskWait}
+}
+
+// meteredReader counts bytes read and nanoseconds spent in Read or between
+// Reads; counters are accessed atomically, nil ones are skipped
+type meteredReader struct {
+	r            io.Reader
+	count        *int64
+	readWait     *int64
+	consumerWait *int64
+	lastRead     time.Time
+}
+
+func (m *meteredReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	if m.consumerWait != nil && !m.lastRead.IsZero() {
+		atomic.AddInt64(m.consumerWait, int64(start.Sub(m.lastRead)))
+	}
+	n, err := m.r.Read(p)
+	m.lastRead = time.Now()
+	if m.readWait != nil {
+		atomic.AddInt64(m.readWait, int64(m.lastRead.Sub(start)))
+	}
+	if m.count != nil {
+		atomic.AddInt64(m.count, int64(n))
+	}
+	return n, err
+}
+
+// meteredWriter counts nanoseconds spent in Write
+type meteredWriter struct {
+	w         io.Writer
+	writeWait *int64
+}
+
+func (m *meteredWriter) Write(p []byte) (int, error) {
+	start := time.Now()
+	n, err := m.w.Write(p)
+	atomic.AddInt64(m.writeWait, int64(time.Since(start)))
+	return n, err
+}
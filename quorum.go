@@ -0,0 +1,99 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+// By default, streaming to a group starts once all of its hosts are ready.
+// With readyTimeout set, master starts waiting as soon as the first host is
+// ready; once the timeout passed and at least minReady hosts are ready, the
+// hosts still missing are dropped from the run and the stream starts.
+
+// droppedHosts are left out of the current run of their group
+var droppedHosts = map[string]bool{}
+
+func (cgroup Clientgroup) readyTimeout() time.Duration {
+	return time.Duration(cgroup.ReadyTimeout) * time.Second
+}
+
+// minReady returns the number of ready hosts required to start after
+// readyTimeout, one unless set
+func (cgroup Clientgroup) minReady() int {
+	if cgroup.MinReady == 0 {
+		return 1
+	}
+	return cgroup.MinReady
+}
+
+// readyHosts returns the hosts of cgroup waiting for the stream, in list
+// order. Call with mutex held.
+func readyHosts(cgroup Clientgroup) []string {
+	ready := []string{}
+	for _, hostname := range cgroup.Hosts {
+		if clients[hostname].Status == STATUS_READY_WAITING {
+			ready = append(ready, hostname)
+		}
+	}
+	return ready
+}
+
+// quorumReached tells whether streaming to cgroup may start with only the
+// ready hosts, waiting since waitStart
+func quorumReached(cgroup Clientgroup, ready []string, waitStart time.Time) bool {
+	if cgroup.ReadyTimeout == 0 || time.Since(waitStart) < cgroup.readyTimeout() {
+		return false
+	}
+	if len(ready) < cgroup.minReady() {
+		log.Printf("readyTimeout of group %s passed, but only %d of %d required hosts ready", cgroup.Name,
+			len(ready), cgroup.minReady())
+		return false
+	}
+	return true
+}
+
+// dropStragglers leaves the hosts of cgroup not in ready out of this run;
+// hosts that did not show up fail for reason, hosts that finished a former
+// run keep their status. Returns cgroup reduced to the ready hosts, see
+// wireChains.
+func dropStragglers(cgroup Clientgroup, ready []string, reason string) Clientgroup {
+	isReady := map[string]bool{}
+	for _, hostname := range ready {
+		isReady[hostname] = true
+	}
+	dropped := []ClientInfo{}
+	mutex.Lock()
+	for _, hostname := range cgroup.Hosts {
+		delete(droppedHosts, hostname)
+		if isReady[hostname] {
+			continue
+		}
+		droppedHosts[hostname] = true
+		if status := clients[hostname].Status; status != STATUS_NONE && status != STATUS_ERROR {
+			continue
+		}
+		log.Printf("Dropping %s from group %s, %s", hostname, cgroup.Name, reason)
+		c := clients[hostname]
+		c.Status = STATUS_ERROR
+		c.Error = reason + ", dropped"
+		c.Neighbors = nil
+		c.Chain = 0
+		clients[hostname] = c
+		dropped = append(dropped, c)
+	}
+	cgroup.Hosts = ready
+	mutex.Unlock()
+	for i := range dropped {
+		wsserver.sendAll(&dropped[i])
+	}
+	if len(dropped) != 0 {
+		stateChanged()
+	}
+	return cgroup
+}
+
+// sitsOut tells whether hostname is left out of the current run of its
+// group, having finished a former run. Call with mutex held.
+func sitsOut(hostname string) bool {
+	return droppedHosts[hostname] && clients[hostname].Status != STATUS_ERROR
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQuorumReached(t *testing.T) {
+	ready := []string{"10.0.0.1", "10.0.0.2"}
+	longAgo, justNow := time.Now().Add(-time.Minute), time.Now()
+	tests := []struct {
+		name      string
+		cgroup    Clientgroup
+		waitStart time.Time
+		want      bool
+	}{
+		{"no readyTimeout", Clientgroup{}, longAgo, false},
+		{"readyTimeout not passed", Clientgroup{ReadyTimeout: 30}, justNow, false},
+		{"minReady defaults to one", Clientgroup{ReadyTimeout: 30}, longAgo, true},
+		{"minReady reached", Clientgroup{ReadyTimeout: 30, MinReady: 2}, longAgo, true},
+		{"minReady not reached", Clientgroup{ReadyTimeout: 30, MinReady: 3}, longAgo, false},
+	}
+	for _, test := range tests {
+		if got := quorumReached(test.cgroup, ready, test.waitStart); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDropStragglers(t *testing.T) {
+	config := Config{Clientgroups: []Clientgroup{
+		{Name: "g1", Hosts: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}, ReadyTimeout: 30}}}
+	defer testState(config)()
+	defer func(saved map[string]bool) { droppedHosts = saved }(droppedHosts)
+	droppedHosts = map[string]bool{}
+	mutex.Lock()
+	setStatus("10.0.0.1", STATUS_READY_WAITING)
+	setStatus("10.0.0.3", STATUS_DONE_OK) // finished a former run
+	setStatus("10.0.0.4", STATUS_ERROR)
+	cgroup := config.Clientgroups[0]
+	ready := readyHosts(cgroup)
+	mutex.Unlock()
+
+	reduced := dropStragglers(cgroup, ready, "not ready within readyTimeout")
+	if !reflect.DeepEqual(reduced.Hosts, []string{"10.0.0.1"}) {
+		t.Errorf("group reduced to %v", reduced.Hosts)
+	}
+	mutex.Lock()
+	for _, host := range []string{"10.0.0.2", "10.0.0.4"} {
+		if c := clients[host]; c.Status != STATUS_ERROR || c.Error != "not ready within readyTimeout, dropped" || c.Neighbors != nil {
+			t.Errorf("straggler %s: %+v", host, c)
+		}
+	}
+	if c := clients["10.0.0.3"]; c.Status != STATUS_DONE_OK || c.Error != "" {
+		t.Errorf("finished host rewritten: %+v", c)
+	}
+	if c := clients["10.0.0.1"]; c.Status != STATUS_READY_WAITING {
+		t.Errorf("ready host changed: %+v", c)
+	}
+	if !reflect.DeepEqual(droppedHosts, map[string]bool{"10.0.0.2": true, "10.0.0.3": true, "10.0.0.4": true}) {
+		t.Errorf("dropped hosts %v", droppedHosts)
+	}
+	if !sitsOut("10.0.0.3") || sitsOut("10.0.0.2") || sitsOut("10.0.0.1") {
+		t.Error("only the finished host sits out the run")
+	}
+	mutex.Unlock()
+
+	// the next run of the group includes all hosts again
+	dropStragglers(cgroup, cgroup.Hosts, "not ready within readyTimeout")
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(droppedHosts) != 0 {
+		t.Errorf("hosts still dropped: %v", droppedHosts)
+	}
+}
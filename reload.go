@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+// The configuration is reloaded on SIGHUP or POST /reloadConfig. Groups and
+// images are replaced by the new ones; clients keep their status. Hosts of
+// a group being streamed to keep their current task and place in the chain
+// until the stream ended and they finished, changes are applied then.
+
+// pendingClients holds the new entries of hosts mid-deployment; an entry
+// without Host means the host was removed from the configuration
+var pendingClients = map[string]ClientInfo{}
+
+func configFile() string {
+	return imageStorage + "/thePusher-config.hcl"
+}
+
+// handleSIGHUP reloads the configuration whenever master receives SIGHUP
+func handleSIGHUP() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		log.Print("SIGHUP received, reloading configuration")
+		reloadConfig()
+	}
+}
+
+func reloadConfigHandler(w http.ResponseWriter, request *http.Request) {
+	// POST /reloadConfig re-reads the configuration file
+	responseCode := 200
+	if request.Method != "POST" {
+		responseCode = http.StatusMethodNotAllowed
+		http.Error(w, "POST required", responseCode)
+	} else if code := refuseOperator(w, request); code != 0 {
+		responseCode = code
+	} else if err := reloadConfig(); err != nil {
+		responseCode = http.StatusBadRequest
+		http.Error(w, err.Error(), responseCode)
+	} else {
+		fmt.Fprintln(w, "Configuration reloaded")
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+// reloadConfig reads the configuration file and merges it into the live
+// state; an invalid configuration is reported and leaves all as it was
+func reloadConfig() error {
+	config, err := readConfig(configFile())
+	if err == nil {
+		mutex.Lock()
+		err = mergeConfig(config)
+		mutex.Unlock()
+	}
+	if err != nil {
+		log.Printf("%s: configuration not reloaded: %s", red("ERROR"), err)
+		return err
+	}
+	log.Printf("Configuration reloaded: %d images, %d client groups", len(config.Images), len(config.Clientgroups))
+	// web UI re-fetches groups and clients
+	wsserver.sendAll(&ClientInfo{Status: STATUS_RELOADED})
+	stateChanged()
+	return nil
+}
+
+// mergeConfig makes config the live configuration. Call with mutex held.
+func mergeConfig(config Config) error {
+	oldConfig := currentConfig()
+	reassignClients(&config)
+	for groupName := range streamingGroups {
+		// a group being streamed to keeps its hosts, see startStream
+		hosts := config.clientgroupByKey(groupName).Hosts
+		if len(hosts) == 0 {
+			return fmt.Errorf("group %s is being streamed to, cannot remove it", groupName)
+		}
+		if strings.Join(hosts, " ") != strings.Join(oldConfig.clientgroupByKey(groupName).Hosts, " ") {
+			return fmt.Errorf("group %s is being streamed to, cannot change its hosts", groupName)
+		}
+	}
+	for host, c := range clients {
+		if midDeployment(c) && config.imageByKey(c.Image) != oldConfig.imageByKey(c.Image) {
+			return fmt.Errorf("image %s is being deployed to %s, cannot change it", c.Image, host)
+		}
+	}
+
+	newClients := map[string]ClientInfo{}
+	for _, group := range config.Clientgroups {
+		for hostIndex, hostname := range group.Hosts {
+			newClients[hostname] = newClientEntry(group, hostIndex)
+		}
+	}
+	pendingClients = map[string]ClientInfo{}
+	for host, c := range clients {
+		entry, listed := newClients[host]
+		if midDeployment(c) {
+			// keep current task and neighbors until finished
+			if !listed {
+				entry = ClientInfo{}
+			}
+			pendingClients[host] = entry
+			newClients[host] = c
+			continue
+		}
+		if listed {
+			newClients[host] = mergeClientEntry(c, entry)
+		}
+	}
+	for host := range newClients {
+		delete(unassignedClients, host)
+	}
+	clients = newClients
+	setConfig(config)
+	pruneAssignedClients(config)
+	return nil
+}
+
+// mergeClientEntry returns the new entry of a client, keeping its state.
+// A waiting client whose task changed must ask for its task again.
+func mergeClientEntry(c ClientInfo, entry ClientInfo) ClientInfo {
+	entry.Status, entry.Progress, entry.Bottleneck, entry.Error = c.Status, c.Progress, c.Bottleneck, c.Error
+	entry.ListenPort = c.ListenPort
+	if entry.Address == "" {
+		entry.Address = c.Address
+	}
+	taskChanged := c.Group != entry.Group || c.Image != entry.Image || c.Port != entry.Port ||
+		c.Transport != entry.Transport || c.MulticastAddr != entry.MulticastAddr
+	if c.Status == STATUS_READY_WAITING && taskChanged {
+		log.Printf("Task of waiting client %s changed, reboot it to receive the new task", entry.Host)
+		entry.Status = STATUS_NONE
+	}
+	return entry
+}
+
+// midDeployment tells whether c takes part in a running deployment: it is
+// receiving, or its group is being streamed to. Call with mutex held.
+func midDeployment(c ClientInfo) bool {
+	return c.Status == STATUS_BUSY || streamingGroups[c.Group]
+}
+
+// applyPendingClient applies a reload to host once it finished receiving
+// and the stream to its group ended
+func applyPendingClient(host string) {
+	mutex.Lock()
+	entry, ok := pendingClients[host]
+	if ok && midDeployment(clients[host]) {
+		ok = false
+	}
+	if ok {
+		delete(pendingClients, host)
+		if entry.Host == "" {
+			delete(clients, host)
+		} else {
+			clients[host] = mergeClientEntry(clients[host], entry)
+		}
+	}
+	mutex.Unlock()
+	if ok {
+		log.Printf("Applied reloaded configuration to %s", host)
+		wsserver.sendAll(&ClientInfo{Status: STATUS_RELOADED})
+		stateChanged()
+	}
+}
+
+// applyPendingGroup applies a reload to the hosts of group groupName, once
+// the stream to it ended; hosts still receiving follow when finished
+func applyPendingGroup(groupName string) {
+	mutex.Lock()
+	hosts := []string{}
+	for host := range pendingClients {
+		if clients[host].Group == groupName {
+			hosts = append(hosts, host)
+		}
+	}
+	mutex.Unlock()
+	for _, host := range hosts {
+		applyPendingClient(host)
+	}
+}
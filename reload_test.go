@@ -0,0 +1,233 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// reloadTestConfig returns a configuration of two groups; g1 is a chain
+// of 10.0.0.1 and 10.0.0.2
+func reloadTestConfig() Config {
+	return Config{
+		Images: []Image{{Name: "a", Filename: "a.img"}, {Name: "b", Filename: "b.img"}},
+		Clientgroups: []Clientgroup{
+			{Name: "g1", Image: "a", Hosts: []string{"10.0.0.1", "10.0.0.2"}},
+			{Name: "g2", Image: "b", Hosts: []string{"10.0.0.3"}},
+		},
+	}
+}
+
+// testState makes config the live configuration, with all clients in their
+// initial state; call the returned function to restore the previous state
+func testState(config Config) func() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	savedConfig, savedClients, savedStreaming := currentConfig(), clients, streamingGroups
+	savedPending, savedAssigned, savedUnassigned, savedServer := pendingClients, assignedClients, unassignedClients, wsserver
+	setConfig(config)
+	clients, streamingGroups, pendingClients = map[string]ClientInfo{}, map[string]bool{}, map[string]ClientInfo{}
+	assignedClients, unassignedClients = map[string]string{}, map[string]ClientInfo{}
+	wsserver = NewWebSocketServer("/ws")
+	for _, group := range config.Clientgroups {
+		for hostIndex, hostname := range group.Hosts {
+			clients[hostname] = newClientEntry(group, hostIndex)
+		}
+	}
+	return func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		setConfig(savedConfig)
+		clients, streamingGroups, pendingClients = savedClients, savedStreaming, savedPending
+		assignedClients, unassignedClients, wsserver = savedAssigned, savedUnassigned, savedServer
+	}
+}
+
+// setStatus sets the status of host. Call with mutex held.
+func setStatus(host string, status string) {
+	c := clients[host]
+	c.Status = status
+	clients[host] = c
+}
+
+func TestMergeClientEntry(t *testing.T) {
+	progress := &ClientProgress{}
+	current := ClientInfo{Host: "pc1", Address: "10.0.0.9", Group: "g1", Image: "a", Port: 9001, ListenPort: 9101,
+		Status: STATUS_READY_WAITING, Progress: progress, Error: "e"}
+	tests := []struct {
+		name   string
+		status string
+		entry  ClientInfo
+		want   ClientInfo
+	}{
+		{"unchanged task", STATUS_READY_WAITING,
+			ClientInfo{Host: "pc1", Group: "g1", Image: "a", Port: 9001, Neighbors: []string{"pc2"}},
+			ClientInfo{Host: "pc1", Address: "10.0.0.9", Group: "g1", Image: "a", Port: 9001, ListenPort: 9101,
+				Neighbors: []string{"pc2"}, Status: STATUS_READY_WAITING, Progress: progress, Error: "e"}},
+		{"image of waiting client changed", STATUS_READY_WAITING,
+			ClientInfo{Host: "pc1", Group: "g1", Image: "b", Port: 9001},
+			ClientInfo{Host: "pc1", Address: "10.0.0.9", Group: "g1", Image: "b", Port: 9001, ListenPort: 9101,
+				Status: STATUS_NONE, Progress: progress, Error: "e"}},
+		{"port of waiting client changed", STATUS_READY_WAITING,
+			ClientInfo{Host: "pc1", Group: "g1", Image: "a", Port: 9002},
+			ClientInfo{Host: "pc1", Address: "10.0.0.9", Group: "g1", Image: "a", Port: 9002, ListenPort: 9101,
+				Status: STATUS_NONE, Progress: progress, Error: "e"}},
+		{"group of finished client changed", STATUS_DONE_OK,
+			ClientInfo{Host: "pc1", Group: "g2", Image: "a", Port: 9001},
+			ClientInfo{Host: "pc1", Address: "10.0.0.9", Group: "g2", Image: "a", Port: 9001, ListenPort: 9101,
+				Status: STATUS_DONE_OK, Progress: progress, Error: "e"}},
+		{"address of IP entry", STATUS_NONE,
+			ClientInfo{Host: "pc1", Address: "10.0.0.1", Group: "g1", Image: "a", Port: 9001},
+			ClientInfo{Host: "pc1", Address: "10.0.0.1", Group: "g1", Image: "a", Port: 9001, ListenPort: 9101,
+				Status: STATUS_NONE, Progress: progress, Error: "e"}},
+	}
+	for _, test := range tests {
+		c := current
+		c.Status = test.status
+		if got := mergeClientEntry(c, test.entry); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestMergeConfigIdle(t *testing.T) {
+	defer testState(reloadTestConfig())()
+	config := reloadTestConfig()
+	config.Clientgroups[0].Hosts = []string{"10.0.0.1", "10.0.0.4"}
+	mutex.Lock()
+	defer mutex.Unlock()
+	setStatus("10.0.0.1", STATUS_DONE_OK)
+	if err := mergeConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := clients["10.0.0.2"]; ok {
+		t.Error("removed host kept")
+	}
+	if c := clients["10.0.0.4"]; c.Status != STATUS_NONE || c.Group != "g1" {
+		t.Errorf("added host %+v", c)
+	}
+	if c := clients["10.0.0.1"]; c.Status != STATUS_DONE_OK || !reflect.DeepEqual(c.Neighbors, []string{"10.0.0.4"}) {
+		t.Errorf("kept host has status %s, neighbors %v", c.Status, c.Neighbors)
+	}
+	if hosts := currentConfig().clientgroupByKey("g1").Hosts; len(hosts) != 2 || hosts[1] != "10.0.0.4" {
+		t.Errorf("configuration not live, g1 has hosts %v", hosts)
+	}
+}
+
+// TestMergeConfigBusy removes a host while it is receiving; it keeps its
+// task until finished
+func TestMergeConfigBusy(t *testing.T) {
+	defer testState(reloadTestConfig())()
+	config := reloadTestConfig()
+	config.Clientgroups[0].Hosts = []string{"10.0.0.1"}
+	mutex.Lock()
+	setStatus("10.0.0.2", STATUS_BUSY)
+	err := mergeConfig(config)
+	_, kept := clients["10.0.0.2"]
+	neighbors := clients["10.0.0.1"].Neighbors
+	mutex.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !kept || len(neighbors) != 0 {
+		t.Errorf("busy host kept %v, neighbors of idle host %v", kept, neighbors)
+	}
+
+	applyPendingClient("10.0.0.2")
+	mutex.Lock()
+	_, kept = clients["10.0.0.2"]
+	setStatus("10.0.0.2", STATUS_DONE_OK)
+	mutex.Unlock()
+	if !kept {
+		t.Error("busy host removed before it finished")
+	}
+	applyPendingClient("10.0.0.2")
+	mutex.Lock()
+	_, kept = clients["10.0.0.2"]
+	mutex.Unlock()
+	if kept {
+		t.Error("host not removed once finished")
+	}
+}
+
+// TestMergeConfigStreaming reloads while g1 is being streamed to
+func TestMergeConfigStreaming(t *testing.T) {
+	removed := reloadTestConfig()
+	removed.Clientgroups = removed.Clientgroups[1:]
+	emptied := reloadTestConfig()
+	emptied.Clientgroups[0].Hosts = []string{}
+	reordered := reloadTestConfig()
+	reordered.Clientgroups[0].Hosts = []string{"10.0.0.2", "10.0.0.1"}
+	imageChanged := reloadTestConfig()
+	imageChanged.Images[0].Filename = "a2.img"
+	otherGroupChanged := reloadTestConfig()
+	otherGroupChanged.Images[1].Filename = "b2.img"
+	otherGroupChanged.Clientgroups[1].Hosts = []string{"10.0.0.3", "10.0.0.5"}
+	tests := []struct {
+		name   string
+		config Config
+		ok     bool
+	}{
+		{"group removed", removed, false},
+		{"hosts removed", emptied, false},
+		{"hosts reordered", reordered, false},
+		{"image changed", imageChanged, false},
+		{"other group changed", otherGroupChanged, true},
+	}
+	for _, test := range tests {
+		restore := testState(reloadTestConfig())
+		mutex.Lock()
+		streamingGroups["g1"] = true
+		setStatus("10.0.0.1", STATUS_BUSY)
+		setStatus("10.0.0.2", STATUS_READY_WAITING)
+		before := map[string]ClientInfo{}
+		for host, c := range clients {
+			before[host] = c
+		}
+		err := mergeConfig(test.config)
+		live := currentConfig()
+		after := clients
+		mutex.Unlock()
+		restore()
+
+		if (err == nil) != test.ok {
+			t.Errorf("%s: got error %v, want success %v", test.name, err, test.ok)
+		}
+		for _, host := range []string{"10.0.0.1", "10.0.0.2"} {
+			if !reflect.DeepEqual(after[host], before[host]) {
+				t.Errorf("%s: %s changed to %+v", test.name, host, after[host])
+			}
+		}
+		if !test.ok && (!reflect.DeepEqual(live, reloadTestConfig()) || len(after) != len(before)) {
+			t.Errorf("%s: refused reload changed the configuration", test.name)
+		}
+		if test.ok && after["10.0.0.5"].Group != "g2" {
+			t.Errorf("%s: other group not reloaded", test.name)
+		}
+	}
+}
+
+func TestMergeConfigAssigned(t *testing.T) {
+	defer testState(reloadTestConfig())()
+	mutex.Lock()
+	defer mutex.Unlock()
+	assignedClients["52:54:00:00:00:05"] = "g2"
+	assignedClients["52:54:00:00:00:06"] = "g3"
+	unassignedClients["10.0.0.4"] = ClientInfo{Host: "10.0.0.4", Status: STATUS_UNASSIGNED}
+	config := reloadTestConfig()
+	config.Clientgroups[1].Hosts = []string{"10.0.0.3", "10.0.0.4"}
+	if err := mergeConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	if hosts := currentConfig().clientgroupByKey("g2").Hosts; !reflect.DeepEqual(hosts, []string{"10.0.0.3", "10.0.0.4", "52:54:00:00:00:05"}) {
+		t.Errorf("g2 has hosts %v", hosts)
+	}
+	if hosts := config.Clientgroups[1].Hosts; len(hosts) != 2 {
+		t.Errorf("configuration read was modified: %v", hosts)
+	}
+	if _, ok := unassignedClients["10.0.0.4"]; ok {
+		t.Error("host listed by new configuration still unassigned")
+	}
+	if _, ok := assignedClients["52:54:00:00:00:06"]; ok {
+		t.Error("assignment to removed group kept")
+	}
+}
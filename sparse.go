@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+)
+
+// Sparse images start with a block map header listing the populated extents
+// of the original device, followed by the data of these extents:
+//
+//	"TPSPARSE" | device size | extent count | (offset, length) ... | data ...
+//
+// All numbers are big endian uint64. On restore, holes are skipped in regular
+// files; on devices, they are zeroed, as they still hold old data.
+const (
+	SPARSE_MAGIC       = "TPSPARSE"
+	SPARSE_BLOCK       = 65536   // granularity of zero block detection
+	SPARSE_MAX_EXTENTS = 1 << 26 // sanity limit for block map
+)
+
+type extent struct {
+	Offset int64
+	Length int64
+}
+
+func encodeSparseHeader(size int64, extents []extent) []byte {
+	var header bytes.Buffer
+	header.WriteString(SPARSE_MAGIC)
+	binary.Write(&header, binary.BigEndian, uint64(size))
+	binary.Write(&header, binary.BigEndian, uint64(len(extents)))
+	for _, e := range extents {
+		binary.Write(&header, binary.BigEndian, uint64(e.Offset))
+		binary.Write(&header, binary.BigEndian, uint64(e.Length))
+	}
+	return header.Bytes()
+}
+
+func readSparseHeader(r io.Reader) (int64, []extent, error) {
+	magic := make([]byte, len(SPARSE_MAGIC))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return 0, nil, err
+	}
+	if string(magic) != SPARSE_MAGIC {
+		return 0, nil, errors.New("not a sparse image")
+	}
+	var size, count uint64
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return 0, nil, err
+	}
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return 0, nil, err
+	}
+	if count > SPARSE_MAX_EXTENTS {
+		return 0, nil, fmt.Errorf("sparse image has too many extents (%d)", count)
+	}
+	extents := make([]extent, count)
+	for i := range extents {
+		var offset, length uint64
+		if err := binary.Read(r, binary.BigEndian, &offset); err != nil {
+			return 0, nil, err
+		}
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+			return 0, nil, err
+		}
+		if offset+length > size {
+			return 0, nil, fmt.Errorf("extent %d exceeds image size", i)
+		}
+		extents[i] = extent{int64(offset), int64(length)}
+	}
+	return int64(size), extents, nil
+}
+
+// writeSparse restores a sparse stream to outfile, seeking over holes of
+// regular files and zeroing holes of devices
+func writeSparse(r io.Reader, outfile *os.File) error {
+	size, extents, err := readSparseHeader(r)
+	if err != nil {
+		return err
+	}
+	fileInfo, err := outfile.Stat()
+	if err != nil {
+		return err
+	}
+	regular := fileInfo.Mode().IsRegular()
+	buf := make([]byte, 1048576)
+	pos := int64(0)
+	for _, e := range extents {
+		if !regular && e.Offset > pos {
+			if err := zeroRange(outfile, pos, e.Offset-pos); err != nil {
+				return err
+			}
+		}
+		if _, err := outfile.Seek(e.Offset, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := io.CopyBuffer(outfile, io.LimitReader(r, e.Length), buf); err != nil {
+			return err
+		}
+		if e.Offset+e.Length > pos {
+			pos = e.Offset + e.Length
+		}
+	}
+	// let regular files end with a hole, if any; devices have a fixed size
+	if regular {
+		return outfile.Truncate(size)
+	}
+	if size > pos {
+		return zeroRange(outfile, pos, size-pos)
+	}
+	return nil
+}
+
+// writeZeros overwrites length bytes of f at offset with zeros
+func writeZeros(f *os.File, offset int64, length int64) error {
+	zeros := make([]byte, 1048576)
+	for length > 0 {
+		n := int64(len(zeros))
+		if length < n {
+			n = length
+		}
+		if _, err := f.WriteAt(zeros[:n], offset); err != nil {
+			return err
+		}
+		offset += n
+		length -= n
+	}
+	return nil
+}
+
+// scanExtents finds the blocks of f that contain anything but zeros
+func scanExtents(f *os.File, size int64) ([]extent, error) {
+	extents := []extent{}
+	buf := make([]byte, SPARSE_BLOCK)
+	for offset := int64(0); offset < size; offset += SPARSE_BLOCK {
+		n, err := f.ReadAt(buf, offset)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if isZero(buf[:n]) {
+			continue
+		}
+		if last := len(extents) - 1; last >= 0 && extents[last].Offset+extents[last].Length == offset {
+			extents[last].Length += int64(n)
+		} else {
+			extents = append(extents, extent{offset, int64(n)})
+		}
+	}
+	return extents, nil
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// sparseReader presents file/device f in sparse image format. It is seekable,
+// so interrupted uploads can be resumed.
+type sparseReader struct {
+	f       *os.File
+	header  []byte
+	extents []extent
+	starts  []int64 // offset of each extent's data within sparse stream
+	size    int64   // size of sparse stream
+	pos     int64
+}
+
+func newSparseReader(f *os.File, size int64) (*sparseReader, error) {
+	// ext file systems: use block bitmaps, otherwise look for zero blocks
+	extents, err := scanExtExtents(f, size)
+	if err == nil {
+		log.Print("Found ext2/3/4 file system, reading allocated blocks only")
+	} else {
+		if err != errNotExtFs {
+			log.Printf("Cannot use ext file system block bitmaps: %s", err)
+		}
+		if extents, err = scanExtents(f, size); err != nil {
+			return nil, err
+		}
+	}
+	s := &sparseReader{f: f, header: encodeSparseHeader(size, extents), extents: extents}
+	s.size = int64(len(s.header))
+	for _, e := range extents {
+		s.starts = append(s.starts, s.size)
+		s.size += e.Length
+	}
+	return s, nil
+}
+
+func (s *sparseReader) Read(p []byte) (int, error) {
+	if s.pos >= s.size {
+		return 0, io.EOF
+	}
+	if s.pos < int64(len(s.header)) {
+		n := copy(p, s.header[s.pos:])
+		s.pos += int64(n)
+		return n, nil
+	}
+	// extent containing pos
+	i := sort.Search(len(s.starts), func(i int) bool { return s.starts[i] > s.pos }) - 1
+	e := s.extents[i]
+	within := s.pos - s.starts[i]
+	if remaining := e.Length - within; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := s.f.ReadAt(p, e.Offset+within)
+	s.pos += int64(n)
+	if err == io.EOF && n == len(p) {
+		err = nil
+	}
+	return n, err
+}
+
+func (s *sparseReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += s.pos
+	case io.SeekEnd:
+		offset += s.size
+	}
+	if offset < 0 {
+		return 0, errors.New("negative seek offset")
+	}
+	s.pos = offset
+	return offset, nil
+}
@@ -0,0 +1,8 @@
+package main
+
+import "os"
+
+// zeroRange zeroes length bytes of device f at offset
+func zeroRange(f *os.File, offset int64, length int64) error {
+	return writeZeros(f, offset, length)
+}
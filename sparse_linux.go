@@ -0,0 +1,20 @@
+package main
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const BLKZEROOUT = 0x127f // ioctl zeroing a range of a block device
+
+// zeroRange zeroes length bytes of device f at offset, letting the device
+// do it if supported
+func zeroRange(f *os.File, offset int64, length int64) error {
+	byteRange := [2]uint64{uint64(offset), uint64(length)}
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), BLKZEROOUT, uintptr(unsafe.Pointer(&byteRange)))
+	if errno == 0 {
+		return nil
+	}
+	return writeZeros(f, offset, length)
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSparseHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int64
+		extents []extent
+	}{
+		{"empty image", 0, []extent{}},
+		{"all holes", 1 << 20, []extent{}},
+		{"single extent", 1 << 20, []extent{{0, 1 << 20}}},
+		{"extents and holes", 10 * SPARSE_BLOCK, []extent{{0, SPARSE_BLOCK}, {3 * SPARSE_BLOCK, 2 * SPARSE_BLOCK}, {9 * SPARSE_BLOCK, SPARSE_BLOCK}}},
+		{"short last block", 2*SPARSE_BLOCK + 100, []extent{{2 * SPARSE_BLOCK, 100}}},
+	}
+	for _, test := range tests {
+		size, extents, err := readSparseHeader(bytes.NewReader(encodeSparseHeader(test.size, test.extents)))
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if size != test.size || !reflect.DeepEqual(extents, test.extents) {
+			t.Errorf("%s: got %d %v, want %d %v", test.name, size, extents, test.size, test.extents)
+		}
+	}
+}
+
+func TestSparseHeaderInvalid(t *testing.T) {
+	header := func(magic string, size uint64, count uint64, extents ...uint64) []byte {
+		var b bytes.Buffer
+		b.WriteString(magic)
+		binary.Write(&b, binary.BigEndian, size)
+		binary.Write(&b, binary.BigEndian, count)
+		for _, v := range extents {
+			binary.Write(&b, binary.BigEndian, v)
+		}
+		return b.Bytes()
+	}
+	tests := []struct {
+		name   string
+		header []byte
+	}{
+		{"no data", []byte{}},
+		{"wrong magic", header("NOSPARSE", 100, 0)},
+		{"truncated size", []byte(SPARSE_MAGIC + "\x00\x00")},
+		{"missing extents", header(SPARSE_MAGIC, 100, 2, 0, 10)},
+		{"too many extents", header(SPARSE_MAGIC, 100, SPARSE_MAX_EXTENTS+1)},
+		{"extent beyond size", header(SPARSE_MAGIC, 100, 1, 50, 51)},
+	}
+	for _, test := range tests {
+		if _, _, err := readSparseHeader(bytes.NewReader(test.header)); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
+
+func TestWriteSparse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sparse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	size := int64(8 * SPARSE_BLOCK)
+	extents := []extent{{SPARSE_BLOCK, SPARSE_BLOCK}, {5 * SPARSE_BLOCK, 2 * SPARSE_BLOCK}}
+	want := make([]byte, size)
+	stream := bytes.NewBuffer(encodeSparseHeader(size, extents))
+	for _, e := range extents {
+		for i := e.Offset; i < e.Offset+e.Length; i++ {
+			want[i] = byte(i%251 + 1)
+		}
+		stream.Write(want[e.Offset : e.Offset+e.Length])
+	}
+
+	path := filepath.Join(dir, "image")
+	outfile, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeSparse(stream, outfile); err != nil {
+		t.Fatal(err)
+	}
+	outfile.Close()
+	got, _ := ioutil.ReadFile(path)
+	if !bytes.Equal(got, want) {
+		t.Errorf("restored image differs (%d bytes, want %d)", len(got), len(want))
+	}
+}
+
+func TestZeroRange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sparse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// holes restored to devices still hold old data, see writeSparse
+	path := filepath.Join(dir, "device")
+	stale := bytes.Repeat([]byte{0xff}, 3*1048576)
+	if err := ioutil.WriteFile(path, stale, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := zeroRange(f, 1000, 2*1048576); err != nil {
+		t.Fatal(err)
+	}
+	want := append([]byte{}, stale...)
+	copy(want[1000:1000+2*1048576], make([]byte, 2*1048576))
+	if got, _ := ioutil.ReadFile(path); !bytes.Equal(got, want) {
+		t.Error("range not zeroed as expected")
+	}
+}
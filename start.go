@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Groups with start = "manual" ignore stream requests of their clients;
+// clients wait until an operator starts the group in the web UI or using
+// POST /startGroup. The chain is wired from the hosts ready at that time
+// (see ordering.go), so boot order does not matter.
+const (
+	START_AUTO   = "auto"
+	START_MANUAL = "manual"
+)
+
+// startGroup starts streaming to the ready hosts of manual group groupName
+func startGroup(groupName string) error {
+	mutex.Lock()
+	group := getClientgroupByKey(groupName)
+	var ready []string
+	var err error
+	if group.Name == "" {
+		err = fmt.Errorf("no client group %s", groupName)
+	} else if group.Start != START_MANUAL {
+		err = fmt.Errorf("group %s starts automatically", groupName)
+	} else if streamingGroups[groupName] {
+		err = fmt.Errorf("group %s is receiving an image", groupName)
+	} else if ready = readyHosts(group); len(ready) == 0 {
+		err = fmt.Errorf("no host of group %s is ready", groupName)
+	} else {
+		streamingGroups[groupName] = true
+	}
+	mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	log.Printf("Group %s started by operator, %d of %d hosts ready", groupName, len(ready), len(group.Hosts))
+	go func() {
+		ready = orderHosts(group, ready)
+		streamToGroup(dropStragglers(group, ready, "not ready when group was started"))
+		mutex.Lock()
+		delete(streamingGroups, groupName)
+		mutex.Unlock()
+		applyPendingGroup(groupName)
+	}()
+	return nil
+}
+
+func startGroupHandler(w http.ResponseWriter, request *http.Request) {
+	// web UI starts a manual group: POST group=<group>
+	responseCode := 200
+	if request.Method != "POST" {
+		responseCode = http.StatusMethodNotAllowed
+		http.Error(w, "POST required", responseCode)
+	} else if code := refuseOperator(w, request); code != 0 {
+		responseCode = code
+	} else if err := startGroup(request.FormValue("group")); err != nil {
+		responseCode = http.StatusConflict
+		http.Error(w, err.Error(), responseCode)
+		log.Printf("Cannot start group: %s", err)
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
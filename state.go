@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Master keeps client states in STATE_FILE and appends every deployment run
+// to the journal HISTORY_FILE, both in image storage. On startup, client
+// states and history are restored from them.
+const (
+	STATE_FILE          = "thePusher-state.json"
+	HISTORY_FILE        = "thePusher-history.jsonl"
+	STATE_SAVE_INTERVAL = time.Second // state is saved at most this often
+)
+
+type masterState struct {
+	Clients         map[string]ClientInfo
+	AssignedClients map[string]string
+	ClientIDs       map[string]string // clients keep them across a master restart
+}
+
+var stateDirty = make(chan bool, 1)
+
+// stateChanged schedules saving client states
+func stateChanged() {
+	select {
+	case stateDirty <- true:
+	default:
+	}
+}
+
+func stateSaver() {
+	for range stateDirty {
+		saveState()
+		time.Sleep(STATE_SAVE_INTERVAL)
+	}
+}
+
+func saveState() {
+	mutex.Lock()
+	stateJSON, err := json.MarshalIndent(masterState{clients, assignedClients, clientIDs}, "", "  ")
+	mutex.Unlock()
+	if err == nil {
+		err = writeFileAtomic(filepath.Join(imageStorage, STATE_FILE), stateJSON)
+	}
+	if err != nil {
+		log.Printf("%s: cannot save state: %s", red("ERROR"), err)
+	}
+}
+
+// writeFileAtomic replaces filename by data; a crash leaves either version
+func writeFileAtomic(filename string, data []byte) error {
+	tmpfile := filename + ".tmp"
+	f, err := os.OpenFile(tmpfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600) // holds client IDs
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpfile, filename)
+}
+
+// restoreState restores client states and deployment history saved by a
+// previous master run; clients no longer configured are dropped
+func restoreState() {
+	stateJSON, err := ioutil.ReadFile(filepath.Join(imageStorage, STATE_FILE))
+	if err == nil {
+		var state masterState
+		if err := json.Unmarshal(stateJSON, &state); err != nil {
+			log.Printf("%s: cannot restore state: %s", red("ERROR"), err)
+		} else {
+			mutex.Lock()
+			if state.AssignedClients != nil {
+				assignedClients = state.AssignedClients
+			}
+			if state.ClientIDs != nil {
+				clientIDs = state.ClientIDs
+			}
+			mergeConfig(currentConfig()) // re-adds hosts assigned at runtime
+			for host, saved := range state.Clients {
+				if c, ok := clients[host]; ok {
+					c.Status, c.Progress, c.Bottleneck, c.Error = saved.Status, saved.Progress, saved.Bottleneck, saved.Error
+					if c.Address == "" {
+						c.Address = saved.Address
+					}
+					clients[host] = c
+				}
+			}
+			mutex.Unlock()
+			log.Printf("Restored state of %d clients", len(state.Clients))
+		}
+	}
+	loadHistory()
+}
+
+func loadHistory() {
+	f, err := os.Open(filepath.Join(imageStorage, HISTORY_FILE))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	index := map[int]int{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1048576), 64*1048576)
+	for scanner.Scan() {
+		var d Deployment
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			// e.g. last line cut short by a crash
+			log.Printf("Skipping invalid history record: %s", err)
+			continue
+		}
+		if i, ok := index[d.ID]; ok {
+			deployments[i] = d
+		} else {
+			index[d.ID] = len(deployments)
+			deployments = append(deployments, d)
+		}
+	}
+	// clients of a run interrupted by the restart never report its end;
+	// its hosts failed, no stream survives a restart
+	mutex.Lock()
+	streamingGroups = map[string]bool{}
+	aborted := []Deployment{}
+	for i := range deployments {
+		d := &deployments[i]
+		if d.Status != DEPLOYMENT_RUNNING {
+			continue
+		}
+		for _, hostname := range d.group().Hosts {
+			if c, ok := clients[hostname]; ok && (c.Status == STATUS_BUSY || c.Status == STATUS_READY_WAITING) {
+				c.Status, c.Error = STATUS_ERROR, "master restarted"
+				clients[hostname] = c
+			}
+		}
+		d.abort("master restarted")
+		d.Hosts = deploymentHosts(d.group())
+		aborted = append(aborted, *d)
+	}
+	mutex.Unlock()
+	for _, d := range aborted {
+		log.Printf("Deployment %d to group %s failed, master restarted", d.ID, d.Group)
+		appendHistory(d)
+	}
+	if len(aborted) > 0 {
+		stateChanged()
+	}
+	log.Printf("Loaded %d deployments from history", len(deployments))
+}
+
+func appendHistory(d Deployment) {
+	record, _ := json.Marshal(d)
+	f, err := os.OpenFile(filepath.Join(imageStorage, HISTORY_FILE), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err == nil {
+		_, err = f.Write(append(record, '\n'))
+		if err == nil {
+			err = f.Sync()
+		}
+		f.Close()
+	}
+	if err != nil {
+		log.Printf("%s: cannot write history: %s", red("ERROR"), err)
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const TAR_XATTR_PREFIX = "SCHILY.xattr." // PAX records holding extended attributes
+
+// extractTar unpacks the tar stream r into directory destination,
+// preserving ownership, permissions, modification times, links and xattrs
+func extractTar(r io.Reader, destination string) error {
+	if err := os.MkdirAll(destination, 0755); err != nil {
+		return err
+	}
+	// directories get their metadata applied last, as extracting
+	// their contents would change mtime or fail if read-only
+	directories := []*tar.Header{}
+	archive := tar.NewReader(r)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		target, err := tarTarget(destination, header.Name)
+		if err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Extracting %s", target)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			// replace anything but a directory, chmod would follow a symlink
+			if info, err := os.Lstat(target); err == nil && !info.IsDir() {
+				os.Remove(target)
+			}
+			if err := os.Mkdir(target, 0700); err != nil && !os.IsExist(err) {
+				return err
+			}
+			directories = append(directories, header)
+			continue
+		case tar.TypeReg, tar.TypeRegA:
+			err = extractTarFile(archive, target)
+		case tar.TypeSymlink:
+			os.RemoveAll(target)
+			err = os.Symlink(header.Linkname, target)
+		case tar.TypeLink:
+			var source string
+			if source, err = tarLinkSource(destination, header.Linkname); err == nil {
+				os.RemoveAll(target)
+				err = os.Link(source, target)
+			}
+		case tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+			os.RemoveAll(target)
+			err = makeNode(target, header)
+		default:
+			log.Printf("Skipping %s: unsupported tar entry type %q", header.Name, header.Typeflag)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := setTarMetadata(target, header); err != nil {
+			return err
+		}
+	}
+	for i := len(directories) - 1; i >= 0; i-- {
+		target, err := tarTarget(destination, directories[i].Name)
+		if err != nil {
+			return err
+		}
+		// a later entry may have replaced the directory
+		if info, err := os.Lstat(target); err != nil || !info.IsDir() {
+			log.Printf("Not setting metadata of %s: no longer a directory", target)
+			continue
+		}
+		if err := setTarMetadata(target, directories[i]); err != nil {
+			return err
+		}
+	}
+	syscall.Sync()
+	return nil
+}
+
+// tarTarget returns the path of a tar entry within destination, refusing
+// entries that would end up outside of it -- by name, or by a symlink in
+// one of its parent directories, created by the archive or present before
+func tarTarget(destination, name string) (string, error) {
+	destination = filepath.Clean(destination)
+	target := filepath.Join(destination, name)
+	if target == destination {
+		return target, nil
+	}
+	prefix := destination
+	if !strings.HasSuffix(prefix, string(os.PathSeparator)) {
+		prefix += string(os.PathSeparator) // unless destination is the root
+	}
+	if !strings.HasPrefix(target, prefix) {
+		return "", fmt.Errorf("tar entry %s points outside of %s", name, destination)
+	}
+	parent := destination
+	for _, component := range strings.Split(filepath.Dir(target)[len(destination):], string(os.PathSeparator)) {
+		if component == "" {
+			continue
+		}
+		parent = filepath.Join(parent, component)
+		info, err := os.Lstat(parent)
+		if os.IsNotExist(err) {
+			break // created by extractTar
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("tar entry %s refused: %s is a symlink", name, parent)
+		}
+	}
+	return target, nil
+}
+
+// tarLinkSource returns the path of the file a hard link entry links to;
+// as chown and chmod of the link change the source, it must not be a symlink
+func tarLinkSource(destination, linkname string) (string, error) {
+	source, err := tarTarget(destination, linkname)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Lstat(source)
+	if err != nil {
+		return "", err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return "", fmt.Errorf("hard link to %s refused: it is a symlink", linkname)
+	}
+	return source, nil
+}
+
+func extractTarFile(archive *tar.Reader, target string) error {
+	// replace, don't write through, existing files -- they may be hard linked
+	os.RemoveAll(target)
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, archive); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// setTarMetadata applies ownership, xattrs, permissions and mtime of header to target
+func setTarMetadata(target string, header *tar.Header) error {
+	if err := os.Lchown(target, header.Uid, header.Gid); err != nil {
+		return err
+	}
+	symlink := header.Typeflag == tar.TypeSymlink
+	for key, value := range header.PAXRecords {
+		if strings.HasPrefix(key, TAR_XATTR_PREFIX) {
+			if err := setXattr(target, strings.TrimPrefix(key, TAR_XATTR_PREFIX), value, symlink); err != nil {
+				log.Printf("Cannot set extended attribute %s on %s: %s", key, target, err)
+			}
+		}
+	}
+	if symlink {
+		return nil
+	}
+	// after chown, which clears setuid/setgid bits
+	if err := os.Chmod(target, header.FileInfo().Mode()&(os.ModePerm|os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+		return err
+	}
+	return os.Chtimes(target, header.ModTime, header.ModTime)
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"archive/tar"
+	"errors"
+	"syscall"
+)
+
+// makeNode creates the device or fifo described by header
+func makeNode(target string, header *tar.Header) error {
+	mode := uint32(header.Mode & 07777)
+	switch header.Typeflag {
+	case tar.TypeChar:
+		mode |= syscall.S_IFCHR
+	case tar.TypeBlock:
+		mode |= syscall.S_IFBLK
+	case tar.TypeFifo:
+		mode |= syscall.S_IFIFO
+	}
+	dev := header.Devmajor<<24 | header.Devminor
+	return syscall.Mknod(target, mode, int(dev))
+}
+
+// setXattr is not supported on mac clients yet
+func setXattr(target string, name string, value string, symlink bool) error {
+	return errors.New("extended attributes not supported on darwin")
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"archive/tar"
+	"syscall"
+)
+
+// makeNode creates the device or fifo described by header
+func makeNode(target string, header *tar.Header) error {
+	mode := uint32(header.Mode & 07777)
+	switch header.Typeflag {
+	case tar.TypeChar:
+		mode |= syscall.S_IFCHR
+	case tar.TypeBlock:
+		mode |= syscall.S_IFBLK
+	case tar.TypeFifo:
+		mode |= syscall.S_IFIFO
+	}
+	major, minor := uint64(header.Devmajor), uint64(header.Devminor)
+	dev := (minor & 0xff) | (major&0xfff)<<8 | (minor&^0xff)<<12 | (major&^0xfff)<<32
+	return syscall.Mknod(target, mode, int(dev))
+}
+
+// setXattr sets an extended attribute; symlinks are not supported by
+// the syscall package, their attributes are skipped
+func setXattr(target string, name string, value string, symlink bool) error {
+	if symlink {
+		return nil
+	}
+	return syscall.Setxattr(target, name, []byte(value), 0)
+}
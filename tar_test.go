@@ -0,0 +1,83 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTarTarget(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	destination := filepath.Join(dir, "dest")
+	outside := filepath.Join(dir, "outside")
+	os.MkdirAll(filepath.Join(destination, "sub"), 0755)
+	os.Mkdir(outside, 0755)
+	os.Symlink(outside, filepath.Join(destination, "link"))
+	os.Symlink("../../outside", filepath.Join(destination, "sub", "uplink"))
+	os.Symlink("sub", filepath.Join(destination, "inlink"))
+
+	tests := []struct {
+		destination string
+		name        string
+		want        string // "" if refused
+	}{
+		{destination, ".", destination},
+		{destination, "a/b", filepath.Join(destination, "a/b")},
+		{destination, "./sub/file", filepath.Join(destination, "sub/file")},
+		{destination, "new/dir/file", filepath.Join(destination, "new/dir/file")},
+		{destination + "/", "file", filepath.Join(destination, "file")},
+		{destination, "a/../b", filepath.Join(destination, "b")},
+		// absolute names are extracted below destination
+		{destination, "/etc/passwd", filepath.Join(destination, "etc/passwd")},
+		{destination, "../outside/file", ""},
+		{destination, "sub/../../outside", ""},
+		{destination, "..", ""},
+		// symlinks in parent directories, even pointing inside, are refused
+		{destination, "link/file", ""},
+		{destination, "link/new/file", ""},
+		{destination, "sub/uplink/file", ""},
+		{destination, "inlink/file", ""},
+		// the symlink itself may be replaced
+		{destination, "link", filepath.Join(destination, "link")},
+		{"/", "usr/file", "/usr/file"},
+		{"/", "../usr/file", "/usr/file"},
+		{"/", ".", "/"},
+	}
+	for _, test := range tests {
+		got, err := tarTarget(test.destination, test.name)
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("%s in %s: not refused, got %s", test.name, test.destination, got)
+			}
+		} else if err != nil {
+			t.Errorf("%s in %s: %s", test.name, test.destination, err)
+		} else if got != test.want {
+			t.Errorf("%s in %s: got %s, want %s", test.name, test.destination, got, test.want)
+		}
+	}
+}
+
+func TestTarLinkSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(filepath.Join(dir, "file"), nil, 0644)
+	os.Symlink("/etc/passwd", filepath.Join(dir, "symlink"))
+
+	if _, err := tarLinkSource(dir, "file"); err != nil {
+		t.Errorf("hard link to file refused: %s", err)
+	}
+	if _, err := tarLinkSource(dir, "symlink"); err == nil {
+		t.Error("hard link to symlink not refused")
+	}
+	if _, err := tarLinkSource(dir, "../file"); err == nil {
+		t.Error("hard link outside of destination not refused")
+	}
+}
@@ -20,13 +20,31 @@ image "ubuntu1604" {
   # comment for personal reference and display on clients
   comment     = "Ubuntu 16.04 LTS"
 
+  # optional md5 and/or sha256 checksum; verified by every client while streaming.
+  # checksumOf may be FILE (default; image file as stored on master) or
+  # DATA (decompressed image data as written to destination)
+  # sha256      = "..."
+  # checksumOf  = "FILE"
+
   # destination device or folder for image
   destination = "/dev/sda1"
 
-  # image types supported: IMG | TAR
+  # image types supported: IMG | TAR | SPARSE | DISK
+  # SPARSE images contain only non-zero blocks (see put-image --sparse) or,
+  # for ext2/3/4 file systems, only allocated blocks;
+  # zero blocks are skipped on restore, not overwritten.
+  # TAR images are extracted into destination folder (e.g. a mounted,
+  # pre-partitioned disk), preserving ownership, permissions, links and xattrs;
+  # create them using tar --xattrs --numeric-owner.
+  # DISK images hold a partition table and one payload per partition,
+  # destination is a whole disk then (e.g. "/dev/sda"). Clients recreate
+  # the partition table using sfdisk. See make-disk-image and disk.go.
   type        = "IMG"
 
-  # compression may be one of NONE | BZ2 | GZIP
+  # compression may be one of NONE | BZ2 | GZ | ZSTD | XZ
+  # ZSTD and XZ (if compressed using xz -T0) decompress on clients using all
+  # cores; BZ2 and GZ decompress on a single core only and may limit restore
+  # speed, so prefer ZSTD or XZ for large images
   compression = "NONE"
 
   # preImage and postImage will be executed using sh -c "...commands..."
@@ -45,6 +63,19 @@ image "fedora25" {
   compression = "BZ2"
 }
 
+### UPLOADS ###################################################################
+
+# optional policy for images uploaded using put-image (/saveImage).
+# Uploads are accepted from sources listed in allow, or carrying a token
+# issued on master using: curl http://localhost:8080/uploadToken/<filename>
+# Without this block, uploads require a token.
+upload {
+  # source CIDRs or IPs allowed to upload without token
+  allow   = ["192.168.78.0/24"]
+  # maximum size of an upload in MB; 0 (default) for no limit
+  maxSize = 102400
+}
+
 ### CLIENT GROUPS #############################################################
 
 # provide a unique name per client group
@@ -54,8 +85,65 @@ clientgroup "test1" {
   image = "ubuntu1604"
 
   # list of hosts who will receive named image
-  # ideally, the last client in list should be booted last (as it triggers image stream)
+  # ideally, the last client in list should be booted last (as it triggers image
+  # stream) -- unless using start = "manual", see below
+  # hosts may be given by IP address, MAC address (of any NIC), DMI system UUID
+  # (see /sys/class/dmi/id/product_uuid) or hostname; for all but IP addresses,
+  # master learns the client's current IP when it asks for its task.
   hosts = ["192.168.78.158","192.168.78.133", "192.168.78.134"]
+  # hosts = ["52:54:00:12:34:56", "4c4c4544-0042-3510-8052-b7c04f4e5931", "pc-lab-03"]
+
+  # optional: let each host forward the image to up to fanout hosts,
+  # forming a tree (in hosts list order) instead of a linear chain
+  # fanout = 2
+
+  # optional: split hosts into several chains (in hosts list order, after
+  # ordering) that master feeds in parallel from a single read of the image
+  # chains = 2
+
+  # optional: port clients listen on for the image stream (default 8080)
+  # clientPort = 8080
+
+  # optional: don't let a host that never boots block the group. Once the
+  # first host is ready, wait at most readyTimeout seconds; if minReady
+  # hosts (default 1) are ready by then, the missing ones are dropped from
+  # this run and streaming starts. Without readyTimeout, all hosts must be ready.
+  # readyTimeout = 300
+  # minReady = 2
+
+  # optional: "manual" lets clients wait until an operator presses Start in
+  # the web UI (or: curl -d group=test1 http://master:8080/startGroup); the
+  # chain is then formed by the hosts ready at that time. Default is "auto".
+  # start = "manual"
+
+  # optional: order of the chain. "list" (default) follows hosts,
+  # "topology" keeps hosts by switch and rack (see host blocks below), so the
+  # stream crosses each inter-switch uplink only once; switches come in the
+  # order of their first listed host, so list a host next to master first.
+  # "measured" lets the ready clients probe each other's round trip time.
+  # ordering = "topology"
+}
+
+# optional location labels of hosts, used by ordering = "topology"
+host "192.168.78.158" {
+  switch = "sw1"
+  rack   = "r1"
+}
+host "192.168.78.133" {
+  switch = "sw2"
+}
+
+# multicast example: master sends the image to all hosts at once using UDP
+# multicast; clients request retransmission of lost datagrams from master.
+# Requires a switch that handles multicast well (IGMP snooping).
+# Not supported with --tls, as datagrams are not authenticated.
+clientgroup "roomB" {
+  image = "fedora25"
+  hosts = ["192.168.78.150", "192.168.78.151", "192.168.78.152"]
+  transport = "multicast"
+  # optional, defaults shown
+  multicastAddr = "239.255.80.80:8081"
+  multicastRate = 100 # Mbit/s
 }
 
 # another client group example
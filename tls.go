@@ -0,0 +1,446 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// With --tls, master runs a built-in CA (stored in image storage) that
+// issues short-lived certificates to clients when they fetch their task.
+// Clients pin the CA by fingerprint and require these certificates from
+// anyone PUTting to /receiveImage; master identifies clients by them.
+// Master and clients renew their certificates once two thirds of their
+// lifetime have passed, so they may run (or wait) for any time.
+const (
+	PKI_DIR              = "pki"
+	CA_LIFETIME          = 10 * 365 * 24 * time.Hour
+	MASTER_CERT_LIFETIME = 30 * 24 * time.Hour
+	CLIENT_CERT_LIFETIME = 24 * time.Hour
+	CERT_RETRY_INTERVAL  = time.Minute // after a failed renewal
+)
+
+var useTLS bool
+var caFingerprint string // hex SHA-256 of CA certificate clients expect
+var scheme = "http"      // "https" with --tls
+var masterCA *certAuthority
+var listenerTLS *tls.Config // of client's /receiveImage listener
+
+// ownCertificate is presented by master or client, in listeners and when
+// connecting; renewCertificate replaces it before it expires
+var ownCertificate struct {
+	sync.Mutex
+	certificate *tls.Certificate
+}
+
+type certAuthority struct {
+	cert    *x509.Certificate
+	certPEM []byte
+	key     *ecdsa.PrivateKey
+}
+
+// MASTER
+
+// setupMasterTLS loads or creates the CA and returns the master listener's
+// TLS configuration. Master uses the same certificate to connect to clients.
+func setupMasterTLS() *tls.Config {
+	var err error
+	if masterCA, err = loadOrCreateCA(filepath.Join(imageStorage, PKI_DIR)); err != nil {
+		log.Fatalf("Cannot set up CA: %s", err)
+	}
+	log.Printf("CA fingerprint (pass to clients): %s", certFingerprint(masterCA.cert.Raw))
+
+	certificate, err := issueMasterCertificate()
+	if err != nil {
+		log.Fatalf("Cannot issue master certificate: %s", err)
+	}
+	setCertificate(certificate)
+	useClientCertificate(masterCA.cert)
+	go renewCertificate("master", MASTER_CERT_LIFETIME, issueMasterCertificate)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(masterCA.cert)
+	return &tls.Config{
+		GetCertificate: getCertificate,
+		ClientCAs:      pool,
+		// web UI and put-image have no certificates; client API checks them
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}
+}
+
+// issueMasterCertificate issues master's certificate, for a new key
+func issueMasterCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	hostname, _ := os.Hostname()
+	certDER, err := masterCA.issue("thePusher master", &key.PublicKey, localIPs(), []string{hostname, "localhost"}, MASTER_CERT_LIFETIME)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return newCertificate(certDER, masterCA.cert.Raw, key)
+}
+
+// renewCertificate replaces our certificate using issue whenever two
+// thirds of its lifetime have passed; it runs forever
+func renewCertificate(owner string, lifetime time.Duration, issue func() (tls.Certificate, error)) {
+	for {
+		ownCertificate.Lock()
+		expires := ownCertificate.certificate.Leaf.NotAfter
+		ownCertificate.Unlock()
+		time.Sleep(time.Until(expires.Add(-lifetime / 3)))
+		certificate, err := issue()
+		for err != nil {
+			log.Printf("Cannot renew %s certificate (expires %s): %s", owner, expires.Format(time.RFC3339), err)
+			time.Sleep(CERT_RETRY_INTERVAL)
+			certificate, err = issue()
+		}
+		setCertificate(certificate)
+		log.Printf("Renewed %s certificate, valid until %s", owner, certificate.Leaf.NotAfter.Format(time.RFC3339))
+	}
+}
+
+// renewClientCertificateHandler issues a new certificate to a client whose
+// current one is still valid
+func renewClientCertificateHandler(w http.ResponseWriter, request *http.Request) {
+	responseCode := 200
+	host, ok := requestClient(request)
+	if request.Method != "POST" {
+		responseCode = http.StatusMethodNotAllowed
+		http.Error(w, "POST required", responseCode)
+	} else if !ok {
+		responseCode = http.StatusForbidden
+		http.Error(w, "Unknown client", responseCode)
+	} else {
+		csr, _ := ioutil.ReadAll(io.LimitReader(request.Body, 65536))
+		certificate, err := issueClientCertificate(csr, clientAddress(request))
+		if err != nil {
+			responseCode = http.StatusBadRequest
+			http.Error(w, err.Error(), responseCode)
+			log.Printf("Cannot renew certificate of %s: %s", host, err)
+		} else {
+			w.Header().Set("Content-Type", "application/x-pem-file")
+			w.Write([]byte(certificate))
+		}
+	}
+
+	if verbose {
+		log.Printf("%s %3d %s %s", request.RemoteAddr, responseCode, request.Method, request.URL.Path)
+	}
+}
+
+func loadOrCreateCA(dir string) (*certAuthority, error) {
+	certFile, keyFile := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+	if certPEM, err := ioutil.ReadFile(certFile); err == nil {
+		keyPEM, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		pair, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+		key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("CA key is not an ECDSA key")
+		}
+		return &certAuthority{cert: cert, certPEM: certPEM, key: key}, nil
+	}
+
+	log.Printf("Creating new CA in %s", dir)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          randomSerial(),
+		Subject:               pkix.Name{CommonName: "thePusher CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(CA_LIFETIME),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(certFile, certPEM, 0644); err != nil {
+		return nil, err
+	}
+	cert, _ := x509.ParseCertificate(certDER)
+	return &certAuthority{cert: cert, certPEM: certPEM, key: key}, nil
+}
+
+// issue signs a certificate for server and client authentication
+func (ca *certAuthority) issue(name string, publicKey interface{}, ips []net.IP, dnsNames []string, lifetime time.Duration) ([]byte, error) {
+	template := &x509.Certificate{
+		SerialNumber: randomSerial(),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-5 * time.Minute), // tolerate clock skew
+		NotAfter:     time.Now().Add(lifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  ips,
+		DNSNames:     dnsNames,
+	}
+	return x509.CreateCertificate(rand.Reader, template, ca.cert, publicKey, ca.key)
+}
+
+// issueClientCertificate signs the PEM encoded CSR of client clientIP
+func issueClientCertificate(csrPEM []byte, clientIP string) (string, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return "", errors.New("no certificate request found")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return "", err
+	}
+	// the requested subject is ignored; certificate is bound to client's address
+	certDER, err := masterCA.issue(clientIP, csr.PublicKey, []net.IP{net.ParseIP(clientIP)}, nil, CLIENT_CERT_LIFETIME)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})), nil
+}
+
+// clientAddress returns the IP of the client sending request. With TLS,
+// the client must present a certificate issued for that IP, otherwise
+// "" is returned.
+func clientAddress(request *http.Request) string {
+	clientIP, _, _ := net.SplitHostPort(request.RemoteAddr)
+	if !useTLS {
+		return clientIP
+	}
+	if request.TLS == nil || len(request.TLS.PeerCertificates) == 0 {
+		return ""
+	}
+	// certificate was verified against our CA during handshake
+	for _, ip := range request.TLS.PeerCertificates[0].IPAddresses {
+		if ip.Equal(net.ParseIP(clientIP)) {
+			return clientIP
+		}
+	}
+	return ""
+}
+
+func localIPs() []net.IP {
+	ips := []net.IP{}
+	addrs, _ := net.InterfaceAddrs()
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok {
+			ips = append(ips, ipNet.IP)
+		}
+	}
+	return ips
+}
+
+func randomSerial() *big.Int {
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	return serial
+}
+
+// CLIENT
+
+// setupClientTLS lets requests to master verify the CA against caFingerprint
+// until the CA certificate is retrieved along with the task
+func setupClientTLS() {
+	scheme = "https"
+	caFingerprint = strings.ToLower(strings.Replace(caFingerprint, ":", "", -1))
+	if caFingerprint == "" {
+		log.Printf("%s: no CA fingerprint given, master is not authenticated", red("WARNING"))
+	}
+	setTLSClientConfig(&tls.Config{
+		// chain is verified against pinned CA below
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if caFingerprint == "" {
+				return nil
+			}
+			for _, raw := range rawCerts {
+				if certFingerprint(raw) != caFingerprint {
+					continue
+				}
+				ca, err := x509.ParseCertificate(raw)
+				if err != nil {
+					return err
+				}
+				leaf, err := x509.ParseCertificate(rawCerts[0])
+				if err != nil {
+					return err
+				}
+				roots := x509.NewCertPool()
+				roots.AddCert(ca)
+				_, err = leaf.Verify(x509.VerifyOptions{Roots: roots})
+				return err
+			}
+			return errors.New("master certificate not issued by pinned CA")
+		},
+	})
+}
+
+// newCertificateRequest creates the client's key and a PEM encoded CSR for it
+func newCertificateRequest() (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Fatalf("Cannot create client key: %s", err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	if err != nil {
+		log.Fatalf("Cannot create certificate request: %s", err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+}
+
+// requestClientCertificate asks master for a new certificate, presenting
+// our current one; caPEM is the CA certificate received with the task
+func requestClientCertificate(caPEM []byte) (tls.Certificate, error) {
+	key, csr := newCertificateRequest()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("%s://%s/renewClientCertificate", scheme, masterAddr()), bytes.NewReader(csr))
+	request.Header.Set(HEADER_CLIENT_ID, cTask.ClientID)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	defer response.Body.Close()
+	certPEM, _ := ioutil.ReadAll(io.LimitReader(response.Body, 65536))
+	if response.StatusCode != http.StatusOK {
+		return tls.Certificate{}, fmt.Errorf("master answered %d: %s", response.StatusCode, strings.TrimSpace(string(certPEM)))
+	}
+	block, _ := pem.Decode(certPEM)
+	caBlock, _ := pem.Decode(caPEM)
+	if block == nil || caBlock == nil {
+		return tls.Certificate{}, errors.New("no certificate received")
+	}
+	return newCertificate(block.Bytes, caBlock.Bytes, key)
+}
+
+// clientServerTLS sets up certificate and CA received with the task; it
+// returns the configuration of the /receiveImage listener, requiring peers
+// to authenticate using a certificate of our CA.
+func clientServerTLS(task ClientTask, key *ecdsa.PrivateKey) *tls.Config {
+	block, _ := pem.Decode([]byte(task.Certificate))
+	caBlock, _ := pem.Decode([]byte(task.CACertificate))
+	if block == nil || caBlock == nil {
+		log.Fatal("Master did not issue a client certificate")
+	}
+	if caFingerprint != "" && certFingerprint(caBlock.Bytes) != caFingerprint {
+		log.Fatal("CA certificate sent by master does not match fingerprint")
+	}
+	ca, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		log.Fatalf("Invalid CA certificate: %s", err)
+	}
+	certificate, err := newCertificate(block.Bytes, caBlock.Bytes, key)
+	if err != nil {
+		log.Fatalf("Invalid client certificate: %s", err)
+	}
+	setCertificate(certificate)
+	useClientCertificate(ca)
+	go renewCertificate("client", CLIENT_CERT_LIFETIME, func() (tls.Certificate, error) {
+		return requestClientCertificate([]byte(task.CACertificate))
+	})
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	return &tls.Config{
+		GetCertificate: getCertificate,
+		ClientCAs:      pool,
+		ClientAuth:     tls.RequireAndVerifyClientCert,
+	}
+}
+
+// newCertificate returns the certificate certDER issued by CA caDER for key
+func newCertificate(certDER []byte, caDER []byte, key *ecdsa.PrivateKey) (tls.Certificate, error) {
+	leaf, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{certDER, caDER}, PrivateKey: key, Leaf: leaf}, nil
+}
+
+func setCertificate(certificate tls.Certificate) {
+	ownCertificate.Lock()
+	ownCertificate.certificate = &certificate
+	ownCertificate.Unlock()
+}
+
+func getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	ownCertificate.Lock()
+	defer ownCertificate.Unlock()
+	return ownCertificate.certificate, nil
+}
+
+// useClientCertificate lets all outgoing requests present our certificate
+// and trust certificates of ca only
+func useClientCertificate(ca *x509.Certificate) {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	setTLSClientConfig(&tls.Config{
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return getCertificate(nil)
+		},
+		RootCAs: pool,
+	})
+}
+
+func setTLSClientConfig(config *tls.Config) {
+	transport := http.DefaultTransport.(*http.Transport)
+	transport.TLSClientConfig = config
+	transport.CloseIdleConnections()
+}
+
+func certFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// listenAndServe serves HTTP or, with TLS, HTTPS on addr
+func listenAndServe(addr string, config *tls.Config) error {
+	if config == nil {
+		return http.ListenAndServe(addr, nil)
+	}
+	server := &http.Server{Addr: addr, TLSConfig: config}
+	return server.ListenAndServeTLS("", "")
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCA sets up masterCA in a temporary directory; call the returned
+// function to remove it again
+func testCA(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := masterCA
+	if masterCA, err = loadOrCreateCA(dir); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		masterCA = saved
+		os.RemoveAll(dir)
+	}
+}
+
+// testTransport restores the TLS configuration of outgoing requests
+func testTransport() func() {
+	transport := http.DefaultTransport.(*http.Transport)
+	savedConfig, savedScheme := transport.TLSClientConfig, scheme
+	return func() {
+		setTLSClientConfig(savedConfig)
+		scheme = savedScheme
+	}
+}
+
+func TestLoadCA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	created, err := loadOrCreateCA(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created.cert.IsCA || time.Until(created.cert.NotAfter) < CA_LIFETIME-time.Hour {
+		t.Errorf("CA certificate IsCA %v, valid until %s", created.cert.IsCA, created.cert.NotAfter)
+	}
+	if info, err := os.Stat(filepath.Join(dir, "ca.key")); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("CA key not private: %v %v", info.Mode(), err)
+	}
+	loaded, err := loadOrCreateCA(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if certFingerprint(loaded.cert.Raw) != certFingerprint(created.cert.Raw) || !loaded.key.Equal(created.key) {
+		t.Error("loaded CA differs from the one created")
+	}
+}
+
+func TestIssueClientCertificate(t *testing.T) {
+	defer testCA(t)()
+	_, csr := newCertificateRequest()
+	certPEM, err := issueClientCertificate(csr, "10.0.0.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode([]byte(certPEM))
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cert.IPAddresses) != 1 || !cert.IPAddresses[0].Equal(net.ParseIP("10.0.0.5")) || len(cert.DNSNames) != 0 {
+		t.Errorf("certificate bound to %v %v, want 10.0.0.5 only", cert.IPAddresses, cert.DNSNames)
+	}
+	if lifetime := time.Until(cert.NotAfter); lifetime > CLIENT_CERT_LIFETIME || lifetime < CLIENT_CERT_LIFETIME-time.Minute {
+		t.Errorf("certificate valid for %s, want %s", lifetime, CLIENT_CERT_LIFETIME)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(masterCA.cert)
+	for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth} {
+		if _, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{usage}}); err != nil {
+			t.Errorf("certificate does not verify for usage %d: %s", usage, err)
+		}
+	}
+
+	// a CSR signed by another key than the one requested is refused
+	csrBlock, _ := pem.Decode(csr)
+	tampered := append([]byte{}, csrBlock.Bytes...)
+	tampered[len(tampered)-5] ^= 0xff
+	invalid := map[string][]byte{
+		"no PEM":        []byte("garbage"),
+		"wrong type":    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: csrBlock.Bytes}),
+		"bad signature": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: tampered}),
+	}
+	for name, csr := range invalid {
+		if _, err := issueClientCertificate(csr, "10.0.0.5"); err == nil {
+			t.Errorf("%s: certificate issued", name)
+		}
+	}
+}
+
+func TestClientAddress(t *testing.T) {
+	defer testCA(t)()
+	defer func(saved bool) { useTLS = saved }(useTLS)
+	certificate := func(ip string) *x509.Certificate {
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		der, err := masterCA.issue(ip, &key.PublicKey, []net.IP{net.ParseIP(ip)}, nil, CLIENT_CERT_LIFETIME)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, _ := x509.ParseCertificate(der)
+		return cert
+	}
+	tests := []struct {
+		name   string
+		useTLS bool
+		peer   *x509.Certificate
+		want   string
+	}{
+		{"no TLS", false, nil, "10.0.0.5"},
+		{"certificate of address", true, certificate("10.0.0.5"), "10.0.0.5"},
+		{"certificate of other address", true, certificate("10.0.0.6"), ""},
+		{"no certificate", true, nil, ""},
+	}
+	for _, test := range tests {
+		useTLS = test.useTLS
+		request := httptest.NewRequest("GET", "/setClientStatus/OK", nil)
+		request.RemoteAddr = "10.0.0.5:40000"
+		if test.peer != nil {
+			request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{test.peer}}
+		}
+		if got := clientAddress(request); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+// TestPinnedCA connects to a master presenting a certificate of our CA,
+// verified by the CA fingerprint clients are given
+func TestPinnedCA(t *testing.T) {
+	defer testCA(t)()
+	defer testTransport()()
+	defer func(saved string) { caFingerprint = saved }(caFingerprint)
+	certificate, err := issueMasterCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	master := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	master.TLS = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	master.StartTLS()
+	defer master.Close()
+
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	other := *masterCA.cert
+	other.PublicKey = &otherKey.PublicKey
+	otherDER, _ := x509.CreateCertificate(rand.Reader, &other, &other, &otherKey.PublicKey, otherKey)
+	fingerprint := certFingerprint(masterCA.cert.Raw)
+	colons := ""
+	for i := 0; i < len(fingerprint); i += 2 {
+		colons += ":" + strings.ToUpper(fingerprint[i:i+2])
+	}
+	tests := []struct {
+		name        string
+		fingerprint string
+		ok          bool
+	}{
+		{"our CA", fingerprint, true},
+		{"our CA, colon separated, upper case", colons[1:], true},
+		{"other CA", certFingerprint(otherDER), false},
+	}
+	for _, test := range tests {
+		caFingerprint = test.fingerprint
+		setupClientTLS()
+		http.DefaultTransport.(*http.Transport).CloseIdleConnections()
+		response, err := http.Get(master.URL)
+		if err == nil {
+			response.Body.Close()
+		}
+		if (err == nil) != test.ok {
+			t.Errorf("%s: got error %v, want success %v", test.name, err, test.ok)
+		}
+	}
+}
+
+// TestClientListener has peers authenticate with certificates of our CA
+// when PUTting to a client's /receiveImage
+func TestClientListener(t *testing.T) {
+	defer testCA(t)()
+	defer testTransport()()
+	key, csr := newCertificateRequest()
+	certPEM, err := issueClientCertificate(csr, "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := clientServerTLS(ClientTask{Certificate: certPEM, CACertificate: string(masterCA.certPEM)}, key)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go http.Serve(tls.NewListener(listener, config), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := "https://" + listener.Addr().String() + "/receiveImage"
+
+	// clientServerTLS made outgoing requests present our certificate
+	response, err := http.Head(url)
+	if err != nil {
+		t.Fatalf("peer with certificate refused: %s", err)
+	}
+	response.Body.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(masterCA.cert)
+	anonymous := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+	if response, err := anonymous.Head(url); err == nil {
+		response.Body.Close()
+		t.Error("peer without certificate accepted")
+	}
+}
+
+func TestRenewCertificate(t *testing.T) {
+	defer testCA(t)()
+	issue := func(lifetime time.Duration) (tls.Certificate, error) {
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		der, err := masterCA.issue("test", &key.PublicKey, nil, nil, lifetime)
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		return newCertificate(der, masterCA.cert.Raw, key)
+	}
+	// issue backdates certificates, so this one is due for renewal
+	expiring, _ := issue(time.Second)
+	setCertificate(expiring)
+	go renewCertificate("test", time.Hour, func() (tls.Certificate, error) { return issue(time.Hour) })
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if current, _ := getCertificate(nil); current.Leaf.NotAfter.After(expiring.Leaf.NotAfter.Add(time.Minute)) {
+			return
+		}
+	}
+	t.Error("certificate not renewed")
+}
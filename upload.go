@@ -0,0 +1,131 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Uploads to /saveImage are accepted from sources within the CIDRs of the
+// upload policy, or with a token master issued for the uploaded file
+// (e.g. for a single capture job). Tokens are valid until the upload
+// completes or UPLOAD_TOKEN_LIFETIME passes.
+const (
+	HEADER_UPLOAD_TOKEN   = "X-Pusher-Token"
+	UPLOAD_TOKEN_LIFETIME = 24 * time.Hour
+)
+
+type Upload struct {
+	Allow   []string `hcl:"allow"`   // source CIDRs or IPs allowed to upload without token
+	MaxSize int64    `hcl:"maxSize"` // MB, 0 for no limit
+}
+
+type issuedToken struct {
+	filename string
+	expires  time.Time
+}
+
+var uploadTokens = make(map[string]issuedToken)
+var uploadTokensMutex sync.Mutex
+
+func uploadTokenHandler(w http.ResponseWriter, request *http.Request) {
+	// /uploadToken/<filename> issues a token to upload filename; only
+	// available on master itself: curl http://localhost:8080/uploadToken/my.img
+	clientIP, _, _ := net.SplitHostPort(request.RemoteAddr)
+	uriSegments := strings.Split(request.RequestURI, "/")
+	if len(uriSegments) < 3 || uriSegments[2] == "" {
+		http.Error(w, "Missing filename", http.StatusBadRequest)
+		return
+	}
+	filename := filepath.Base(uriSegments[2])
+	if ip := net.ParseIP(clientIP); ip == nil || !ip.IsLoopback() {
+		log.Printf("REFUSED upload token for %s to %s: not requested from master itself", filename, clientIP)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		http.Error(w, "Cannot create token", http.StatusInternalServerError)
+		return
+	}
+	token := hex.EncodeToString(raw)
+	expires := time.Now().Add(UPLOAD_TOKEN_LIFETIME)
+	uploadTokensMutex.Lock()
+	uploadTokens[token] = issuedToken{filename: filename, expires: expires}
+	uploadTokensMutex.Unlock()
+	log.Printf("Issued upload token for %s, valid until %s", filename, expires.Format(time.RFC1123))
+	fmt.Fprintln(w, token)
+}
+
+// refuseUpload checks an upload of filename against the upload policy;
+// it returns the HTTP status and reason if refused, or 0 and "".
+// size is the total size of the upload, -1 if unknown.
+func refuseUpload(request *http.Request, upload Upload, filename string, size int64) (int, string) {
+	clientIP, _, _ := net.SplitHostPort(request.RemoteAddr)
+	if !upload.allowedFrom(clientIP) {
+		token := request.Header.Get(HEADER_UPLOAD_TOKEN)
+		if token == "" {
+			return http.StatusForbidden, "source not allowed, no token given"
+		}
+		if !uploadTokenValid(token, filename) {
+			return http.StatusForbidden, "invalid or expired token"
+		}
+	}
+	if maxSize := upload.maxBytes(); maxSize > 0 && size > maxSize {
+		return http.StatusRequestEntityTooLarge, fmt.Sprintf("size %d exceeds maxSize of %d MB", size, upload.MaxSize)
+	}
+	return 0, ""
+}
+
+func (u Upload) allowedFrom(clientIP string) bool {
+	ip := net.ParseIP(clientIP)
+	for _, allowed := range u.Allow {
+		if _, ipNet, err := parseCIDR(allowed); err == nil && ip != nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func uploadTokenValid(token string, filename string) bool {
+	uploadTokensMutex.Lock()
+	defer uploadTokensMutex.Unlock()
+	issued, ok := uploadTokens[token]
+	if ok && time.Now().After(issued.expires) {
+		delete(uploadTokens, token)
+		return false
+	}
+	// a token for an image also covers its sparse upload, see put-image --sparse
+	return ok && (issued.filename == filename || issued.filename+".sparse" == filename)
+}
+
+// consumeUploadToken invalidates the token of a completed upload
+func consumeUploadToken(request *http.Request) {
+	uploadTokensMutex.Lock()
+	delete(uploadTokens, request.Header.Get(HEADER_UPLOAD_TOKEN))
+	uploadTokensMutex.Unlock()
+}
+
+// maxBytes returns the maximum upload size in bytes, 0 for no limit
+func (u Upload) maxBytes() int64 {
+	return u.MaxSize * 1024 * 1024
+}
+
+// parseCIDR parses a CIDR or a single IP address
+func parseCIDR(s string) (net.IP, *net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		if ip := net.ParseIP(s); ip != nil && ip.To4() != nil {
+			s += "/32"
+		} else {
+			s += "/128"
+		}
+	}
+	return net.ParseCIDR(s)
+}
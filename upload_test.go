@@ -0,0 +1,83 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// issueToken requests an upload token for filename from remoteAddr
+func issueToken(filename string, remoteAddr string) (int, string) {
+	request := httptest.NewRequest("GET", "/uploadToken/"+filename, nil)
+	request.RemoteAddr = remoteAddr
+	recorder := httptest.NewRecorder()
+	uploadTokenHandler(recorder, request)
+	return recorder.Code, strings.TrimSpace(recorder.Body.String())
+}
+
+func TestUploadToken(t *testing.T) {
+	if code, _ := issueToken("sda", "10.1.2.3:40000"); code != http.StatusForbidden {
+		t.Errorf("token issued to remote host (%d)", code)
+	}
+	if code, token := issueToken("sda", "127.0.0.1:40000"); code != http.StatusOK || len(token) != 32 {
+		t.Errorf("no token issued on master itself (%d %q)", code, token)
+	}
+}
+
+func TestRefuseUpload(t *testing.T) {
+	_, token := issueToken("sda", "127.0.0.1:40000")
+	_, otherToken := issueToken("sdb", "127.0.0.1:40000")
+	uploadTokensMutex.Lock()
+	uploadTokens["expired"] = issuedToken{filename: "sda", expires: time.Now().Add(-time.Second)}
+	uploadTokensMutex.Unlock()
+
+	upload := Upload{Allow: []string{"10.1.0.0/16", "192.168.1.5"}, MaxSize: 1}
+	tests := []struct {
+		name     string
+		source   string
+		token    string
+		filename string
+		size     int64
+		want     int
+	}{
+		{"CIDR", "10.1.2.3", "", "sda", 1000, 0},
+		{"single IP", "192.168.1.5", "", "sda", 1000, 0},
+		{"outside CIDRs", "192.168.1.6", "", "sda", 1000, http.StatusForbidden},
+		{"token", "192.168.1.6", token, "sda", 1000, 0},
+		{"token of sparse upload", "192.168.1.6", token, "sda.sparse", 1000, 0},
+		{"token of other file", "192.168.1.6", otherToken, "sda", 1000, http.StatusForbidden},
+		{"unknown token", "192.168.1.6", "0123456789abcdef", "sda", 1000, http.StatusForbidden},
+		{"expired token", "192.168.1.6", "expired", "sda", 1000, http.StatusForbidden},
+		{"unknown size", "10.1.2.3", "", "sda", -1, 0},
+		{"maxSize", "10.1.2.3", "", "sda", 1048576, 0},
+		{"above maxSize", "10.1.2.3", "", "sda", 1048577, http.StatusRequestEntityTooLarge},
+		{"token, above maxSize", "192.168.1.6", token, "sda", 1048577, http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		request := httptest.NewRequest("PUT", "/saveImage/"+test.filename, nil)
+		request.RemoteAddr = test.source + ":40000"
+		if test.token != "" {
+			request.Header.Set(HEADER_UPLOAD_TOKEN, test.token)
+		}
+		if code, reason := refuseUpload(request, upload, test.filename, test.size); code != test.want {
+			t.Errorf("%s: got %d %q, want %d", test.name, code, reason, test.want)
+		}
+	}
+	uploadTokensMutex.Lock()
+	_, kept := uploadTokens["expired"]
+	uploadTokensMutex.Unlock()
+	if kept {
+		t.Error("expired token kept")
+	}
+
+	// tokens are single use: a completed upload consumes its token
+	request := httptest.NewRequest("PUT", "/saveImage/sda", nil)
+	request.RemoteAddr = "192.168.1.6:40000"
+	request.Header.Set(HEADER_UPLOAD_TOKEN, token)
+	consumeUploadToken(request)
+	if code, _ := refuseUpload(request, upload, "sda", 1000); code != http.StatusForbidden {
+		t.Errorf("consumed token accepted (%d)", code)
+	}
+}
@@ -8,14 +8,24 @@ void 0!==c?null===c?void r.removeAttr(a,b):e&&"set"in e&&void 0!==(d=e.set(a,c,b
 
 var clientGroups;
 var selectedGroup = -1;
-var wsock = new WebSocket("ws://"+window.location.host+"/websocket");
+var wsock = new WebSocket((location.protocol == "https:" ? "wss://" : "ws://")+window.location.host+"/websocket");
 
 $.getJSON( "getClientGroups.json", function( data ) {
   clientGroups = data;
   var items = [];
   // create button for each group
   $.each( data, function( key, val ) {
-    $("<li/>", { id: "g"+key, text: val.Name, class: "gButton"}).click(function () { showGroup(key); }).appendTo($("#groups")) ;
+    var groupButton = $("<li/>", { id: "g"+key, text: val.Name, class: "gButton"}).click(function () { showGroup(key); });
+    if (val.Start == "manual") {
+      // clients of manual groups wait until started here
+      groupButton.append($("<button/>", { text: "Start", class: "start" }).click(function (event) {
+        event.stopPropagation();
+        $.post("startGroup", { group: val.Name })
+          .fail(function (xhr) { alert("Cannot start " + val.Name + ": " + xhr.responseText); });
+      }));
+    }
+    groupButton.append(progressBar());
+    groupButton.appendTo($("#groups"));
   });
   // add DIVs for all groups, all hidden by default
   $("#clients").html("");
@@ -24,19 +34,34 @@ $.getJSON( "getClientGroups.json", function( data ) {
     var groupDiv = $("<div/>", { id: groupDivId, text: "", class: "cGroup"}) ;
     $("#clients").append(groupDiv);
     $.each( clientGroups[key].Hosts, function( k, v ) {
-      var item = $("<li/>", { id: v, text: v, class: "cButton"}) ;
-      $("#"+groupDivId).append(item);
+      $("#"+groupDivId).append(hostButton(v));
     });
   });
   // get/set initial state for all clients upon page load
   $.getJSON( "getClientStati.json", function( data ) {
     $.each( data, function( host, hostdata ) {
-      var selector = host.replace(/\./g,"\\.");
-      $("#"+selector).addClass(hostdata.Status);
+      $("#"+hostId(host)).addClass(hostdata.Status);
+      showAddress(hostdata);
+      showChain(hostdata);
+      showUnconfirmed(hostdata);
+      showProgress(hostdata);
+    });
+  });
+  $.getJSON( "getUnassignedClients.json", function( data ) {
+    $.each( data, function( host, hostdata ) {
+      showUnassigned(hostdata);
     });
   });
 });
 
+function hostButton(host) {
+  var item = $("<li/>", { id: hostId(host), text: host, class: "cButton"}) ;
+  item.append($("<div/>", { class: "address" }));
+  item.append($("<div/>", { class: "chain" }));
+  item.append(progressBar());
+  return item;
+}
+
 function showGroup(n) {
   selectedGroup = n;
   $("#clients div.cGroup").hide();
@@ -55,7 +80,176 @@ wsock.onerror = function (event) {
 
 wsock.onmessage = function (event) {
   var data = $.parseJSON( event.data );
-  var hostaddr = data.Address;
-  var selector = hostaddr.replace(/\./g,"\\.");
-  $("#"+selector).removeClass("NONE BUSY WAIT ERROR DONE").addClass(data.Status);
+  if (data.Status == "RELOADED") {
+    // configuration changed: redraw groups and clients
+    location.reload();
+    return;
+  }
+  if (data.Status == "UNASSIGNED") {
+    showUnassigned(data);
+    return;
+  }
+  $("#u"+hostId(data.Host)).remove();
+  addHost(data);
+  $("#"+hostId(data.Host)).removeClass("NONE BUSY WAIT ERROR DONE BADSUM").addClass(data.Status);
+  showAddress(data);
+  showChain(data);
+  showUnconfirmed(data);
+  showProgress(data);
+}
+
+// hostId turns a hosts entry (IP, MAC, UUID or hostname) into an element id
+function hostId(host) {
+  return "h_" + host.replace(/[^A-Za-z0-9_-]/g, "_");
+}
+
+// showAddress shows the learned IP of clients not listed by IP
+function showAddress(data) {
+  $("#"+hostId(data.Host)).find(".address").text(data.Address != data.Host ? data.Address : "");
+}
+
+// showChain shows which chain a client is in, for groups with several chains
+function showChain(data) {
+  $("#"+hostId(data.Host)).find(".chain").text(data.Chain ? "chain " + data.Chain : "");
+}
+
+// showUnconfirmed lets the operator confirm a new address claiming a client (TLS)
+function showUnconfirmed(data) {
+  var item = $("#"+hostId(data.Host));
+  item.find(".confirm").remove();
+  if (data.Unconfirmed) {
+    item.append($("<button/>", { text: "Confirm " + data.Unconfirmed, class: "confirm" }).click(function () {
+      $.post("confirmClient", { host: data.Host })
+        .fail(function (xhr) { alert("Cannot confirm " + data.Host + ": " + xhr.responseText); });
+    }));
+  }
+}
+
+/// unassigned clients
+
+// showUnassigned lists an unknown client, letting the operator assign it to a group
+function showUnassigned(data) {
+  var item = $("#u"+hostId(data.Host));
+  if (item.length == 0) {
+    var select = $("<select/>");
+    $.each( clientGroups, function( key, val ) {
+      select.append($("<option/>", { value: val.Name, text: val.Name }));
+    });
+    var button = $("<button/>", { text: "Assign" }).click(function () {
+      $.post("assignClient", { host: data.Host, group: select.val() })
+        .fail(function (xhr) { alert("Cannot assign " + data.Host + ": " + xhr.responseText); });
+    });
+    item = $("<li/>", { id: "u"+hostId(data.Host), class: "cButton UNASSIGNED" });
+    item.append($("<div/>", { class: "identity" }), select, button);
+    $("#unassigned").append(item);
+  }
+  var identity = [data.Host, data.Address];
+  if (data.Identity && data.Identity.Hostname) {
+    identity.push(data.Identity.Hostname);
+  }
+  item.find(".identity").text(identity.join(" · "));
+}
+
+// addHost adds a client assigned at runtime to its group
+function addHost(data) {
+  if ($("#"+hostId(data.Host)).length != 0) {
+    return;
+  }
+  $.each( clientGroups, function( key, val ) {
+    if (val.Name == data.Group) {
+      val.Hosts.push(data.Host);
+      $("#"+key).append(hostButton(data.Host));
+    }
+  });
+}
+
+/// progress bars
+
+var progress = {}; // latest progress by hosts entry
+
+function progressBar() {
+  return $("<div/>", { class: "progress" }).append($("<div/>", { class: "bar" }))
+    .add($("<div/>", { class: "progressInfo" }));
+}
+
+function showProgress(data) {
+  progress[data.Host] = data.Progress;
+  var item = $("#"+hostId(data.Host));
+  renderProgress(item, data.Progress);
+  item.toggleClass("BOTTLENECK", data.Bottleneck === true);
+  // failed clients show why, others where they spend their time
+  item.attr("title", data.Error ? data.Error : waitTimes(data.Progress));
+  // group progress: all bytes received vs. all bytes to receive; ETA of slowest host
+  $.each( clientGroups, function( key, val ) {
+    if (val.Name != data.Group) {
+      return;
+    }
+    var sum = { Size: 0, Received: 0, Rate: 0 };
+    var eta = 0;
+    $.each( val.Hosts, function( k, host ) {
+      var p = progress[host];
+      if (p) {
+        sum.Size += p.Size;
+        sum.Received += p.Received;
+        eta = Math.max(eta, remainingSeconds(p));
+      }
+    });
+    renderProgress($("#g"+key), sum.Size > 0 ? sum : null, eta);
+  });
+}
+
+function renderProgress(item, p, eta) {
+  if (!p) {
+    item.find(".progress").hide();
+    item.find(".progressInfo").text("");
+    return;
+  }
+  var percent = p.Size > 0 ? Math.floor(100 * p.Received / p.Size) : 0;
+  var info = percent + "%";
+  if (p.Rate > 0) {
+    info += " · " + formatBytes(p.Rate) + "/s";
+  }
+  if (eta === undefined) {
+    eta = remainingSeconds(p);
+  }
+  if (p.Received < p.Size && eta > 0) {
+    info += " · ETA " + formatDuration(eta);
+  }
+  item.find(".progress").show();
+  item.find(".bar").css("width", percent + "%");
+  item.find(".progressInfo").text(info);
+}
+
+// waitTimes describes where a host spends its time
+function waitTimes(p) {
+  if (!p || p.Elapsed <= 0) {
+    return "";
+  }
+  var share = function (ms) { return Math.round(100 * ms / p.Elapsed) + "%"; };
+  return "waiting for disk " + share(p.DiskWait) + ", upstream " + share(p.UpstreamWait) +
+    ", neighbors " + share(p.NeighborWait);
+}
+
+function remainingSeconds(p) {
+  if (p.Received >= p.Size || p.Rate <= 0) {
+    return 0;
+  }
+  return (p.Size - p.Received) / p.Rate;
+}
+
+function formatBytes(n) {
+  var units = ["B", "KB", "MB", "GB", "TB"];
+  var i = 0;
+  while (n >= 1000 && i < units.length - 1) {
+    n /= 1000;
+    i++;
+  }
+  return n.toFixed(i > 0 ? 1 : 0) + " " + units[i];
+}
+
+function formatDuration(seconds) {
+  seconds = Math.ceil(seconds);
+  var m = Math.floor(seconds / 60);
+  var s = seconds % 60;
+  return m + ":" + (s < 10 ? "0" : "") + s;
 }
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"runtime"
+
+	"github.com/ulikunitz/xz"
+)
+
+// xz streams consist of blocks that are compressed independently:
+//
+//	stream header | block ... | index | stream footer
+//
+// xz -T (multi-threaded, the default as of xz 5.4) records compressed and
+// uncompressed size in each block header, so blocks can be split off the
+// stream without decoding it. newXZReader decodes such blocks concurrently,
+// each wrapped into a stream of its own; streams whose blocks lack their
+// sizes (xz -T1) are decoded sequentially.
+const (
+	XZ_HEADER_MAGIC = "\xfd7zXZ\x00"
+	XZ_FOOTER_MAGIC = "YZ"
+	XZ_MAX_BLOCK    = 1 << 30 // sanity limit for block sizes
+)
+
+// xzBlock is a unit of the decoded output, delivered in stream order
+type xzBlock struct {
+	stream []byte     // the block wrapped into a stream of its own
+	size   int64      // uncompressed size of the block
+	data   []byte     // decoded block
+	tail   io.Reader  // alternatively, decoder for the rest of the input
+	done   chan error // receives the outcome of decoding
+}
+
+type xzRecord struct {
+	unpadded     int64
+	uncompressed int64
+}
+
+// newXZReader decodes xz data read from r using all cores, see above
+func newXZReader(r io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	blocks := make(chan *xzBlock, runtime.NumCPU())
+	work := make(chan *xzBlock)
+	quit := make(chan bool)
+	for i := 0; i < runtime.NumCPU(); i++ {
+		go func() {
+			for b := range work {
+				b.decode()
+			}
+		}()
+	}
+	go func() {
+		defer close(blocks)
+		defer close(work)
+		send := func(b *xzBlock) bool {
+			select {
+			case blocks <- b:
+			case <-quit:
+				return false
+			}
+			if b.stream == nil {
+				return true
+			}
+			select {
+			case work <- b:
+				return true
+			case <-quit:
+				return false
+			}
+		}
+		if err := splitXZ(bufio.NewReaderSize(r, 1048576), send); err != nil {
+			b := &xzBlock{done: make(chan error, 1)}
+			b.done <- err
+			send(b)
+		}
+	}()
+	go func() {
+		defer close(quit)
+		for b := range blocks {
+			err := <-b.done
+			if err == nil && b.tail != nil {
+				_, err = io.Copy(pw, b.tail)
+			} else if err == nil {
+				_, err = pw.Write(b.data)
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return pr
+}
+
+// decode decompresses the block, verifying its check and recorded sizes
+func (b *xzBlock) decode() {
+	defer func() { b.stream = nil }()
+	xzReader, err := xz.NewReader(bytes.NewReader(b.stream))
+	if err != nil {
+		b.done <- err
+		return
+	}
+	b.data = make([]byte, b.size)
+	if _, err := io.ReadFull(xzReader, b.data); err != nil {
+		b.done <- err
+		return
+	}
+	extra, err := io.Copy(ioutil.Discard, xzReader)
+	if err == nil && extra > 0 {
+		err = errors.New("xz: block larger than recorded")
+	}
+	b.done <- err
+}
+
+// splitXZ passes the blocks of the xz streams read from r to send, in order.
+// It stops if send returns false, i.e. nobody is reading anymore.
+func splitXZ(r *bufio.Reader, send func(*xzBlock) bool) error {
+	for {
+		header := make([]byte, 12)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return fmt.Errorf("xz: reading stream header: %s", err)
+		}
+		if string(header[:6]) != XZ_HEADER_MAGIC ||
+			crc32.ChecksumIEEE(header[6:8]) != binary.LittleEndian.Uint32(header[8:]) {
+			return errors.New("xz: invalid stream header")
+		}
+		checkSize := xzCheckSize(header[7])
+		records := []xzRecord{}
+		for {
+			size, err := r.ReadByte()
+			if err != nil {
+				return fmt.Errorf("xz: reading block header: %s", err)
+			}
+			if size == 0 { // index indicator
+				break
+			}
+			blockHeader := make([]byte, (int(size)+1)*4)
+			blockHeader[0] = size
+			if _, err := io.ReadFull(r, blockHeader[1:]); err != nil {
+				return fmt.Errorf("xz: reading block header: %s", err)
+			}
+			compressed, uncompressed, err := xzBlockSizes(blockHeader)
+			if err != nil {
+				return err
+			}
+			if compressed < 0 || uncompressed < 0 {
+				if len(records) > 0 {
+					return errors.New("xz: block without recorded sizes")
+				}
+				// decode this and any following streams sequentially
+				consumed := append(header, blockHeader...)
+				tail, err := xz.NewReader(io.MultiReader(bytes.NewReader(consumed), r))
+				if err != nil {
+					return err
+				}
+				b := &xzBlock{tail: tail, done: make(chan error, 1)}
+				b.done <- nil
+				send(b)
+				return nil
+			}
+			data := make([]byte, (compressed+3)&^3+checkSize)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return fmt.Errorf("xz: reading block: %s", err)
+			}
+			record := xzRecord{int64(len(blockHeader)) + compressed + checkSize, uncompressed}
+			records = append(records, record)
+			b := &xzBlock{stream: xzBlockStream(header, blockHeader, data, record),
+				size: uncompressed, done: make(chan error, 1)}
+			if !send(b) {
+				return nil
+			}
+		}
+		indexSize, err := readXZIndex(r, records)
+		if err != nil {
+			return err
+		}
+		footer := make([]byte, 12)
+		if _, err := io.ReadFull(r, footer); err != nil {
+			return fmt.Errorf("xz: reading stream footer: %s", err)
+		}
+		if string(footer[10:]) != XZ_FOOTER_MAGIC || !bytes.Equal(footer[8:10], header[6:8]) ||
+			crc32.ChecksumIEEE(footer[4:10]) != binary.LittleEndian.Uint32(footer[:4]) ||
+			int64(binary.LittleEndian.Uint32(footer[4:8])+1)*4 != indexSize {
+			return errors.New("xz: invalid stream footer")
+		}
+		// stream padding, then possibly another stream
+		for {
+			padding, err := r.Peek(4)
+			if len(padding) == 0 && err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("xz: reading stream padding: %s", err)
+			}
+			if !bytes.Equal(padding, []byte{0, 0, 0, 0}) {
+				break
+			}
+			r.Discard(4)
+		}
+	}
+}
+
+// xzCheckSize returns the size of the integrity check field of blocks
+func xzCheckSize(checkType byte) int64 {
+	checkType &= 0x0f
+	if checkType == 0 {
+		return 0
+	}
+	// sizes grow in steps of three types: 4, 8, 16, 32 and 64 bytes
+	return 4 << uint((checkType-1)/3)
+}
+
+// xzBlockSizes returns compressed and uncompressed size recorded in a block
+// header, or -1 for sizes not recorded
+func xzBlockSizes(blockHeader []byte) (int64, int64, error) {
+	end := len(blockHeader) - 4
+	if crc32.ChecksumIEEE(blockHeader[:end]) != binary.LittleEndian.Uint32(blockHeader[end:]) {
+		return 0, 0, errors.New("xz: invalid block header")
+	}
+	flags := blockHeader[1]
+	sizes := []int64{-1, -1}
+	offset := 2
+	for i, present := range []byte{0x40, 0x80} {
+		if flags&present == 0 {
+			continue
+		}
+		size, n := binary.Uvarint(blockHeader[offset:end])
+		if n <= 0 || size == 0 || size > XZ_MAX_BLOCK {
+			return 0, 0, errors.New("xz: invalid or too large block size")
+		}
+		sizes[i] = int64(size)
+		offset += n
+	}
+	return sizes[0], sizes[1], nil
+}
+
+// xzBlockStream wraps a block into a stream of its own, so it can be decoded
+// independently of the others
+func xzBlockStream(header, blockHeader, data []byte, record xzRecord) []byte {
+	var stream bytes.Buffer
+	stream.Write(header)
+	stream.Write(blockHeader)
+	stream.Write(data)
+
+	index := []byte{0, 1}
+	varint := make([]byte, binary.MaxVarintLen64)
+	index = append(index, varint[:binary.PutUvarint(varint, uint64(record.unpadded))]...)
+	index = append(index, varint[:binary.PutUvarint(varint, uint64(record.uncompressed))]...)
+	for len(index)%4 != 0 {
+		index = append(index, 0)
+	}
+	crc := make([]byte, 4)
+	binary.LittleEndian.PutUint32(crc, crc32.ChecksumIEEE(index))
+	index = append(index, crc...)
+	stream.Write(index)
+
+	footer := make([]byte, 12)
+	binary.LittleEndian.PutUint32(footer[4:8], uint32(len(index)/4-1))
+	copy(footer[8:10], header[6:8])
+	copy(footer[10:], XZ_FOOTER_MAGIC)
+	binary.LittleEndian.PutUint32(footer[:4], crc32.ChecksumIEEE(footer[4:10]))
+	stream.Write(footer)
+	return stream.Bytes()
+}
+
+// crcByteReader computes the CRC32 of the bytes read
+type crcByteReader struct {
+	r   *bufio.Reader
+	crc hash.Hash32
+	n   int64
+}
+
+func (c *crcByteReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.crc.Write([]byte{b})
+		c.n++
+	}
+	return b, err
+}
+
+// readXZIndex verifies the index of a stream against the blocks read, its
+// indicator byte already consumed. It returns the size of the index.
+func readXZIndex(r *bufio.Reader, records []xzRecord) (int64, error) {
+	invalid := errors.New("xz: invalid index")
+	index := &crcByteReader{r: r, crc: crc32.NewIEEE()}
+	index.crc.Write([]byte{0})
+	index.n = 1
+	count, err := binary.ReadUvarint(index)
+	if err != nil || count != uint64(len(records)) {
+		return 0, invalid
+	}
+	for _, record := range records {
+		unpadded, err := binary.ReadUvarint(index)
+		if err != nil || int64(unpadded) != record.unpadded {
+			return 0, invalid
+		}
+		uncompressed, err := binary.ReadUvarint(index)
+		if err != nil || int64(uncompressed) != record.uncompressed {
+			return 0, invalid
+		}
+	}
+	for index.n%4 != 0 {
+		if b, err := index.ReadByte(); err != nil || b != 0 {
+			return 0, invalid
+		}
+	}
+	crc := make([]byte, 4)
+	if _, err := io.ReadFull(r, crc); err != nil || binary.LittleEndian.Uint32(crc) != index.crc.Sum32() {
+		return 0, invalid
+	}
+	return index.n + 4, nil
+}
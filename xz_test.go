@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os/exec"
+	"testing"
+
+	"github.com/ulikunitz/xz"
+)
+
+// xzImage returns deterministic, moderately compressible test data
+func xzImage() []byte {
+	image := make([]byte, 3*1048576+123)
+	for i := range image {
+		image[i] = byte(i * 7 % 251)
+		if i%1000 < 10 {
+			image[i] = byte(i / 1000)
+		}
+	}
+	return image
+}
+
+// xzMultiBlock compresses data using xz -T, as users do; the test is skipped
+// if xz is not installed
+func xzMultiBlock(t *testing.T, data []byte) []byte {
+	if _, err := exec.LookPath("xz"); err != nil {
+		t.Skip("xz not installed")
+	}
+	cmd := exec.Command("xz", "-T4", "--block-size=262144", "-c")
+	cmd.Stdin = bytes.NewReader(data)
+	compressed, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return compressed
+}
+
+// xzSingleBlock compresses data without recording block sizes
+func xzSingleBlock(t *testing.T, data []byte) []byte {
+	var compressed bytes.Buffer
+	w, err := xz.NewWriter(&compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data)
+	w.Close()
+	return compressed.Bytes()
+}
+
+func TestXZReader(t *testing.T) {
+	image := xzImage()
+	multiBlock := xzMultiBlock(t, image)
+	singleBlock := xzSingleBlock(t, image)
+	concatenated := append(append(append([]byte{}, multiBlock...), 0, 0, 0, 0), singleBlock...)
+	tests := []struct {
+		name       string
+		compressed []byte
+		want       []byte
+		blocks     int // passed to decoders by splitXZ, sequential tail included
+	}{
+		{"multi block", multiBlock, image, 13},
+		{"single block", singleBlock, image, 1},
+		{"concatenated, padded", concatenated, append(append([]byte{}, image...), image...), 14},
+	}
+	for _, test := range tests {
+		blocks := 0
+		err := splitXZ(bufio.NewReader(bytes.NewReader(test.compressed)), func(*xzBlock) bool {
+			blocks++
+			return true
+		})
+		if err != nil || blocks != test.blocks {
+			t.Errorf("%s: split into %d blocks (%v), want %d", test.name, blocks, err, test.blocks)
+		}
+		got, err := ioutil.ReadAll(newXZReader(bytes.NewReader(test.compressed)))
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%s: decoded %d bytes differ, want %d", test.name, len(got), len(test.want))
+		}
+	}
+}
+
+func TestXZReaderInvalid(t *testing.T) {
+	multiBlock := xzMultiBlock(t, xzImage())
+	corrupt := func(offset int) []byte {
+		c := append([]byte{}, multiBlock...)
+		c[offset] ^= 0x55
+		return c
+	}
+	tests := []struct {
+		name       string
+		compressed []byte
+	}{
+		{"no data", []byte{}},
+		{"stream header", corrupt(3)},
+		{"block header", corrupt(14)},
+		{"block data", corrupt(len(multiBlock) / 2)},
+		{"index", corrupt(len(multiBlock) - 20)},
+		{"stream footer", corrupt(len(multiBlock) - 2)},
+		{"truncated", multiBlock[:len(multiBlock)-100]},
+	}
+	for _, test := range tests {
+		if _, err := ioutil.ReadAll(newXZReader(bytes.NewReader(test.compressed))); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}